@@ -19,9 +19,11 @@ package v1beta1
 import (
 	"fmt"
 
+	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
 const (
@@ -66,6 +68,7 @@ func (c *AzureCluster) setNetworkSpecDefaults() {
 	c.SetNodeOutboundLBDefaults()
 	if c.Spec.ControlPlaneEnabled {
 		c.SetControlPlaneOutboundLBDefaults()
+		c.setPrivateLinkServiceDefaults()
 	}
 	if !c.Spec.ControlPlaneEnabled {
 		c.Spec.NetworkSpec.APIServerLB = nil
@@ -85,6 +88,9 @@ func (c *AzureCluster) setAzureEnvironmentDefault() {
 }
 
 func (c *AzureCluster) setVnetDefaults() {
+	if c.Spec.NetworkSpec.Vnet.ResourceGroup == "" {
+		c.Spec.NetworkSpec.Vnet.ResourceGroup = c.Spec.NetworkSpec.NetworkResourceGroup
+	}
 	if c.Spec.NetworkSpec.Vnet.ResourceGroup == "" {
 		c.Spec.NetworkSpec.Vnet.ResourceGroup = c.Spec.ResourceGroup
 	}
@@ -98,7 +104,7 @@ func (c *AzureCluster) setSubnetDefaults() {
 	clusterSubnet, err := c.Spec.NetworkSpec.GetSubnet(SubnetCluster)
 	clusterSubnetExists := err == nil
 	if clusterSubnetExists {
-		clusterSubnet.setClusterSubnetDefaults(c.ObjectMeta.Name)
+		clusterSubnet.setClusterSubnetDefaults(c.ObjectMeta.Name, c.Spec.NetworkSpec.DisableOutbound)
 		c.Spec.NetworkSpec.UpdateSubnet(clusterSubnet, SubnetCluster)
 	}
 
@@ -124,7 +130,7 @@ func (c *AzureCluster) setSubnetDefaults() {
 		}
 		nodeSubnetCounter++
 		nodeSubnetFound = true
-		subnet.setNodeSubnetDefaults(c.ObjectMeta.Name, nodeSubnetCounter)
+		subnet.setNodeSubnetDefaults(c.ObjectMeta.Name, nodeSubnetCounter, c.Spec.NetworkSpec.DisableOutbound)
 		c.Spec.NetworkSpec.Subnets[i] = subnet
 	}
 
@@ -141,17 +147,15 @@ func (c *AzureCluster) setSubnetDefaults() {
 			RouteTable: RouteTable{
 				Name: generateNodeRouteTableName(c.ObjectMeta.Name),
 			},
-			NatGateway: NatGateway{
-				NatGatewayClassSpec: NatGatewayClassSpec{
-					Name: generateNatGatewayName(c.ObjectMeta.Name),
-				},
-			},
+		}
+		if !c.Spec.NetworkSpec.DisableOutbound {
+			nodeSubnet.NatGateway.Name = generateNatGatewayName(c.ObjectMeta.Name)
 		}
 		c.Spec.NetworkSpec.Subnets = append(c.Spec.NetworkSpec.Subnets, nodeSubnet)
 	}
 }
 
-func (s *SubnetSpec) setNodeSubnetDefaults(clusterName string, index int) {
+func (s *SubnetSpec) setNodeSubnetDefaults(clusterName string, index int, disableOutbound bool) {
 	if s.Name == "" {
 		s.Name = withIndex(generateNodeSubnetName(clusterName), index)
 	}
@@ -169,7 +173,7 @@ func (s *SubnetSpec) setNodeSubnetDefaults(clusterName string, index int) {
 	// NAT gateway only supports the use of IPv4 public IP addresses for outbound connectivity.
 	// So default use the NAT gateway for outbound traffic in IPv4 cluster instead of loadbalancer.
 	// We assume that if the ID is set, the subnet already exists so we shouldn't add a NAT gateway.
-	if !s.IsIPv6Enabled() && s.ID == "" {
+	if !s.IsIPv6Enabled() && s.ID == "" && !disableOutbound {
 		if s.NatGateway.Name == "" {
 			s.NatGateway.Name = withIndex(generateNatGatewayName(clusterName), index)
 		}
@@ -192,7 +196,7 @@ func (s *SubnetSpec) setControlPlaneSubnetDefaults(clusterName string) {
 	s.SecurityGroup.SecurityGroupClass.setDefaults()
 }
 
-func (s *SubnetSpec) setClusterSubnetDefaults(clusterName string) {
+func (s *SubnetSpec) setClusterSubnetDefaults(clusterName string, disableOutbound bool) {
 	if s.Name == "" {
 		s.Name = generateClusterSubnetSubnetName(clusterName)
 	}
@@ -202,11 +206,13 @@ func (s *SubnetSpec) setClusterSubnetDefaults(clusterName string) {
 	if s.RouteTable.Name == "" {
 		s.RouteTable.Name = generateClustereRouteTableName(clusterName)
 	}
-	if s.NatGateway.Name == "" {
-		s.NatGateway.Name = generateClusterNatGatewayName(clusterName)
-	}
-	if !s.IsIPv6Enabled() && s.ID == "" && s.NatGateway.NatGatewayIP.Name == "" {
-		s.NatGateway.NatGatewayIP.Name = generateNatGatewayIPName(s.NatGateway.Name)
+	if !disableOutbound {
+		if s.NatGateway.Name == "" {
+			s.NatGateway.Name = generateClusterNatGatewayName(clusterName)
+		}
+		if !s.IsIPv6Enabled() && s.ID == "" && s.NatGateway.NatGatewayIP.Name == "" {
+			s.NatGateway.NatGatewayIP.Name = generateNatGatewayIPName(s.NatGateway.Name)
+		}
 	}
 	s.setDefaults(DefaultClusterSubnetCIDR)
 	s.SecurityGroup.SecurityGroupClass.setDefaults()
@@ -231,7 +237,11 @@ func (c *AzureCluster) setAPIServerLBDefaults() {
 	}
 	lb := c.Spec.NetworkSpec.APIServerLB
 
-	lb.LoadBalancerClassSpec.setAPIServerLBDefaults()
+	if lb.Unmanaged {
+		lb.setUnmanagedLBDefaults()
+	} else {
+		lb.LoadBalancerClassSpec.setAPIServerLBDefaults()
+	}
 
 	if lb.Type == Public {
 		if lb.Name == "" {
@@ -242,7 +252,7 @@ func (c *AzureCluster) setAPIServerLBDefaults() {
 				{
 					Name: generateFrontendIPConfigName(lb.Name),
 					PublicIP: &PublicIPSpec{
-						Name: generatePublicIPName(c.ObjectMeta.Name),
+						Name: generatePublicIPName(c.publicIPNameBase()),
 					},
 				},
 			}
@@ -288,6 +298,28 @@ func (c *AzureCluster) setAPIServerLBDefaults() {
 	c.SetAPIServerLBBackendPoolNameDefault()
 }
 
+func (c *AzureCluster) setPrivateLinkServiceDefaults() {
+	pls := c.Spec.NetworkSpec.PrivateLinkService
+	if pls == nil {
+		return
+	}
+	if pls.Name == "" {
+		pls.Name = generatePrivateLinkServiceName(c.ObjectMeta.Name)
+	}
+	if len(pls.NATIPConfigurations) == 0 {
+		pls.NATIPConfigurations = []PrivateLinkServiceNATIPConfiguration{{}}
+	}
+	controlPlaneSubnet, err := c.Spec.NetworkSpec.GetControlPlaneSubnet()
+	if err != nil {
+		return
+	}
+	for i := range pls.NATIPConfigurations {
+		if pls.NATIPConfigurations[i].Subnet == "" {
+			pls.NATIPConfigurations[i].Subnet = controlPlaneSubnet.Name
+		}
+	}
+}
+
 // SetNodeOutboundLBDefaults sets the default values for the NodeOutboundLB.
 func (c *AzureCluster) SetNodeOutboundLBDefaults() {
 	if c.Spec.NetworkSpec.NodeOutboundLB == nil {
@@ -295,6 +327,16 @@ func (c *AzureCluster) SetNodeOutboundLBDefaults() {
 			return
 		}
 
+		// Nodes use the public IP of the API server LB for outbound traffic.
+		if c.Spec.NetworkSpec.ShareAPIServerOutboundIP {
+			return
+		}
+
+		// Egress is provided by the route tables of the subnets.
+		if c.Spec.NetworkSpec.DisableOutbound {
+			return
+		}
+
 		var needsOutboundLB bool
 		for _, subnet := range c.Spec.NetworkSpec.Subnets {
 			if (subnet.Role == SubnetNode || subnet.Role == SubnetCluster) && subnet.IsIPv6Enabled() {
@@ -325,9 +367,29 @@ func (c *AzureCluster) SetNodeOutboundLBDefaults() {
 	}
 
 	c.setOutboundLBFrontendIPs(lb, generateNodeOutboundIPName)
+	c.setOutboundBackendPoolsDefaults(lb)
 	c.SetNodeOutboundLBBackendPoolNameDefault()
 }
 
+// setOutboundBackendPoolsDefaults gives each outbound backend pool without frontend IPs one frontend IP with a new
+// public IP.
+func (c *AzureCluster) setOutboundBackendPoolsDefaults(lb *LoadBalancerSpec) {
+	for i := range lb.OutboundBackendPools {
+		pool := &lb.OutboundBackendPools[i]
+		if len(pool.FrontendIPs) != 0 {
+			continue
+		}
+		pool.FrontendIPs = []FrontendIP{
+			{
+				Name: fmt.Sprintf("%s-%s", generateFrontendIPConfigName(lb.Name), pool.Name),
+				PublicIP: &PublicIPSpec{
+					Name: fmt.Sprintf("%s-%s", generateNodeOutboundIPName(c.publicIPNameBase()), pool.Name),
+				},
+			},
+		}
+	}
+}
+
 // SetControlPlaneOutboundLBDefaults sets the default values for the control plane's outbound LB.
 func (c *AzureCluster) SetControlPlaneOutboundLBDefaults() {
 	lb := c.Spec.NetworkSpec.ControlPlaneOutboundLB
@@ -381,6 +443,15 @@ func (c *AzureCluster) SetControlPlaneOutboundLBBackendPoolNameDefault() {
 // setOutboundLBFrontendIPs sets the frontend ips for the given load balancer.
 // The name of the frontend ip is generated using generatePublicIPName function.
 func (c *AzureCluster) setOutboundLBFrontendIPs(lb *LoadBalancerSpec, generatePublicIPName func(string) string) {
+	// Generated names with a random suffix must survive later defaulting passes, so the names already set are kept.
+	existing := lb.FrontendIPs
+	publicIPName := func(i int, generate func() string) string {
+		if feature.Gates.Enabled(feature.PublicIPNameSuffix) && i < len(existing) && existing[i].PublicIP != nil && existing[i].PublicIP.Name != "" {
+			return existing[i].PublicIP.Name
+		}
+		return generate()
+	}
+
 	switch *lb.FrontendIPsCount {
 	case 0:
 		lb.FrontendIPs = []FrontendIP{}
@@ -389,7 +460,7 @@ func (c *AzureCluster) setOutboundLBFrontendIPs(lb *LoadBalancerSpec, generatePu
 			{
 				Name: generateFrontendIPConfigName(lb.Name),
 				PublicIP: &PublicIPSpec{
-					Name: generatePublicIPName(c.ObjectMeta.Name),
+					Name: publicIPName(0, func() string { return generatePublicIPName(c.publicIPNameBase()) }),
 				},
 			},
 		}
@@ -399,7 +470,7 @@ func (c *AzureCluster) setOutboundLBFrontendIPs(lb *LoadBalancerSpec, generatePu
 			lb.FrontendIPs[i] = FrontendIP{
 				Name: withIndex(generateFrontendIPConfigName(lb.Name), i+1),
 				PublicIP: &PublicIPSpec{
-					Name: withIndex(generatePublicIPName(c.ObjectMeta.Name), i+1),
+					Name: publicIPName(i, func() string { return withIndex(generatePublicIPName(c.publicIPNameBase()), i+1) }),
 				},
 			}
 		}
@@ -423,7 +494,7 @@ func (c *AzureCluster) setBastionDefaults() {
 		}
 		// Ensure defaults for the PublicIP settings.
 		if c.Spec.BastionSpec.AzureBastion.PublicIP.Name == "" {
-			c.Spec.BastionSpec.AzureBastion.PublicIP.Name = generateAzureBastionPublicIPName(c.ObjectMeta.Name)
+			c.Spec.BastionSpec.AzureBastion.PublicIP.Name = generateAzureBastionPublicIPName(c.publicIPNameBase())
 		}
 	}
 }
@@ -440,6 +511,33 @@ func (lb *LoadBalancerClassSpec) setAPIServerLBDefaults() {
 	}
 }
 
+// setUnmanagedLBDefaults sets the defaults of a load balancer managed outside of CAPZ. It is named after its ID, and
+// its type is determined by its frontend IPs since CAPZ does not manage its SKU, type or rules.
+func (lb *LoadBalancerSpec) setUnmanagedLBDefaults() {
+	if lb.Name == "" && lb.ID != "" {
+		if id, err := azureutil.ParseResourceID(lb.ID); err == nil {
+			lb.Name = id.Name
+		}
+	}
+	if lb.Type == "" {
+		lb.Type = unmanagedLBType(lb.FrontendIPs)
+	}
+}
+
+// unmanagedLBType returns the type of a load balancer managed outside of CAPZ from its frontend IPs. It is internal
+// when it only has private frontend IPs, and public otherwise.
+func unmanagedLBType(frontendIPs []FrontendIP) LBType {
+	if len(frontendIPs) == 0 {
+		return Public
+	}
+	for _, frontendIP := range frontendIPs {
+		if frontendIP.PublicIP != nil {
+			return Public
+		}
+	}
+	return Internal
+}
+
 func (lb *LoadBalancerClassSpec) setNodeOutboundLBDefaults() {
 	lb.setOutboundLBDefaults()
 }
@@ -495,6 +593,17 @@ func generateNodeSubnetName(clusterName string) string {
 	return fmt.Sprintf("%s-%s", clusterName, "node-subnet")
 }
 
+// publicIPNameBase returns the cluster name used to generate public IP names. When the PublicIPNameSuffix feature
+// is enabled, a short random suffix is appended so that public IPs of a deleted cluster with the same name which
+// are still being deleted in Azure do not conflict with the new ones. The object UID cannot be used for this as it
+// is not assigned yet when the defaulting webhook runs on create.
+func (c *AzureCluster) publicIPNameBase() string {
+	if !feature.Gates.Enabled(feature.PublicIPNameSuffix) {
+		return c.ObjectMeta.Name
+	}
+	return fmt.Sprintf("%s-%s", c.ObjectMeta.Name, rand.String(5))
+}
+
 // generateAzureBastionName generates an azure bastion name.
 func generateAzureBastionName(clusterName string) string {
 	return fmt.Sprintf("%s-azure-bastion", clusterName)
@@ -545,6 +654,11 @@ func generateControlPlaneOutboundLBName(clusterName string) string {
 	return fmt.Sprintf("%s-outbound-lb", clusterName)
 }
 
+// generatePrivateLinkServiceName generates the name of the API server private link service, based on the cluster name.
+func generatePrivateLinkServiceName(clusterName string) string {
+	return fmt.Sprintf("%s-apiserver-pls", clusterName)
+}
+
 // generatePublicIPName generates a public IP name, based on the cluster name and a hash.
 func generatePublicIPName(clusterName string) string {
 	return fmt.Sprintf("pip-%s-apiserver", clusterName)
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"testing"
 
+	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/component-base/featuregate"
@@ -358,6 +359,52 @@ func TestSubnetDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "no subnets with outbound disabled",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ControlPlaneEnabled: true,
+					NetworkSpec: NetworkSpec{
+						DisableOutbound: true,
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ControlPlaneEnabled: true,
+					NetworkSpec: NetworkSpec{
+						DisableOutbound: true,
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{DefaultControlPlaneSubnetCIDR},
+									Name:       "cluster-test-controlplane-subnet",
+								},
+
+								SecurityGroup: SecurityGroup{Name: "cluster-test-controlplane-nsg"},
+								RouteTable:    RouteTable{},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{DefaultNodeSubnetCIDR},
+									Name:       "cluster-test-node-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-node-nsg"},
+								RouteTable:    RouteTable{Name: "cluster-test-node-routetable"},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "subnets with custom attributes",
 			cluster: &AzureCluster{
@@ -1287,6 +1334,60 @@ func TestAPIServerLBDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "unmanaged lb",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ControlPlaneEnabled: true,
+					NetworkSpec: NetworkSpec{
+						APIServerLB: &LoadBalancerSpec{
+							Unmanaged: true,
+							ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb",
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "my-lb-frontend",
+									FrontendIPClass: FrontendIPClass{
+										PrivateIPAddress: "10.0.0.100",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ControlPlaneEnabled: true,
+					NetworkSpec: NetworkSpec{
+						APIServerLB: &LoadBalancerSpec{
+							Unmanaged: true,
+							ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb",
+							Name:      "my-lb",
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "my-lb-frontend",
+									FrontendIPClass: FrontendIPClass{
+										PrivateIPAddress: "10.0.0.100",
+									},
+								},
+							},
+							BackendPool: BackendPool{
+								Name: "my-lb-backendPool",
+							},
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								Type: Internal,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:        "no lb with APIServerILB feature gate enabled",
 			featureGate: feature.APIServerILB,
@@ -1614,6 +1715,39 @@ func TestAPIServerLBDefaults(t *testing.T) {
 	}
 }
 
+func TestPublicIPNameSuffixDefaults(t *testing.T) {
+	g := NewWithT(t)
+	defer featuregatetesting.SetFeatureGateDuringTest(t, feature.Gates, feature.PublicIPNameSuffix, true)()
+
+	cluster := &AzureCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-test",
+		},
+		Spec: AzureClusterSpec{
+			ControlPlaneEnabled: true,
+			NetworkSpec: NetworkSpec{
+				ControlPlaneOutboundLB: &LoadBalancerSpec{
+					FrontendIPsCount: ptr.To[int32](2),
+				},
+			},
+		},
+	}
+	cluster.setAPIServerLBDefaults()
+	cluster.SetControlPlaneOutboundLBDefaults()
+
+	g.Expect(cluster.Spec.NetworkSpec.APIServerLB.FrontendIPs).To(HaveLen(1))
+	g.Expect(cluster.Spec.NetworkSpec.APIServerLB.FrontendIPs[0].PublicIP.Name).To(MatchRegexp(`^pip-cluster-test-[a-z0-9]{5}-apiserver$`))
+	g.Expect(cluster.Spec.NetworkSpec.ControlPlaneOutboundLB.FrontendIPs).To(HaveLen(2))
+	g.Expect(cluster.Spec.NetworkSpec.ControlPlaneOutboundLB.FrontendIPs[0].PublicIP.Name).To(MatchRegexp(`^pip-cluster-test-[a-z0-9]{5}-controlplane-outbound-1$`))
+	g.Expect(cluster.Spec.NetworkSpec.ControlPlaneOutboundLB.FrontendIPs[1].PublicIP.Name).To(MatchRegexp(`^pip-cluster-test-[a-z0-9]{5}-controlplane-outbound-2$`))
+
+	// Defaulting again keeps the generated names.
+	defaulted := cluster.DeepCopy()
+	cluster.setAPIServerLBDefaults()
+	cluster.SetControlPlaneOutboundLBDefaults()
+	g.Expect(cluster).To(Equal(defaulted))
+}
+
 func TestAzureEnviromentDefault(t *testing.T) {
 	cases := map[string]struct {
 		cluster *AzureCluster
@@ -2371,6 +2505,55 @@ func TestNodeOutboundLBDefaults(t *testing.T) {
 	}
 }
 
+func TestOutboundBackendPoolsDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &AzureCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-test",
+		},
+	}
+	lb := &LoadBalancerSpec{
+		Name: "cluster-test",
+		OutboundBackendPools: []OutboundBackendPool{
+			{
+				Name: "gpu",
+			},
+			{
+				Name: "batch",
+				FrontendIPs: []FrontendIP{
+					{
+						Name:     "batch-frontEnd",
+						PublicIP: &PublicIPSpec{Name: "pip-batch"},
+					},
+				},
+			},
+		},
+	}
+
+	cluster.setOutboundBackendPoolsDefaults(lb)
+	g.Expect(lb.OutboundBackendPools).To(Equal([]OutboundBackendPool{
+		{
+			Name: "gpu",
+			FrontendIPs: []FrontendIP{
+				{
+					Name:     "cluster-test-frontEnd-gpu",
+					PublicIP: &PublicIPSpec{Name: "pip-cluster-test-node-outbound-gpu"},
+				},
+			},
+		},
+		{
+			Name: "batch",
+			FrontendIPs: []FrontendIP{
+				{
+					Name:     "batch-frontEnd",
+					PublicIP: &PublicIPSpec{Name: "pip-batch"},
+				},
+			},
+		},
+	}))
+}
+
 func TestControlPlaneOutboundLBDefaults(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -2782,3 +2965,128 @@ func TestBastionDefault(t *testing.T) {
 		})
 	}
 }
+
+func TestPrivateLinkServiceDefaults(t *testing.T) {
+	controlPlaneSubnet := SubnetSpec{
+		SubnetClassSpec: SubnetClassSpec{
+			Role: SubnetControlPlane,
+			Name: "foo-controlplane-subnet",
+		},
+	}
+	cases := map[string]struct {
+		cluster *AzureCluster
+		output  *AzureCluster
+	}{
+		"no private link service set": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{controlPlaneSubnet},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{controlPlaneSubnet},
+					},
+				},
+			},
+		},
+		"private link service enabled with no settings": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets:            Subnets{controlPlaneSubnet},
+						PrivateLinkService: &PrivateLinkServiceSpec{},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{controlPlaneSubnet},
+						PrivateLinkService: &PrivateLinkServiceSpec{
+							Name: "foo-apiserver-pls",
+							NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+								{
+									Subnet: "foo-controlplane-subnet",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"private link service with name and NAT IP configurations set": {
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{controlPlaneSubnet},
+						PrivateLinkService: &PrivateLinkServiceSpec{
+							Name: "my-pls",
+							NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+								{
+									Subnet: "my-pls-subnet",
+								},
+								{
+									PrivateIPAddress: "10.0.0.100",
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{controlPlaneSubnet},
+						PrivateLinkService: &PrivateLinkServiceSpec{
+							Name: "my-pls",
+							NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+								{
+									Subnet: "my-pls-subnet",
+								},
+								{
+									Subnet:           "foo-controlplane-subnet",
+									PrivateIPAddress: "10.0.0.100",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name := range cases {
+		c := cases[name]
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c.cluster.setPrivateLinkServiceDefaults()
+			if !reflect.DeepEqual(c.cluster, c.output) {
+				expected, _ := json.MarshalIndent(c.output, "", "\t")
+				actual, _ := json.MarshalIndent(c.cluster, "", "\t")
+				t.Errorf("Expected %s, got %s", string(expected), string(actual))
+			}
+		})
+	}
+}
@@ -79,6 +79,22 @@ type AzureClusterStatus struct {
 	// next reconciliation loop.
 	// +optional
 	LongRunningOperationStates Futures `json:"longRunningOperationStates,omitempty"`
+
+	// ManagedResources is an inventory of the Azure resources in the cluster's resource group that are tagged as
+	// owned by the cluster. It is refreshed periodically while the cluster is reconciled.
+	// +optional
+	ManagedResources *ManagedResources `json:"managedResources,omitempty"`
+}
+
+// ManagedResources summarizes the Azure resources owned by a cluster.
+type ManagedResources struct {
+	// Count is the total number of owned resources found.
+	Count int `json:"count"`
+
+	// ResourceIDs are the IDs of the owned resources, sorted and capped to keep the object small.
+	// When Count is larger than the number of IDs, the list is truncated.
+	// +optional
+	ResourceIDs []string `json:"resourceIDs,omitempty"`
 }
 
 // +kubebuilder:object:root=true
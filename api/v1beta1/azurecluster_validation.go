@@ -21,8 +21,11 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
+	"strings"
 
 	valid "github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
 const (
@@ -45,12 +49,18 @@ const (
 	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
 	subnetRegex       = `^[-\w\._]+$`
 	loadBalancerRegex = `^[-\w\._]+$`
+	// 1-80 characters, starting with an alphanumeric and ending with an alphanumeric or an underscore.
+	privateLinkServiceRegex = `^[a-zA-Z0-9]([-\w\.]{0,78}\w)?$`
 	// MaxLoadBalancerOutboundIPs is the maximum number of outbound IPs in a Standard LoadBalancer frontend configuration.
 	MaxLoadBalancerOutboundIPs = 16
 	// MinLBIdleTimeoutInMinutes is the minimum number of minutes for the LB idle timeout.
 	MinLBIdleTimeoutInMinutes = 4
 	// MaxLBIdleTimeoutInMinutes is the maximum number of minutes for the LB idle timeout.
 	MaxLBIdleTimeoutInMinutes = 30
+	// MaxLBOutboundRuleIdleTimeoutInMinutes is the maximum number of minutes for the idle timeout of an outbound LB.
+	MaxLBOutboundRuleIdleTimeoutInMinutes = 120
+	// MaxLBAllocatedOutboundPorts is the maximum number of SNAT ports allocated per backend instance.
+	MaxLBAllocatedOutboundPorts = 64000
 	// Network security rules should be a number between 100 and 4096.
 	// https://learn.microsoft.com/azure/virtual-network/network-security-groups-overview#security-rules
 	minRulePriority = 100
@@ -61,6 +71,16 @@ const (
 	serviceEndpointLocationRegexPattern = `^([a-z]{1,42}\d{0,5}|[*])$`
 	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
 	privateEndpointRegex = `^[-\w\._]+$`
+	// securityGroupResourceType is the resource type of a network security group.
+	securityGroupResourceType = "Microsoft.Network/networkSecurityGroups"
+	// publicIPPrefixResourceType is the resource type of a public IP prefix.
+	publicIPPrefixResourceType = "Microsoft.Network/publicIPPrefixes"
+	// minNatGatewayPublicIPPrefixLength and maxNatGatewayPublicIPPrefixLength bound the length of the public IP
+	// prefixes a NAT gateway accepts.
+	minNatGatewayPublicIPPrefixLength = 28
+	maxNatGatewayPublicIPPrefixLength = 31
+	// loadBalancerResourceType is the resource type of a load balancer.
+	loadBalancerResourceType = "Microsoft.Network/loadBalancers"
 	// resource ID Pattern.
 	resourceIDPattern = `(?i)subscriptions/(.+)/resourceGroups/(.+)/providers/(.+?)/(.+?)/(.+)`
 )
@@ -94,6 +114,24 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 
 	allErrs = append(allErrs, validateNetworkSpec(c.Spec.ControlPlaneEnabled, c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
 
+	if c.Spec.ControlPlaneEnabled && c.Spec.NetworkSpec.APIServerLB != nil {
+		allErrs = append(allErrs, validateExistingAPIServerLB(*c.Spec.NetworkSpec.APIServerLB, oldNetworkSpec.APIServerLB, c.Spec.ResourceGroup,
+			field.NewPath("spec").Child("networkSpec").Child("apiServerLB"))...)
+		if c.Spec.NetworkSpec.APIServerLB.Unmanaged && c.Spec.NetworkSpec.ShareAPIServerOutboundIP {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("networkSpec").Child("shareAPIServerOutboundIP"),
+				"cannot be set when using an existing API server load balancer"))
+		}
+	}
+	if c.Spec.NetworkSpec.NodeOutboundLB != nil && c.Spec.NetworkSpec.NodeOutboundLB.Unmanaged {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("networkSpec").Child("nodeOutboundLB").Child("unmanaged"),
+			"only the API server load balancer can be unmanaged"))
+	}
+	if c.Spec.NetworkSpec.ControlPlaneOutboundLB != nil && c.Spec.NetworkSpec.ControlPlaneOutboundLB.Unmanaged {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec").Child("networkSpec").Child("controlPlaneOutboundLB").Child("unmanaged"),
+			"only the API server load balancer can be unmanaged"))
+	}
+	allErrs = append(allErrs, validateOutboundBackendPools(c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
+
 	var oldCloudProviderConfigOverrides *CloudProviderConfigOverrides
 	if old != nil {
 		oldCloudProviderConfigOverrides = old.Spec.CloudProviderConfigOverrides
@@ -101,13 +139,22 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 	allErrs = append(allErrs, validateCloudProviderConfigOverrides(c.Spec.CloudProviderConfigOverrides, oldCloudProviderConfigOverrides,
 		field.NewPath("spec").Child("cloudProviderConfigOverrides"))...)
 
+	allErrs = append(allErrs, validateClusterLabelTagPrefixes(c.Spec.ClusterLabelTagPrefixes, field.NewPath("spec").Child("clusterLabelTagPrefixes"))...)
+
+	if c.Spec.RestrictFailureDomains && len(c.Spec.FailureDomains) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "failureDomains"), "failureDomains must be set when restrictFailureDomains is true"))
+	}
+
 	// If ClusterSpec has non-nil ExtendedLocation field but not enable EdgeZone feature gate flag, ClusterSpec validation failed.
 	if !feature.Gates.Enabled(feature.EdgeZone) && c.Spec.ExtendedLocation != nil {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "extendedLocation"), "can be set only if the EdgeZone feature flag is enabled"))
 	}
 
-	if err := validateBastionSpec(c.Spec.BastionSpec, field.NewPath("spec").Child("azureBastion").Child("bastionSpec")); err != nil {
-		allErrs = append(allErrs, err)
+	allErrs = append(allErrs, validateBastionSpec(c.Spec.BastionSpec, field.NewPath("spec", "bastionSpec"))...)
+
+	if c.Spec.BastionSpec.AzureBastion != nil {
+		allErrs = append(allErrs, validateUnmanagedSecurityGroup(c.Spec.BastionSpec.AzureBastion.Subnet.SecurityGroup,
+			field.NewPath("spec", "bastionSpec", "azureBastion", "subnet", "securityGroup"))...)
 	}
 
 	if err := validateIdentityRef(c.Spec.IdentityRef, field.NewPath("spec").Child("identityRef")); err != nil {
@@ -136,12 +183,63 @@ func (c *AzureCluster) validateClusterName() field.ErrorList {
 }
 
 // validateBastionSpec validates a BastionSpec.
-func validateBastionSpec(bastionSpec BastionSpec, fldPath *field.Path) *field.Error {
-	if bastionSpec.AzureBastion != nil && bastionSpec.AzureBastion.Sku != StandardBastionHostSku && bastionSpec.AzureBastion.EnableTunneling {
-		return field.Invalid(fldPath.Child("sku"), bastionSpec.AzureBastion.Sku,
-			"sku must be Standard if tunneling is enabled")
+func validateBastionSpec(bastionSpec BastionSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	bastion := bastionSpec.AzureBastion
+	if bastion == nil || bastion.Sku == StandardBastionHostSku {
+		return allErrs
 	}
-	return nil
+	bastionPath := fldPath.Child("azureBastion")
+	if bastion.EnableTunneling {
+		allErrs = append(allErrs, field.Forbidden(bastionPath.Child("enableTunneling"),
+			"sku must be Standard if tunneling is enabled"))
+	}
+	if bastion.ScaleUnits != nil {
+		allErrs = append(allErrs, field.Forbidden(bastionPath.Child("scaleUnits"),
+			"sku must be Standard if scale units are set"))
+	}
+	if ptr.Deref(bastion.DisableCopyPaste, false) {
+		allErrs = append(allErrs, field.Forbidden(bastionPath.Child("disableCopyPaste"),
+			"sku must be Standard if copy and paste is disabled"))
+	}
+	return allErrs
+}
+
+// validateBastionUpdate validates an update of the Azure Bastion of a cluster. Azure Bastion can be enabled but not
+// removed, and only its SKU and features can be changed once it is enabled.
+func validateBastionUpdate(old, bastion *AzureBastion, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if old == nil {
+		return allErrs
+	}
+	if bastion == nil {
+		return append(allErrs, field.Invalid(fldPath, bastion, "azure bastion cannot be removed from a cluster"))
+	}
+
+	if old.Sku == StandardBastionHostSku && bastion.Sku != StandardBastionHostSku {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sku"), bastion.Sku,
+			"sku cannot be downgraded from Standard"))
+	}
+	// Unset scale units and copy paste settings leave the Azure Bastion Host as is, so clearing them would not
+	// revert the Azure Bastion Host to its defaults.
+	if old.ScaleUnits != nil && bastion.ScaleUnits == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleUnits"),
+			"scale units cannot be unset once set, set them to 2 to use the default instead"))
+	}
+	if old.DisableCopyPaste != nil && bastion.DisableCopyPaste == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableCopyPaste"),
+			"disableCopyPaste cannot be unset once set, set it to false to use the default instead"))
+	}
+
+	oldImmutable, immutable := *old, *bastion
+	for _, b := range []*AzureBastion{&oldImmutable, &immutable} {
+		b.Sku, b.EnableTunneling, b.ScaleUnits, b.DisableCopyPaste = "", false, nil, nil
+	}
+	if !reflect.DeepEqual(oldImmutable, immutable) {
+		allErrs = append(allErrs, field.Invalid(fldPath, bastion,
+			"only the sku, enableTunneling, scaleUnits and disableCopyPaste of azure bastion can be changed"))
+	}
+	return allErrs
 }
 
 // validateIdentityRef validates an IdentityRef.
@@ -158,6 +256,17 @@ func validateIdentityRef(identityRef *corev1.ObjectReference, fldPath *field.Pat
 // validateNetworkSpec validates a NetworkSpec.
 func validateNetworkSpec(controlPlaneEnabled bool, networkSpec NetworkSpec, old NetworkSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
+	if networkSpec.NetworkResourceGroup != "" {
+		if err := validateResourceGroup(networkSpec.NetworkResourceGroup,
+			fldPath.Child("networkResourceGroup")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if networkSpec.Vnet.ResourceGroup != "" && !strings.EqualFold(networkSpec.Vnet.ResourceGroup, networkSpec.NetworkResourceGroup) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("vnet").Child("resourceGroup"), networkSpec.Vnet.ResourceGroup,
+				"vnet resource group must match networkResourceGroup"))
+		}
+	}
+
 	// If the user specifies a resourceGroup for vnet, it means
 	// that they intend to use a pre-existing vnet. In this case,
 	// we need to verify the information they provide
@@ -174,6 +283,8 @@ func validateNetworkSpec(controlPlaneEnabled bool, networkSpec NetworkSpec, old
 		allErrs = append(allErrs, validateVnetPeerings(networkSpec.Vnet.Peerings, fldPath.Child("peerings"))...)
 	}
 
+	allErrs = append(allErrs, validateVnetDNSServers(networkSpec.Vnet.DNSServers, fldPath.Child("vnet").Child("dnsServers"))...)
+
 	var cidrBlocks []string
 	if controlPlaneEnabled {
 		controlPlaneSubnet, err := networkSpec.GetControlPlaneSubnet()
@@ -185,6 +296,12 @@ func validateNetworkSpec(controlPlaneEnabled bool, networkSpec NetworkSpec, old
 		allErrs = append(allErrs, validateAPIServerLB(networkSpec.APIServerLB, old.APIServerLB, cidrBlocks, fldPath.Child("apiServerLB"))...)
 	}
 
+	allErrs = append(allErrs, validateAllowedSSHSourceCIDRs(networkSpec.AllowedSSHSourceCIDRs, fldPath.Child("allowedSSHSourceCIDRs"))...)
+
+	for i, subnet := range networkSpec.Subnets {
+		allErrs = append(allErrs, validateUnmanagedSecurityGroup(subnet.SecurityGroup, fldPath.Child("subnets").Index(i).Child("securityGroup"))...)
+	}
+
 	var needOutboundLB bool
 	for _, subnet := range networkSpec.Subnets {
 		if (subnet.Role == SubnetNode || subnet.Role == SubnetCluster) && subnet.IsIPv6Enabled() {
@@ -192,10 +309,14 @@ func validateNetworkSpec(controlPlaneEnabled bool, networkSpec NetworkSpec, old
 			break
 		}
 	}
-	if needOutboundLB {
+	if networkSpec.DisableOutbound {
+		allErrs = append(allErrs, validateDisableOutbound(controlPlaneEnabled, networkSpec, fldPath)...)
+	} else if networkSpec.ShareAPIServerOutboundIP {
+		allErrs = append(allErrs, validateSharedAPIServerOutboundIP(controlPlaneEnabled, networkSpec, old, fldPath)...)
+	} else if needOutboundLB {
 		allErrs = append(allErrs, validateNodeOutboundLB(networkSpec.NodeOutboundLB, old.NodeOutboundLB, networkSpec.APIServerLB, fldPath.Child("nodeOutboundLB"))...)
 	}
-	if controlPlaneEnabled {
+	if controlPlaneEnabled && !networkSpec.DisableOutbound {
 		allErrs = append(allErrs, validateControlPlaneOutboundLB(networkSpec.ControlPlaneOutboundLB, networkSpec.APIServerLB, fldPath.Child("controlPlaneOutboundLB"))...)
 	}
 	var lbType = Internal
@@ -204,6 +325,12 @@ func validateNetworkSpec(controlPlaneEnabled bool, networkSpec NetworkSpec, old
 	}
 	allErrs = append(allErrs, validatePrivateDNSZoneName(networkSpec.PrivateDNSZoneName, controlPlaneEnabled, lbType, fldPath.Child("privateDNSZoneName"))...)
 
+	if networkSpec.PrivateLinkService != nil {
+		allErrs = append(allErrs, validatePrivateLinkService(controlPlaneEnabled, networkSpec, old.PrivateLinkService, fldPath.Child("privateLinkService"))...)
+	} else if old.PrivateLinkService != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("privateLinkService"), "private link service should not be removed after AzureCluster creation."))
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
@@ -268,6 +395,8 @@ func validateSubnets(controlPlaneEnabled bool, subnets Subnets, vnet VnetSpec, f
 		if len(subnet.PrivateEndpoints) > 0 {
 			allErrs = append(allErrs, validatePrivateEndpoints(subnet.PrivateEndpoints, subnet.CIDRBlocks, fldPath.Index(i).Child("privateEndpoints"))...)
 		}
+
+		allErrs = append(allErrs, validateNatGatewayPublicIPPrefixes(subnet.NatGateway.NatGatewayClassSpec, fldPath.Index(i).Child("natGateway"))...)
 	}
 
 	// The clusterSubnet is applicable to both the control-plane and node pools.
@@ -285,6 +414,29 @@ func validateSubnets(controlPlaneEnabled bool, subnets Subnets, vnet VnetSpec, f
 	return allErrs
 }
 
+// validateNatGatewayPublicIPPrefixes validates the public IP prefixes attached to a NAT gateway.
+func validateNatGatewayPublicIPPrefixes(natGateway NatGatewayClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(natGateway.PublicIPPrefixes) == 0 {
+		return allErrs
+	}
+	prefixesPath := fldPath.Child("publicIPPrefixes")
+	if natGateway.Name == "" {
+		allErrs = append(allErrs, field.Forbidden(prefixesPath, "public IP prefixes can be set only when a NAT gateway name is set"))
+	}
+	for i, prefix := range natGateway.PublicIPPrefixes {
+		if id, err := azureutil.ParseResourceID(prefix.ID); err != nil || !strings.EqualFold(id.ResourceType.String(), publicIPPrefixResourceType) {
+			allErrs = append(allErrs, field.Invalid(prefixesPath.Index(i).Child("id"), prefix.ID,
+				"must be a public IP prefix ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/publicIPPrefixes/{publicIPPrefixName}"))
+		}
+		if prefix.PrefixLength < minNatGatewayPublicIPPrefixLength || prefix.PrefixLength > maxNatGatewayPublicIPPrefixLength {
+			allErrs = append(allErrs, field.Invalid(prefixesPath.Index(i).Child("prefixLength"), prefix.PrefixLength,
+				fmt.Sprintf("NAT gateways accept public IP prefixes with a length between /%d and /%d", minNatGatewayPublicIPPrefixLength, maxNatGatewayPublicIPPrefixLength)))
+		}
+	}
+	return allErrs
+}
+
 // validateSubnetName validates the Name of a Subnet.
 func validateSubnetName(name string, fldPath *field.Path) *field.Error {
 	if success, _ := regexp.Match(subnetRegex, []byte(name)); !success {
@@ -338,17 +490,77 @@ func validateVnetCIDR(vnetCIDRBlocks []string, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+// validateVnetDNSServers validates the DNS server IP addresses of a Vnet.
+func validateVnetDNSServers(dnsServers []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, dnsServer := range dnsServers {
+		if net.ParseIP(dnsServer) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), dnsServer, "invalid IP address"))
+		}
+	}
+	return allErrs
+}
+
+// validateUnmanagedSecurityGroup validates that an unmanaged security group is referenced by ID and has no security rules.
+func validateUnmanagedSecurityGroup(sg SecurityGroup, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if !sg.Unmanaged {
+		return allErrs
+	}
+	if sg.ID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("id"), "an unmanaged security group must be referenced by ID"))
+	} else if id, err := azureutil.ParseResourceID(sg.ID); err != nil || !strings.EqualFold(id.ResourceType.String(), securityGroupResourceType) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), sg.ID,
+			"must be a network security group ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}"))
+	}
+	if len(sg.SecurityRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("securityRules"), "security rules cannot be set on an unmanaged security group"))
+	}
+	return allErrs
+}
+
+// validateAllowedSSHSourceCIDRs validates the CIDR blocks allowed to connect to the control plane with SSH.
+func validateAllowedSSHSourceCIDRs(cidrs []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, "invalid CIDR format"))
+		}
+	}
+	return allErrs
+}
+
 // validateVnetPeerings validates a list of virtual network peerings.
 func validateVnetPeerings(peerings VnetPeerings, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	vnetIdentifiers := make(map[string]bool, len(peerings))
 
-	for _, peering := range peerings {
+	for i, peering := range peerings {
 		vnetIdentifier := peering.ResourceGroup + "/" + peering.RemoteVnetName
 		if _, ok := vnetIdentifiers[vnetIdentifier]; ok {
 			allErrs = append(allErrs, field.Duplicate(fldPath, vnetIdentifier))
 		}
 		vnetIdentifiers[vnetIdentifier] = true
+		allErrs = append(allErrs, validateVnetPeeringClassSpec(peering.VnetPeeringClassSpec, fldPath.Index(i))...)
+	}
+	return allErrs
+}
+
+// validateVnetPeeringClassSpec validates the properties of the peerings in both directions.
+func validateVnetPeeringClassSpec(peering VnetPeeringClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateVnetPeeringProperties(peering.ForwardPeeringProperties, fldPath.Child("forwardPeeringProperties"))...)
+	allErrs = append(allErrs, validateVnetPeeringProperties(peering.ReversePeeringProperties, fldPath.Child("reversePeeringProperties"))...)
+	return allErrs
+}
+
+// validateVnetPeeringProperties validates the properties of a virtual network peering.
+func validateVnetPeeringProperties(properties VnetPeeringProperties, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	// A virtual network either offers its gateway to the remote virtual network or uses the remote one, not both.
+	if ptr.Deref(properties.UseRemoteGateways, false) && ptr.Deref(properties.AllowGatewayTransit, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("useRemoteGateways"),
+			"useRemoteGateways and allowGatewayTransit cannot both be true on the same peering"))
 	}
 	return allErrs
 }
@@ -401,7 +613,10 @@ func validateAPIServerLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, cidrs []st
 		olLBClassSpec = old.LoadBalancerClassSpec
 	}
 
-	allErrs = append(allErrs, validateClassSpecForAPIServerLB(lbClassSpec, &olLBClassSpec, fldPath)...)
+	// The class spec of an unmanaged load balancer is validated by validateExistingAPIServerLB.
+	if !lb.Unmanaged {
+		allErrs = append(allErrs, validateClassSpecForAPIServerLB(lbClassSpec, &olLBClassSpec, fldPath)...)
+	}
 
 	// Name should be valid.
 	if err := validateLoadBalancerName(lb.Name, fldPath.Child("name")); err != nil {
@@ -411,6 +626,35 @@ func validateAPIServerLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, cidrs []st
 	if old != nil && old.Name != "" && old.Name != lb.Name {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "API Server load balancer name should not be modified after AzureCluster creation."))
 	}
+	// HealthProbe should be immutable since existing probes are not updated.
+	if old != nil && !reflect.DeepEqual(old.HealthProbe, lb.HealthProbe) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "API Server load balancer health probe should not be modified after AzureCluster creation."))
+	}
+	// HAPorts and EnableFloatingIP should be immutable since existing load balancing rules are not updated.
+	if old != nil && old.HAPorts != lb.HAPorts {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("haPorts"), "API Server load balancer HA ports should not be modified after AzureCluster creation."))
+	}
+	if old != nil && old.EnableFloatingIP != lb.EnableFloatingIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableFloatingIP"), "API Server load balancer floating IP should not be modified after AzureCluster creation."))
+	}
+	// Public IP zones should be immutable since existing public IPs are not updated.
+	if old != nil {
+		oldZones := make(map[string][]string, len(old.FrontendIPs))
+		for _, frontendIP := range old.FrontendIPs {
+			if frontendIP.PublicIP != nil {
+				oldZones[frontendIP.PublicIP.Name] = frontendIP.PublicIP.Zones
+			}
+		}
+		for i, frontendIP := range lb.FrontendIPs {
+			if frontendIP.PublicIP == nil {
+				continue
+			}
+			if zones, ok := oldZones[frontendIP.PublicIP.Name]; ok && !reflect.DeepEqual(zones, frontendIP.PublicIP.Zones) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendIPConfigs").Index(i).Child("publicIP", "zones"),
+					"API Server load balancer public IP zones should not be modified after AzureCluster creation."))
+			}
+		}
+	}
 
 	publicIPCount, privateIPCount := 0, 0
 	privateIP := ""
@@ -458,7 +702,7 @@ func validateAPIServerLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, cidrs []st
 				allErrs = append(allErrs, err)
 			}
 
-			if len(old.FrontendIPs) != 0 && old.FrontendIPs[0].PrivateIPAddress != lb.FrontendIPs[0].PrivateIPAddress {
+			if len(old.FrontendIPs) != 0 && old.Type == lb.Type && old.FrontendIPs[0].PrivateIPAddress != lb.FrontendIPs[0].PrivateIPAddress {
 				allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "API Server load balancer private IP should not be modified after AzureCluster creation."))
 			}
 		}
@@ -466,6 +710,197 @@ func validateAPIServerLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, cidrs []st
 	return allErrs
 }
 
+// validateExistingAPIServerLB validates an unmanaged API server load balancer, which references an existing load
+// balancer by ID. CAPZ only adds its frontend IP configuration and backend pool to an existing load balancer, so the
+// fields that configure the load balancer itself, its load balancing rules and probes cannot be set.
+func validateExistingAPIServerLB(lb LoadBalancerSpec, old *LoadBalancerSpec, resourceGroup string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if old != nil {
+		if old.Unmanaged != lb.Unmanaged {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("unmanaged"), lb.Unmanaged, "field is immutable"))
+		}
+		// The ID of an unmanaged load balancer is immutable.
+		if old.Unmanaged && old.ID != lb.ID {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("id"), "API Server load balancer ID should not be modified after AzureCluster creation."))
+		}
+	}
+	if !lb.Unmanaged {
+		return allErrs
+	}
+
+	if lb.ID == "" {
+		return append(allErrs, field.Required(fldPath.Child("id"), "must be set when the load balancer is unmanaged"))
+	}
+	id, err := azureutil.ParseResourceID(lb.ID)
+	if err != nil || !strings.EqualFold(id.ResourceType.String(), loadBalancerResourceType) {
+		return append(allErrs, field.Invalid(fldPath.Child("id"), lb.ID,
+			"must be a load balancer ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}"))
+	}
+	if resourceGroup != "" && !strings.EqualFold(id.ResourceGroupName, resourceGroup) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), lb.ID,
+			fmt.Sprintf("load balancer must be in the cluster resource group %s", resourceGroup)))
+	}
+	if id.Name != lb.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), lb.Name,
+			fmt.Sprintf("name must match the name of the load balancer ID %s", id.Name)))
+	}
+	if lb.SKU != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("sku"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.IdleTimeoutInMinutes != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("idleTimeoutInMinutes"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.Type != "" && lb.Type != unmanagedLBType(lb.FrontendIPs) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"),
+			"cannot be set when using an existing load balancer, the type is determined by the frontend IPs"))
+	}
+	if lb.FrontendIPsCount != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendIPsCount"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.AllocatedOutboundPorts != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("allocatedOutboundPorts"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.HealthProbe != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.HAPorts {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("haPorts"), "cannot be set when using an existing load balancer"))
+	}
+	if lb.EnableFloatingIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableFloatingIP"), "cannot be set when using an existing load balancer"))
+	}
+
+	return allErrs
+}
+
+// validateSharedAPIServerOutboundIP validates that nodes can use the public IP of the API server LB for outbound traffic.
+// The feature flag is only required to opt in, so clusters that already share the IP keep validating when the flag
+// is turned off.
+func validateSharedAPIServerOutboundIP(controlPlaneEnabled bool, networkSpec NetworkSpec, old NetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	sharePath := fldPath.Child("shareAPIServerOutboundIP")
+
+	if !old.ShareAPIServerOutboundIP && !feature.Gates.Enabled(feature.SharedAPIServerOutboundIP) {
+		allErrs = append(allErrs, field.Forbidden(sharePath, "can be set only if the SharedAPIServerOutboundIP feature flag is enabled"))
+	}
+	if !controlPlaneEnabled || networkSpec.APIServerLB == nil || networkSpec.APIServerLB.Type != Public {
+		allErrs = append(allErrs, field.Forbidden(sharePath, "API server load balancer public IP can only be shared for public clusters"))
+	}
+	if networkSpec.NodeOutboundLB != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeOutboundLB"),
+			"Node outbound load balancer cannot be set when the API server load balancer public IP is shared"))
+	}
+
+	return allErrs
+}
+
+// validateDisableOutbound validates that no CAPZ-managed outbound path is configured when outbound connectivity is disabled.
+func validateDisableOutbound(controlPlaneEnabled bool, networkSpec NetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if controlPlaneEnabled && (networkSpec.APIServerLB == nil || networkSpec.APIServerLB.Type != Internal) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("disableOutbound"), "outbound connectivity can only be disabled for clusters with an internal API server load balancer"))
+	}
+	if networkSpec.ShareAPIServerOutboundIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("shareAPIServerOutboundIP"),
+			"API server load balancer public IP cannot be shared when outbound connectivity is disabled"))
+	}
+	if networkSpec.NodeOutboundLB != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeOutboundLB"),
+			"Node outbound load balancer cannot be set when outbound connectivity is disabled"))
+	}
+	if networkSpec.ControlPlaneOutboundLB != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("controlPlaneOutboundLB"),
+			"Control plane outbound load balancer cannot be set when outbound connectivity is disabled"))
+	}
+	for i, subnet := range networkSpec.Subnets {
+		if subnet.NatGateway.Name != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("subnets").Index(i).Child("natGateway"),
+				"NAT gateway cannot be set when outbound connectivity is disabled"))
+		}
+		if (subnet.Role == SubnetNode || subnet.Role == SubnetCluster) && subnet.RouteTable.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("subnets").Index(i).Child("routeTable", "name"),
+				"a route table with a default route is required when outbound connectivity is disabled"))
+		}
+	}
+
+	return allErrs
+}
+
+// validatePrivateLinkService validates the private link service in front of the API server load balancer.
+func validatePrivateLinkService(controlPlaneEnabled bool, networkSpec NetworkSpec, old *PrivateLinkServiceSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	pls := networkSpec.PrivateLinkService
+
+	if !controlPlaneEnabled || networkSpec.APIServerLB == nil || networkSpec.APIServerLB.Type != Internal {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "a private link service can only be created for clusters with an internal API server load balancer"))
+	}
+
+	if success, _ := regexp.MatchString(privateLinkServiceRegex, pls.Name); !success {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), pls.Name,
+			fmt.Sprintf("name of private link service doesn't match regex %s", privateLinkServiceRegex)))
+	}
+	if old != nil && old.Name != "" && old.Name != pls.Name {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("name"), "private link service name should not be modified after AzureCluster creation."))
+	}
+
+	for i, ipConfig := range pls.NATIPConfigurations {
+		ipConfigPath := fldPath.Child("natIPConfigurations").Index(i)
+		var subnet *SubnetSpec
+		for j := range networkSpec.Subnets {
+			if networkSpec.Subnets[j].Name == ipConfig.Subnet {
+				subnet = &networkSpec.Subnets[j]
+				break
+			}
+		}
+		if subnet == nil {
+			allErrs = append(allErrs, field.Invalid(ipConfigPath.Child("subnet"), ipConfig.Subnet, "subnet must be one of the subnets of the AzureCluster"))
+			continue
+		}
+		if ipConfig.PrivateIPAddress != "" {
+			if err := validatePrivateLinkServiceIPAddress(ipConfig.PrivateIPAddress, subnet.CIDRBlocks, ipConfigPath.Child("privateIPAddress")); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+	}
+
+	for i, subscription := range pls.VisibilitySubscriptions {
+		if subscription == "*" {
+			continue
+		}
+		if _, err := uuid.Parse(subscription); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("visibilitySubscriptions").Index(i), subscription, "must be a subscription ID or \"*\""))
+		}
+	}
+	for i, subscription := range pls.AutoApprovalSubscriptions {
+		if _, err := uuid.Parse(subscription); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("autoApprovalSubscriptions").Index(i), subscription, "must be a subscription ID"))
+		}
+	}
+
+	return allErrs
+}
+
+// validatePrivateLinkServiceIPAddress validates that a NAT IP address of a private link service is in its subnet.
+func validatePrivateLinkServiceIPAddress(address string, cidrs []string, fldPath *field.Path) *field.Error {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return field.Invalid(fldPath, address,
+			"Private Link Service IP address isn't a valid IPv4 or IPv6 address")
+	}
+
+	for _, cidr := range cidrs {
+		_, subnet, _ := net.ParseCIDR(cidr)
+		if subnet != nil && subnet.Contains(ip) {
+			return nil
+		}
+	}
+
+	return field.Invalid(fldPath, address,
+		fmt.Sprintf("Private Link Service IP address needs to be in subnet range (%s)", cidrs))
+}
+
 func validateNodeOutboundLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, apiserverLB *LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -556,6 +991,21 @@ func validatePrivateDNSZoneName(privateDNSZoneName string, controlPlaneEnabled b
 	return allErrs
 }
 
+// validateClusterLabelTagPrefixes validates that the CAPI Cluster labels matching the prefixes can be converted to Azure tag keys.
+func validateClusterLabelTagPrefixes(prefixes []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, prefix := range prefixes {
+		if prefix == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), prefix, "prefix must not be empty"))
+			continue
+		}
+		if err := ValidateTagKey(ClusterLabelTagKey(prefix)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), prefix, err.Error()))
+		}
+	}
+	return allErrs
+}
+
 // validateCloudProviderConfigOverrides validates CloudProviderConfigOverrides.
 func validateCloudProviderConfigOverrides(oldConfig, newConfig *CloudProviderConfigOverrides, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -584,8 +1034,8 @@ func validateClassSpecForAPIServerLB(lb LoadBalancerClassSpec, old *LoadBalancer
 		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("sku"), "API Server load balancer SKU should not be modified after AzureCluster creation."))
 	}
 
-	// Type should be immutable.
-	if old != nil && old.Type != "" && old.Type != lb.Type {
+	// Type should be immutable unless the APIServerLBTypeMigration feature flag is enabled.
+	if old != nil && old.Type != "" && old.Type != lb.Type && !feature.Gates.Enabled(feature.APIServerLBTypeMigration) {
 		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("type"), "API Server load balancer type should not be modified after AzureCluster creation."))
 	}
 
@@ -596,7 +1046,44 @@ func validateClassSpecForAPIServerLB(lb LoadBalancerClassSpec, old *LoadBalancer
 
 	if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
 		allErrs = append(allErrs, field.Invalid(apiServerLBPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+			fmt.Sprintf("API Server load balancer idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBIdleTimeoutInMinutes)))
+	}
+
+	if lb.AllocatedOutboundPorts != nil {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("allocatedOutboundPorts"), "API Server load balancer does not support allocated outbound ports."))
+	}
+
+	allErrs = append(allErrs, validateLoadBalancerHealthProbe(lb.HealthProbe, apiServerLBPath.Child("healthProbe"))...)
+
+	// HA ports rules are only supported on internal Standard load balancers.
+	if lb.HAPorts && lb.Type != Internal {
+		allErrs = append(allErrs, field.Forbidden(apiServerLBPath.Child("haPorts"), "HA ports are only supported for an internal API server load balancer."))
+	}
+
+	return allErrs
+}
+
+// validateLoadBalancerHealthProbe validates a LoadBalancerHealthProbe.
+func validateLoadBalancerHealthProbe(probe *LoadBalancerHealthProbe, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if probe == nil {
+		return allErrs
+	}
+
+	switch probe.Protocol {
+	case LBProbeProtocolTCP:
+		if probe.RequestPath != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("requestPath"), "request path is not allowed for Tcp health probes"))
+		}
+	case LBProbeProtocolHTTP, LBProbeProtocolHTTPS:
+		if probe.RequestPath == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("requestPath"), fmt.Sprintf("request path is required for %s health probes", probe.Protocol)))
+		}
+	}
+
+	if probe.RequestPath != nil && !strings.HasPrefix(*probe.RequestPath, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requestPath"), *probe.RequestPath, "request path must start with '/'"))
 	}
 
 	return allErrs
@@ -627,9 +1114,27 @@ func validateClassSpecForNodeOutboundLB(lb *LoadBalancerClassSpec, old *LoadBala
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("idleTimeoutInMinutes"), "Node outbound load balancer idle timeout cannot be modified after AzureCluster creation."))
 	}
 
-	if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
+	if old != nil && !ptr.Equal(old.AllocatedOutboundPorts, lb.AllocatedOutboundPorts) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("allocatedOutboundPorts"), "Node outbound load balancer allocated outbound ports cannot be modified after AzureCluster creation."))
+	}
+
+	if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBOutboundRuleIdleTimeoutInMinutes) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBOutboundRuleIdleTimeoutInMinutes)))
+	}
+
+	allErrs = append(allErrs, validateAllocatedOutboundPorts(lb.AllocatedOutboundPorts, fldPath.Child("allocatedOutboundPorts"))...)
+
+	if lb.HealthProbe != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "Node outbound load balancer does not support a health probe."))
+	}
+
+	if lb.HAPorts {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("haPorts"), "Node outbound load balancer does not support HA ports."))
+	}
+
+	if lb.EnableFloatingIP {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableFloatingIP"), "Node outbound load balancer does not support floating IP."))
 	}
 
 	return allErrs
@@ -649,10 +1154,105 @@ func validateClassSpecForControlPlaneOutboundLB(lb *LoadBalancerClassSpec, apise
 			return nil
 		}
 
-		if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBIdleTimeoutInMinutes) {
+		if lb.IdleTimeoutInMinutes != nil && (*lb.IdleTimeoutInMinutes < MinLBIdleTimeoutInMinutes || *lb.IdleTimeoutInMinutes > MaxLBOutboundRuleIdleTimeoutInMinutes) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
-				fmt.Sprintf("Control plane outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
+				fmt.Sprintf("Control plane outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLBOutboundRuleIdleTimeoutInMinutes)))
+		}
+
+		allErrs = append(allErrs, validateAllocatedOutboundPorts(lb.AllocatedOutboundPorts, fldPath.Child("allocatedOutboundPorts"))...)
+
+		if lb.HealthProbe != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "Control plane outbound load balancer does not support a health probe."))
+		}
+
+		if lb.HAPorts {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("haPorts"), "Control plane outbound load balancer does not support HA ports."))
 		}
+
+		if lb.EnableFloatingIP {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("enableFloatingIP"), "Control plane outbound load balancer does not support floating IP."))
+		}
+	}
+
+	return allErrs
+}
+
+// validateOutboundBackendPools validates the outbound backend pools of the load balancers. Only the node outbound
+// load balancer can have them, and they can be added but not modified or removed after creation.
+func validateOutboundBackendPools(networkSpec NetworkSpec, old NetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if networkSpec.APIServerLB != nil && len(networkSpec.APIServerLB.OutboundBackendPools) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("apiServerLB", "outboundBackendPools"), "API Server load balancer does not support outbound backend pools."))
+	}
+	if networkSpec.ControlPlaneOutboundLB != nil && len(networkSpec.ControlPlaneOutboundLB.OutboundBackendPools) != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("controlPlaneOutboundLB", "outboundBackendPools"), "Control plane outbound load balancer does not support outbound backend pools."))
+	}
+
+	lb := networkSpec.NodeOutboundLB
+	poolsPath := fldPath.Child("nodeOutboundLB", "outboundBackendPools")
+	if lb == nil {
+		return allErrs
+	}
+
+	frontendIPNames := make(map[string]struct{}, len(lb.FrontendIPs))
+	for _, ip := range lb.FrontendIPs {
+		frontendIPNames[ip.Name] = struct{}{}
+	}
+	poolNames := make(map[string]struct{}, len(lb.OutboundBackendPools))
+	for i, pool := range lb.OutboundBackendPools {
+		poolPath := poolsPath.Index(i)
+		if pool.Name == "" {
+			allErrs = append(allErrs, field.Required(poolPath.Child("name"), "name is required"))
+		} else if pool.Name == lb.BackendPool.Name {
+			allErrs = append(allErrs, field.Invalid(poolPath.Child("name"), pool.Name, "name must be different from the name of the backend pool of the load balancer"))
+		}
+		if _, ok := poolNames[pool.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(poolPath.Child("name"), pool.Name))
+		}
+		poolNames[pool.Name] = struct{}{}
+
+		for j, ip := range pool.FrontendIPs {
+			if ip.PublicIP == nil || ip.PublicIP.Name == "" {
+				allErrs = append(allErrs, field.Required(poolPath.Child("frontendIPs").Index(j).Child("publicIP", "name"), "outbound backend pool frontend IPs must have a public IP"))
+			}
+			if _, ok := frontendIPNames[ip.Name]; ok {
+				allErrs = append(allErrs, field.Duplicate(poolPath.Child("frontendIPs").Index(j).Child("name"), ip.Name))
+			}
+			frontendIPNames[ip.Name] = struct{}{}
+		}
+
+		allErrs = append(allErrs, validateAllocatedOutboundPorts(pool.AllocatedOutboundPorts, poolPath.Child("allocatedOutboundPorts"))...)
+	}
+
+	if old.NodeOutboundLB == nil {
+		return allErrs
+	}
+	for _, oldPool := range old.NodeOutboundLB.OutboundBackendPools {
+		idx := slices.IndexFunc(lb.OutboundBackendPools, func(pool OutboundBackendPool) bool { return pool.Name == oldPool.Name })
+		if idx == -1 {
+			allErrs = append(allErrs, field.Forbidden(poolsPath, fmt.Sprintf("outbound backend pool %s cannot be removed after AzureCluster creation.", oldPool.Name)))
+			continue
+		}
+		if !reflect.DeepEqual(oldPool, lb.OutboundBackendPools[idx]) {
+			allErrs = append(allErrs, field.Forbidden(poolsPath.Index(idx), "Outbound backend pools cannot be modified after AzureCluster creation."))
+		}
+	}
+
+	return allErrs
+}
+
+// validateAllocatedOutboundPorts validates the number of SNAT ports allocated per backend instance by an outbound rule.
+func validateAllocatedOutboundPorts(ports *int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ports == nil {
+		return allErrs
+	}
+
+	if *ports < 0 || *ports > MaxLBAllocatedOutboundPorts || *ports%8 != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, *ports,
+			fmt.Sprintf("allocated outbound ports should be a multiple of 8 between 0 and %d", MaxLBAllocatedOutboundPorts)))
 	}
 
 	return allErrs
@@ -26,6 +26,7 @@ import (
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
 )
@@ -415,6 +416,68 @@ func TestNetworkSpecWithPreexistingVnetInvalidResourceGroup(t *testing.T) {
 	})
 }
 
+func TestNetworkSpecNetworkResourceGroup(t *testing.T) {
+	tests := []struct {
+		name                 string
+		networkResourceGroup string
+		vnetResourceGroup    string
+		wantErrField         string
+	}{
+		{
+			name:                 "network resource group matches vnet resource group",
+			networkResourceGroup: "custom-vnet",
+			vnetResourceGroup:    "custom-vnet",
+		},
+		{
+			name:                 "network resource group matches vnet resource group case-insensitively",
+			networkResourceGroup: "Custom-VNet",
+			vnetResourceGroup:    "custom-vnet",
+		},
+		{
+			name:                 "network resource group differs from vnet resource group",
+			networkResourceGroup: "network-rg",
+			vnetResourceGroup:    "custom-vnet",
+			wantErrField:         "spec.networkSpec.vnet.resourceGroup",
+		},
+		{
+			name:                 "invalid network resource group",
+			networkResourceGroup: "invalid-name###",
+			vnetResourceGroup:    "invalid-name###",
+			wantErrField:         "spec.networkSpec.networkResourceGroup",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			networkSpec := createValidNetworkSpec()
+			networkSpec.NetworkResourceGroup = test.networkResourceGroup
+			networkSpec.Vnet.ResourceGroup = test.vnetResourceGroup
+			errs := validateNetworkSpec(true, networkSpec, NetworkSpec{APIServerLB: &LoadBalancerSpec{}}, field.NewPath("spec").Child("networkSpec"))
+			if test.wantErrField == "" {
+				g.Expect(errs).To(BeEmpty())
+				return
+			}
+			g.Expect(errs).NotTo(BeEmpty())
+			g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
+			g.Expect(errs[0].Field).To(Equal(test.wantErrField))
+		})
+	}
+}
+
+func TestNetworkSpecPrivateLinkServiceRemoved(t *testing.T) {
+	g := NewWithT(t)
+	networkSpec := createValidNetworkSpec()
+	errs := validateNetworkSpec(true, networkSpec, NetworkSpec{
+		APIServerLB: &LoadBalancerSpec{},
+		PrivateLinkService: &PrivateLinkServiceSpec{
+			Name: "my-cluster-apiserver-pls",
+		},
+	}, field.NewPath("spec").Child("networkSpec"))
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Type).To(Equal(field.ErrorTypeForbidden))
+	g.Expect(errs[0].Field).To(Equal("spec.networkSpec.privateLinkService"))
+}
+
 func TestNetworkSpecWithoutPreexistingVnetValid(t *testing.T) {
 	type test struct {
 		name        string
@@ -527,6 +590,375 @@ func TestValidateVnetCIDR(t *testing.T) {
 	}
 }
 
+func TestValidateVnetDNSServers(t *testing.T) {
+	tests := []struct {
+		name        string
+		dnsServers  []string
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:       "no dns servers",
+			dnsServers: nil,
+			wantErr:    false,
+		},
+		{
+			name:       "valid dns servers",
+			dnsServers: []string{"10.0.0.4", "2001:1234:5678:9a00::4"},
+			wantErr:    false,
+		},
+		{
+			name:       "invalid dns server",
+			dnsServers: []string{"10.0.0.4", "10.0.0.0/24"},
+			wantErr:    true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "vnet.dnsServers[1]",
+				BadValue: "10.0.0.0/24",
+				Detail:   "invalid IP address",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVnetDNSServers(testCase.dnsServers, field.NewPath("vnet", "dnsServers"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateUnmanagedSecurityGroup(t *testing.T) {
+	tests := []struct {
+		name        string
+		sg          SecurityGroup
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:    "managed security group",
+			sg:      SecurityGroup{Name: "nsg"},
+			wantErr: false,
+		},
+		{
+			name: "unmanaged security group referenced by ID",
+			sg: SecurityGroup{
+				ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/nsg",
+				Name:      "nsg",
+				Unmanaged: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "unmanaged security group without ID",
+			sg: SecurityGroup{
+				Name:      "nsg",
+				Unmanaged: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "securityGroup.id",
+				BadValue: "",
+				Detail:   "an unmanaged security group must be referenced by ID",
+			},
+		},
+		{
+			name: "unmanaged security group with an ID of another resource type",
+			sg: SecurityGroup{
+				ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/routeTables/rt",
+				Name:      "nsg",
+				Unmanaged: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "securityGroup.id",
+				BadValue: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/routeTables/rt",
+				Detail:   "must be a network security group ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}",
+			},
+		},
+		{
+			name: "unmanaged security group with security rules",
+			sg: SecurityGroup{
+				ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/nsg",
+				Name:      "nsg",
+				Unmanaged: true,
+				SecurityGroupClass: SecurityGroupClass{
+					SecurityRules: SecurityRules{{Name: "allow_ssh"}},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "securityGroup.securityRules",
+				Detail: "security rules cannot be set on an unmanaged security group",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateUnmanagedSecurityGroup(testCase.sg, field.NewPath("securityGroup"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateExistingAPIServerLB(t *testing.T) {
+	lbID := "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb"
+	tests := []struct {
+		name        string
+		lb          LoadBalancerSpec
+		old         *LoadBalancerSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:    "load balancer created by CAPZ",
+			lb:      LoadBalancerSpec{Name: "my-lb"},
+			wantErr: false,
+		},
+		{
+			name:    "existing load balancer in the cluster resource group",
+			lb:      LoadBalancerSpec{Unmanaged: true, ID: lbID, Name: "my-lb"},
+			wantErr: false,
+		},
+		{
+			name:    "existing load balancer with an ID of another resource type",
+			lb:      LoadBalancerSpec{Unmanaged: true, ID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-lb", Name: "my-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.id",
+				BadValue: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-lb",
+				Detail:   "must be a load balancer ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}",
+			},
+		},
+		{
+			name:    "existing load balancer in another resource group",
+			lb:      LoadBalancerSpec{Unmanaged: true, ID: "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Network/loadBalancers/my-lb", Name: "my-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.id",
+				BadValue: "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Network/loadBalancers/my-lb",
+				Detail:   "load balancer must be in the cluster resource group my-rg",
+			},
+		},
+		{
+			name:    "existing load balancer with another name",
+			lb:      LoadBalancerSpec{Unmanaged: true, ID: lbID, Name: "other-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.name",
+				BadValue: "other-lb",
+				Detail:   "name must match the name of the load balancer ID my-lb",
+			},
+		},
+		{
+			name: "existing load balancer with a health probe",
+			lb: LoadBalancerSpec{
+				Unmanaged: true,
+				ID:        lbID,
+				Name:      "my-lb",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					HealthProbe: &LoadBalancerHealthProbe{Protocol: LBProbeProtocolTCP},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.healthProbe",
+				Detail: "cannot be set when using an existing load balancer",
+			},
+		},
+		{
+			name: "existing load balancer with HA ports",
+			lb: LoadBalancerSpec{
+				Unmanaged: true,
+				ID:        lbID,
+				Name:      "my-lb",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					HAPorts: true,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.haPorts",
+				Detail: "cannot be set when using an existing load balancer",
+			},
+		},
+		{
+			name:    "load balancer created by CAPZ with an ID",
+			lb:      LoadBalancerSpec{ID: lbID, Name: "my-lb"},
+			old:     &LoadBalancerSpec{Name: "my-lb"},
+			wantErr: false,
+		},
+		{
+			name:    "unmanaged load balancer without an ID",
+			lb:      LoadBalancerSpec{Unmanaged: true, Name: "my-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueRequired",
+				Field:  "apiServerLB.id",
+				Detail: "must be set when the load balancer is unmanaged",
+			},
+		},
+		{
+			name: "existing load balancer with a SKU",
+			lb: LoadBalancerSpec{
+				Unmanaged: true,
+				ID:        lbID,
+				Name:      "my-lb",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					SKU: SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.sku",
+				Detail: "cannot be set when using an existing load balancer",
+			},
+		},
+		{
+			name: "existing load balancer with an idle timeout",
+			lb: LoadBalancerSpec{
+				Unmanaged: true,
+				ID:        lbID,
+				Name:      "my-lb",
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					IdleTimeoutInMinutes: ptr.To[int32](4),
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.idleTimeoutInMinutes",
+				Detail: "cannot be set when using an existing load balancer",
+			},
+		},
+		{
+			name: "existing load balancer with the type of its frontend IPs",
+			lb: LoadBalancerSpec{
+				Unmanaged:   true,
+				ID:          lbID,
+				Name:        "my-lb",
+				FrontendIPs: []FrontendIP{{Name: "ip", FrontendIPClass: FrontendIPClass{PrivateIPAddress: "10.0.0.100"}}},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "existing load balancer with another type than its frontend IPs",
+			lb: LoadBalancerSpec{
+				Unmanaged:   true,
+				ID:          lbID,
+				Name:        "my-lb",
+				FrontendIPs: []FrontendIP{{Name: "ip", FrontendIPClass: FrontendIPClass{PrivateIPAddress: "10.0.0.100"}}},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.type",
+				Detail: "cannot be set when using an existing load balancer, the type is determined by the frontend IPs",
+			},
+		},
+		{
+			name:    "unmanaged changed",
+			lb:      LoadBalancerSpec{Unmanaged: true, ID: lbID, Name: "my-lb"},
+			old:     &LoadBalancerSpec{Name: "my-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.unmanaged",
+				BadValue: true,
+				Detail:   "field is immutable",
+			},
+		},
+		{
+			name:    "existing load balancer ID changed",
+			lb:      LoadBalancerSpec{Unmanaged: true, Name: "my-lb"},
+			old:     &LoadBalancerSpec{Unmanaged: true, ID: lbID, Name: "my-lb"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.id",
+				Detail: "API Server load balancer ID should not be modified after AzureCluster creation.",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateExistingAPIServerLB(testCase.lb, testCase.old, "my-rg", field.NewPath("apiServerLB"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateAllowedSSHSourceCIDRs(t *testing.T) {
+	tests := []struct {
+		name        string
+		cidrs       []string
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:    "empty source cidrs",
+			cidrs:   nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid source cidrs",
+			cidrs:   []string{"10.0.0.0/8", "2001:1234:5678:9a00::/56"},
+			wantErr: false,
+		},
+		{
+			name:    "invalid source cidr not in the right format",
+			cidrs:   []string{"10.0.0.0/8", "10.1.2.3"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "networkSpec.allowedSSHSourceCIDRs[1]",
+				BadValue: "10.1.2.3",
+				Detail:   "invalid CIDR format",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateAllowedSSHSourceCIDRs(testCase.cidrs, field.NewPath("networkSpec", "allowedSSHSourceCIDRs"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestClusterSubnetsValid(t *testing.T) {
 	type test struct {
 		name    string
@@ -809,14 +1241,107 @@ func TestValidateSubnetCIDR(t *testing.T) {
 	}
 }
 
-func TestValidateSecurityRule(t *testing.T) {
+func TestValidateNatGatewayPublicIPPrefixes(t *testing.T) {
 	tests := []struct {
-		name      string
-		validRule SecurityRule
-		wantErr   bool
+		name       string
+		natGateway NatGatewayClassSpec
+		wantErr    bool
 	}{
 		{
-			name: "security rule - valid priority",
+			name:       "no public IP prefixes",
+			natGateway: NatGatewayClassSpec{Name: "natgw"},
+			wantErr:    false,
+		},
+		{
+			name: "valid public IP prefixes",
+			natGateway: NatGatewayClassSpec{
+				Name: "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-1", PrefixLength: 28},
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-2", PrefixLength: 31},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "public IP prefixes without a NAT gateway name",
+			natGateway: NatGatewayClassSpec{
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-1", PrefixLength: 28},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid public IP prefix ID",
+			natGateway: NatGatewayClassSpec{
+				Name:             "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{{ID: "prefix-1", PrefixLength: 28}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "public IP prefix ID with wrong resource type",
+			natGateway: NatGatewayClassSpec{
+				Name: "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ip-1", PrefixLength: 28},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "public IP prefix shorter than /28",
+			natGateway: NatGatewayClassSpec{
+				Name: "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-1", PrefixLength: 24},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "public IP prefix longer than /31",
+			natGateway: NatGatewayClassSpec{
+				Name: "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-1", PrefixLength: 32},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "public IP prefix without a length",
+			natGateway: NatGatewayClassSpec{
+				Name: "natgw",
+				PublicIPPrefixes: []NatGatewayPublicIPPrefix{
+					{ID: "/subscriptions/123/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/prefix-1"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateNatGatewayPublicIPPrefixes(testCase.natGateway, field.NewPath("natGateway"))
+			if testCase.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateSecurityRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		validRule SecurityRule
+		wantErr   bool
+	}{
+		{
+			name: "security rule - valid priority",
 			validRule: SecurityRule{
 				Name:        "allow_apiserver",
 				Description: "Allow K8s API Server",
@@ -889,6 +1414,86 @@ func TestValidateSecurityRule(t *testing.T) {
 	}
 }
 
+func TestValidateVnetPeerings(t *testing.T) {
+	tests := []struct {
+		name     string
+		peerings VnetPeerings
+		wantErr  bool
+	}{
+		{
+			name: "hub and spoke peering using the remote gateway",
+			peerings: VnetPeerings{
+				{
+					VnetPeeringClassSpec: VnetPeeringClassSpec{
+						ResourceGroup:  "hub-rg",
+						RemoteVnetName: "hub-vnet",
+						ForwardPeeringProperties: VnetPeeringProperties{
+							AllowForwardedTraffic:     ptr.To(true),
+							AllowVirtualNetworkAccess: ptr.To(true),
+							UseRemoteGateways:         ptr.To(true),
+						},
+						ReversePeeringProperties: VnetPeeringProperties{
+							AllowGatewayTransit: ptr.To(true),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "duplicate remote vnet",
+			peerings: VnetPeerings{
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "rg", RemoteVnetName: "vnet"}},
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "rg", RemoteVnetName: "vnet"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "useRemoteGateways and allowGatewayTransit both true on the forward peering",
+			peerings: VnetPeerings{
+				{
+					VnetPeeringClassSpec: VnetPeeringClassSpec{
+						ResourceGroup:  "hub-rg",
+						RemoteVnetName: "hub-vnet",
+						ForwardPeeringProperties: VnetPeeringProperties{
+							AllowGatewayTransit: ptr.To(true),
+							UseRemoteGateways:   ptr.To(true),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "useRemoteGateways and allowGatewayTransit both true on the reverse peering",
+			peerings: VnetPeerings{
+				{
+					VnetPeeringClassSpec: VnetPeeringClassSpec{
+						ResourceGroup:  "hub-rg",
+						RemoteVnetName: "hub-vnet",
+						ReversePeeringProperties: VnetPeeringProperties{
+							AllowGatewayTransit: ptr.To(true),
+							UseRemoteGateways:   ptr.To(true),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVnetPeerings(testCase.peerings, field.NewPath("peerings"))
+			if testCase.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestValidateAPIServerLB(t *testing.T) {
 	testcases := []struct {
 		name        string
@@ -1274,152 +1879,732 @@ func TestValidateAPIServerLB(t *testing.T) {
 				Detail:   "Internal LB IP address needs to be in control plane subnet range ([10.0.0.0/24 10.1.0.0/24])",
 			},
 		},
-	}
-
-	for _, test := range testcases {
-		t.Run(test.name, func(t *testing.T) {
-			g := NewWithT(t)
-			if test.featureGate == feature.APIServerILB {
-				defer featuregatetesting.SetFeatureGateDuringTest(t, feature.Gates, test.featureGate, true)()
-			}
-			err := validateAPIServerLB(&test.lb, &test.old, test.cpCIDRS, field.NewPath("apiServerLB"))
-			if test.wantErr {
-				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
-			} else {
-				g.Expect(err).To(BeEmpty())
-			}
-		})
-	}
-}
-func TestPrivateDNSZoneName(t *testing.T) {
-	testcases := []struct {
-		name        string
-		network     NetworkSpec
-		wantErr     bool
-		expectedErr field.Error
-	}{
 		{
-			name: "testInvalidPrivateDNSZoneName",
-			network: NetworkSpec{
-				NetworkClassSpec: NetworkClassSpec{
-					PrivateDNSZoneName: "wrong@d_ns.io",
+			name: "public LB with valid TCP health probe",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:    "my-valid-frontend-ip",
+							DNSName: "my-valid-frontend-ip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol:          LBProbeProtocolTCP,
+						IntervalInSeconds: ptr.To[int32](5),
+						NumberOfProbes:    ptr.To[int32](2),
+					},
 				},
-				APIServerLB: createValidAPIServerInternalLB(),
-			},
-			expectedErr: field.Error{
-				Type:     "FieldValueInvalid",
-				Field:    "spec.networkSpec.privateDNSZoneName",
-				BadValue: "wrong@d_ns.io",
-				Detail:   "PrivateDNSZoneName can only contain alphanumeric characters, underscores and dashes, must end with an alphanumeric character",
 			},
-			wantErr: true,
-		},
-		{
-			name: "testValidPrivateDNSZoneName",
-			network: NetworkSpec{
-				NetworkClassSpec: NetworkClassSpec{
-					PrivateDNSZoneName: "good.dns.io",
+			old: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol:          LBProbeProtocolTCP,
+						IntervalInSeconds: ptr.To[int32](5),
+						NumberOfProbes:    ptr.To[int32](2),
+					},
 				},
-				APIServerLB: createValidAPIServerInternalLB(),
 			},
 			wantErr: false,
 		},
 		{
-			name: "testValidPrivateDNSZoneNameWithUnderscore",
-			network: NetworkSpec{
-				NetworkClassSpec: NetworkClassSpec{
-					PrivateDNSZoneName: "_good.__dns.io",
+			name: "TCP health probe with request path",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol:    LBProbeProtocolTCP,
+						RequestPath: ptr.To("/readyz"),
+					},
 				},
-				APIServerLB: createValidAPIServerInternalLB(),
 			},
-			wantErr: false,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.healthProbe.requestPath",
+				Detail: "request path is not allowed for Tcp health probes",
+			},
 		},
 		{
-			name: "testBadAPIServerLBType",
-			network: NetworkSpec{
-				NetworkClassSpec: NetworkClassSpec{
-					PrivateDNSZoneName: "good.dns.io",
-				},
-				APIServerLB: &LoadBalancerSpec{
-					Name: "my-lb",
-					LoadBalancerClassSpec: LoadBalancerClassSpec{
-						Type: Public,
+			name: "HTTP health probe without request path",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol: LBProbeProtocolHTTP,
 					},
 				},
 			},
+			wantErr: true,
 			expectedErr: field.Error{
-				Type:     "FieldValueInvalid",
-				Field:    "spec.networkSpec.privateDNSZoneName",
-				BadValue: "Public",
-				Detail:   "PrivateDNSZoneName is available only if APIServerLB.Type is Internal",
+				Type:   "FieldValueRequired",
+				Field:  "apiServerLB.healthProbe.requestPath",
+				Detail: "request path is required for Http health probes",
 			},
-			wantErr: true,
 		},
-	}
-
-	for _, test := range testcases {
-		t.Run(test.name, func(t *testing.T) {
-			t.Parallel()
-			g := NewWithT(t)
-			err := validatePrivateDNSZoneName(test.network.PrivateDNSZoneName, true, test.network.APIServerLB.Type, field.NewPath("spec", "networkSpec", "privateDNSZoneName"))
-			if test.wantErr {
-				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
-			} else {
-				g.Expect(err).To(BeEmpty())
-			}
-		})
-	}
-}
-
-func TestValidateNodeOutboundLB(t *testing.T) {
-	testcases := []struct {
-		name        string
-		lb          *LoadBalancerSpec
-		old         *LoadBalancerSpec
-		apiServerLB LoadBalancerSpec
-		wantErr     bool
-		expectedErr field.Error
-	}{
 		{
-			name: "no lb for public clusters",
-			lb:   nil,
-			apiServerLB: LoadBalancerSpec{
+			name: "HTTPS health probe with relative request path",
+			lb: LoadBalancerSpec{
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
 					Type: Public,
+					SKU:  SKUStandard,
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol:    LBProbeProtocolHTTPS,
+						RequestPath: ptr.To("readyz"),
+					},
 				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:     "FieldValueRequired",
-				Field:    "nodeOutboundLB",
-				BadValue: nil,
-				Detail:   "Node outbound load balancer cannot be nil for public clusters.",
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.requestPath",
+				BadValue: "readyz",
+				Detail:   "request path must start with '/'",
 			},
 		},
 		{
-			name: "no lb allowed for internal clusters",
-			lb:   nil,
-			apiServerLB: LoadBalancerSpec{
+			name: "internal LB with HA ports and floating IP",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.0.0.100",
+						},
+					},
+				},
 				LoadBalancerClassSpec: LoadBalancerClassSpec{
-					Type: Internal,
+					Type:             Internal,
+					SKU:              SKUStandard,
+					HAPorts:          true,
+					EnableFloatingIP: true,
+				},
+			},
+			old: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					HAPorts:          true,
+					EnableFloatingIP: true,
 				},
 			},
+			cpCIDRS: []string{"10.0.0.0/24"},
 			wantErr: false,
 		},
 		{
-			name: "invalid ID update",
-			lb: &LoadBalancerSpec{
-				ID: "some-id",
-			},
-			old: &LoadBalancerSpec{
-				ID: "old-id",
+			name: "public LB with HA ports",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:    Public,
+					SKU:     SKUStandard,
+					HAPorts: true,
+				},
 			},
 			wantErr: true,
 			expectedErr: field.Error{
-				Type:     "FieldValueForbidden",
-				Field:    "nodeOutboundLB.id",
-				BadValue: "some-id",
-				Detail:   "Node outbound load balancer ID should not be modified after AzureCluster creation.",
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.haPorts",
+				Detail: "HA ports are only supported for an internal API server load balancer.",
+			},
+		},
+		{
+			name: "HA ports modified",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:    Internal,
+					SKU:     SKUStandard,
+					HAPorts: true,
+				},
+			},
+			old: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.haPorts",
+				Detail: "API Server load balancer HA ports should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name: "floating IP modified",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type:             Public,
+					SKU:              SKUStandard,
+					EnableFloatingIP: true,
+				},
+			},
+			old: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.enableFloatingIP",
+				Detail: "API Server load balancer floating IP should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name: "health probe modified",
+			lb: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+					HealthProbe: &LoadBalancerHealthProbe{
+						Protocol: LBProbeProtocolTCP,
+					},
+				},
+			},
+			old: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.healthProbe",
+				Detail: "API Server load balancer health probe should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name: "public IP zones modified",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-pip",
+							Zones: []string{"1"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-pip",
+							Zones: []string{"1", "2", "3"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendIPConfigs[0].publicIP.zones",
+				Detail: "API Server load balancer public IP zones should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name: "public IP zones modified on a frontend IP other than the first",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-pip",
+							Zones: []string{"1", "2", "3"},
+						},
+					},
+					{
+						Name: "ip-2",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-other-pip",
+							Zones: []string{"1"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-pip",
+							Zones: []string{"1", "2", "3"},
+						},
+					},
+					{
+						Name: "ip-2",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-other-pip",
+							Zones: []string{"1", "2", "3"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.frontendIPConfigs[1].publicIP.zones",
+				Detail: "API Server load balancer public IP zones should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name: "public IP zones of a replaced public IP",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-new-pip",
+							Zones: []string{"1"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:  "my-pip",
+							Zones: []string{"1", "2", "3"},
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "type modified",
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.0.0.100",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name: "my-pip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			cpCIDRS: []string{"10.0.0.0/24"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.type",
+				Detail: "API Server load balancer type should not be modified after AzureCluster creation.",
+			},
+		},
+		{
+			name:        "type modified with feature flag APIServerLBTypeMigration enabled",
+			featureGate: feature.APIServerLBTypeMigration,
+			lb: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						FrontendIPClass: FrontendIPClass{
+							PrivateIPAddress: "10.0.0.100",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+					SKU:  SKUStandard,
+				},
+			},
+			old: LoadBalancerSpec{
+				Name: "my-lb",
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name: "my-pip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+			},
+			cpCIDRS: []string{"10.0.0.0/24"},
+			wantErr: false,
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			if test.featureGate != "" {
+				defer featuregatetesting.SetFeatureGateDuringTest(t, feature.Gates, test.featureGate, true)()
+			}
+			err := validateAPIServerLB(&test.lb, &test.old, test.cpCIDRS, field.NewPath("apiServerLB"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+func TestPrivateDNSZoneName(t *testing.T) {
+	testcases := []struct {
+		name        string
+		network     NetworkSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "testInvalidPrivateDNSZoneName",
+			network: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					PrivateDNSZoneName: "wrong@d_ns.io",
+				},
+				APIServerLB: createValidAPIServerInternalLB(),
+			},
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateDNSZoneName",
+				BadValue: "wrong@d_ns.io",
+				Detail:   "PrivateDNSZoneName can only contain alphanumeric characters, underscores and dashes, must end with an alphanumeric character",
+			},
+			wantErr: true,
+		},
+		{
+			name: "testValidPrivateDNSZoneName",
+			network: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					PrivateDNSZoneName: "good.dns.io",
+				},
+				APIServerLB: createValidAPIServerInternalLB(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "testValidPrivateDNSZoneNameWithUnderscore",
+			network: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					PrivateDNSZoneName: "_good.__dns.io",
+				},
+				APIServerLB: createValidAPIServerInternalLB(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "testBadAPIServerLBType",
+			network: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					PrivateDNSZoneName: "good.dns.io",
+				},
+				APIServerLB: &LoadBalancerSpec{
+					Name: "my-lb",
+					LoadBalancerClassSpec: LoadBalancerClassSpec{
+						Type: Public,
+					},
+				},
+			},
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateDNSZoneName",
+				BadValue: "Public",
+				Detail:   "PrivateDNSZoneName is available only if APIServerLB.Type is Internal",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validatePrivateDNSZoneName(test.network.PrivateDNSZoneName, true, test.network.APIServerLB.Type, field.NewPath("spec", "networkSpec", "privateDNSZoneName"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidatePrivateLinkService(t *testing.T) {
+	validNetworkSpec := func(pls *PrivateLinkServiceSpec) NetworkSpec {
+		return NetworkSpec{
+			APIServerLB: createValidAPIServerInternalLB(),
+			Subnets: Subnets{
+				{
+					SubnetClassSpec: SubnetClassSpec{
+						Role:       SubnetControlPlane,
+						Name:       "control-plane-subnet",
+						CIDRBlocks: []string{"10.0.0.0/16"},
+					},
+				},
+			},
+			PrivateLinkService: pls,
+		}
+	}
+
+	testcases := []struct {
+		name        string
+		network     NetworkSpec
+		old         *PrivateLinkServiceSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid private link service",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name: "my-cluster-apiserver-pls",
+				NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+					{
+						Subnet:           "control-plane-subnet",
+						PrivateIPAddress: "10.0.0.100",
+					},
+				},
+				VisibilitySubscriptions:   []string{"*"},
+				AutoApprovalSubscriptions: []string{"00000000-0000-0000-0000-000000000000"},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "public API server load balancer",
+			network: func() NetworkSpec {
+				network := validNetworkSpec(&PrivateLinkServiceSpec{
+					Name: "my-cluster-apiserver-pls",
+				})
+				network.APIServerLB = &LoadBalancerSpec{
+					Name: "my-lb",
+					LoadBalancerClassSpec: LoadBalancerClassSpec{
+						Type: Public,
+					},
+				}
+				return network
+			}(),
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.privateLinkService",
+				Detail: "a private link service can only be created for clusters with an internal API server load balancer",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid name",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name: "-invalid-pls-",
+			}),
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateLinkService.name",
+				BadValue: "-invalid-pls-",
+				Detail:   "name of private link service doesn't match regex " + privateLinkServiceRegex,
+			},
+			wantErr: true,
+		},
+		{
+			name: "name is modified",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name: "my-cluster-apiserver-pls",
+			}),
+			old: &PrivateLinkServiceSpec{
+				Name: "my-old-pls",
+			},
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.privateLinkService.name",
+				Detail: "private link service name should not be modified after AzureCluster creation.",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown NAT subnet",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name: "my-cluster-apiserver-pls",
+				NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+					{
+						Subnet: "unknown-subnet",
+					},
+				},
+			}),
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateLinkService.natIPConfigurations[0].subnet",
+				BadValue: "unknown-subnet",
+				Detail:   "subnet must be one of the subnets of the AzureCluster",
+			},
+			wantErr: true,
+		},
+		{
+			name: "NAT IP address outside of the subnet",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name: "my-cluster-apiserver-pls",
+				NATIPConfigurations: []PrivateLinkServiceNATIPConfiguration{
+					{
+						Subnet:           "control-plane-subnet",
+						PrivateIPAddress: "192.168.0.10",
+					},
+				},
+			}),
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateLinkService.natIPConfigurations[0].privateIPAddress",
+				BadValue: "192.168.0.10",
+				Detail:   "Private Link Service IP address needs to be in subnet range ([10.0.0.0/16])",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid visibility subscription",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name:                    "my-cluster-apiserver-pls",
+				VisibilitySubscriptions: []string{"not-a-subscription"},
+			}),
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateLinkService.visibilitySubscriptions[0]",
+				BadValue: "not-a-subscription",
+				Detail:   "must be a subscription ID or \"*\"",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wildcard auto-approval subscription",
+			network: validNetworkSpec(&PrivateLinkServiceSpec{
+				Name:                      "my-cluster-apiserver-pls",
+				AutoApprovalSubscriptions: []string{"*"},
+			}),
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "spec.networkSpec.privateLinkService.autoApprovalSubscriptions[0]",
+				BadValue: "*",
+				Detail:   "must be a subscription ID",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validatePrivateLinkService(true, test.network, test.old, field.NewPath("spec", "networkSpec", "privateLinkService"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateNodeOutboundLB(t *testing.T) {
+	testcases := []struct {
+		name        string
+		lb          *LoadBalancerSpec
+		old         *LoadBalancerSpec
+		apiServerLB LoadBalancerSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "no lb for public clusters",
+			lb:   nil,
+			apiServerLB: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "nodeOutboundLB",
+				BadValue: nil,
+				Detail:   "Node outbound load balancer cannot be nil for public clusters.",
+			},
+		},
+		{
+			name: "no lb allowed for internal clusters",
+			lb:   nil,
+			apiServerLB: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid ID update",
+			lb: &LoadBalancerSpec{
+				ID: "some-id",
+			},
+			old: &LoadBalancerSpec{
+				ID: "old-id",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueForbidden",
+				Field:    "nodeOutboundLB.id",
+				BadValue: "some-id",
+				Detail:   "Node outbound load balancer ID should not be modified after AzureCluster creation.",
 			},
 		},
 		{
@@ -1499,29 +2684,157 @@ func TestValidateNodeOutboundLB(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "frontend ips count exceeds max value",
-			lb: &LoadBalancerSpec{
-				FrontendIPsCount: ptr.To[int32](100),
-			},
+			name: "HA ports are not supported",
+			lb: &LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					HAPorts: true,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "nodeOutboundLB.haPorts",
+				Detail: "Node outbound load balancer does not support HA ports.",
+			},
+		},
+		{
+			name: "frontend ips count exceeds max value",
+			lb: &LoadBalancerSpec{
+				FrontendIPsCount: ptr.To[int32](100),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "nodeOutboundLB.frontendIPsCount",
+				BadValue: 100,
+				Detail:   "Max front end ips allowed is 16",
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := validateNodeOutboundLB(test.lb, test.old, &test.apiServerLB, field.NewPath("nodeOutboundLB"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateOutboundBackendPools(t *testing.T) {
+	pool := func(name, frontendIPName string) OutboundBackendPool {
+		return OutboundBackendPool{
+			Name: name,
+			FrontendIPs: []FrontendIP{{
+				Name:     frontendIPName,
+				PublicIP: &PublicIPSpec{Name: "pip-" + frontendIPName},
+			}},
+		}
+	}
+	nodeOutboundLB := func(pools ...OutboundBackendPool) *LoadBalancerSpec {
+		return &LoadBalancerSpec{
+			Name: "my-cluster",
+			BackendPool: BackendPool{
+				Name: "my-cluster-outboundBackendPool",
+			},
+			FrontendIPs: []FrontendIP{{
+				Name:     "my-cluster-frontEnd",
+				PublicIP: &PublicIPSpec{Name: "pip-my-cluster-node-outbound"},
+			}},
+			OutboundBackendPools: pools,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		spec    NetworkSpec
+		old     NetworkSpec
+		wantErr bool
+	}{
+		{
+			name: "no outbound backend pools",
+			spec: NetworkSpec{NodeOutboundLB: nodeOutboundLB()},
+		},
+		{
+			name: "valid outbound backend pools",
+			spec: NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"), pool("batch", "batch-frontEnd"))},
+		},
+		{
+			name: "outbound backend pools on the API server load balancer",
+			spec: NetworkSpec{
+				APIServerLB: &LoadBalancerSpec{OutboundBackendPools: []OutboundBackendPool{pool("gpu", "gpu-frontEnd")}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "outbound backend pool without a name",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("", "gpu-frontEnd"))},
+			wantErr: true,
+		},
+		{
+			name:    "outbound backend pool with the name of the load balancer backend pool",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("my-cluster-outboundBackendPool", "gpu-frontEnd"))},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate outbound backend pool names",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"), pool("gpu", "batch-frontEnd"))},
+			wantErr: true,
+		},
+		{
+			name:    "outbound backend pool frontend IP name used by the load balancer",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "my-cluster-frontEnd"))},
+			wantErr: true,
+		},
+		{
+			name: "outbound backend pool frontend IP without a public IP",
+			spec: NetworkSpec{NodeOutboundLB: nodeOutboundLB(OutboundBackendPool{
+				Name:        "gpu",
+				FrontendIPs: []FrontendIP{{Name: "gpu-frontEnd"}},
+			})},
+			wantErr: true,
+		},
+		{
+			name: "outbound backend pool with invalid allocated outbound ports",
+			spec: NetworkSpec{NodeOutboundLB: nodeOutboundLB(OutboundBackendPool{
+				Name:                   "gpu",
+				FrontendIPs:            pool("gpu", "gpu-frontEnd").FrontendIPs,
+				AllocatedOutboundPorts: ptr.To[int32](10),
+			})},
+			wantErr: true,
+		},
+		{
+			name: "outbound backend pool added",
+			spec: NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"), pool("batch", "batch-frontEnd"))},
+			old:  NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"))},
+		},
+		{
+			name:    "outbound backend pool removed",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("batch", "batch-frontEnd"))},
+			old:     NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"), pool("batch", "batch-frontEnd"))},
+			wantErr: true,
+		},
+		{
+			name:    "outbound backend pool modified",
+			spec:    NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd-2"))},
+			old:     NetworkSpec{NodeOutboundLB: nodeOutboundLB(pool("gpu", "gpu-frontEnd"))},
 			wantErr: true,
-			expectedErr: field.Error{
-				Type:     "FieldValueInvalid",
-				Field:    "nodeOutboundLB.frontendIPsCount",
-				BadValue: 100,
-				Detail:   "Max front end ips allowed is 16",
-			},
 		},
 	}
-
-	for _, test := range testcases {
-		t.Run(test.name, func(t *testing.T) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
-			err := validateNodeOutboundLB(test.lb, test.old, &test.apiServerLB, field.NewPath("nodeOutboundLB"))
-			if test.wantErr {
-				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			errs := validateOutboundBackendPools(tc.spec, tc.old, field.NewPath("spec").Child("networkSpec"))
+			if tc.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
 			} else {
-				g.Expect(err).To(BeEmpty())
+				g.Expect(errs).To(BeEmpty())
 			}
 		})
 	}
@@ -1606,6 +2919,310 @@ func TestValidateControlPlaneNodeOutboundLB(t *testing.T) {
 	}
 }
 
+func TestValidateSharedAPIServerOutboundIP(t *testing.T) {
+	publicAPIServerLB := &LoadBalancerSpec{
+		LoadBalancerClassSpec: LoadBalancerClassSpec{
+			Type: Public,
+		},
+	}
+	testcases := []struct {
+		name                string
+		featureGate         bool
+		controlPlaneEnabled bool
+		networkSpec         NetworkSpec
+		oldNetworkSpec      NetworkSpec
+		wantErr             bool
+		expectedErr         field.Error
+	}{
+		{
+			name:                "shared outbound ip is valid for public clusters",
+			featureGate:         true,
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:              publicAPIServerLB,
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:                "shared outbound ip requires the feature flag",
+			featureGate:         false,
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:              publicAPIServerLB,
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.shareAPIServerOutboundIP",
+				Detail: "can be set only if the SharedAPIServerOutboundIP feature flag is enabled",
+			},
+		},
+		{
+			name:                "shared outbound ip is kept when the feature flag is turned off",
+			featureGate:         false,
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:              publicAPIServerLB,
+				ShareAPIServerOutboundIP: true,
+			},
+			oldNetworkSpec: NetworkSpec{
+				APIServerLB:              publicAPIServerLB,
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:                "shared outbound ip cannot be set for private clusters",
+			featureGate:         true,
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB: &LoadBalancerSpec{
+					LoadBalancerClassSpec: LoadBalancerClassSpec{
+						Type: Internal,
+					},
+				},
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.shareAPIServerOutboundIP",
+				Detail: "API server load balancer public IP can only be shared for public clusters",
+			},
+		},
+		{
+			name:                "shared outbound ip cannot be set when the control plane is disabled",
+			featureGate:         true,
+			controlPlaneEnabled: false,
+			networkSpec: NetworkSpec{
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.shareAPIServerOutboundIP",
+				Detail: "API server load balancer public IP can only be shared for public clusters",
+			},
+		},
+		{
+			name:                "node outbound lb cannot be set with shared outbound ip",
+			featureGate:         true,
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:              publicAPIServerLB,
+				NodeOutboundLB:           &LoadBalancerSpec{Name: "foo"},
+				ShareAPIServerOutboundIP: true,
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.nodeOutboundLB",
+				Detail: "Node outbound load balancer cannot be set when the API server load balancer public IP is shared",
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			if test.featureGate {
+				defer featuregatetesting.SetFeatureGateDuringTest(t, feature.Gates, feature.SharedAPIServerOutboundIP, true)()
+			}
+			err := validateSharedAPIServerOutboundIP(test.controlPlaneEnabled, test.networkSpec, test.oldNetworkSpec, field.NewPath("spec", "networkSpec"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateDisableOutbound(t *testing.T) {
+	internalAPIServerLB := &LoadBalancerSpec{
+		LoadBalancerClassSpec: LoadBalancerClassSpec{
+			Type: Internal,
+		},
+	}
+	nodeSubnet := SubnetSpec{
+		SubnetClassSpec: SubnetClassSpec{Role: SubnetNode, Name: "node-subnet"},
+		RouteTable:      RouteTable{Name: "node-routetable"},
+	}
+	testcases := []struct {
+		name                string
+		controlPlaneEnabled bool
+		networkSpec         NetworkSpec
+		wantErr             bool
+		expectedErr         field.Error
+	}{
+		{
+			name:                "disabled outbound is valid for private clusters",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:     internalAPIServerLB,
+				DisableOutbound: true,
+				Subnets:         Subnets{nodeSubnet},
+			},
+			wantErr: false,
+		},
+		{
+			name:                "disabled outbound is valid when the control plane is disabled",
+			controlPlaneEnabled: false,
+			networkSpec: NetworkSpec{
+				DisableOutbound: true,
+				Subnets:         Subnets{nodeSubnet},
+			},
+			wantErr: false,
+		},
+		{
+			name:                "disabled outbound cannot be set for public clusters",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB: &LoadBalancerSpec{
+					LoadBalancerClassSpec: LoadBalancerClassSpec{
+						Type: Public,
+					},
+				},
+				DisableOutbound: true,
+				Subnets:         Subnets{nodeSubnet},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.disableOutbound",
+				Detail: "outbound connectivity can only be disabled for clusters with an internal API server load balancer",
+			},
+		},
+		{
+			name:                "node outbound lb cannot be set with disabled outbound",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:     internalAPIServerLB,
+				NodeOutboundLB:  &LoadBalancerSpec{Name: "foo"},
+				DisableOutbound: true,
+				Subnets:         Subnets{nodeSubnet},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.nodeOutboundLB",
+				Detail: "Node outbound load balancer cannot be set when outbound connectivity is disabled",
+			},
+		},
+		{
+			name:                "control plane outbound lb cannot be set with disabled outbound",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:            internalAPIServerLB,
+				ControlPlaneOutboundLB: &LoadBalancerSpec{Name: "foo"},
+				DisableOutbound:        true,
+				Subnets:                Subnets{nodeSubnet},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.controlPlaneOutboundLB",
+				Detail: "Control plane outbound load balancer cannot be set when outbound connectivity is disabled",
+			},
+		},
+		{
+			name:                "nat gateway cannot be set with disabled outbound",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:     internalAPIServerLB,
+				DisableOutbound: true,
+				Subnets: Subnets{
+					{
+						SubnetClassSpec: SubnetClassSpec{Role: SubnetNode, Name: "node-subnet"},
+						RouteTable:      RouteTable{Name: "node-routetable"},
+						NatGateway:      NatGateway{NatGatewayClassSpec: NatGatewayClassSpec{Name: "node-natgw"}},
+					},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "spec.networkSpec.subnets[0].natGateway",
+				Detail: "NAT gateway cannot be set when outbound connectivity is disabled",
+			},
+		},
+		{
+			name:                "node subnets require a route table with disabled outbound",
+			controlPlaneEnabled: true,
+			networkSpec: NetworkSpec{
+				APIServerLB:     internalAPIServerLB,
+				DisableOutbound: true,
+				Subnets: Subnets{
+					{
+						SubnetClassSpec: SubnetClassSpec{Role: SubnetNode, Name: "node-subnet"},
+					},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueRequired",
+				Field:  "spec.networkSpec.subnets[0].routeTable.name",
+				Detail: "a route table with a default route is required when outbound connectivity is disabled",
+			},
+		},
+	}
+
+	for _, test := range testcases {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateDisableOutbound(test.controlPlaneEnabled, test.networkSpec, field.NewPath("spec", "networkSpec"))
+			if test.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(test.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateClusterLabelTagPrefixes(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefixes []string
+		wantErr  bool
+	}{
+		{
+			name:     "no prefixes",
+			prefixes: nil,
+			wantErr:  false,
+		},
+		{
+			name:     "valid prefixes",
+			prefixes: []string{"billing.example.com/", "cost-"},
+			wantErr:  false,
+		},
+		{
+			name:     "empty prefix",
+			prefixes: []string{""},
+			wantErr:  true,
+		},
+		{
+			name:     "prefix converting to a reserved tag key",
+			prefixes: []string{"azure.example.com/"},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			errs := validateClusterLabelTagPrefixes(tc.prefixes, field.NewPath("spec").Child("clusterLabelTagPrefixes"))
+			if tc.wantErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestValidateCloudProviderConfigOverrides(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1996,3 +3613,176 @@ func TestClusterWithExtendedLocationInvalid(t *testing.T) {
 		g.Expect(err).NotTo(BeNil())
 	})
 }
+
+func TestClusterWithRestrictedFailureDomains(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := createValidCluster()
+	cluster.Spec.RestrictFailureDomains = true
+	g.Expect(cluster.validateClusterSpec(nil)).To(ContainElement(HaveField("Field", "spec.failureDomains")))
+
+	cluster.Spec.FailureDomains = clusterv1.FailureDomains{"1": clusterv1.FailureDomainSpec{ControlPlane: true}}
+	g.Expect(cluster.validateClusterSpec(nil)).To(BeEmpty())
+}
+
+func TestValidateBastionSpec(t *testing.T) {
+	testcases := []struct {
+		name          string
+		bastion       *AzureBastion
+		expectedField string
+		expectedErr   string
+	}{
+		{
+			name:    "bastion disabled",
+			bastion: nil,
+		},
+		{
+			name:    "basic bastion",
+			bastion: &AzureBastion{Sku: BasicBastionHostSku},
+		},
+		{
+			name: "standard bastion with tunneling, scale units and copy paste disabled",
+			bastion: &AzureBastion{
+				Sku:              StandardBastionHostSku,
+				EnableTunneling:  true,
+				ScaleUnits:       ptr.To(10),
+				DisableCopyPaste: ptr.To(true),
+			},
+		},
+		{
+			name:          "basic bastion with tunneling",
+			bastion:       &AzureBastion{Sku: BasicBastionHostSku, EnableTunneling: true},
+			expectedField: "spec.bastionSpec.azureBastion.enableTunneling",
+			expectedErr:   "sku must be Standard if tunneling is enabled",
+		},
+		{
+			name:          "basic bastion with scale units",
+			bastion:       &AzureBastion{Sku: BasicBastionHostSku, ScaleUnits: ptr.To(2)},
+			expectedField: "spec.bastionSpec.azureBastion.scaleUnits",
+			expectedErr:   "sku must be Standard if scale units are set",
+		},
+		{
+			name:          "basic bastion with copy paste disabled",
+			bastion:       &AzureBastion{Sku: BasicBastionHostSku, DisableCopyPaste: ptr.To(true)},
+			expectedField: "spec.bastionSpec.azureBastion.disableCopyPaste",
+			expectedErr:   "sku must be Standard if copy and paste is disabled",
+		},
+		{
+			name:    "basic bastion with copy paste enabled",
+			bastion: &AzureBastion{Sku: BasicBastionHostSku, DisableCopyPaste: ptr.To(false)},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateBastionSpec(BastionSpec{AzureBastion: tc.bastion}, field.NewPath("spec", "bastionSpec"))
+			if tc.expectedErr != "" {
+				g.Expect(errs).To(HaveLen(1))
+				g.Expect(errs[0].Field).To(Equal(tc.expectedField))
+				g.Expect(errs[0].Detail).To(Equal(tc.expectedErr))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateBastionUpdate(t *testing.T) {
+	standardBastion := func() *AzureBastion {
+		return &AzureBastion{
+			Name:             "my-bastion",
+			Sku:              StandardBastionHostSku,
+			EnableTunneling:  true,
+			ScaleUnits:       ptr.To(4),
+			DisableCopyPaste: ptr.To(true),
+		}
+	}
+	testcases := []struct {
+		name           string
+		old            *AzureBastion
+		bastion        *AzureBastion
+		expectedFields []string
+	}{
+		{
+			name:    "enabling bastion",
+			old:     nil,
+			bastion: standardBastion(),
+		},
+		{
+			name:    "unchanged bastion",
+			old:     standardBastion(),
+			bastion: standardBastion(),
+		},
+		{
+			name:           "removing bastion",
+			old:            standardBastion(),
+			bastion:        nil,
+			expectedFields: []string{"spec.bastionSpec.azureBastion"},
+		},
+		{
+			name: "upgrading the sku and enabling features",
+			old:  &AzureBastion{Name: "my-bastion", Sku: BasicBastionHostSku},
+			bastion: func() *AzureBastion {
+				bastion := standardBastion()
+				bastion.EnableTunneling = false
+				return bastion
+			}(),
+		},
+		{
+			name: "changing scale units and copy paste",
+			old:  standardBastion(),
+			bastion: func() *AzureBastion {
+				bastion := standardBastion()
+				bastion.ScaleUnits = ptr.To(2)
+				bastion.DisableCopyPaste = ptr.To(false)
+				return bastion
+			}(),
+		},
+		{
+			name: "downgrading the sku",
+			old:  standardBastion(),
+			bastion: &AzureBastion{
+				Name:             "my-bastion",
+				Sku:              BasicBastionHostSku,
+				ScaleUnits:       ptr.To(4),
+				DisableCopyPaste: ptr.To(true),
+			},
+			expectedFields: []string{"spec.bastionSpec.azureBastion.sku"},
+		},
+		{
+			name: "clearing scale units and copy paste",
+			old:  standardBastion(),
+			bastion: func() *AzureBastion {
+				bastion := standardBastion()
+				bastion.ScaleUnits = nil
+				bastion.DisableCopyPaste = nil
+				return bastion
+			}(),
+			expectedFields: []string{
+				"spec.bastionSpec.azureBastion.scaleUnits",
+				"spec.bastionSpec.azureBastion.disableCopyPaste",
+			},
+		},
+		{
+			name: "renaming bastion",
+			old:  standardBastion(),
+			bastion: func() *AzureBastion {
+				bastion := standardBastion()
+				bastion.Name = "other-bastion"
+				return bastion
+			}(),
+			expectedFields: []string{"spec.bastionSpec.azureBastion"},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateBastionUpdate(tc.old, tc.bastion, field.NewPath("spec", "bastionSpec", "azureBastion"))
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(Equal(tc.expectedFields))
+		})
+	}
+}
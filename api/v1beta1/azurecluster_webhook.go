@@ -109,6 +109,34 @@ func (c *AzureCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings
 		}
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "networkSpec", "networkResourceGroup"),
+		old.Spec.NetworkSpec.NetworkResourceGroup,
+		c.Spec.NetworkSpec.NetworkResourceGroup); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "networkSpec", "shareAPIServerOutboundIP"),
+		old.Spec.NetworkSpec.ShareAPIServerOutboundIP,
+		c.Spec.NetworkSpec.ShareAPIServerOutboundIP); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "networkSpec", "disableOutbound"),
+		old.Spec.NetworkSpec.DisableOutbound,
+		c.Spec.NetworkSpec.DisableOutbound); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "networkSpec", "allowedSSHSourceCIDRs"),
+		old.Spec.NetworkSpec.AllowedSSHSourceCIDRs,
+		c.Spec.NetworkSpec.AllowedSSHSourceCIDRs); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "networkSpec", "privateDNSZoneName"),
 		old.Spec.NetworkSpec.PrivateDNSZoneName,
@@ -117,12 +145,10 @@ func (c *AzureCluster) ValidateUpdate(oldRaw runtime.Object) (admission.Warnings
 	}
 
 	// Allow enabling azure bastion but avoid disabling it.
-	if old.Spec.BastionSpec.AzureBastion != nil && !reflect.DeepEqual(old.Spec.BastionSpec.AzureBastion, c.Spec.BastionSpec.AzureBastion) {
-		allErrs = append(allErrs,
-			field.Invalid(field.NewPath("spec", "bastionSpec", "azureBastion"),
-				c.Spec.BastionSpec.AzureBastion, "azure bastion cannot be removed from a cluster"),
-		)
-	}
+	allErrs = append(allErrs, validateBastionUpdate(
+		old.Spec.BastionSpec.AzureBastion,
+		c.Spec.BastionSpec.AzureBastion,
+		field.NewPath("spec", "bastionSpec", "azureBastion"))...)
 
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "networkSpec", "controlPlaneOutboundLB"),
@@ -182,6 +208,19 @@ func (c *AzureCluster) validateSubnetUpdate(old *AzureCluster) field.ErrorList {
 						c.Spec.NetworkSpec.Subnets[i].SecurityGroup.Name, "field is immutable"),
 				)
 			}
+			if subnet.SecurityGroup.Unmanaged != oldSubnet.SecurityGroup.Unmanaged {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec", "networkSpec", "subnets").Index(oldSubnetIndex[subnet.Name]).Child("SecurityGroup").Child("Unmanaged"),
+						c.Spec.NetworkSpec.Subnets[i].SecurityGroup.Unmanaged, "field is immutable"),
+				)
+			}
+			// The ID of a managed security group is set once it is created, so it is only immutable for unmanaged ones.
+			if oldSubnet.SecurityGroup.Unmanaged && subnet.SecurityGroup.ID != oldSubnet.SecurityGroup.ID {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec", "networkSpec", "subnets").Index(oldSubnetIndex[subnet.Name]).Child("SecurityGroup").Child("ID"),
+						c.Spec.NetworkSpec.Subnets[i].SecurityGroup.ID, "field is immutable"),
+				)
+			}
 		}
 	}
 
@@ -82,6 +82,20 @@ func TestAzureCluster_ValidateCreate(t *testing.T) {
 			}(),
 			wantErr: true,
 		},
+		{
+			name: "azurecluster with an unmanaged bastion security group without an ID",
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.BastionSpec.AzureBastion = &AzureBastion{
+					Subnet: SubnetSpec{
+						SubnetClassSpec: SubnetClassSpec{Name: "AzureBastionSubnet"},
+						SecurityGroup:   SecurityGroup{Name: "bastion-nsg", Unmanaged: true},
+					},
+				}
+				return cluster
+			}(),
+			wantErr: true,
+		},
 		{
 			name: "azurecluster with pre-existing vnet - invalid subnet name",
 			cluster: func() *AzureCluster {
@@ -233,6 +247,24 @@ func TestAzureCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "azurecluster network resource group is immutable",
+			oldCluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkResourceGroup: "network-rg",
+					},
+				},
+			},
+			cluster: &AzureCluster{
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						NetworkResourceGroup: "network-rg-2",
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "azurecluster subscription ID is immutable",
 			oldCluster: &AzureCluster{
@@ -329,6 +361,43 @@ func TestAzureCluster_ValidateUpdate(t *testing.T) {
 			}(),
 			wantErr: true,
 		},
+		{
+			name:       "security group unmanaged is immutable",
+			oldCluster: createValidCluster(),
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.Unmanaged = true
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.ID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/my-nsg"
+				return cluster
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "unmanaged security group ID is immutable",
+			oldCluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.Unmanaged = true
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.ID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/my-nsg"
+				return cluster
+			}(),
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.Unmanaged = true
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.ID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/other-nsg"
+				return cluster
+			}(),
+			wantErr: true,
+		},
+		{
+			name:       "managed security group ID can be set",
+			oldCluster: createValidCluster(),
+			cluster: func() *AzureCluster {
+				cluster := createValidCluster()
+				cluster.Spec.NetworkSpec.Subnets[0].SecurityGroup.ID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/my-nsg"
+				return cluster
+			}(),
+			wantErr: false,
+		},
 		{
 			name:       "natGateway name can be empty before AzureCluster is updated",
 			oldCluster: createValidCluster(),
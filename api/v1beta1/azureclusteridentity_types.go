@@ -64,6 +64,20 @@ type AzureClusterIdentitySpec struct {
 	CertPath string `json:"certPath,omitempty"`
 	// TenantID is the service principal primary tenant id.
 	TenantID string `json:"tenantID"`
+	// AzureEnvironment is the name of the Azure cloud the identity authenticates with. The clusters using the identity
+	// are reconciled in this cloud, regardless of their own azureEnvironment. AzureStackCloud is a custom cloud,
+	// e.g. Azure Stack Hub or an air-gapped cloud, whose endpoints are loaded from the file set with the
+	// --azure-environment-file flag of the controller. When not set, the azureEnvironment of the cluster is used.
+	// Immutable.
+	// +kubebuilder:validation:Enum=AzurePublicCloud;AzureUSGovernmentCloud;AzureChinaCloud;AzureStackCloud
+	// +optional
+	AzureEnvironment string `json:"azureEnvironment,omitempty"`
+	// RateLimit limits the rate of the Azure Resource Manager requests CAPZ makes to the subscriptions of the
+	// clusters using the identity. It does not apply to the requests made by Azure Service Operator. When several
+	// identities set a limit for the same subscription, the lowest limit applies to all the requests CAPZ makes to
+	// it. When not set, requests are not rate limited by CAPZ.
+	// +optional
+	RateLimit *ClientRateLimit `json:"rateLimit,omitempty"`
 	// AllowedNamespaces is used to identify the namespaces the clusters are allowed to use the identity from.
 	// Namespaces can be selected either using an array of namespaces or with label selector.
 	// An empty allowedNamespaces object indicates that AzureClusters can use this identity from any namespace.
@@ -75,6 +89,17 @@ type AzureClusterIdentitySpec struct {
 	AllowedNamespaces *AllowedNamespaces `json:"allowedNamespaces"`
 }
 
+// ClientRateLimit is a limit on the rate of Azure Resource Manager requests.
+type ClientRateLimit struct {
+	// QPS is the sustained number of requests per second.
+	// +kubebuilder:validation:Minimum=1
+	QPS int32 `json:"qps"`
+	// Burst is the maximum number of requests sent at once when the rate allows it. Defaults to QPS.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
 // AzureClusterIdentityStatus defines the observed state of AzureClusterIdentity.
 type AzureClusterIdentityStatus struct {
 	// Conditions defines current service state of the AzureClusterIdentity.
@@ -17,16 +17,24 @@ limitations under the License.
 package v1beta1
 
 import (
+	"slices"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
+// identityAzureEnvironments are the Azure clouds an AzureClusterIdentity can authenticate with.
+var identityAzureEnvironments = []string{DefaultAzureCloud, "AzureUSGovernmentCloud", "AzureChinaCloud", "AzureStackCloud"}
+
 func (c *AzureClusterIdentity) validateClusterIdentity() (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	if c.Spec.Type != UserAssignedMSI && c.Spec.ResourceID != "" {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "resourceID"), c.Spec.ResourceID))
 	}
+	if c.Spec.AzureEnvironment != "" && !slices.Contains(identityAzureEnvironments, c.Spec.AzureEnvironment) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "azureEnvironment"), c.Spec.AzureEnvironment, identityAzureEnvironments))
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
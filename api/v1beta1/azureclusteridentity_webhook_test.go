@@ -78,6 +78,30 @@ func TestAzureClusterIdentity_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "azureclusteridentity with a custom azure environment",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:             ServicePrincipal,
+					ClientID:         fakeClientID,
+					TenantID:         fakeTenantID,
+					AzureEnvironment: "AzureStackCloud",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "azureclusteridentity with an invalid azure environment",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:             ServicePrincipal,
+					ClientID:         fakeClientID,
+					TenantID:         fakeTenantID,
+					AzureEnvironment: "AzureGermanCloud",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -158,6 +182,25 @@ func TestAzureClusterIdentity_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "azureclusteridentity with a change in azure environment",
+			clusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:             ServicePrincipal,
+					ClientID:         fakeClientID,
+					TenantID:         fakeTenantID,
+					AzureEnvironment: "AzureUSGovernmentCloud",
+				},
+			},
+			oldClusterIdentity: &AzureClusterIdentity{
+				Spec: AzureClusterIdentitySpec{
+					Type:     ServicePrincipal,
+					ClientID: fakeClientID,
+					TenantID: fakeTenantID,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1425,3 +1425,51 @@ func TestBastionTemplateDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterTemplateNetworkCIDRBlocksPatch(t *testing.T) {
+	// ClusterClass topology patches set the CIDR blocks on the defaulted template, which is then copied as is into the
+	// AzureCluster spec. The patched CIDR blocks must survive the copy and the AzureCluster defaults.
+	clusterTemplate := &AzureClusterTemplate{
+		Spec: AzureClusterTemplateSpec{
+			Template: AzureClusterTemplateResource{
+				Spec: AzureClusterTemplateResourceSpec{
+					NetworkSpec: NetworkTemplateSpec{
+						Subnets: SubnetTemplatesSpec{
+							{SubnetClassSpec: SubnetClassSpec{Name: "control-plane-subnet", Role: SubnetControlPlane}},
+							{SubnetClassSpec: SubnetClassSpec{Name: "node-subnet", Role: SubnetNode}},
+						},
+					},
+				},
+			},
+		},
+	}
+	clusterTemplate.setDefaults()
+
+	networkSpec := &clusterTemplate.Spec.Template.Spec.NetworkSpec
+	networkSpec.Vnet.CIDRBlocks = []string{"172.16.0.0/12"}
+	networkSpec.Subnets[0].CIDRBlocks = []string{"172.16.0.0/24"}
+	networkSpec.Subnets[1].CIDRBlocks = []string{"172.17.0.0/16"}
+
+	templateSpec, err := json.Marshal(clusterTemplate.Spec.Template.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster := &AzureCluster{ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"}}
+	if err := json.Unmarshal(templateSpec, &cluster.Spec); err != nil {
+		t.Fatal(err)
+	}
+	cluster.setDefaults()
+
+	if !reflect.DeepEqual(cluster.Spec.NetworkSpec.Vnet.CIDRBlocks, []string{"172.16.0.0/12"}) {
+		t.Errorf("Expected vnet CIDR blocks [172.16.0.0/12], got %v", cluster.Spec.NetworkSpec.Vnet.CIDRBlocks)
+	}
+	expectedSubnetCIDRBlocks := map[string][]string{
+		"control-plane-subnet": {"172.16.0.0/24"},
+		"node-subnet":          {"172.17.0.0/16"},
+	}
+	for _, subnet := range cluster.Spec.NetworkSpec.Subnets {
+		if !reflect.DeepEqual(subnet.CIDRBlocks, expectedSubnetCIDRBlocks[subnet.Name]) {
+			t.Errorf("Expected subnet %s CIDR blocks %v, got %v", subnet.Name, expectedSubnetCIDRBlocks[subnet.Name], subnet.CIDRBlocks)
+		}
+	}
+}
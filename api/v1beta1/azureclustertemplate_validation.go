@@ -46,6 +46,17 @@ func (c *AzureClusterTemplate) validateClusterTemplateSpec() field.ErrorList {
 		field.NewPath("spec").Child("template").Child("spec").
 			Child("networkSpec").Child("vnet").Child("cidrBlocks"))...)
 
+	allErrs = append(allErrs, validateVnetDNSServers(
+		c.Spec.Template.Spec.NetworkSpec.Vnet.DNSServers,
+		field.NewPath("spec").Child("template").Child("spec").
+			Child("networkSpec").Child("vnet").Child("dnsServers"))...)
+
+	for i, peering := range c.Spec.Template.Spec.NetworkSpec.Vnet.Peerings {
+		allErrs = append(allErrs, validateVnetPeeringClassSpec(peering,
+			field.NewPath("spec").Child("template").Child("spec").
+				Child("networkSpec").Child("vnet").Child("peerings").Index(i))...)
+	}
+
 	allErrs = append(allErrs, validateSubnetTemplates(
 		c.Spec.Template.Spec.NetworkSpec.Subnets,
 		c.Spec.Template.Spec.NetworkSpec.Vnet,
@@ -62,6 +73,11 @@ func (c *AzureClusterTemplate) validateClusterTemplateSpec() field.ErrorList {
 
 	allErrs = append(allErrs, c.validatePrivateDNSZoneName()...)
 
+	allErrs = append(allErrs, validateClusterLabelTagPrefixes(
+		c.Spec.Template.Spec.ClusterLabelTagPrefixes,
+		field.NewPath("spec").Child("template").Child("spec").Child("clusterLabelTagPrefixes"),
+	)...)
+
 	return allErrs
 }
 
@@ -113,6 +129,7 @@ func validateSubnetTemplates(subnets SubnetTemplatesSpec, vnet VnetTemplateSpec,
 			}
 		}
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fld.Index(i).Child("cidrBlocks"))...)
+		allErrs = append(allErrs, validateNatGatewayPublicIPPrefixes(subnet.NatGateway, fld.Index(i).Child("natGateway"))...)
 	}
 	for k, v := range requiredSubnetRoles {
 		if !v {
@@ -603,7 +603,7 @@ func TestControlPlaneOutboundLBTemplate(t *testing.T) {
 									Type: Internal,
 								},
 								ControlPlaneOutboundLB: &LoadBalancerClassSpec{
-									IdleTimeoutInMinutes: ptr.To[int32](60),
+									IdleTimeoutInMinutes: ptr.To[int32](150),
 								},
 							},
 						},
@@ -733,7 +733,77 @@ func TestNodeOutboundLBTemplate(t *testing.T) {
 									Type: Public,
 								},
 								NodeOutboundLB: &LoadBalancerClassSpec{
-									IdleTimeoutInMinutes: ptr.To[int32](60),
+									IdleTimeoutInMinutes: ptr.To[int32](150),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectValid: false,
+		},
+		{
+			name: "timeout and allocated outbound ports can be tuned",
+			clusterTemplate: &AzureClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster-template",
+				},
+				Spec: AzureClusterTemplateSpec{
+					Template: AzureClusterTemplateResource{
+						Spec: AzureClusterTemplateResourceSpec{
+							NetworkSpec: NetworkTemplateSpec{
+								APIServerLB: LoadBalancerClassSpec{
+									Type: Public,
+								},
+								NodeOutboundLB: &LoadBalancerClassSpec{
+									IdleTimeoutInMinutes:   ptr.To[int32](60),
+									AllocatedOutboundPorts: ptr.To[int32](1024),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectValid: true,
+		},
+		{
+			name: "allocated outbound ports should be a multiple of 8",
+			clusterTemplate: &AzureClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster-template",
+				},
+				Spec: AzureClusterTemplateSpec{
+					Template: AzureClusterTemplateResource{
+						Spec: AzureClusterTemplateResourceSpec{
+							NetworkSpec: NetworkTemplateSpec{
+								APIServerLB: LoadBalancerClassSpec{
+									Type: Public,
+								},
+								NodeOutboundLB: &LoadBalancerClassSpec{
+									AllocatedOutboundPorts: ptr.To[int32](1001),
+								},
+							},
+						},
+					},
+				},
+			},
+			expectValid: false,
+		},
+		{
+			name: "allocated outbound ports should not be more than maximum",
+			clusterTemplate: &AzureClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-cluster-template",
+				},
+				Spec: AzureClusterTemplateSpec{
+					Template: AzureClusterTemplateResource{
+						Spec: AzureClusterTemplateResourceSpec{
+							NetworkSpec: NetworkTemplateSpec{
+								APIServerLB: LoadBalancerClassSpec{
+									Type: Public,
+								},
+								NodeOutboundLB: &LoadBalancerClassSpec{
+									AllocatedOutboundPorts: ptr.To[int32](64008),
 								},
 							},
 						},
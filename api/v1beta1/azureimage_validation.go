@@ -17,9 +17,18 @@ limitations under the License.
 package v1beta1
 
 import (
+	"regexp"
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+var (
+	communityGalleryImageIDRegex = regexp.MustCompile(`(?i)^/CommunityGalleries/[^/]+/Images/[^/]+(/Versions/[^/]+)?$`)
+	sharedGalleryImageIDRegex    = regexp.MustCompile(`(?i)^/SharedGalleries/[^/]+/Images/[^/]+(/Versions/[^/]+)?$`)
+	computeGalleryImageIDRegex   = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Compute/galleries/[^/]+/images/[^/]+(/versions/[^/]+)?$`)
+)
+
 // ValidateImage validates an image.
 func ValidateImage(image *Image, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -146,5 +155,27 @@ func validateSpecificImage(image *Image, fldPath *field.Path) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("ID"), "", "ID cannot be empty when specifying an AzureImageByID"))
 	}
 
+	// Gallery images can be shared with the cluster's subscription from another subscription through RBAC, a
+	// community gallery or a direct shared gallery. Check their references are complete so that a mistake is
+	// reported here rather than when a VM is created.
+	id := strings.ToLower(*image.ID)
+	switch {
+	case strings.HasPrefix(id, "/communitygalleries/"):
+		if !communityGalleryImageIDRegex.MatchString(*image.ID) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ID"), *image.ID,
+				"community gallery image ID must be of the form /CommunityGalleries/<public gallery name>/Images/<image>[/Versions/<version>]"))
+		}
+	case strings.HasPrefix(id, "/sharedgalleries/"):
+		if !sharedGalleryImageIDRegex.MatchString(*image.ID) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ID"), *image.ID,
+				"shared gallery image ID must be of the form /SharedGalleries/<gallery unique name>/Images/<image>[/Versions/<version>]"))
+		}
+	case strings.Contains(id, "/providers/microsoft.compute/galleries/"):
+		if !computeGalleryImageIDRegex.MatchString(*image.ID) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ID"), *image.ID,
+				"compute gallery image ID must be of the form /subscriptions/<subscription>/resourceGroups/<resource group>/providers/Microsoft.Compute/galleries/<gallery>/images/<image>[/versions/<version>]"))
+		}
+	}
+
 	return allErrs
 }
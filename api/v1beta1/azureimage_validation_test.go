@@ -173,6 +173,34 @@ func TestImageByIDValid(t *testing.T) {
 			expectedErrors: 1,
 			image:          createTestImageByID(""),
 		},
+		"AzureImageByID - compute gallery image in another subscription": {
+			expectedErrors: 0,
+			image:          createTestImageByID("/subscriptions/other-sub/resourceGroups/images-rg/providers/Microsoft.Compute/galleries/golden/images/ubuntu/versions/1.0.0"),
+		},
+		"AzureImageByID - latest version of a compute gallery image": {
+			expectedErrors: 0,
+			image:          createTestImageByID("/subscriptions/other-sub/resourceGroups/images-rg/providers/Microsoft.Compute/galleries/golden/images/ubuntu"),
+		},
+		"AzureImageByID - compute gallery image without a resource group": {
+			expectedErrors: 1,
+			image:          createTestImageByID("/subscriptions/other-sub/providers/Microsoft.Compute/galleries/golden/images/ubuntu/versions/1.0.0"),
+		},
+		"AzureImageByID - community gallery image": {
+			expectedErrors: 0,
+			image:          createTestImageByID("/CommunityGalleries/golden-1234abcd/Images/ubuntu/Versions/1.0.0"),
+		},
+		"AzureImageByID - community gallery image without an image name": {
+			expectedErrors: 1,
+			image:          createTestImageByID("/CommunityGalleries/golden-1234abcd/Versions/1.0.0"),
+		},
+		"AzureImageByID - direct shared gallery image": {
+			expectedErrors: 0,
+			image:          createTestImageByID("/SharedGalleries/00000000-0000-0000-0000-000000000000-GOLDEN/Images/ubuntu/Versions/latest"),
+		},
+		"AzureImageByID - direct shared gallery image with a trailing slash": {
+			expectedErrors: 1,
+			image:          createTestImageByID("/SharedGalleries/00000000-0000-0000-0000-000000000000-GOLDEN/Images/ubuntu/"),
+		},
 	}
 
 	for _, tc := range testCases {
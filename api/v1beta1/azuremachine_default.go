@@ -36,6 +36,9 @@ import (
 // ContributorRoleID is the ID of the built-in "Contributor" role.
 const ContributorRoleID = "b24988ac-6180-42a0-ab88-20f7382dd24c"
 
+// AcrPullRoleID is the ID of the built-in "AcrPull" role.
+const AcrPullRoleID = "7f951dda-4ed3-4680-a7ca-43fe172d538d"
+
 // SetDefaultSSHPublicKey sets the default SSHPublicKey for an AzureMachine.
 func (s *AzureMachineSpec) SetDefaultSSHPublicKey() error {
 	if sshKeyData := s.SSHPublicKey; sshKeyData == "" {
@@ -49,6 +52,14 @@ func (s *AzureMachineSpec) SetDefaultSSHPublicKey() error {
 	return nil
 }
 
+// SetOSDiskDefaults sets the OS disk defaults for an AzureMachine. The API server applies the same defaults from the
+// CRD schema, which don't apply when an AzureMachine is defaulted outside of it.
+func (s *AzureMachineSpec) SetOSDiskDefaults() {
+	if s.OSDisk.CachingType == "" {
+		s.OSDisk.CachingType = string(armcompute.CachingTypesNone)
+	}
+}
+
 // SetDataDisksDefaults sets the data disk defaults for an AzureMachine.
 func (s *AzureMachineSpec) SetDataDisksDefaults() {
 	set := make(map[int32]struct{})
@@ -60,7 +71,8 @@ func (s *AzureMachineSpec) SetDataDisksDefaults() {
 	}
 	// Look for unique values for unassigned LUNs
 	for i, disk := range s.DataDisks {
-		if disk.Lun == nil {
+		// The LUN of an existing disk to attach must be chosen by the user.
+		if disk.Lun == nil && disk.ManagedDiskID == "" {
 			for l := range s.DataDisks {
 				lun := int32(l)
 				if _, ok := set[lun]; !ok {
@@ -218,9 +230,6 @@ func GetSubscriptionID(cli client.Client, ownerAzureClusterName string, ownerAzu
 // SetDefaults sets to the defaults for the AzureMachineSpec.
 func (m *AzureMachine) SetDefaults(client client.Client) error {
 	var errs []error
-	if err := m.Spec.SetDefaultSSHPublicKey(); err != nil {
-		errs = append(errs, errors.Wrap(err, "failed to set default SSH public key"))
-	}
 
 	// Fetch the Cluster.
 	clusterName, ok := m.Labels[clusterv1.ClusterNameLabel]
@@ -238,11 +247,26 @@ func (m *AzureMachine) SetDefaults(client client.Client) error {
 		errs = append(errs, errors.Wrapf(err, "failed to fetch subscription ID for AzureMachine %s/%s", m.Namespace, m.Name))
 	}
 
-	m.Spec.SetDataDisksDefaults()
-	m.Spec.SetIdentityDefaults(subscriptionID)
-	m.Spec.SetSpotEvictionPolicyDefaults()
-	m.Spec.SetDiagnosticsDefaults()
-	m.Spec.SetNetworkInterfacesDefaults()
+	if err := m.Spec.SetDefaultsWithSubscriptionID(subscriptionID); err != nil {
+		errs = append(errs, err)
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// SetDefaultsWithSubscriptionID sets the defaults for the AzureMachineSpec without reading the owner cluster.
+// The scope of a system-assigned identity role is defaulted from subscriptionID and left unset if it is empty.
+func (s *AzureMachineSpec) SetDefaultsWithSubscriptionID(subscriptionID string) error {
+	var errs []error
+	if err := s.SetDefaultSSHPublicKey(); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed to set default SSH public key"))
+	}
+	s.SetOSDiskDefaults()
+	s.SetDataDisksDefaults()
+	s.SetIdentityDefaults(subscriptionID)
+	s.SetSpotEvictionPolicyDefaults()
+	s.SetDiagnosticsDefaults()
+	s.SetNetworkInterfacesDefaults()
 
 	return kerrors.NewAggregate(errs)
 }
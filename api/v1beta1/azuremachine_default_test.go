@@ -159,6 +159,37 @@ func TestAzureMachineSpec_SetSpotEvictionPolicyDefaults(t *testing.T) {
 	g.Expect(localDiffDiskSettingsExistTest.machine.Spec.SpotVMOptions.EvictionPolicy).To(Equal(&deletePolicy))
 }
 
+func TestAzureMachineSpec_SetOSDiskDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	emptyCachingTypeTest := &AzureMachine{Spec: AzureMachineSpec{OSDisk: OSDisk{OSType: "Linux"}}}
+	emptyCachingTypeTest.Spec.SetOSDiskDefaults()
+	g.Expect(emptyCachingTypeTest.Spec.OSDisk.CachingType).To(Equal("None"))
+
+	cachingTypeSetTest := &AzureMachine{Spec: AzureMachineSpec{OSDisk: OSDisk{OSType: "Linux", CachingType: "ReadWrite"}}}
+	cachingTypeSetTest.Spec.SetOSDiskDefaults()
+	g.Expect(cachingTypeSetTest.Spec.OSDisk.CachingType).To(Equal("ReadWrite"))
+}
+
+func TestAzureMachineSpec_SetDefaultsWithSubscriptionID(t *testing.T) {
+	g := NewWithT(t)
+
+	machine := &AzureMachine{Spec: AzureMachineSpec{
+		OSDisk:    OSDisk{OSType: "Linux"},
+		DataDisks: []DataDisk{{NameSuffix: "disk"}},
+		Identity:  VMIdentitySystemAssigned,
+	}}
+	g.Expect(machine.Spec.SetDefaultsWithSubscriptionID("")).To(Succeed())
+	g.Expect(machine.Spec.SSHPublicKey).NotTo(BeEmpty())
+	g.Expect(machine.Spec.OSDisk.CachingType).To(Equal("None"))
+	g.Expect(machine.Spec.DataDisks[0].Lun).To(Equal(ptr.To[int32](0)))
+	g.Expect(machine.Spec.SystemAssignedIdentityRole.Name).NotTo(BeEmpty())
+	g.Expect(machine.Spec.SystemAssignedIdentityRole.Scope).To(BeEmpty())
+
+	g.Expect(machine.Spec.SetDefaultsWithSubscriptionID("123")).To(Succeed())
+	g.Expect(machine.Spec.SystemAssignedIdentityRole.Scope).To(Equal("/subscriptions/123/"))
+}
+
 func TestAzureMachineSpec_SetDataDisksDefaults(t *testing.T) {
 	cases := []struct {
 		name   string
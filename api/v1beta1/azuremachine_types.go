@@ -30,6 +30,17 @@ const (
 	MachineFinalizer = "azuremachine.infrastructure.cluster.x-k8s.io"
 )
 
+const (
+	// LicenseTypeWindowsServer is the Azure Hybrid Benefit license type for Windows Server.
+	LicenseTypeWindowsServer = "Windows_Server"
+	// LicenseTypeWindowsClient is the Azure Hybrid Benefit license type for Windows client.
+	LicenseTypeWindowsClient = "Windows_Client"
+	// LicenseTypeRHELBYOS is the Azure Hybrid Benefit license type for Red Hat Enterprise Linux.
+	LicenseTypeRHELBYOS = "RHEL_BYOS"
+	// LicenseTypeSLESBYOS is the Azure Hybrid Benefit license type for SUSE Linux Enterprise Server.
+	LicenseTypeSLESBYOS = "SLES_BYOS"
+)
+
 // AzureMachineSpec defines the desired state of AzureMachine.
 type AzureMachineSpec struct {
 	// ProviderID is the unique identifier as specified by the cloud provider.
@@ -70,6 +81,18 @@ type AzureMachineSpec struct {
 	// +optional
 	SystemAssignedIdentityRole *SystemAssignedIdentityRole `json:"systemAssignedIdentityRole,omitempty"`
 
+	// AdditionalRoleAssignments is a list of additional roles to assign to the system-assigned identity,
+	// each on a specific scope such as a container registry.
+	// It may only be set when identity is SystemAssigned and may not be changed once set.
+	// +optional
+	AdditionalRoleAssignments []RoleAssignment `json:"additionalRoleAssignments,omitempty"`
+
+	// ImagePullRegistryID is the resource ID of the Azure Container Registry that the user-assigned
+	// identity with the ImagePull purpose is granted AcrPull on. It must be set if and only if exactly
+	// one of the userAssignedIdentities has the ImagePull purpose, and may not be changed once set.
+	// +optional
+	ImagePullRegistryID string `json:"imagePullRegistryID,omitempty"`
+
 	// Deprecated: RoleAssignmentName should be set in the systemAssignedIdentityRole field.
 	// +optional
 	RoleAssignmentName string `json:"roleAssignmentName,omitempty"`
@@ -106,6 +129,12 @@ type AzureMachineSpec struct {
 	// +optional
 	EnableIPForwarding bool `json:"enableIPForwarding,omitempty"`
 
+	// NodeOutboundBackendPool is the name of an outbound backend pool of the cluster's node outbound load balancer
+	// that the machine joins instead of its default backend pool, to egress through the frontend IPs of the pool.
+	// Only supported for worker machines. Immutable.
+	// +optional
+	NodeOutboundBackendPool string `json:"nodeOutboundBackendPool,omitempty"`
+
 	// Deprecated: AcceleratedNetworking should be set in the networkInterfaces field.
 	// +kubebuilder:validation:nullable
 	// +optional
@@ -157,6 +186,24 @@ type AzureMachineSpec struct {
 	// It is optional but may not be changed once set.
 	// +optional
 	CapacityReservationGroupID *string `json:"capacityReservationGroupID,omitempty"`
+
+	// GalleryApplications specifies a list of Azure Compute Gallery applications to install on the virtual machine.
+	// It is optional but may not be changed once set.
+	// +optional
+	GalleryApplications []VMGalleryApplication `json:"galleryApplications,omitempty"`
+
+	// Secrets specifies certificates stored in Azure Key Vault to install on the virtual machine.
+	// It is optional but may not be changed once set.
+	// +optional
+	Secrets []VaultSecretGroup `json:"secrets,omitempty"`
+
+	// LicenseType specifies that the image or disk of the virtual machine was licensed on-premises, to use
+	// Azure Hybrid Benefit. Windows_Server and Windows_Client apply to Windows virtual machines, RHEL_BYOS and
+	// SLES_BYOS to Linux virtual machines.
+	// It is optional but may not be changed once set.
+	// +kubebuilder:validation:Enum=Windows_Server;Windows_Client;RHEL_BYOS;SLES_BYOS
+	// +optional
+	LicenseType string `json:"licenseType,omitempty"`
 }
 
 // SpotVMOptions defines the options relevant to running the Machine on Spot VMs.
@@ -168,6 +215,13 @@ type SpotVMOptions struct {
 	// EvictionPolicy defines the behavior of the virtual machine when it is evicted. It can be either Delete or Deallocate.
 	// +optional
 	EvictionPolicy *SpotEvictionPolicy `json:"evictionPolicy,omitempty"`
+
+	// MaxStartAttempts is the number of times CAPZ tries to start a Spot VM that was evicted and deallocated
+	// before marking the AzureMachine as failed so that it can be remediated. It cannot be set when the
+	// EvictionPolicy is Delete, nor on an AzureMachinePool. Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxStartAttempts *int32 `json:"maxStartAttempts,omitempty"`
 }
 
 // SystemAssignedIdentityRole defines the role and scope to assign to the system assigned identity.
@@ -188,6 +242,17 @@ type SystemAssignedIdentityRole struct {
 	Scope string `json:"scope,omitempty"`
 }
 
+// RoleAssignment defines a role to assign to an identity on a specific scope.
+type RoleAssignment struct {
+	// DefinitionID is the ID of the role definition to assign, in the form
+	// /subscriptions/{subscriptionId}/providers/Microsoft.Authorization/roleDefinitions/{roleDefinitionId}.
+	// Refer to built-in roles: https://learn.microsoft.com/en-us/azure/role-based-access-control/built-in-roles
+	DefinitionID string `json:"definitionID"`
+
+	// Scope is the resource ID that the role assignment applies to, for example a container registry.
+	Scope string `json:"scope"`
+}
+
 // AzureMachineStatus defines the observed state of AzureMachine.
 type AzureMachineStatus struct {
 	// Ready is true when the provider resource is ready.
@@ -202,6 +267,14 @@ type AzureMachineStatus struct {
 	// +optional
 	VMState *ProvisioningState `json:"vmState,omitempty"`
 
+	// FailedStartAttempts is the number of consecutive failed attempts to start a deallocated Spot VM.
+	// +optional
+	FailedStartAttempts int32 `json:"failedStartAttempts,omitempty"`
+
+	// OSDiskResizing is true while the VM is deallocated to grow its OS disk, until the VM is started again.
+	// +optional
+	OSDiskResizing bool `json:"osDiskResizing,omitempty"`
+
 	// ErrorReason will be set in the event that there is a terminal problem
 	// reconciling the Machine and will contain a succinct value suitable
 	// for machine interpretation.
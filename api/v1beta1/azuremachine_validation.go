@@ -19,6 +19,9 @@ package v1beta1
 import (
 	"encoding/base64"
 	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/google/uuid"
@@ -27,8 +30,12 @@ import (
 	"k8s.io/utils/ptr"
 
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
 )
 
+// userAssignedIdentityNameRegex matches the name of a user-assigned identity.
+var userAssignedIdentityNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{2,127}$`)
+
 // ValidateAzureMachineSpec checks an AzureMachineSpec and returns any validation errors.
 func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 	var allErrs field.ErrorList
@@ -45,6 +52,10 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateEncryptionAtHost(spec.VMSize, spec.SecurityProfile, field.NewPath("securityProfile", "encryptionAtHost")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := ValidateSSHKey(spec.SSHPublicKey, field.NewPath("sshPublicKey")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -53,6 +64,14 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateImagePullIdentity(spec.UserAssignedIdentities, spec.ImagePullRegistryID, field.NewPath("userAssignedIdentities"), field.NewPath("imagePullRegistryID")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateSpotVMOptions(spec.SpotVMOptions, field.NewPath("spotVMOptions")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := ValidateDataDisks(spec.DataDisks, field.NewPath("dataDisks")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -69,6 +88,10 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateAdditionalRoleAssignments(spec.Identity, spec.AdditionalRoleAssignments, field.NewPath("additionalRoleAssignments")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := ValidateCapacityReservationGroupID(spec.CapacityReservationGroupID, field.NewPath("capacityReservationGroupID")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -77,6 +100,18 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateGalleryApplications(spec.GalleryApplications, field.NewPath("galleryApplications")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateVMSecrets(spec.Secrets, spec.OSDisk.OSType, field.NewPath("secrets")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateLicenseType(spec.LicenseType, spec.OSDisk.OSType, field.NewPath("licenseType")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	return allErrs
 }
 
@@ -148,10 +183,18 @@ func ValidateUserAssignedIdentity(identityType VMIdentity, userAssignedIdentitie
 			allErrs = append(allErrs, field.Required(fldPath, "must be specified for the 'UserAssigned' identity type"))
 		}
 		for _, identity := range userAssignedIdentities {
-			if identity.ProviderID != "" {
-				if _, err := azureutil.ParseResourceID(identity.ProviderID); err != nil {
-					allErrs = append(allErrs, field.Invalid(fldPath, identity.ProviderID, "must be a valid Azure resource ID"))
+			if identity.ProviderID == "" {
+				continue
+			}
+			if !strings.Contains(identity.ProviderID, "/") {
+				// The identity is referenced by name in the cluster's resource group.
+				if !userAssignedIdentityNameRegex.MatchString(identity.ProviderID) {
+					allErrs = append(allErrs, field.Invalid(fldPath, identity.ProviderID, "must be a valid Azure resource ID or user-assigned identity name"))
 				}
+				continue
+			}
+			if _, err := azureutil.ParseResourceID(identity.ProviderID); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath, identity.ProviderID, "must be a valid Azure resource ID"))
 			}
 		}
 	}
@@ -159,6 +202,42 @@ func ValidateUserAssignedIdentity(identityType VMIdentity, userAssignedIdentitie
 	return allErrs
 }
 
+// ValidateImagePullIdentity validates that exactly one user-assigned identity has the ImagePull purpose
+// when an image pull registry is set, and that none does otherwise.
+func ValidateImagePullIdentity(userAssignedIdentities []UserAssignedIdentity, registryID string, identitiesPath, registryPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	marked := 0
+	for i, identity := range userAssignedIdentities {
+		if identity.Purpose != UserAssignedIdentityPurposeImagePull {
+			continue
+		}
+		marked++
+		if marked > 1 {
+			allErrs = append(allErrs, field.Invalid(identitiesPath.Index(i).Child("purpose"), identity.Purpose,
+				"only one user-assigned identity may have the ImagePull purpose"))
+		}
+	}
+
+	if registryID == "" {
+		if marked > 0 {
+			allErrs = append(allErrs, field.Required(registryPath, "must be set when a user-assigned identity has the ImagePull purpose"))
+		}
+		return allErrs
+	}
+
+	if marked == 0 {
+		allErrs = append(allErrs, field.Required(identitiesPath, "exactly one user-assigned identity must have the ImagePull purpose when imagePullRegistryID is set"))
+	}
+	registry, err := azureutil.ParseResourceID(registryID)
+	if err != nil || !strings.EqualFold(registry.ResourceType.String(), containerRegistryResourceType) {
+		allErrs = append(allErrs, field.Invalid(registryPath, registryID,
+			"must be a container registry ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ContainerRegistry/registries/{registryName}"))
+	}
+
+	return allErrs
+}
+
 // ValidateSystemAssignedIdentityRole validates the system-assigned identity role.
 func ValidateSystemAssignedIdentityRole(identityType VMIdentity, roleAssignmentName string, role *SystemAssignedIdentityRole, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -179,14 +258,68 @@ func ValidateSystemAssignedIdentityRole(identityType VMIdentity, roleAssignmentN
 	return allErrs
 }
 
+// ValidateAdditionalRoleAssignments validates the additional role assignments of the system-assigned identity.
+func ValidateAdditionalRoleAssignments(identityType VMIdentity, roleAssignments []RoleAssignment, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(roleAssignments) == 0 {
+		return allErrs
+	}
+	if identityType != VMIdentitySystemAssigned {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "additionalRoleAssignments can only be set when identity is set to SystemAssigned"))
+		return allErrs
+	}
+
+	seen := make(map[string]struct{}, len(roleAssignments))
+	for i, role := range roleAssignments {
+		definitionID, err := azureutil.ParseResourceID(role.DefinitionID)
+		if err != nil || !strings.EqualFold(definitionID.ResourceType.String(), roleDefinitionResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("definitionID"), role.DefinitionID,
+				"must be a role definition ID in the form /subscriptions/{subscriptionId}/providers/Microsoft.Authorization/roleDefinitions/{roleDefinitionId}"))
+		}
+		if _, err := azureutil.ParseResourceID(role.Scope); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("scope"), role.Scope, "must be a valid Azure resource ID"))
+		}
+		key := strings.ToLower(role.DefinitionID + role.Scope)
+		if _, ok := seen[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), role))
+		}
+		seen[key] = struct{}{}
+	}
+	return allErrs
+}
+
+// ValidateSpotVMOptions validates that the maximum number of start attempts is only set for Spot VMs that are
+// deallocated when evicted, as evicted VMs with the Delete policy cannot be started again.
+func ValidateSpotVMOptions(spotVMOptions *SpotVMOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spotVMOptions == nil || spotVMOptions.MaxStartAttempts == nil {
+		return allErrs
+	}
+	if ptr.Deref(spotVMOptions.EvictionPolicy, SpotEvictionPolicyDeallocate) == SpotEvictionPolicyDelete {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxStartAttempts"), "maxStartAttempts cannot be set when evictionPolicy is Delete"))
+	}
+	return allErrs
+}
+
 // ValidateDataDisks validates a list of data disks.
 func ValidateDataDisks(dataDisks []DataDisk, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	lunSet := make(map[int32]struct{})
 	nameSet := make(map[string]struct{})
 	for _, disk := range dataDisks {
-		// validate that the disk size is between 4 and 32767.
-		if disk.DiskSizeGB < 4 || disk.DiskSizeGB > 32767 {
+		if disk.ManagedDiskID != "" {
+			// an existing disk is attached as is, so it must not specify the properties of a new disk.
+			if _, err := azureutil.ParseResourceID(disk.ManagedDiskID); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("managedDiskID"), disk.ManagedDiskID, "must be a valid Azure resource ID"))
+			}
+			if disk.DiskSizeGB != 0 {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("DiskSizeGB"), "cannot be set when attaching an existing managed disk"))
+			}
+			if disk.ManagedDisk != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("managedDisk"), "cannot be set when attaching an existing managed disk"))
+			}
+		} else if disk.DiskSizeGB < 4 || disk.DiskSizeGB > 32767 {
+			// validate that the disk size is between 4 and 32767.
 			allErrs = append(allErrs, field.Invalid(fieldPath.Child("DiskSizeGB"), "", "the disk size should be a value between 4 and 32767"))
 		}
 
@@ -266,12 +399,39 @@ func ValidateOSDisk(osDisk OSDisk, fieldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+// ValidateOSDiskUpdate validates updates to the OSDisk spec.
+// The OS disk is immutable except for its size, which may only be increased.
+func ValidateOSDiskUpdate(oldOSDisk, newOSDisk OSDisk, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldOSDisk.DiskSizeGB != nil && newOSDisk.DiskSizeGB != nil && *newOSDisk.DiskSizeGB != *oldOSDisk.DiskSizeGB {
+		switch {
+		case *newOSDisk.DiskSizeGB < *oldOSDisk.DiskSizeGB:
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("diskSizeGB"), *newOSDisk.DiskSizeGB, "the OS disk size cannot be decreased"))
+		case oldOSDisk.DiffDiskSettings != nil:
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("diskSizeGB"), *newOSDisk.DiskSizeGB, "ephemeral OS disks cannot be resized"))
+		}
+		// The size may only grow, so exclude it from the immutability check below.
+		newOSDisk.DiskSizeGB = oldOSDisk.DiskSizeGB
+	}
+
+	if err := webhookutils.ValidateImmutable(fieldPath, oldOSDisk, newOSDisk); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	return allErrs
+}
+
 // validateManagedDisk validates updates to the ManagedDiskParameters field.
 func validateManagedDisk(m *ManagedDiskParameters, fieldPath *field.Path, isOSDisk bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if m != nil {
 		allErrs = append(allErrs, validateStorageAccountType(m.StorageAccountType, fieldPath.Child("StorageAccountType"), isOSDisk)...)
+		allErrs = append(allErrs, validateDiskEncryptionSet(m.DiskEncryptionSet, fieldPath.Child("diskEncryptionSet"))...)
+		if m.SecurityProfile != nil {
+			allErrs = append(allErrs, validateDiskEncryptionSet(m.SecurityProfile.DiskEncryptionSet, fieldPath.Child("securityProfile").Child("diskEncryptionSet"))...)
+		}
 
 		// DiskEncryptionSet can only be set when SecurityEncryptionType is set to DiskWithVMGuestState
 		// https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#securityencryptiontypes
@@ -289,7 +449,24 @@ func validateManagedDisk(m *ManagedDiskParameters, fieldPath *field.Path, isOSDi
 	return allErrs
 }
 
-// ValidateDataDisksUpdate validates updates to Data disks.
+// validateDiskEncryptionSet validates that a disk encryption set is referenced by its resource ID.
+func validateDiskEncryptionSet(des *DiskEncryptionSetParameters, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if des == nil || des.ID == "" {
+		return allErrs
+	}
+
+	desID, err := azureutil.ParseResourceID(des.ID)
+	if err != nil || !strings.EqualFold(desID.ResourceType.String(), diskEncryptionSetResourceType) {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("id"), des.ID,
+			"must be a disk encryption set ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/diskEncryptionSets/{diskEncryptionSetName}"))
+	}
+
+	return allErrs
+}
+
+// ValidateDataDisksUpdate validates updates to Data disks. Data disks can be grown but not shrunk.
 func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -309,8 +486,14 @@ func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *f
 
 	for i, newDisk := range newDataDisks {
 		if oldDisk, ok := oldDisks[newDisk.NameSuffix]; ok {
-			if newDisk.DiskSizeGB != oldDisk.DiskSizeGB {
+			switch {
+			case newDisk.ManagedDiskID != "" && newDisk.DiskSizeGB != oldDisk.DiskSizeGB:
 				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("diskSizeGB"), newDataDisks, fieldErrMsg))
+			case newDisk.DiskSizeGB < oldDisk.DiskSizeGB:
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("diskSizeGB"), newDisk.DiskSizeGB,
+					fmt.Sprintf("data disks cannot be shrunk, the size must be at least %d", oldDisk.DiskSizeGB)))
+			case newDisk.DiskSizeGB > 32767:
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("diskSizeGB"), newDisk.DiskSizeGB, "the disk size should be a value between 4 and 32767"))
 			}
 
 			allErrs = append(allErrs, validateManagedDisksUpdate(oldDisk.ManagedDisk, newDisk.ManagedDisk, fieldPath.Index(i).Child("managedDisk"))...)
@@ -324,6 +507,10 @@ func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *f
 			if newDisk.CachingType != oldDisk.CachingType {
 				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("cachingType"), newDataDisks, fieldErrMsg))
 			}
+
+			if newDisk.ManagedDiskID != oldDisk.ManagedDiskID {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("managedDiskID"), newDataDisks, fieldErrMsg))
+			}
 		} else {
 			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("nameSuffix"), newDataDisks, diskErrMsg))
 		}
@@ -429,6 +616,25 @@ func ValidateDiagnostics(diagnostics *Diagnostics, fieldPath *field.Path) field.
 	return allErrs
 }
 
+// vmSizesWithoutEncryptionAtHost matches the VM size series whose resource SKUs never report the
+// EncryptionAtHostSupported capability. The webhook has no access to the resource SKU API, so the
+// capability of the VM size in the target region is checked again by the controller.
+var vmSizesWithoutEncryptionAtHost = regexp.MustCompile(`(?i)^(Basic_A\d+|Standard_A\d+|Standard_D\d+|Standard_DS\d+|Standard_G\d+|Standard_GS\d+(-\d+)?)$`)
+
+// ValidateEncryptionAtHost validates that encryption at host is only enabled for VM sizes that support it.
+func ValidateEncryptionAtHost(vmSize string, profile *SecurityProfile, fieldPath *field.Path) field.ErrorList {
+	if profile == nil || !ptr.Deref(profile.EncryptionAtHost, false) {
+		return nil
+	}
+
+	if vmSizesWithoutEncryptionAtHost.MatchString(vmSize) {
+		return field.ErrorList{field.Invalid(fieldPath, profile.EncryptionAtHost,
+			fmt.Sprintf("encryption at host is not supported for VM size %s", vmSize))}
+	}
+
+	return nil
+}
+
 // ValidateConfidentialCompute validates the configuration options when the machine is a Confidential VM.
 // https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#vmdisksecurityprofile
 // https://learn.microsoft.com/en-us/rest/api/compute/virtual-machines/create-or-update?tabs=HTTP#securityencryptiontypes
@@ -483,21 +689,129 @@ func ValidateCapacityReservationGroupID(capacityReservationGroupID *string, fldP
 	allErrs := field.ErrorList{}
 
 	if capacityReservationGroupID != nil {
-		if _, err := azureutil.ParseResourceID(*capacityReservationGroupID); err != nil {
+		groupID, err := azureutil.ParseResourceID(*capacityReservationGroupID)
+		if err != nil {
 			allErrs = append(allErrs, field.Invalid(fldPath, capacityReservationGroupID, "must be a valid Azure resource ID"))
+		} else if !strings.EqualFold(groupID.ResourceType.String(), capacityReservationGroupResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath, capacityReservationGroupID,
+				"must be a capacity reservation group ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/capacityReservationGroups/{capacityReservationGroupName}"))
 		}
 	}
 
 	return allErrs
 }
 
+// roleDefinitionResourceType is the resource type of Azure role definitions.
+const roleDefinitionResourceType = "Microsoft.Authorization/roleDefinitions"
+
+// containerRegistryResourceType is the resource type of Azure Container Registries.
+const containerRegistryResourceType = "Microsoft.ContainerRegistry/registries"
+
+// diskEncryptionSetResourceType is the resource type of Azure disk encryption sets.
+const diskEncryptionSetResourceType = "Microsoft.Compute/diskEncryptionSets"
+
+// capacityReservationGroupResourceType is the resource type of Azure capacity reservation groups.
+const capacityReservationGroupResourceType = "Microsoft.Compute/capacityReservationGroups"
+
+// keyVaultResourceType is the resource type of Azure Key Vaults.
+const keyVaultResourceType = "Microsoft.KeyVault/vaults"
+
+// reservedVMExtensionNames are the names of VM extensions managed by CAPZ.
+var reservedVMExtensionNames = []string{"CAPZ.Linux.Bootstrapping", "CAPZ.Windows.Bootstrapping"}
+
 // ValidateVMExtensions validates the VMExtensions spec.
-func ValidateVMExtensions(disableExtensionOperations *bool, vmExtensions []VMExtension, _ *field.Path) field.ErrorList {
+func ValidateVMExtensions(disableExtensionOperations *bool, vmExtensions []VMExtension, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if ptr.Deref(disableExtensionOperations, false) && len(vmExtensions) > 0 {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("AzureMachineTemplate", "spec", "template", "spec", "vmExtensions"), "VMExtensions must be empty when DisableExtensionOperations is true"))
 	}
 
+	names := make(map[string]struct{})
+	for i, extension := range vmExtensions {
+		for _, reserved := range reservedVMExtensionNames {
+			if strings.EqualFold(extension.Name, reserved) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), extension.Name, "name is reserved for a CAPZ-managed VM extension"))
+			}
+		}
+		if _, ok := names[strings.ToLower(extension.Name)]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), extension.Name))
+		}
+		names[strings.ToLower(extension.Name)] = struct{}{}
+	}
+
+	return allErrs
+}
+
+// ValidateGalleryApplications validates the gallery applications of a virtual machine.
+func ValidateGalleryApplications(galleryApplications []VMGalleryApplication, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, app := range galleryApplications {
+		if _, err := azureutil.ParseResourceID(app.PackageReferenceID); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("packageReferenceID"), app.PackageReferenceID, "must be a valid Azure resource ID"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateVMSecrets validates the Key Vault certificates to install on a virtual machine.
+func ValidateVMSecrets(secrets []VaultSecretGroup, osType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, secret := range secrets {
+		vaultID, err := azureutil.ParseResourceID(secret.SourceVaultID)
+		if err != nil || !strings.EqualFold(vaultID.ResourceType.String(), keyVaultResourceType) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("sourceVaultID"), secret.SourceVaultID,
+				"must be a Key Vault ID in the form /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.KeyVault/vaults/{vaultName}"))
+		}
+		for j, cert := range secret.VaultCertificates {
+			certPath := fldPath.Index(i).Child("vaultCertificates").Index(j)
+			if !isKeyVaultSecretURL(cert.CertificateURL) {
+				allErrs = append(allErrs, field.Invalid(certPath.Child("certificateURL"), cert.CertificateURL,
+					"must be a Key Vault secret URL in the form https://{vaultName}.vault.azure.net/secrets/{secretName}/{secretVersion}"))
+			}
+			if osType == WindowsOS && cert.CertificateStore == "" {
+				allErrs = append(allErrs, field.Required(certPath.Child("certificateStore"), "certificateStore is required for Windows virtual machines"))
+			}
+			if osType != WindowsOS && cert.CertificateStore != "" {
+				allErrs = append(allErrs, field.Forbidden(certPath.Child("certificateStore"), "certificateStore can only be set for Windows virtual machines"))
+			}
+		}
+	}
+
 	return allErrs
 }
+
+// ValidateLicenseType validates that the Azure Hybrid Benefit license type of a virtual machine matches its OS type.
+func ValidateLicenseType(licenseType string, osType string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch licenseType {
+	case "":
+	case LicenseTypeWindowsServer, LicenseTypeWindowsClient:
+		if osType != WindowsOS {
+			allErrs = append(allErrs, field.Invalid(fldPath, licenseType, "license type can only be used with Windows virtual machines"))
+		}
+	case LicenseTypeRHELBYOS, LicenseTypeSLESBYOS:
+		if osType != LinuxOS {
+			allErrs = append(allErrs, field.Invalid(fldPath, licenseType, "license type can only be used with Linux virtual machines"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, licenseType,
+			[]string{LicenseTypeWindowsServer, LicenseTypeWindowsClient, LicenseTypeRHELBYOS, LicenseTypeSLESBYOS}))
+	}
+
+	return allErrs
+}
+
+// isKeyVaultSecretURL returns true if the URL references a version of a Key Vault secret.
+func isKeyVaultSecretURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	return len(segments) == 3 && segments[0] == "secrets" && segments[1] != "" && segments[2] != ""
+}
@@ -158,6 +158,51 @@ func TestAzureMachine_ValidateOSDisk(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "valid disk encryption set ID",
+			wantErr: false,
+			osDisk: OSDisk{
+				DiskSizeGB:  ptr.To[int32](30),
+				CachingType: "None",
+				OSType:      "blah",
+				ManagedDisk: &ManagedDiskParameters{
+					StorageAccountType: "Standard_LRS",
+					DiskEncryptionSet: &DiskEncryptionSetParameters{
+						ID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/diskEncryptionSets/my-des",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid disk encryption set ID",
+			wantErr: true,
+			osDisk: OSDisk{
+				DiskSizeGB:  ptr.To[int32](30),
+				CachingType: "None",
+				OSType:      "blah",
+				ManagedDisk: &ManagedDiskParameters{
+					StorageAccountType: "Standard_LRS",
+					DiskEncryptionSet: &DiskEncryptionSetParameters{
+						ID: "my-des",
+					},
+				},
+			},
+		},
+		{
+			name:    "disk encryption set ID of another resource type",
+			wantErr: true,
+			osDisk: OSDisk{
+				DiskSizeGB:  ptr.To[int32](30),
+				CachingType: "None",
+				OSType:      "blah",
+				ManagedDisk: &ManagedDiskParameters{
+					StorageAccountType: "Standard_LRS",
+					DiskEncryptionSet: &DiskEncryptionSetParameters{
+						ID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/my-vault",
+					},
+				},
+			},
+		},
 	}
 	testcases = append(testcases, generateNegativeTestCases()...)
 
@@ -261,6 +306,24 @@ func TestAzureMachine_ValidateDataDisks(t *testing.T) {
 		disks   []DataDisk
 		wantErr bool
 	}{
+		{
+			name: "invalid disk encryption set ID",
+			disks: []DataDisk{
+				{
+					NameSuffix:  "my_disk",
+					DiskSizeGB:  64,
+					Lun:         ptr.To[int32](0),
+					CachingType: string(armcompute.PossibleCachingTypesValues()[0]),
+					ManagedDisk: &ManagedDiskParameters{
+						StorageAccountType: "Standard_LRS",
+						DiskEncryptionSet: &DiskEncryptionSetParameters{
+							ID: "my-des",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name:    "valid nil data disks",
 			disks:   nil,
@@ -457,6 +520,81 @@ func TestAzureMachine_ValidateDataDisks(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid existing managed disk",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					Lun:           ptr.To[int32](0),
+					CachingType:   string(armcompute.CachingTypesReadWrite),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "existing managed disk without a LUN",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with a size",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					DiskSizeGB:    64,
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with a storage account type",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					ManagedDisk: &ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+					Lun: ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with an invalid ID",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: "my-disk",
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with a LUN used by another disk",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 64,
+					Lun:        ptr.To[int32](0),
+				},
+				{
+					NameSuffix:    "my_existing_disk",
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, test := range testcases {
@@ -659,6 +797,26 @@ func TestAzureMachine_ValidateUserAssignedIdentity(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:   "valid identity name",
+			idType: VMIdentityUserAssigned,
+			identities: []UserAssignedIdentity{
+				{
+					ProviderID: "my-identity_1",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:   "invalid identity name",
+			idType: VMIdentityUserAssigned,
+			identities: []UserAssignedIdentity{
+				{
+					ProviderID: "my.identity",
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -737,6 +895,80 @@ func TestAzureMachine_ValidateDataDisksUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "data disks can be grown after machine creation",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 256,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 128,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "data disks cannot be shrunk after machine creation",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 64,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 128,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "data disks cannot be grown beyond the maximum disk size",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 32768,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 128,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing data disks cannot be resized",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					DiskSizeGB:    256,
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					DiskSizeGB:    128,
+					ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "cannot update data disk fields after machine creation",
 			disks: []DataDisk{
@@ -869,6 +1101,74 @@ func TestAzureMachine_ValidateDataDisksUpdate(t *testing.T) {
 	}
 }
 
+func TestAzureMachine_ValidateOSDiskUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		osDisk    OSDisk
+		oldOSDisk OSDisk
+		wantErr   bool
+	}{
+		{
+			name:      "valid unchanged os disk",
+			osDisk:    OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30), CachingType: "None"},
+			oldOSDisk: OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30), CachingType: "None"},
+			wantErr:   false,
+		},
+		{
+			name:      "valid os disk size increase",
+			osDisk:    OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128), CachingType: "None"},
+			oldOSDisk: OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30), CachingType: "None"},
+			wantErr:   false,
+		},
+		{
+			name:      "invalid os disk size decrease",
+			osDisk:    OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30), CachingType: "None"},
+			oldOSDisk: OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128), CachingType: "None"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid os disk size set after creation",
+			osDisk:    OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128), CachingType: "None"},
+			oldOSDisk: OSDisk{OSType: "Linux", CachingType: "None"},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid os disk size increase with other changes",
+			osDisk:    OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128), CachingType: "ReadOnly"},
+			oldOSDisk: OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30), CachingType: "None"},
+			wantErr:   true,
+		},
+		{
+			name: "invalid ephemeral os disk size increase",
+			osDisk: OSDisk{
+				OSType:           "Linux",
+				DiskSizeGB:       ptr.To[int32](128),
+				CachingType:      "ReadOnly",
+				DiffDiskSettings: &DiffDiskSettings{Option: string(armcompute.DiffDiskOptionsLocal)},
+			},
+			oldOSDisk: OSDisk{
+				OSType:           "Linux",
+				DiskSizeGB:       ptr.To[int32](30),
+				CachingType:      "ReadOnly",
+				DiffDiskSettings: &DiffDiskSettings{Option: string(armcompute.DiffDiskOptionsLocal)},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateOSDiskUpdate(test.oldOSDisk, test.osDisk, field.NewPath("osDisk"))
+			if test.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestAzureMachine_ValidateNetwork(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -961,6 +1261,73 @@ func TestAzureMachine_ValidateNetwork(t *testing.T) {
 	}
 }
 
+func TestAzureMachine_ValidateEncryptionAtHost(t *testing.T) {
+	tests := []struct {
+		name            string
+		vmSize          string
+		securityProfile *SecurityProfile
+		wantErr         bool
+	}{
+		{
+			name:            "no security profile",
+			vmSize:          "Standard_A1",
+			securityProfile: nil,
+			wantErr:         false,
+		},
+		{
+			name:   "encryption at host disabled on a VM size without support",
+			vmSize: "Standard_A1",
+			securityProfile: &SecurityProfile{
+				EncryptionAtHost: ptr.To(false),
+			},
+			wantErr: false,
+		},
+		{
+			name:   "encryption at host enabled on a VM size with support",
+			vmSize: "Standard_D2s_v3",
+			securityProfile: &SecurityProfile{
+				EncryptionAtHost: ptr.To(true),
+			},
+			wantErr: false,
+		},
+		{
+			name:   "encryption at host enabled on a basic VM size",
+			vmSize: "Basic_A2",
+			securityProfile: &SecurityProfile{
+				EncryptionAtHost: ptr.To(true),
+			},
+			wantErr: true,
+		},
+		{
+			name:   "encryption at host enabled on a previous generation VM size",
+			vmSize: "Standard_DS3",
+			securityProfile: &SecurityProfile{
+				EncryptionAtHost: ptr.To(true),
+			},
+			wantErr: true,
+		},
+		{
+			name:   "encryption at host enabled on a constrained vCPU VM size without support",
+			vmSize: "Standard_GS4-8",
+			securityProfile: &SecurityProfile{
+				EncryptionAtHost: ptr.To(true),
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateEncryptionAtHost(test.vmSize, test.securityProfile, field.NewPath("securityProfile", "encryptionAtHost"))
+			if test.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestAzureMachine_ValidateConfidentialCompute(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -1142,3 +1509,400 @@ func TestAzureMachine_ValidateConfidentialCompute(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureMachine_ValidateGalleryApplications(t *testing.T) {
+	tests := []struct {
+		name                string
+		galleryApplications []VMGalleryApplication
+		wantErr             bool
+	}{
+		{
+			name:                "valid config with no gallery applications",
+			galleryApplications: nil,
+			wantErr:             false,
+		},
+		{
+			name: "valid config with gallery application version resource IDs",
+			galleryApplications: []VMGalleryApplication{
+				{
+					PackageReferenceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/galleries/my-gallery/applications/my-app/versions/1.0.0",
+					Order:              ptr.To[int32](1),
+				},
+				{
+					PackageReferenceID:              "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/galleries/my-gallery/applications/other-app/versions/2.0.0",
+					ConfigurationReference:          ptr.To("https://mystorageaccount.blob.core.windows.net/config/app.conf"),
+					TreatFailureAsDeploymentFailure: ptr.To(true),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid config with a package reference ID that is not a resource ID",
+			galleryApplications: []VMGalleryApplication{
+				{
+					PackageReferenceID: "my-app",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateGalleryApplications(tc.galleryApplications, field.NewPath("galleryApplications"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateVMSecrets(t *testing.T) {
+	vaultID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/my-vault"
+	certURL := "https://my-vault.vault.azure.net/secrets/my-cert/0123456789abcdef0123456789abcdef"
+	tests := []struct {
+		name    string
+		secrets []VaultSecretGroup
+		osType  string
+		wantErr bool
+	}{
+		{
+			name:    "valid config with no secrets",
+			secrets: nil,
+			osType:  LinuxOS,
+			wantErr: false,
+		},
+		{
+			name: "valid config with a certificate on Linux",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: certURL}},
+				},
+			},
+			osType:  LinuxOS,
+			wantErr: false,
+		},
+		{
+			name: "valid config with a certificate store on Windows",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: certURL, CertificateStore: "My"}},
+				},
+			},
+			osType:  WindowsOS,
+			wantErr: false,
+		},
+		{
+			name: "invalid config with a source vault ID that is not a Key Vault",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/galleries/my-gallery",
+					VaultCertificates: []VaultCertificate{{CertificateURL: certURL}},
+				},
+			},
+			osType:  LinuxOS,
+			wantErr: true,
+		},
+		{
+			name: "invalid config with a certificate URL without a secret version",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: "https://my-vault.vault.azure.net/secrets/my-cert"}},
+				},
+			},
+			osType:  LinuxOS,
+			wantErr: true,
+		},
+		{
+			name: "invalid config with a certificate URL that is not https",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: "http://my-vault.vault.azure.net/secrets/my-cert/1"}},
+				},
+			},
+			osType:  LinuxOS,
+			wantErr: true,
+		},
+		{
+			name: "invalid config with a certificate store on Linux",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: certURL, CertificateStore: "My"}},
+				},
+			},
+			osType:  LinuxOS,
+			wantErr: true,
+		},
+		{
+			name: "invalid config without a certificate store on Windows",
+			secrets: []VaultSecretGroup{
+				{
+					SourceVaultID:     vaultID,
+					VaultCertificates: []VaultCertificate{{CertificateURL: certURL}},
+				},
+			},
+			osType:  WindowsOS,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateVMSecrets(tc.secrets, tc.osType, field.NewPath("secrets"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateLicenseType(t *testing.T) {
+	tests := []struct {
+		name        string
+		licenseType string
+		osType      string
+		wantErr     bool
+	}{
+		{
+			name:        "valid config without a license type",
+			licenseType: "",
+			osType:      LinuxOS,
+			wantErr:     false,
+		},
+		{
+			name:        "valid Windows Server license type on Windows",
+			licenseType: LicenseTypeWindowsServer,
+			osType:      WindowsOS,
+			wantErr:     false,
+		},
+		{
+			name:        "valid RHEL license type on Linux",
+			licenseType: LicenseTypeRHELBYOS,
+			osType:      LinuxOS,
+			wantErr:     false,
+		},
+		{
+			name:        "invalid Windows client license type on Linux",
+			licenseType: LicenseTypeWindowsClient,
+			osType:      LinuxOS,
+			wantErr:     true,
+		},
+		{
+			name:        "invalid SLES license type on Windows",
+			licenseType: LicenseTypeSLESBYOS,
+			osType:      WindowsOS,
+			wantErr:     true,
+		},
+		{
+			name:        "invalid unsupported license type",
+			licenseType: "Ubuntu_Pro",
+			osType:      LinuxOS,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateLicenseType(tc.licenseType, tc.osType, field.NewPath("licenseType"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateAdditionalRoleAssignments(t *testing.T) {
+	acrPull := RoleAssignment{
+		DefinitionID: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/7f951dda-4ed3-4680-a7ca-43fe172d538d",
+		Scope:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.ContainerRegistry/registries/myregistry",
+	}
+	tests := []struct {
+		name            string
+		identity        VMIdentity
+		roleAssignments []RoleAssignment
+		wantErr         bool
+	}{
+		{
+			name:            "valid config with no additional role assignments",
+			identity:        VMIdentityNone,
+			roleAssignments: nil,
+			wantErr:         false,
+		},
+		{
+			name:            "valid config with a role assignment on a registry",
+			identity:        VMIdentitySystemAssigned,
+			roleAssignments: []RoleAssignment{acrPull},
+			wantErr:         false,
+		},
+		{
+			name:            "invalid config without a system-assigned identity",
+			identity:        VMIdentityUserAssigned,
+			roleAssignments: []RoleAssignment{acrPull},
+			wantErr:         true,
+		},
+		{
+			name:     "invalid config with a definition ID that is not a role definition",
+			identity: VMIdentitySystemAssigned,
+			roleAssignments: []RoleAssignment{
+				{
+					DefinitionID: "7f951dda-4ed3-4680-a7ca-43fe172d538d",
+					Scope:        acrPull.Scope,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "invalid config with a scope that is not a resource ID",
+			identity: VMIdentitySystemAssigned,
+			roleAssignments: []RoleAssignment{
+				{
+					DefinitionID: acrPull.DefinitionID,
+					Scope:        "myregistry",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name:            "invalid config with duplicate role assignments",
+			identity:        VMIdentitySystemAssigned,
+			roleAssignments: []RoleAssignment{acrPull, acrPull},
+			wantErr:         true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateAdditionalRoleAssignments(tc.identity, tc.roleAssignments, field.NewPath("additionalRoleAssignments"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateSpotVMOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		spotVMOptions *SpotVMOptions
+		wantErr       bool
+	}{
+		{
+			name:    "valid config without spot VM options",
+			wantErr: false,
+		},
+		{
+			name:          "valid config without max start attempts",
+			spotVMOptions: &SpotVMOptions{EvictionPolicy: ptr.To(SpotEvictionPolicyDelete)},
+			wantErr:       false,
+		},
+		{
+			name: "valid config with max start attempts and the Deallocate eviction policy",
+			spotVMOptions: &SpotVMOptions{
+				EvictionPolicy:   ptr.To(SpotEvictionPolicyDeallocate),
+				MaxStartAttempts: ptr.To[int32](5),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid config with max start attempts and the Delete eviction policy",
+			spotVMOptions: &SpotVMOptions{
+				EvictionPolicy:   ptr.To(SpotEvictionPolicyDelete),
+				MaxStartAttempts: ptr.To[int32](5),
+			},
+			wantErr: true,
+		},
+		{
+			name:          "valid config with max start attempts and the default eviction policy",
+			spotVMOptions: &SpotVMOptions{MaxStartAttempts: ptr.To[int32](5)},
+			wantErr:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateSpotVMOptions(tc.spotVMOptions, field.NewPath("spotVMOptions"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateImagePullIdentity(t *testing.T) {
+	registryID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.ContainerRegistry/registries/myregistry"
+	general := UserAssignedIdentity{ProviderID: "general"}
+	pull := UserAssignedIdentity{ProviderID: "pull", Purpose: UserAssignedIdentityPurposeImagePull}
+	tests := []struct {
+		name       string
+		identities []UserAssignedIdentity
+		registryID string
+		wantErr    bool
+	}{
+		{
+			name:       "valid config without an image pull identity",
+			identities: []UserAssignedIdentity{general},
+			wantErr:    false,
+		},
+		{
+			name:       "valid config with one image pull identity",
+			identities: []UserAssignedIdentity{general, pull},
+			registryID: registryID,
+			wantErr:    false,
+		},
+		{
+			name:       "invalid config with an image pull identity but no registry",
+			identities: []UserAssignedIdentity{pull},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid config with a registry but no image pull identity",
+			identities: []UserAssignedIdentity{general},
+			registryID: registryID,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid config with more than one image pull identity",
+			identities: []UserAssignedIdentity{pull, {ProviderID: "pull2", Purpose: UserAssignedIdentityPurposeImagePull}},
+			registryID: registryID,
+			wantErr:    true,
+		},
+		{
+			name:       "invalid config with a registry that is not a container registry",
+			identities: []UserAssignedIdentity{pull},
+			registryID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.KeyVault/vaults/myvault",
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateImagePullIdentity(tc.identities, tc.registryID, field.NewPath("userAssignedIdentities"), field.NewPath("imagePullRegistryID"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
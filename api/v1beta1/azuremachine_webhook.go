@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
@@ -48,6 +49,12 @@ type azureMachineWebhook struct {
 	Client client.Client
 }
 
+// NewAzureMachineValidator returns the validator of the AzureMachine webhook. The validation does not read
+// from the API server, so it can run without a management cluster.
+func NewAzureMachineValidator() webhook.CustomValidator {
+	return &azureMachineWebhook{}
+}
+
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (mw *azureMachineWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	m, ok := obj.(*AzureMachine)
@@ -107,6 +114,13 @@ func (mw *azureMachineWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
+	if !reflect.DeepEqual(m.Spec.AdditionalRoleAssignments, old.Spec.AdditionalRoleAssignments) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "additionalRoleAssignments"),
+				m.Spec.AdditionalRoleAssignments, "field is immutable"),
+		)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "userAssignedIdentities"),
 		old.Spec.UserAssignedIdentities,
@@ -114,6 +128,13 @@ func (mw *azureMachineWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "imagePullRegistryID"),
+		old.Spec.ImagePullRegistryID,
+		m.Spec.ImagePullRegistryID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "roleAssignmentName"),
 		old.Spec.RoleAssignmentName,
@@ -121,17 +142,17 @@ func (mw *azureMachineWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
-		field.NewPath("spec", "osDisk"),
-		old.Spec.OSDisk,
-		m.Spec.OSDisk); err != nil {
-		allErrs = append(allErrs, err)
+	if errs := ValidateOSDiskUpdate(old.Spec.OSDisk, m.Spec.OSDisk, field.NewPath("spec", "osDisk")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
 	}
 
-	if err := webhookutils.ValidateImmutable(
+	// Data disks can be grown after the machine is created, all their other fields are immutable.
+	if errs := ValidateDataDisksUpdate(old.Spec.DataDisks, m.Spec.DataDisks, field.NewPath("spec", "dataDisks")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	} else if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "dataDisks"),
 		old.Spec.DataDisks,
-		m.Spec.DataDisks); err != nil {
+		withDataDiskSizes(m.Spec.DataDisks, old.Spec.DataDisks)); err != nil {
 		allErrs = append(allErrs, err)
 	}
 
@@ -156,6 +177,13 @@ func (mw *azureMachineWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "nodeOutboundBackendPool"),
+		old.Spec.NodeOutboundBackendPool,
+		m.Spec.NodeOutboundBackendPool); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	// Spec.AcceleratedNetworking can only be reset to nil and no other changes apart from that
 	// is accepted if the field is set.
 	// Ref issue #3518
@@ -221,6 +249,27 @@ func (mw *azureMachineWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		allErrs = append(allErrs, err)
 	}
 
+	if !reflect.DeepEqual(m.Spec.GalleryApplications, old.Spec.GalleryApplications) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "galleryApplications"),
+				m.Spec.GalleryApplications, "field is immutable"),
+		)
+	}
+
+	if !reflect.DeepEqual(m.Spec.Secrets, old.Spec.Secrets) {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "secrets"),
+				m.Spec.Secrets, "field is immutable"),
+		)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "licenseType"),
+		old.Spec.LicenseType,
+		m.Spec.LicenseType); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -240,3 +289,18 @@ func (mw *azureMachineWebhook) Default(_ context.Context, obj runtime.Object) er
 	}
 	return m.SetDefaults(mw.Client)
 }
+
+// withDataDiskSizes returns a copy of the data disks with the sizes of the given disks at the same index.
+func withDataDiskSizes(dataDisks, sizes []DataDisk) []DataDisk {
+	if dataDisks == nil {
+		return nil
+	}
+	disks := make([]DataDisk, len(dataDisks))
+	for i := range dataDisks {
+		disks[i] = dataDisks[i]
+		if i < len(sizes) {
+			disks[i].DiskSizeGB = sizes[i].DiskSizeGB
+		}
+	}
+	return disks
+}
@@ -238,6 +238,11 @@ func TestAzureMachine_ValidateCreate(t *testing.T) {
 			machine: createMachineWithCapacityReservaionGroupID("invalid-capacity-group-id"),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachine with capacity reservation group id of another resource type",
+			machine: createMachineWithCapacityReservaionGroupID("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-resource-group/providers/Microsoft.Compute/availabilitySets/my-availability-set"),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachine with DisableExtensionOperations true and without VMExtensions",
 			machine: createMachineWithDisableExtenionOperations(),
@@ -248,6 +253,21 @@ func TestAzureMachine_ValidateCreate(t *testing.T) {
 			machine: createMachineWithDisableExtenionOperationsAndHasExtension(),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachine with VMExtension",
+			machine: createMachineWithVMExtensions("monitoring-agent"),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachine with VMExtension using a reserved name",
+			machine: createMachineWithVMExtensions("capz.linux.bootstrapping"),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachine with duplicate VMExtension names",
+			machine: createMachineWithVMExtensions("monitoring-agent", "Monitoring-Agent"),
+			wantErr: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -478,6 +498,46 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "validTest: azuremachine.spec.OSDisk.DiskSizeGB can be increased",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					OSDisk: OSDisk{
+						OSType:     "osType-1",
+						DiskSizeGB: ptr.To[int32](30),
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					OSDisk: OSDisk{
+						OSType:     "osType-1",
+						DiskSizeGB: ptr.To[int32](64),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.OSDisk.DiskSizeGB cannot be decreased",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					OSDisk: OSDisk{
+						OSType:     "osType-1",
+						DiskSizeGB: ptr.To[int32](64),
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					OSDisk: OSDisk{
+						OSType:     "osType-1",
+						DiskSizeGB: ptr.To[int32](30),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.DataDisks is immutable",
 			oldMachine: &AzureMachine{
@@ -522,6 +582,55 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "validTest: azuremachine.spec.DataDisks can be grown",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							NameSuffix: "etcddisk",
+							DiskSizeGB: 128,
+						},
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							NameSuffix: "etcddisk",
+							DiskSizeGB: 256,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.DataDisks fields other than the size are immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							NameSuffix: "etcddisk",
+							DiskSizeGB: 128,
+						},
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							NameSuffix:  "etcddisk",
+							DiskSizeGB:  256,
+							CachingType: "ReadOnly",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.SSHPublicKey is immutable",
 			oldMachine: &AzureMachine{
@@ -606,6 +715,34 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.NodeOutboundBackendPool is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					NodeOutboundBackendPool: "batch",
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					NodeOutboundBackendPool: "web",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.NodeOutboundBackendPool is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					NodeOutboundBackendPool: "batch",
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					NodeOutboundBackendPool: "batch",
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.AcceleratedNetworking is immutable",
 			oldMachine: &AzureMachine{
@@ -922,6 +1059,20 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.licenseType is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					LicenseType: "",
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					LicenseType: LicenseTypeRHELBYOS,
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -1211,6 +1362,24 @@ func createMachineWithDisableExtenionOperationsAndHasExtension() *AzureMachine {
 	}
 }
 
+func createMachineWithVMExtensions(names ...string) *AzureMachine {
+	extensions := make([]VMExtension, 0, len(names))
+	for _, name := range names {
+		extensions = append(extensions, VMExtension{
+			Name:      name,
+			Publisher: "test-publisher",
+			Version:   "v0.0.1-test",
+		})
+	}
+	return &AzureMachine{
+		Spec: AzureMachineSpec{
+			SSHPublicKey: validSSHPublicKey,
+			OSDisk:       validOSDisk,
+			VMExtensions: extensions,
+		},
+	}
+}
+
 func createMachineWithDisableExtenionOperations() *AzureMachine {
 	return &AzureMachine{
 		Spec: AzureMachineSpec{
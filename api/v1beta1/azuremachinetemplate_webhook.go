@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -86,6 +87,14 @@ func (r *AzureMachineTemplate) ValidateCreate(_ context.Context, obj runtime.Obj
 		}
 	}
 
+	for i, disk := range r.Spec.Template.Spec.DataDisks {
+		// An existing disk can only be attached to a single VM.
+		if disk.ManagedDiskID != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("AzureMachineTemplate", "spec", "template", "spec", "dataDisks").Index(i).Child("managedDiskID"),
+				"attaching an existing managed disk is not supported for AzureMachineTemplates"))
+		}
+	}
+
 	if ptr.Deref(r.Spec.Template.Spec.DisableExtensionOperations, false) && len(r.Spec.Template.Spec.VMExtensions) > 0 {
 		allErrs = append(allErrs, field.Forbidden(field.NewPath("AzureMachineTemplate", "spec", "template", "spec", "vmExtensions"), "VMExtensions must be empty when DisableExtensionOperations is true"))
 	}
@@ -127,10 +136,11 @@ func (r *AzureMachineTemplate) ValidateUpdate(ctx context.Context, oldRaw runtim
 			)
 		}
 
-		// if it's still not equal, return error.
-		if !reflect.DeepEqual(t.Spec.Template.Spec, old.Spec.Template.Spec) {
+		// if it's still not equal, return an error for each changed field so users can tell what to move
+		// to a new template.
+		for _, name := range changedAzureMachineSpecFields(old.Spec.Template.Spec, t.Spec.Template.Spec) {
 			allErrs = append(allErrs,
-				field.Invalid(field.NewPath("AzureMachineTemplate", "spec", "template", "spec"), t, AzureMachineTemplateImmutableMsg),
+				field.Forbidden(field.NewPath("spec", "template", "spec", name), AzureMachineTemplateImmutableMsg),
 			)
 		}
 	}
@@ -141,6 +151,21 @@ func (r *AzureMachineTemplate) ValidateUpdate(ctx context.Context, oldRaw runtim
 	return nil, apierrors.NewInvalid(GroupVersion.WithKind(AzureMachineTemplateKind).GroupKind(), t.Name, allErrs)
 }
 
+// changedAzureMachineSpecFields returns the JSON names of the top-level fields which differ between two AzureMachineSpecs.
+func changedAzureMachineSpecFields(oldSpec, newSpec AzureMachineSpec) []string {
+	var changed []string
+	oldValue := reflect.ValueOf(oldSpec)
+	newValue := reflect.ValueOf(newSpec)
+	for i := 0; i < oldValue.NumField(); i++ {
+		if reflect.DeepEqual(oldValue.Field(i).Interface(), newValue.Field(i).Interface()) {
+			continue
+		}
+		name, _, _ := strings.Cut(oldValue.Type().Field(i).Tag.Get("json"), ",")
+		changed = append(changed, name)
+	}
+	return changed
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (r *AzureMachineTemplate) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
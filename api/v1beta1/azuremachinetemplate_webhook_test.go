@@ -242,6 +242,34 @@ func TestAzureMachineTemplate_ValidateCreate(t *testing.T) {
 			),
 			wantErr: false,
 		},
+		{
+			name: "azuremachinetemplate with an existing managed data disk",
+			machineTemplate: createAzureMachineTemplateFromMachine(
+				createMachineWithDataDisks([]DataDisk{
+					{
+						NameSuffix:    "my_disk",
+						ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk",
+						Lun:           ptr.To[int32](0),
+						CachingType:   "ReadWrite",
+					},
+				}),
+			),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinetemplate with a new managed data disk",
+			machineTemplate: createAzureMachineTemplateFromMachine(
+				createMachineWithDataDisks([]DataDisk{
+					{
+						NameSuffix:  "my_disk",
+						DiskSizeGB:  64,
+						Lun:         ptr.To[int32](0),
+						CachingType: "ReadWrite",
+					},
+				}),
+			),
+			wantErr: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -572,6 +600,16 @@ func TestAzureMachineTemplate_ValidateUpdate(t *testing.T) {
 	}
 }
 
+func createMachineWithDataDisks(dataDisks []DataDisk) *AzureMachine {
+	return &AzureMachine{
+		Spec: AzureMachineSpec{
+			SSHPublicKey: validSSHPublicKey,
+			OSDisk:       validOSDisk,
+			DataDisks:    dataDisks,
+		},
+	}
+}
+
 func createAzureMachineTemplateFromMachine(machine *AzureMachine) *AzureMachineTemplate {
 	return &AzureMachineTemplate{
 		Spec: AzureMachineTemplateSpec{
@@ -581,3 +619,42 @@ func createAzureMachineTemplateFromMachine(machine *AzureMachine) *AzureMachineT
 		},
 	}
 }
+
+func TestChangedAzureMachineSpecFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldSpec  AzureMachineSpec
+		newSpec  AzureMachineSpec
+		expected []string
+	}{
+		{
+			name:     "unchanged spec",
+			oldSpec:  AzureMachineSpec{VMSize: "size", SSHPublicKey: "key"},
+			newSpec:  AzureMachineSpec{VMSize: "size", SSHPublicKey: "key"},
+			expected: nil,
+		},
+		{
+			name:     "changed VM size",
+			oldSpec:  AzureMachineSpec{VMSize: "size", SSHPublicKey: "key"},
+			newSpec:  AzureMachineSpec{VMSize: "size1", SSHPublicKey: "key"},
+			expected: []string{"vmSize"},
+		},
+		{
+			name: "changed nested and optional fields",
+			oldSpec: AzureMachineSpec{
+				OSDisk: OSDisk{DiskSizeGB: ptr.To[int32](30)},
+			},
+			newSpec: AzureMachineSpec{
+				OSDisk:         OSDisk{DiskSizeGB: ptr.To[int32](60)},
+				AdditionalTags: Tags{"foo": "bar"},
+			},
+			expected: []string{"osDisk", "additionalTags"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(changedAzureMachineSpecFields(tc.oldSpec, tc.newSpec)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -31,6 +31,21 @@ const (
 
 	// PrivateDNSZoneModeNone represents mode None for azuremanagedcontrolplane.
 	PrivateDNSZoneModeNone string = "None"
+
+	// DNSZoneResourceType is the resource type of an Azure DNS zone.
+	DNSZoneResourceType = "Microsoft.Network/dnszones"
+
+	// PrivateDNSZoneResourceType is the resource type of an Azure private DNS zone.
+	PrivateDNSZoneResourceType = "Microsoft.Network/privateDnsZones"
+
+	// LogAnalyticsWorkspaceResourceType is the resource type of an Azure Log Analytics workspace.
+	LogAnalyticsWorkspaceResourceType = "Microsoft.OperationalInsights/workspaces"
+
+	// DNSZoneContributorRoleID is the ID of the built-in "DNS Zone Contributor" role.
+	DNSZoneContributorRoleID = "befefa01-2a29-4197-83a8-272ff33ce314"
+
+	// PrivateDNSZoneContributorRoleID is the ID of the built-in "Private DNS Zone Contributor" role.
+	PrivateDNSZoneContributorRoleID = "b12aa53e-6015-4669-85d0-8515ebb3ae7f"
 )
 
 // UpgradeChannel determines the type of upgrade channel for automatically upgrading the cluster.
@@ -65,6 +80,29 @@ const (
 	UpgradeChannelStable UpgradeChannel = "stable"
 )
 
+// NodeOSUpgradeChannel determines how the OS of the nodes is automatically updated.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/auto-upgrade-node-os-image
+type NodeOSUpgradeChannel string
+
+const (
+	// NodeOSUpgradeChannelNone applies no security updates to the node OS, which means the nodes are only updated
+	// when the node image is upgraded.
+	NodeOSUpgradeChannelNone NodeOSUpgradeChannel = "None"
+
+	// NodeOSUpgradeChannelUnmanaged leaves OS updates to the built-in patching of the OS, e.g. unattended upgrades on Ubuntu.
+	NodeOSUpgradeChannelUnmanaged NodeOSUpgradeChannel = "Unmanaged"
+
+	// NodeOSUpgradeChannelNodeImage automatically upgrades the node image to the latest version available. This is the
+	// only node OS upgrade channel allowed with the node-image upgrade channel.
+	NodeOSUpgradeChannelNodeImage NodeOSUpgradeChannel = "NodeImage"
+
+	// NodeOSUpgradeChannelSecurityPatch applies security patches to the nodes without reimaging them, and reimages
+	// the nodes with a patched node image when a patch requires it.
+	NodeOSUpgradeChannelSecurityPatch NodeOSUpgradeChannel = "SecurityPatch"
+)
+
 // ManagedControlPlaneOutboundType enumerates the values for the managed control plane OutboundType.
 type ManagedControlPlaneOutboundType string
 
@@ -115,6 +153,17 @@ const (
 	NetworkDataplaneTypeCilium NetworkDataplaneType = "cilium"
 )
 
+// IPFamily is an IP family of the pod and service IP addresses of a managed cluster.
+// +kubebuilder:validation:Enum=IPv4;IPv6
+type IPFamily string
+
+const (
+	// IPFamilyIPv4 is the IPv4 family.
+	IPFamilyIPv4 IPFamily = "IPv4"
+	// IPFamilyIPv6 is the IPv6 family.
+	IPFamilyIPv6 IPFamily = "IPv6"
+)
+
 const (
 	// LoadBalancerSKUStandard is the Standard load balancer SKU.
 	LoadBalancerSKUStandard = "Standard"
@@ -135,6 +184,32 @@ const (
 	KeyVaultNetworkAccessTypesPublic KeyVaultNetworkAccessTypes = "Public"
 )
 
+// KubernetesSupportPlan is the support plan for the Kubernetes version of a managed cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/en-us/azure/aks/long-term-support
+// +kubebuilder:validation:Enum=KubernetesOfficial;AKSLongTermSupport
+type KubernetesSupportPlan string
+
+const (
+	// KubernetesSupportPlanKubernetesOfficial is support for the version matching the open source Kubernetes offering.
+	KubernetesSupportPlanKubernetesOfficial KubernetesSupportPlan = "KubernetesOfficial"
+
+	// KubernetesSupportPlanAKSLongTermSupport is support for the version extended past the community support window.
+	// Requires the Premium SKU tier.
+	KubernetesSupportPlanAKSLongTermSupport KubernetesSupportPlan = "AKSLongTermSupport"
+)
+
+// ManagedClusterPowerState is the power state of a managed cluster.
+type ManagedClusterPowerState string
+
+const (
+	// ManagedClusterPowerStateRunning means the control plane and nodes of the cluster are running.
+	ManagedClusterPowerStateRunning ManagedClusterPowerState = "Running"
+	// ManagedClusterPowerStateStopped means the control plane of the cluster is stopped and its nodes are deallocated.
+	ManagedClusterPowerStateStopped ManagedClusterPowerState = "Stopped"
+)
+
 // AzureManagedControlPlaneSpec defines the desired state of AzureManagedControlPlane.
 type AzureManagedControlPlaneSpec struct {
 	AzureManagedControlPlaneClassSpec `json:",inline"`
@@ -146,6 +221,12 @@ type AzureManagedControlPlaneSpec struct {
 	// +optional
 	NodeResourceGroupName string `json:"nodeResourceGroupName,omitempty"`
 
+	// NodeResourceGroupTags is an optional set of tags to add to the node resource group, in addition to the
+	// tags AKS propagates to it from the managed cluster. Tags removed from this set are removed from the node
+	// resource group.
+	// +optional
+	NodeResourceGroupTags Tags `json:"nodeResourceGroupTags,omitempty"`
+
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// Immutable, populated by the AKS API at create.
 	// +optional
@@ -168,6 +249,19 @@ type AzureManagedControlPlaneSpec struct {
 	// [AKS doc]: https://learn.microsoft.com/en-us/azure/templates/microsoft.containerservice/2023-03-15-preview/fleets/members
 	// +optional
 	FleetsMember *FleetsMember `json:"fleetsMember,omitempty"`
+
+	// SupportPlan is the support plan for the Kubernetes version of the cluster. AKSLongTermSupport allows
+	// the cluster to stay on a version past its community end of life and requires the Premium SKU tier.
+	// Defaults to KubernetesOfficial when unset.
+	// +optional
+	SupportPlan *KubernetesSupportPlan `json:"supportPlan,omitempty"`
+
+	// PowerState is the desired power state of the cluster. Stopping the cluster stops its control plane and
+	// deallocates its nodes, and changes to the cluster are not applied until it is started again.
+	// The power state cannot be changed together with other fields. Defaults to Running when unset.
+	// +kubebuilder:validation:Enum=Running;Stopped
+	// +optional
+	PowerState *ManagedClusterPowerState `json:"powerState,omitempty"`
 }
 
 // ManagedClusterSecurityProfile defines the security profile for the cluster.
@@ -184,6 +278,10 @@ type ManagedClusterSecurityProfile struct {
 	// +optional
 	ImageCleaner *ManagedClusterSecurityProfileImageCleaner `json:"imageCleaner,omitempty"`
 
+	// ImageIntegrity settings for the security profile. Requires EnablePreviewFeatures and the azurepolicy add-on.
+	// +optional
+	ImageIntegrity *ManagedClusterSecurityProfileImageIntegrity `json:"imageIntegrity,omitempty"`
+
 	// Workloadidentity enables Kubernetes applications to access Azure cloud resources securely with Azure AD. Ensure to enable OIDC issuer while enabling Workload Identity
 	// +optional
 	WorkloadIdentity *ManagedClusterSecurityProfileWorkloadIdentity `json:"workloadIdentity,omitempty"`
@@ -227,6 +325,16 @@ type ManagedClusterSecurityProfileImageCleaner struct {
 	IntervalHours *int `json:"intervalHours,omitempty"`
 }
 
+// ManagedClusterSecurityProfileImageIntegrity validates the signatures of container images before they are deployed.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/image-integrity
+type ManagedClusterSecurityProfileImageIntegrity struct {
+	// Enabled enables Image Integrity on the AKS cluster.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
 // ManagedClusterSecurityProfileWorkloadIdentity settings for the security profile.
 // See also [AKS doc].
 //
@@ -264,6 +372,327 @@ type AzureKeyVaultKms struct {
 	KeyVaultResourceID *string `json:"keyVaultResourceID,omitempty"`
 }
 
+// ManagedClusterIngressProfile defines the ingress profile for the cluster.
+type ManagedClusterIngressProfile struct {
+	// WebAppRouting defines the settings of the application routing add-on.
+	// +optional
+	WebAppRouting *ManagedClusterIngressProfileWebAppRouting `json:"webAppRouting,omitempty"`
+}
+
+// ManagedClusterIngressProfileWebAppRouting defines the settings of the application routing add-on.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/app-routing
+type ManagedClusterIngressProfileWebAppRouting struct {
+	// Enabled enables the application routing add-on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// DNSZoneResourceIDs are the resource IDs of the Azure DNS and private DNS zones managed by the add-on.
+	// CAPZ grants the add-on's identity the DNS Zone Contributor role on each public DNS zone and the
+	// Private DNS Zone Contributor role on each private DNS zone.
+	// +optional
+	DNSZoneResourceIDs []string `json:"dnsZoneResourceIDs,omitempty"`
+}
+
+// KubeProxyMode enumerates the values for ManagedClusterKubeProxyConfig.Mode.
+type KubeProxyMode string
+
+const (
+	// KubeProxyModeIPTables uses iptables to implement Services.
+	KubeProxyModeIPTables KubeProxyMode = "IPTABLES"
+	// KubeProxyModeIPVS uses IPVS to implement Services.
+	KubeProxyModeIPVS KubeProxyMode = "IPVS"
+)
+
+// IPVSScheduler enumerates the values for ManagedClusterKubeProxyIPVSConfig.Scheduler.
+type IPVSScheduler string
+
+const (
+	// IPVSSchedulerRoundRobin distributes connections to the backends in turn.
+	IPVSSchedulerRoundRobin IPVSScheduler = "RoundRobin"
+	// IPVSSchedulerLeastConnection distributes connections to the backend with the fewest active connections.
+	IPVSSchedulerLeastConnection IPVSScheduler = "LeastConnection"
+)
+
+// ManagedClusterKubeProxyConfig defines the kube-proxy configuration for the cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/configure-kube-proxy
+type ManagedClusterKubeProxyConfig struct {
+	// Enabled deploys kube-proxy on the cluster. AKS enables kube-proxy by default.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Mode specifies which proxy mode to use. Possible values include: 'IPTABLES', 'IPVS'.
+	// +kubebuilder:validation:Enum=IPTABLES;IPVS
+	// +optional
+	Mode *KubeProxyMode `json:"mode,omitempty"`
+
+	// IPVSConfig defines the IPVS settings. It can be set only when Mode is 'IPVS'.
+	// +optional
+	IPVSConfig *ManagedClusterKubeProxyIPVSConfig `json:"ipvsConfig,omitempty"`
+}
+
+// ManagedClusterKubeProxyIPVSConfig defines the IPVS settings of kube-proxy.
+type ManagedClusterKubeProxyIPVSConfig struct {
+	// Scheduler is the IPVS scheduler. Possible values include: 'RoundRobin', 'LeastConnection'.
+	// +kubebuilder:validation:Enum=RoundRobin;LeastConnection
+	// +optional
+	Scheduler *IPVSScheduler `json:"scheduler,omitempty"`
+
+	// TCPTimeoutSeconds is the timeout value used for idle IPVS TCP sessions in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TCPTimeoutSeconds *int `json:"tcpTimeoutSeconds,omitempty"`
+
+	// TCPFinTimeoutSeconds is the timeout value used for IPVS TCP sessions after receiving a FIN in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TCPFinTimeoutSeconds *int `json:"tcpFinTimeoutSeconds,omitempty"`
+
+	// UDPTimeoutSeconds is the timeout value used for IPVS UDP packets in seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UDPTimeoutSeconds *int `json:"udpTimeoutSeconds,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfile defines the Azure Monitor profile for the cluster.
+type ManagedClusterAzureMonitorProfile struct {
+	// Metrics defines the settings of the Azure Monitor managed service for Prometheus add-on.
+	// +optional
+	Metrics *ManagedClusterAzureMonitorProfileMetrics `json:"metrics,omitempty"`
+
+	// ContainerInsights defines the settings of the Azure Monitor Container Insights add-on.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	ContainerInsights *ManagedClusterAzureMonitorProfileContainerInsights `json:"containerInsights,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfileContainerInsights defines the settings of the Azure Monitor Container Insights add-on.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/azure-monitor/containers/container-insights-overview
+type ManagedClusterAzureMonitorProfileContainerInsights struct {
+	// Enabled enables the Azure Monitor Container Insights add-on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// LogAnalyticsWorkspaceResourceID is the resource ID of the Log Analytics workspace that stores the
+	// collected logs. If not specified, AKS creates and uses a default workspace.
+	// +optional
+	LogAnalyticsWorkspaceResourceID *string `json:"logAnalyticsWorkspaceResourceID,omitempty"`
+
+	// WindowsHostLogs defines the settings of Windows host log collection.
+	// +optional
+	WindowsHostLogs *ManagedClusterAzureMonitorProfileWindowsHostLogs `json:"windowsHostLogs,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfileWindowsHostLogs defines the settings of Windows host log collection.
+type ManagedClusterAzureMonitorProfileWindowsHostLogs struct {
+	// Enabled enables the collection of Windows host logs.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterAzureMonitorProfileMetrics defines the settings of the Azure Monitor managed service for Prometheus add-on.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/azure-monitor/containers/kubernetes-monitoring-enable
+type ManagedClusterAzureMonitorProfileMetrics struct {
+	// Enabled enables the Azure Monitor managed service for Prometheus add-on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// KubeStateMetrics defines the settings of the kube-state-metrics pod deployed with the add-on.
+	// +optional
+	KubeStateMetrics *ManagedClusterAzureMonitorProfileKubeStateMetrics `json:"kubeStateMetrics,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfileKubeStateMetrics defines the settings of the kube-state-metrics pod deployed with the
+// Azure Monitor managed service for Prometheus add-on.
+type ManagedClusterAzureMonitorProfileKubeStateMetrics struct {
+	// MetricAnnotationsAllowList is a comma-separated list of Kubernetes annotation keys used in the resource's
+	// kube_<resource>_annotations metric, for example "namespaces=[kubernetes.io/team,...],pods=[kubernetes.io/team],...".
+	// By default the metric contains only name and namespace labels.
+	// +optional
+	MetricAnnotationsAllowList *string `json:"metricAnnotationsAllowList,omitempty"`
+
+	// MetricLabelsAllowlist is a comma-separated list of additional Kubernetes label keys used in the resource's
+	// kube_<resource>_labels metric, for example "namespaces=[k8s-label-1,k8s-label-n,...],pods=[app],...".
+	// By default the metric contains only name and namespace labels.
+	// +optional
+	MetricLabelsAllowlist *string `json:"metricLabelsAllowlist,omitempty"`
+}
+
+// ManagedClusterMetricsProfile defines the metrics profile for the cluster.
+type ManagedClusterMetricsProfile struct {
+	// CostAnalysis defines the cost analysis configuration for the cluster.
+	// +optional
+	CostAnalysis *ManagedClusterCostAnalysis `json:"costAnalysis,omitempty"`
+}
+
+// ManagedClusterCostAnalysis defines the cost analysis configuration for the cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/cost-analysis
+type ManagedClusterCostAnalysis struct {
+	// Enabled adds Kubernetes namespace and deployment details to the Cost Analysis views in the Azure portal.
+	// Requires the Standard SKU tier.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ServiceMeshMode enumerates the values for ManagedClusterServiceMeshProfile.Mode.
+// Istio is the only value, as it is the only service mesh AKS offers. Other values are reserved for future service meshes.
+type ServiceMeshMode string
+
+const (
+	// ServiceMeshModeIstio enables the Istio-based service mesh add-on.
+	ServiceMeshModeIstio ServiceMeshMode = "Istio"
+)
+
+// ManagedClusterServiceMeshProfile defines the service mesh profile for the cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/istio-about
+type ManagedClusterServiceMeshProfile struct {
+	// Mode is the mode of the service mesh. It must be Istio, which is the only service mesh AKS offers, and is
+	// reserved for future service meshes.
+	// +kubebuilder:validation:Enum=Istio
+	// +kubebuilder:validation:Required
+	Mode ServiceMeshMode `json:"mode"`
+
+	// Istio defines the Istio service mesh configuration.
+	// +optional
+	Istio *ManagedClusterIstioServiceMesh `json:"istio,omitempty"`
+}
+
+// ManagedClusterIstioServiceMesh defines the Istio service mesh configuration.
+type ManagedClusterIstioServiceMesh struct {
+	// Revisions is the list of Istio control plane revisions, for example "asm-1-22". When empty, AKS picks the
+	// default revision for the Kubernetes version of the cluster. Two adjacent revisions may be listed during a
+	// canary upgrade, see also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/istio-upgrade
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	Revisions []string `json:"revisions,omitempty"`
+
+	// InternalIngressGateway enables an Istio ingress gateway exposed with an internal load balancer.
+	// +optional
+	InternalIngressGateway bool `json:"internalIngressGateway,omitempty"`
+
+	// ExternalIngressGateway enables an Istio ingress gateway exposed with a public load balancer.
+	// +optional
+	ExternalIngressGateway bool `json:"externalIngressGateway,omitempty"`
+}
+
+// ManagedClusterAADPodIdentityProfile defines the AKS-managed AAD Pod Identity profile of the cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/use-azure-ad-pod-identity
+type ManagedClusterAADPodIdentityProfile struct {
+	// Enabled enables the AAD Pod Identity add-on.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// AllowNetworkPluginKubenet allows the add-on to run on clusters using the kubenet network plugin. Kubenet is not
+	// supported by default because pods could spoof the IP address of other pods to get their tokens, see also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/use-azure-ad-pod-identity#using-kubenet-network-plugin-with-microsoft-entra-pod-managed-identities
+	// +optional
+	AllowNetworkPluginKubenet bool `json:"allowNetworkPluginKubenet,omitempty"`
+
+	// UserAssignedIdentities are the pod identities to use in the cluster.
+	// +optional
+	UserAssignedIdentities []ManagedClusterAADPodIdentity `json:"userAssignedIdentities,omitempty"`
+
+	// UserAssignedIdentityExceptions are the pods allowed to access the Azure Instance Metadata Service without
+	// being bound to a pod identity.
+	// +optional
+	UserAssignedIdentityExceptions []ManagedClusterAADPodIdentityException `json:"userAssignedIdentityExceptions,omitempty"`
+}
+
+// ManagedClusterAADPodIdentity defines a pod identity of the AAD Pod Identity add-on.
+type ManagedClusterAADPodIdentity struct {
+	// Name is the name of the pod identity.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the pod identity.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// BindingSelector is the value of the aadpodidbinding label of the pods bound to the pod identity.
+	// +optional
+	BindingSelector string `json:"bindingSelector,omitempty"`
+
+	// Identity is the user-assigned identity of the pod identity.
+	// +kubebuilder:validation:Required
+	Identity ManagedClusterAADPodIdentityUserAssignedIdentity `json:"identity"`
+}
+
+// ManagedClusterAADPodIdentityUserAssignedIdentity defines the user-assigned identity of a pod identity.
+type ManagedClusterAADPodIdentityUserAssignedIdentity struct {
+	// ResourceID is the resource ID of the user-assigned identity.
+	// +kubebuilder:validation:Required
+	ResourceID string `json:"resourceID"`
+
+	// ClientID is the client ID of the user-assigned identity.
+	// +kubebuilder:validation:Required
+	ClientID string `json:"clientID"`
+
+	// ObjectID is the object ID of the user-assigned identity.
+	// +kubebuilder:validation:Required
+	ObjectID string `json:"objectID"`
+}
+
+// ManagedClusterAADPodIdentityException defines pods allowed to access the Azure Instance Metadata Service without
+// being bound to a pod identity.
+type ManagedClusterAADPodIdentityException struct {
+	// Name is the name of the exception.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the pods.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// PodLabels are the labels of the pods.
+	// +kubebuilder:validation:Required
+	PodLabels map[string]string `json:"podLabels"`
+}
+
+// ManagedControlPlaneSystemNodePool defines the system node pool created together with the AKS cluster.
+type ManagedControlPlaneSystemNodePool struct {
+	// Name is the name of the agent pool in Azure.
+	// +kubebuilder:validation:Pattern=`^[a-z][a-z0-9]{0,11}$`
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Mode is the mode of the agent pool. Only System is supported.
+	// +kubebuilder:validation:Enum=System
+	// +kubebuilder:default:=System
+	// +optional
+	Mode NodePoolMode `json:"mode,omitempty"`
+
+	// SKU is the size of the VMs in the node pool.
+	// +kubebuilder:validation:Required
+	SKU string `json:"sku"`
+
+	// Count is the number of nodes in the node pool.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=1
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// OSDiskSizeGB is the disk size for every machine in this pool.
+	// If you specify 0, it will apply the default osDisk size according to the vmSize specified.
+	// +optional
+	OSDiskSizeGB *int `json:"osDiskSizeGB,omitempty"`
+}
+
 // HTTPProxyConfig is the HTTP proxy configuration for the cluster.
 type HTTPProxyConfig struct {
 	// HTTPProxy is the HTTP proxy server endpoint to use.
@@ -297,6 +726,9 @@ type AADProfile struct {
 	AdminGroupObjectIDs []string `json:"adminGroupObjectIDs"`
 }
 
+// AzurePolicyAddonName is the name of the Azure Policy managed cluster add-on.
+const AzurePolicyAddonName = "azurepolicy"
+
 // AddonProfile represents a managed cluster add-on.
 type AddonProfile struct {
 	// Name - The name of the managed cluster add-on.
@@ -311,7 +743,7 @@ type AddonProfile struct {
 }
 
 // AzureManagedControlPlaneSkuTier - Tier of a managed cluster SKU.
-// +kubebuilder:validation:Enum=Free;Paid;Standard
+// +kubebuilder:validation:Enum=Free;Paid;Standard;Premium
 type AzureManagedControlPlaneSkuTier string
 
 const (
@@ -322,6 +754,8 @@ const (
 	PaidManagedControlPlaneTier AzureManagedControlPlaneSkuTier = "Paid"
 	// StandardManagedControlPlaneTier is the standard tier of AKS with corresponding SLAs.
 	StandardManagedControlPlaneTier AzureManagedControlPlaneSkuTier = "Standard"
+	// PremiumManagedControlPlaneTier is the premium tier of AKS, which is required for long-term support.
+	PremiumManagedControlPlaneTier AzureManagedControlPlaneSkuTier = "Premium"
 )
 
 // AKSSku - AKS SKU.
@@ -358,6 +792,20 @@ type LoadBalancerProfile struct {
 	IdleTimeoutInMinutes *int `json:"idleTimeoutInMinutes,omitempty"`
 }
 
+// NATGatewayProfile - Profile of the managed NAT gateway of the cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/nat-gateway
+type NATGatewayProfile struct {
+	// ManagedOutboundIPCount - Desired number of outbound IPs created and managed by Azure for the NAT gateway. Allowed values must be in the range of 1 to 16 (inclusive). The default value is 1.
+	// +optional
+	ManagedOutboundIPCount *int `json:"managedOutboundIPCount,omitempty"`
+
+	// IdleTimeoutInMinutes - Desired outbound flow idle timeout in minutes. Allowed values must be in the range of 4 to 120 (inclusive). The default value is 4 minutes.
+	// +optional
+	IdleTimeoutInMinutes *int `json:"idleTimeoutInMinutes,omitempty"`
+}
+
 // APIServerAccessProfile tunes the accessibility of the cluster's control plane.
 // See also [AKS doc].
 //
@@ -428,9 +876,18 @@ type AzureManagedControlPlaneStatus struct {
 	// +optional
 	OIDCIssuerProfile *OIDCIssuerProfileStatus `json:"oidcIssuerProfile,omitempty"`
 
+	// PowerState is the current power state of the cluster as reported by AKS.
+	// +optional
+	PowerState ManagedClusterPowerState `json:"powerState,omitempty"`
+
 	// Version defines the Kubernetes version for the control plane instance.
 	// +optional
 	Version string `json:"version"`
+
+	// AvailableUpgrades are the Kubernetes versions the control plane can be upgraded to, as reported by
+	// the upgrade profile of the Managed Cluster.
+	// +optional
+	AvailableUpgrades []string `json:"availableUpgrades,omitempty"`
 }
 
 // OIDCIssuerProfileStatus is the OIDC issuer profile of the Managed Cluster.
@@ -452,11 +909,26 @@ type AutoScalerProfile struct {
 	// +kubebuilder:default:="false"
 	// +optional
 	BalanceSimilarNodeGroups *BalanceSimilarNodeGroups `json:"balanceSimilarNodeGroups,omitempty"`
+	// DaemonsetEvictionForEmptyNodes - If true, DaemonSet pods are gracefully terminated from empty nodes before they
+	// are deleted. If false, the nodes are deleted without ensuring that DaemonSet pods are evicted. The default is false.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	DaemonsetEvictionForEmptyNodes *bool `json:"daemonsetEvictionForEmptyNodes,omitempty"`
+	// DaemonsetEvictionForOccupiedNodes - If true, DaemonSet pods are gracefully terminated from non-empty nodes before
+	// they are deleted. If false, the nodes are deleted without ensuring that DaemonSet pods are evicted. The default is true.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	DaemonsetEvictionForOccupiedNodes *bool `json:"daemonsetEvictionForOccupiedNodes,omitempty"`
 	// Expander - If not specified, the default is 'random'. See [expanders](https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/FAQ.md#what-are-expanders) for more information.
 	// +kubebuilder:validation:Enum=least-waste;most-pods;priority;random
 	// +kubebuilder:default:="random"
 	// +optional
 	Expander *Expander `json:"expander,omitempty"`
+	// IgnoreDaemonsetsUtilization - If true, the resources used by DaemonSet pods are ignored when calculating the
+	// resource utilization of a node for scale down. The default is false.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	IgnoreDaemonsetsUtilization *bool `json:"ignoreDaemonsetsUtilization,omitempty"`
 	// MaxEmptyBulkDelete - The default is 10.
 	// +kubebuilder:default:="10"
 	// +optional
@@ -591,6 +1063,13 @@ type Identity struct {
 	// UserAssignedIdentityResourceID - Identity ARM resource ID when using user-assigned identity.
 	// +optional
 	UserAssignedIdentityResourceID string `json:"userAssignedIdentityResourceID,omitempty"`
+
+	// AdditionalRoleAssignments is a list of additional roles to assign to the system-assigned identity of the
+	// control plane, each on a specific scope such as the resource group of a custom virtual network.
+	// It may only be set when Type is SystemAssigned. Roles of a user-assigned identity are managed by its owner.
+	// Removing a role assignment from the list does not delete it from Azure.
+	// +optional
+	AdditionalRoleAssignments []RoleAssignment `json:"additionalRoleAssignments,omitempty"`
 }
 
 // OIDCIssuerProfile is the OIDC issuer profile of the Managed Cluster.
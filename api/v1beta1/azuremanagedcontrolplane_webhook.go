@@ -22,10 +22,12 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/versions"
 	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
 )
@@ -47,6 +50,8 @@ var (
 	rScaleDownTime             = regexp.MustCompile(`^(\d+)m$`)
 	rScaleDownDelayAfterDelete = regexp.MustCompile(`^(\d+)s$`)
 	rScanInterval              = regexp.MustCompile(`^(\d+)s$`)
+	rIstioRevision             = regexp.MustCompile(`^asm-1-(0|[1-9][0-9]*)$`)
+	rAgentPoolName             = regexp.MustCompile(`^[a-z][a-z0-9]{0,11}$`)
 )
 
 // SetupAzureManagedControlPlaneWebhookWithManager sets up and registers the webhook with the manager.
@@ -109,6 +114,13 @@ func (mw *azureManagedControlPlaneWebhook) ValidateCreate(_ context.Context, obj
 		)
 	}
 
+	if ptr.Deref(m.Spec.PowerState, ManagedClusterPowerStateRunning) == ManagedClusterPowerStateStopped {
+		return nil, field.Forbidden(
+			field.NewPath("spec", "powerState"),
+			"a cluster cannot be created in the Stopped power state",
+		)
+	}
+
 	return nil, m.Validate(mw.Client)
 }
 
@@ -135,6 +147,7 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(_ context.Context, old
 		{field.NewPath("spec", "location"), old.Spec.Location, m.Spec.Location},
 		{field.NewPath("spec", "sshPublicKey"), old.Spec.SSHPublicKey, m.Spec.SSHPublicKey},
 		{field.NewPath("spec", "dnsServiceIP"), old.Spec.DNSServiceIP, m.Spec.DNSServiceIP},
+		{field.NewPath("spec", "ipFamilies"), old.Spec.IPFamilies, m.Spec.IPFamilies},
 		{field.NewPath("spec", "networkPlugin"), old.Spec.NetworkPlugin, m.Spec.NetworkPlugin},
 		{field.NewPath("spec", "networkPolicy"), old.Spec.NetworkPolicy, m.Spec.NetworkPolicy},
 		{field.NewPath("spec", "networkDataplane"), old.Spec.NetworkDataplane, m.Spec.NetworkDataplane},
@@ -149,6 +162,8 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(_ context.Context, old
 		}
 	}
 
+	allErrs = append(allErrs, validateSystemNodePoolUpdate(old.Spec.SystemNodePool, m.Spec.SystemNodePool, field.NewPath("spec", "systemNodePool"))...)
+
 	// This nil check is only to streamline tests from having to define this correctly in every test case.
 	// Normally, the defaulting webhooks will always set the new DNSPrefix so users can never entirely unset it.
 	if m.Spec.DNSPrefix != nil {
@@ -214,6 +229,10 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(_ context.Context, old
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := m.validatePowerStateUpdate(old); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if errs := validateAKSExtensionsUpdate(old.Spec.Extensions, m.Spec.Extensions); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -222,6 +241,10 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(_ context.Context, old
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := m.validateServiceMeshProfileUpdate(old); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, m.Validate(mw.Client)
 	}
@@ -258,17 +281,25 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 		m.Spec.LoadBalancerProfile,
 		field.NewPath("spec").Child("loadBalancerProfile"))...)
 
+	allErrs = append(allErrs, validateNATGatewayProfile(
+		m.Spec.NATGatewayProfile,
+		m.Spec.OutboundType,
+		field.NewPath("spec").Child("natGatewayProfile"))...)
+
 	allErrs = append(allErrs, validateManagedClusterNetwork(
 		cli,
 		m.Labels,
 		m.Namespace,
 		m.Spec.DNSServiceIP,
+		m.Spec.IPFamilies,
 		m.Spec.VirtualNetwork.Subnet,
 		field.NewPath("spec"))...)
 
+	allErrs = append(allErrs, validateIPFamilies(m.Spec.IPFamilies, field.NewPath("spec").Child("ipFamilies"))...)
+
 	allErrs = append(allErrs, validateName(m.Name, field.NewPath("name"))...)
 
-	allErrs = append(allErrs, validateAutoScalerProfile(m.Spec.AutoScalerProfile, field.NewPath("spec").Child("autoScalerProfile"))...)
+	allErrs = append(allErrs, validateAutoScalerProfile(m.Spec.AutoScalerProfile, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("autoScalerProfile"))...)
 
 	allErrs = append(allErrs, validateAKSExtensions(m.Spec.Extensions, field.NewPath("spec").Child("aksExtensions"))...)
 
@@ -284,6 +315,24 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 
 	allErrs = append(allErrs, validateFleetsMember(m.Spec.FleetsMember, field.NewPath("spec").Child("fleetsMember"))...)
 
+	allErrs = append(allErrs, validateIngressProfile(m.Spec.IngressProfile, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("ingressProfile"))...)
+
+	allErrs = append(allErrs, validateMetricsProfile(m.Spec.MetricsProfile, m.Spec.SKU, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("metricsProfile"))...)
+
+	allErrs = append(allErrs, validateAzureMonitorProfile(m.Spec.AzureMonitorProfile, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("azureMonitorProfile"))...)
+
+	allErrs = append(allErrs, validateSupportPlan(m.Spec.SupportPlan, m.Spec.SKU, field.NewPath("spec").Child("supportPlan"))...)
+
+	allErrs = append(allErrs, validateKubeProxyConfig(m.Spec.KubeProxyConfig, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("kubeProxyConfig"))...)
+
+	allErrs = append(allErrs, validateServiceMeshProfile(m.Spec.ServiceMeshProfile, field.NewPath("spec").Child("serviceMeshProfile"))...)
+
+	allErrs = append(allErrs, validateAADPodIdentityProfile(m.Spec.AADPodIdentityProfile, m.Spec.NetworkPlugin, field.NewPath("spec").Child("aadPodIdentityProfile"))...)
+
+	allErrs = append(allErrs, validateUpgradeChannels(m.Spec.AutoUpgradeProfile, m.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("autoUpgradeProfile"))...)
+
+	allErrs = append(allErrs, validateSystemNodePool(m.Spec.SystemNodePool, field.NewPath("spec").Child("systemNodePool"))...)
+
 	return allErrs.ToAggregate()
 }
 
@@ -316,6 +365,9 @@ func (m *AzureManagedControlPlaneClassSpec) validateSecurityProfile() field.Erro
 	if err := m.validateWorkloadIdentity(); err != nil {
 		allErrs = append(allErrs, err...)
 	}
+	if err := m.validateImageIntegrity(); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 	return allErrs
 }
 
@@ -363,6 +415,33 @@ func (m *AzureManagedControlPlaneClassSpec) validateWorkloadIdentity() field.Err
 	return nil
 }
 
+// validateImageIntegrity validates ImageIntegrity.
+func (m *AzureManagedControlPlaneClassSpec) validateImageIntegrity() field.ErrorList {
+	if m.SecurityProfile == nil || m.SecurityProfile.ImageIntegrity == nil {
+		return nil
+	}
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec", "securityProfile", "imageIntegrity")
+	if !ptr.Deref(m.EnablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "Spec.SecurityProfile.ImageIntegrity can be set only when Spec.EnablePreviewFeatures is true"))
+	}
+	if m.SecurityProfile.ImageIntegrity.Enabled && !m.isAzurePolicyEnabled() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("enabled"), m.SecurityProfile.ImageIntegrity.Enabled,
+			"Spec.SecurityProfile.ImageIntegrity cannot be enabled when the azurepolicy add-on is disabled"))
+	}
+	return allErrs
+}
+
+// isAzurePolicyEnabled returns true if the azurepolicy add-on is enabled.
+func (m *AzureManagedControlPlaneClassSpec) isAzurePolicyEnabled() bool {
+	for _, addonProfile := range m.AddonProfiles {
+		if addonProfile.Name == AzurePolicyAddonName && addonProfile.Enabled {
+			return true
+		}
+	}
+	return false
+}
+
 // validateDisableLocalAccounts disabling local accounts for AAD based clusters.
 func (m *AzureManagedControlPlane) validateDisableLocalAccounts(_ client.Client) field.ErrorList {
 	if m.Spec.DisableLocalAccounts != nil && m.Spec.AADProfile == nil {
@@ -394,6 +473,32 @@ func (m *AzureManagedControlPlane) validateSSHKey(_ client.Client) field.ErrorLi
 	return nil
 }
 
+// validateNATGatewayProfile validates a NATGatewayProfile.
+func validateNATGatewayProfile(natGatewayProfile *NATGatewayProfile, outboundType *ManagedControlPlaneOutboundType, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if natGatewayProfile == nil {
+		return allErrs
+	}
+
+	if ptr.Deref(outboundType, "") != ManagedControlPlaneOutboundTypeManagedNATGateway {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("natGatewayProfile can only be set when outboundType is %s", ManagedControlPlaneOutboundTypeManagedNATGateway)))
+	}
+
+	if natGatewayProfile.ManagedOutboundIPCount != nil {
+		if *natGatewayProfile.ManagedOutboundIPCount < 1 || *natGatewayProfile.ManagedOutboundIPCount > 16 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("managedOutboundIPCount"), *natGatewayProfile.ManagedOutboundIPCount, "value should be in between 1 and 16"))
+		}
+	}
+
+	if natGatewayProfile.IdleTimeoutInMinutes != nil {
+		if *natGatewayProfile.IdleTimeoutInMinutes < 4 || *natGatewayProfile.IdleTimeoutInMinutes > 120 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *natGatewayProfile.IdleTimeoutInMinutes, "value should be in between 4 and 120"))
+		}
+	}
+
+	return allErrs
+}
+
 // validateLoadBalancerProfile validates a LoadBalancerProfile.
 func validateLoadBalancerProfile(loadBalancerProfile *LoadBalancerProfile, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -474,6 +579,266 @@ func validateFleetsMember(fleetsMember *FleetsMember, fldPath *field.Path) field
 	return allErrs
 }
 
+// validateIngressProfile validates an IngressProfile.
+func validateIngressProfile(ingressProfile *ManagedClusterIngressProfile, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ingressProfile == nil {
+		return allErrs
+	}
+	if !ptr.Deref(enablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "IngressProfile can be set only when EnablePreviewFeatures is true"))
+	}
+	if ingressProfile.WebAppRouting == nil {
+		return allErrs
+	}
+	dnsZonesPath := fldPath.Child("webAppRouting", "dnsZoneResourceIDs")
+	if !ingressProfile.WebAppRouting.Enabled && len(ingressProfile.WebAppRouting.DNSZoneResourceIDs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(dnsZonesPath, "DNS zones can be set only when WebAppRouting is enabled"))
+	}
+	seen := make(map[string]struct{}, len(ingressProfile.WebAppRouting.DNSZoneResourceIDs))
+	for i, id := range ingressProfile.WebAppRouting.DNSZoneResourceIDs {
+		if _, ok := seen[strings.ToLower(id)]; ok {
+			allErrs = append(allErrs, field.Duplicate(dnsZonesPath.Index(i), id))
+			continue
+		}
+		seen[strings.ToLower(id)] = struct{}{}
+		resourceID, err := azureutil.ParseResourceID(id)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(dnsZonesPath.Index(i), id, fmt.Sprintf("invalid resource ID: %v", err)))
+			continue
+		}
+		resourceType := resourceID.ResourceType.String()
+		if !strings.EqualFold(resourceType, DNSZoneResourceType) && !strings.EqualFold(resourceType, PrivateDNSZoneResourceType) {
+			allErrs = append(allErrs, field.Invalid(dnsZonesPath.Index(i), id,
+				fmt.Sprintf("resource type must be either %s or %s", DNSZoneResourceType, PrivateDNSZoneResourceType)))
+		}
+	}
+	return allErrs
+}
+
+// validateMetricsProfile validates a MetricsProfile.
+func validateMetricsProfile(metricsProfile *ManagedClusterMetricsProfile, sku *AKSSku, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if metricsProfile == nil {
+		return allErrs
+	}
+	if !ptr.Deref(enablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "MetricsProfile can be set only when EnablePreviewFeatures is true"))
+	}
+	if metricsProfile.CostAnalysis != nil && metricsProfile.CostAnalysis.Enabled {
+		if sku == nil || (sku.Tier != StandardManagedControlPlaneTier && sku.Tier != PaidManagedControlPlaneTier && sku.Tier != PremiumManagedControlPlaneTier) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("costAnalysis", "enabled"), "CostAnalysis can be enabled only when the SKU tier is Standard"))
+		}
+	}
+	return allErrs
+}
+
+// validateAzureMonitorProfile validates an AzureMonitorProfile.
+func validateAzureMonitorProfile(azureMonitorProfile *ManagedClusterAzureMonitorProfile, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if azureMonitorProfile == nil || azureMonitorProfile.ContainerInsights == nil {
+		return allErrs
+	}
+	containerInsights := azureMonitorProfile.ContainerInsights
+	containerInsightsPath := fldPath.Child("containerInsights")
+	if !ptr.Deref(enablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(containerInsightsPath, "ContainerInsights can be set only when EnablePreviewFeatures is true"))
+	}
+	if containerInsights.LogAnalyticsWorkspaceResourceID == nil {
+		return allErrs
+	}
+	workspacePath := containerInsightsPath.Child("logAnalyticsWorkspaceResourceID")
+	id := *containerInsights.LogAnalyticsWorkspaceResourceID
+	if !containerInsights.Enabled {
+		allErrs = append(allErrs, field.Forbidden(workspacePath, "LogAnalyticsWorkspaceResourceID can be set only when ContainerInsights is enabled"))
+	}
+	resourceID, err := azureutil.ParseResourceID(id)
+	if err != nil {
+		return append(allErrs, field.Invalid(workspacePath, id, fmt.Sprintf("invalid resource ID: %v", err)))
+	}
+	if !strings.EqualFold(resourceID.ResourceType.String(), LogAnalyticsWorkspaceResourceType) {
+		allErrs = append(allErrs, field.Invalid(workspacePath, id, fmt.Sprintf("resource type must be %s", LogAnalyticsWorkspaceResourceType)))
+	}
+	return allErrs
+}
+
+// validateKubeProxyConfig validates a KubeProxyConfig.
+func validateKubeProxyConfig(kubeProxyConfig *ManagedClusterKubeProxyConfig, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if kubeProxyConfig == nil {
+		return allErrs
+	}
+	if !ptr.Deref(enablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "KubeProxyConfig can be set only when EnablePreviewFeatures is true"))
+	}
+	if kubeProxyConfig.Mode != nil && *kubeProxyConfig.Mode != KubeProxyModeIPTables && *kubeProxyConfig.Mode != KubeProxyModeIPVS {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *kubeProxyConfig.Mode,
+			[]string{string(KubeProxyModeIPTables), string(KubeProxyModeIPVS)}))
+	}
+	ipvsConfig := kubeProxyConfig.IPVSConfig
+	if ipvsConfig == nil {
+		return allErrs
+	}
+	if ptr.Deref(kubeProxyConfig.Mode, "") != KubeProxyModeIPVS {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipvsConfig"), "IPVSConfig can be set only when Mode is IPVS"))
+	}
+	if ipvsConfig.Scheduler != nil && *ipvsConfig.Scheduler != IPVSSchedulerRoundRobin && *ipvsConfig.Scheduler != IPVSSchedulerLeastConnection {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("ipvsConfig", "scheduler"), *ipvsConfig.Scheduler,
+			[]string{string(IPVSSchedulerRoundRobin), string(IPVSSchedulerLeastConnection)}))
+	}
+	return allErrs
+}
+
+// validateServiceMeshProfile validates a ServiceMeshProfile.
+func validateServiceMeshProfile(serviceMeshProfile *ManagedClusterServiceMeshProfile, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if serviceMeshProfile == nil {
+		return allErrs
+	}
+	if serviceMeshProfile.Mode != ServiceMeshModeIstio {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), serviceMeshProfile.Mode, []string{string(ServiceMeshModeIstio)}))
+	}
+	if serviceMeshProfile.Istio == nil {
+		return allErrs
+	}
+	revisions := serviceMeshProfile.Istio.Revisions
+	revisionsPath := fldPath.Child("istio", "revisions")
+	if len(revisions) > 2 {
+		return append(allErrs, field.TooMany(revisionsPath, len(revisions), 2))
+	}
+	minors := make([]int, 0, len(revisions))
+	for i, revision := range revisions {
+		match := rIstioRevision.FindStringSubmatch(revision)
+		if match == nil {
+			allErrs = append(allErrs, field.Invalid(revisionsPath.Index(i), revision, "must be of the form asm-1-<minor version>, for example asm-1-22"))
+			continue
+		}
+		minor, _ := strconv.Atoi(match[1])
+		minors = append(minors, minor)
+	}
+	if len(allErrs) > 0 || len(minors) < 2 {
+		return allErrs
+	}
+	// Two revisions are only allowed during a canary upgrade, from one minor version to the next.
+	if minors[0] == minors[1] {
+		allErrs = append(allErrs, field.Duplicate(revisionsPath.Index(1), revisions[1]))
+	} else if minors[0]-minors[1] != 1 && minors[1]-minors[0] != 1 {
+		allErrs = append(allErrs, field.Invalid(revisionsPath, revisions, "two revisions can be set only for a canary upgrade between adjacent minor versions"))
+	}
+	return allErrs
+}
+
+// validateAADPodIdentityProfile validates an AADPodIdentityProfile.
+func validateAADPodIdentityProfile(profile *ManagedClusterAADPodIdentityProfile, networkPlugin *string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if profile == nil {
+		return allErrs
+	}
+	if !profile.Enabled {
+		if len(profile.UserAssignedIdentities) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("userAssignedIdentities"), "can be set only when the AAD Pod Identity profile is enabled"))
+		}
+		if len(profile.UserAssignedIdentityExceptions) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("userAssignedIdentityExceptions"), "can be set only when the AAD Pod Identity profile is enabled"))
+		}
+		return allErrs
+	}
+
+	const kubenet = "kubenet"
+	if ptr.Deref(networkPlugin, "") == kubenet && !profile.AllowNetworkPluginKubenet {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("allowNetworkPluginKubenet"),
+			fmt.Sprintf("must be true to enable AAD Pod Identity when NetworkPlugin is %q", kubenet)))
+	}
+
+	identities := make(map[string]struct{}, len(profile.UserAssignedIdentities))
+	for i, identity := range profile.UserAssignedIdentities {
+		identityPath := fldPath.Child("userAssignedIdentities").Index(i)
+		key := identity.Namespace + "/" + identity.Name
+		if _, ok := identities[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(identityPath, key))
+		}
+		identities[key] = struct{}{}
+		if _, err := azureutil.ParseResourceID(identity.Identity.ResourceID); err != nil {
+			allErrs = append(allErrs, field.Invalid(identityPath.Child("identity", "resourceID"), identity.Identity.ResourceID, "must be a valid Azure resource ID"))
+		}
+		if _, err := uuid.Parse(identity.Identity.ClientID); err != nil {
+			allErrs = append(allErrs, field.Invalid(identityPath.Child("identity", "clientID"), identity.Identity.ClientID, "must be a valid UUID"))
+		}
+		if _, err := uuid.Parse(identity.Identity.ObjectID); err != nil {
+			allErrs = append(allErrs, field.Invalid(identityPath.Child("identity", "objectID"), identity.Identity.ObjectID, "must be a valid UUID"))
+		}
+	}
+
+	exceptions := make(map[string]struct{}, len(profile.UserAssignedIdentityExceptions))
+	for i, exception := range profile.UserAssignedIdentityExceptions {
+		key := exception.Namespace + "/" + exception.Name
+		if _, ok := exceptions[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("userAssignedIdentityExceptions").Index(i), key))
+		}
+		exceptions[key] = struct{}{}
+	}
+	return allErrs
+}
+
+// validateSystemNodePoolUpdate validates an update of a ManagedControlPlaneSystemNodePool. The system node pool can
+// only be scaled after the cluster is created.
+func validateSystemNodePoolUpdate(old, systemNodePool *ManagedControlPlaneSystemNodePool, fldPath *field.Path) field.ErrorList {
+	if old == nil || systemNodePool == nil {
+		if err := webhookutils.ValidateImmutable(fldPath, old, systemNodePool); err != nil {
+			return field.ErrorList{err}
+		}
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	immutableFields := []struct {
+		path *field.Path
+		old  interface{}
+		new  interface{}
+	}{
+		{fldPath.Child("name"), old.Name, systemNodePool.Name},
+		{fldPath.Child("mode"), old.Mode, systemNodePool.Mode},
+		{fldPath.Child("sku"), old.SKU, systemNodePool.SKU},
+		{fldPath.Child("osDiskSizeGB"), old.OSDiskSizeGB, systemNodePool.OSDiskSizeGB},
+	}
+	for _, f := range immutableFields {
+		if err := webhookutils.ValidateImmutable(f.path, f.old, f.new); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
+
+// validateSystemNodePool validates a ManagedControlPlaneSystemNodePool.
+func validateSystemNodePool(systemNodePool *ManagedControlPlaneSystemNodePool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if systemNodePool == nil {
+		return allErrs
+	}
+	if systemNodePool.Mode != "" && systemNodePool.Mode != NodePoolModeSystem {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), systemNodePool.Mode, []string{string(NodePoolModeSystem)}))
+	}
+	if systemNodePool.SKU == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sku"), "sku must be set"))
+	}
+	if !rAgentPoolName.MatchString(systemNodePool.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), systemNodePool.Name, "must begin with a lowercase letter, contain only lowercase alphanumeric characters, and be at most 12 characters long"))
+	}
+	return allErrs
+}
+
+// validateSupportPlan validates a KubernetesSupportPlan. Long-term support requires the Premium SKU tier.
+func validateSupportPlan(supportPlan *KubernetesSupportPlan, sku *AKSSku, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if ptr.Deref(supportPlan, KubernetesSupportPlanKubernetesOfficial) != KubernetesSupportPlanAKSLongTermSupport {
+		return allErrs
+	}
+	if sku == nil || sku.Tier != PremiumManagedControlPlaneTier {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "AKSLongTermSupport can be selected only when the SKU tier is Premium"))
+	}
+	return allErrs
+}
+
 // validateAPIServerAccessProfile validates an APIServerAccessProfile.
 func validateAPIServerAccessProfile(apiServerAccessProfile *APIServerAccessProfile, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -491,6 +856,11 @@ func validateAPIServerAccessProfile(apiServerAccessProfile *APIServerAccessProfi
 		// Performing a lower case comparison to avoid case sensitivity.
 		if apiServerAccessProfile.PrivateDNSZone != nil {
 			privateDNSZone := strings.ToLower(ptr.Deref(apiServerAccessProfile.PrivateDNSZone, ""))
+			// With "None", AKS does not create a private DNS zone and DNS records must be managed by the user,
+			// which is only meaningful for private clusters.
+			if strings.EqualFold(privateDNSZone, "none") && !ptr.Deref(apiServerAccessProfile.EnablePrivateCluster, false) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("enablePrivateCluster"), apiServerAccessProfile.EnablePrivateCluster, "Private Cluster should be enabled to use PrivateDNSZone None"))
+			}
 			if !strings.EqualFold(strings.ToLower(privateDNSZone), "system") &&
 				!strings.EqualFold(strings.ToLower(privateDNSZone), "none") {
 				// Extract substring starting from "privatednszones/"
@@ -524,7 +894,7 @@ func validateAPIServerAccessProfile(apiServerAccessProfile *APIServerAccessProfi
 }
 
 // validateManagedClusterNetwork validates the Cluster network values.
-func validateManagedClusterNetwork(cli client.Client, labels map[string]string, namespace string, dnsServiceIP *string, subnet ManagedControlPlaneSubnet, fldPath *field.Path) field.ErrorList {
+func validateManagedClusterNetwork(cli client.Client, labels map[string]string, namespace string, dnsServiceIP *string, ipFamilies []IPFamily, subnet ManagedControlPlaneSubnet, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs     field.ErrorList
 		serviceCIDR string
@@ -551,21 +921,17 @@ func validateManagedClusterNetwork(cli client.Client, labels map[string]string,
 
 	if clusterNetwork := ownerCluster.Spec.ClusterNetwork; clusterNetwork != nil {
 		if clusterNetwork.Services != nil {
-			// A user may provide zero or one CIDR blocks. If they provide an empty array,
-			// we ignore it and use the default. AKS doesn't support > 1 Service/Pod CIDR.
-			if len(clusterNetwork.Services.CIDRBlocks) > 1 {
-				allErrs = append(allErrs, field.TooMany(field.NewPath("Cluster", "spec", "clusterNetwork", "services", "cidrBlocks"), len(clusterNetwork.Services.CIDRBlocks), 1))
-			}
-			if len(clusterNetwork.Services.CIDRBlocks) == 1 {
+			// A user may provide zero or one CIDR block per IP family. If they provide an empty array,
+			// we ignore it and use the default.
+			allErrs = append(allErrs, validateCIDRBlockFamilies(clusterNetwork.Services.CIDRBlocks, ipFamilies, field.NewPath("Cluster", "spec", "clusterNetwork", "services", "cidrBlocks"))...)
+			if len(clusterNetwork.Services.CIDRBlocks) > 0 {
 				serviceCIDR = clusterNetwork.Services.CIDRBlocks[0]
 			}
 		}
 		if clusterNetwork.Pods != nil {
-			// A user may provide zero or one CIDR blocks. If they provide an empty array,
-			// we ignore it and use the default. AKS doesn't support > 1 Service/Pod CIDR.
-			if len(clusterNetwork.Pods.CIDRBlocks) > 1 {
-				allErrs = append(allErrs, field.TooMany(field.NewPath("Cluster", "spec", "clusterNetwork", "pods", "cidrBlocks"), len(clusterNetwork.Pods.CIDRBlocks), 1))
-			}
+			// A user may provide zero or one CIDR block per IP family. If they provide an empty array,
+			// we ignore it and use the default.
+			allErrs = append(allErrs, validateCIDRBlockFamilies(clusterNetwork.Pods.CIDRBlocks, ipFamilies, field.NewPath("Cluster", "spec", "clusterNetwork", "pods", "cidrBlocks"))...)
 		}
 	}
 
@@ -602,6 +968,48 @@ func validateManagedClusterNetwork(cli client.Client, labels map[string]string,
 	return allErrs
 }
 
+// validateIPFamilies validates the IP families of a managed cluster.
+func validateIPFamilies(ipFamilies []IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(ipFamilies) == 2 && ipFamilies[0] == ipFamilies[1] {
+		allErrs = append(allErrs, field.Duplicate(fldPath.Index(1), ipFamilies[1]))
+	}
+	return allErrs
+}
+
+// validateCIDRBlockFamilies validates that there is at most one CIDR block per IP family of the managed cluster,
+// in the same order as the IP families. Without IP families, AKS only supports a single IPv4 CIDR block.
+func validateCIDRBlockFamilies(cidrBlocks []string, ipFamilies []IPFamily, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(cidrBlocks) == 0 {
+		return allErrs
+	}
+	if len(ipFamilies) == 0 {
+		if len(cidrBlocks) > 1 {
+			allErrs = append(allErrs, field.TooMany(fldPath, len(cidrBlocks), 1))
+		}
+		return allErrs
+	}
+	if len(cidrBlocks) != len(ipFamilies) {
+		return append(allErrs, field.Invalid(fldPath, cidrBlocks, fmt.Sprintf("must have one CIDR block for each of the IP families %v", ipFamilies)))
+	}
+	for i, cidrBlock := range cidrBlocks {
+		ip, _, err := net.ParseCIDR(cidrBlock)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidrBlock, "must be a valid CIDR block"))
+			continue
+		}
+		family := IPFamilyIPv4
+		if ip.To4() == nil {
+			family = IPFamilyIPv6
+		}
+		if family != ipFamilies[i] {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidrBlock, fmt.Sprintf("must be an %s CIDR block to match the IP families", ipFamilies[i])))
+		}
+	}
+	return allErrs
+}
+
 // validateAutoUpgradeProfile validates auto upgrade profile.
 func (m *AzureManagedControlPlane) validateAutoUpgradeProfile(old *AzureManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
@@ -615,6 +1023,34 @@ func (m *AzureManagedControlPlane) validateAutoUpgradeProfile(old *AzureManagedC
 					old.Spec.AutoUpgradeProfile.UpgradeChannel,
 					"field cannot be set to nil, to disable auto upgrades set the channel to none."))
 		}
+		if old.Spec.AutoUpgradeProfile.NodeOSUpgradeChannel != nil && (m.Spec.AutoUpgradeProfile == nil || m.Spec.AutoUpgradeProfile.NodeOSUpgradeChannel == nil) {
+			// Unsetting the field leaves the channel unchanged in AKS, so it is not allowed either.
+			allErrs = append(allErrs,
+				field.Invalid(
+					field.NewPath("Spec", "AutoUpgradeProfile", "NodeOSUpgradeChannel"),
+					old.Spec.AutoUpgradeProfile.NodeOSUpgradeChannel,
+					"field cannot be set to nil, to disable node OS upgrades set the channel to None."))
+		}
+	}
+	return allErrs
+}
+
+// validateUpgradeChannels validates that the node OS upgrade channel doesn't conflict with the upgrade channel.
+// AKS always upgrades the node image with the node-image upgrade channel, so it takes precedence and the node OS
+// upgrade channel must be NodeImage or left for AKS to set. The SecurityPatch channel is only available in the
+// preview API.
+func validateUpgradeChannels(profile *ManagedClusterAutoUpgradeProfile, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if profile == nil || profile.NodeOSUpgradeChannel == nil {
+		return allErrs
+	}
+	if *profile.NodeOSUpgradeChannel == NodeOSUpgradeChannelSecurityPatch && !ptr.Deref(enablePreviewFeatures, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeOSUpgradeChannel"),
+			fmt.Sprintf("%s can be set only when EnablePreviewFeatures is true", NodeOSUpgradeChannelSecurityPatch)))
+	}
+	if ptr.Deref(profile.UpgradeChannel, "") == UpgradeChannelNodeImage && *profile.NodeOSUpgradeChannel != NodeOSUpgradeChannelNodeImage {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeOSUpgradeChannel"), *profile.NodeOSUpgradeChannel,
+			fmt.Sprintf("must be %s when upgradeChannel is %s", NodeOSUpgradeChannelNodeImage, UpgradeChannelNodeImage)))
 	}
 	return allErrs
 }
@@ -666,7 +1102,7 @@ func (m *AzureManagedControlPlane) validateAPIServerAccessProfileUpdate(old *Azu
 	if !reflect.DeepEqual(newAPIServerAccessProfileNormalized, oldAPIServerAccessProfileNormalized) {
 		allErrs = append(allErrs,
 			field.Invalid(field.NewPath("spec", "apiServerAccessProfile"),
-				m.Spec.APIServerAccessProfile, "fields (except for AuthorizedIPRanges) are immutable"),
+				m.Spec.APIServerAccessProfile, "fields (except for AuthorizedIPRanges and DisableRunCommand) are immutable"),
 		)
 	}
 
@@ -823,6 +1259,9 @@ func (m *AzureManagedControlPlaneClassSpec) validateSecurityProfileUpdate(old *A
 		if errWorkloadIdentity := m.validateDefender(old); errWorkloadIdentity != nil {
 			allErrs = append(allErrs, errWorkloadIdentity...)
 		}
+		if errImageIntegrity := m.validateImageIntegrityUpdate(old); errImageIntegrity != nil {
+			allErrs = append(allErrs, errImageIntegrity...)
+		}
 	}
 	return allErrs
 }
@@ -864,6 +1303,18 @@ func (m *AzureManagedControlPlaneClassSpec) validateImageCleanerUpdate(old *Azur
 	return allErrs
 }
 
+// validateImageIntegrityUpdate validates ImageIntegrityUpdate profile.
+func (m *AzureManagedControlPlaneClassSpec) validateImageIntegrityUpdate(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.SecurityProfile.ImageIntegrity != nil {
+		if m.SecurityProfile == nil || m.SecurityProfile.ImageIntegrity == nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "securityProfile", "imageIntegrity"),
+				nil, "cannot unset Spec.SecurityProfile.ImageIntegrity, to disable imageIntegrity please set Spec.SecurityProfile.ImageIntegrity.Enabled to false"))
+		}
+	}
+	return allErrs
+}
+
 // validateDefender validates defender profile.
 func (m *AzureManagedControlPlaneClassSpec) validateDefender(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
 	var allErrs field.ErrorList
@@ -893,6 +1344,37 @@ func (m *AzureManagedControlPlane) validateOIDCIssuerProfileUpdate(old *AzureMan
 	return allErrs
 }
 
+// validateServiceMeshProfileUpdate validates that the Istio revisions are only changed by a canary upgrade, i.e. by
+// adding the next revision alongside the current one and then removing either of them.
+func (m *AzureManagedControlPlane) validateServiceMeshProfileUpdate(old *AzureManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.Spec.ServiceMeshProfile == nil || old.Spec.ServiceMeshProfile.Istio == nil ||
+		m.Spec.ServiceMeshProfile == nil || m.Spec.ServiceMeshProfile.Istio == nil {
+		return allErrs
+	}
+	oldRevisions := old.Spec.ServiceMeshProfile.Istio.Revisions
+	newRevisions := m.Spec.ServiceMeshProfile.Istio.Revisions
+	if len(oldRevisions) == 0 || len(newRevisions) == 0 {
+		return allErrs
+	}
+	revisionsPath := field.NewPath("spec", "serviceMeshProfile", "istio", "revisions")
+	kept := 0
+	for _, revision := range newRevisions {
+		if slices.Contains(oldRevisions, revision) {
+			kept++
+		}
+	}
+	switch {
+	case kept == 0:
+		allErrs = append(allErrs, field.Forbidden(revisionsPath,
+			"the Istio revision can only be upgraded by adding the new revision alongside the current one for a canary upgrade"))
+	case len(oldRevisions) == 2 && len(newRevisions) == 2 && kept != 2:
+		allErrs = append(allErrs, field.Forbidden(revisionsPath,
+			"the Istio revisions cannot be replaced during a canary upgrade, remove one of them to complete or roll back the upgrade"))
+	}
+	return allErrs
+}
+
 // validateFleetsMemberUpdate validates a FleetsMember.
 func (m *AzureManagedControlPlane) validateFleetsMemberUpdate(old *AzureManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
@@ -912,6 +1394,33 @@ func (m *AzureManagedControlPlane) validateFleetsMemberUpdate(old *AzureManagedC
 	return allErrs
 }
 
+// validatePowerStateUpdate validates that the power state is not changed together with other fields, since
+// changes to a stopped cluster cannot be applied and AKS rejects updates while the cluster is starting or stopping.
+func (m *AzureManagedControlPlane) validatePowerStateUpdate(old *AzureManagedControlPlane) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldPowerState := ptr.Deref(old.Spec.PowerState, ManagedClusterPowerStateRunning)
+	newPowerState := ptr.Deref(m.Spec.PowerState, ManagedClusterPowerStateRunning)
+	if oldPowerState == newPowerState {
+		return allErrs
+	}
+
+	oldSpec := old.Spec.DeepCopy()
+	newSpec := m.Spec.DeepCopy()
+	oldSpec.PowerState = nil
+	newSpec.PowerState = nil
+	if !reflect.DeepEqual(oldSpec, newSpec) {
+		allErrs = append(allErrs,
+			field.Forbidden(
+				field.NewPath("spec", "powerState"),
+				"cannot be changed together with other fields",
+			),
+		)
+	}
+
+	return allErrs
+}
+
 // validateAKSExtensionsUpdate validates update to AKS extensions.
 func validateAKSExtensionsUpdate(old []AKSExtension, current []AKSExtension) field.ErrorList {
 	var allErrs field.ErrorList
@@ -1072,13 +1581,29 @@ func validateNetworkDataplane(networkDataplane *NetworkDataplaneType, networkPol
 }
 
 // validateAutoScalerProfile validates an AutoScalerProfile.
-func validateAutoScalerProfile(autoScalerProfile *AutoScalerProfile, fldPath *field.Path) field.ErrorList {
+func validateAutoScalerProfile(autoScalerProfile *AutoScalerProfile, enablePreviewFeatures *bool, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	if autoScalerProfile == nil {
 		return nil
 	}
 
+	if !ptr.Deref(enablePreviewFeatures, false) {
+		previewFields := []struct {
+			name  string
+			value *bool
+		}{
+			{"daemonsetEvictionForEmptyNodes", autoScalerProfile.DaemonsetEvictionForEmptyNodes},
+			{"daemonsetEvictionForOccupiedNodes", autoScalerProfile.DaemonsetEvictionForOccupiedNodes},
+			{"ignoreDaemonsetsUtilization", autoScalerProfile.IgnoreDaemonsetsUtilization},
+		}
+		for _, f := range previewFields {
+			if f.value != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child(f.name), "can be set only when EnablePreviewFeatures is true"))
+			}
+		}
+	}
+
 	if errs := validateIntegerStringGreaterThanZero(autoScalerProfile.MaxEmptyBulkDelete, fldPath, "MaxEmptyBulkDelete"); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -1224,6 +1749,13 @@ func (m *AzureManagedControlPlane) validateIdentity(_ client.Client) field.Error
 				allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "identity", "userAssignedIdentityResourceID"), m.Spec.Identity.UserAssignedIdentityResourceID, "should be empty if Identity.Type is SystemAssigned"))
 			}
 		}
+
+		identityType := m.Spec.Identity.Type
+		if identityType == "" {
+			identityType = ManagedControlPlaneIdentityTypeSystemAssigned
+		}
+		allErrs = append(allErrs, ValidateAdditionalRoleAssignments(VMIdentity(identityType), m.Spec.Identity.AdditionalRoleAssignments,
+			field.NewPath("spec", "identity", "additionalRoleAssignments"))...)
 	}
 
 	if len(allErrs) > 0 {
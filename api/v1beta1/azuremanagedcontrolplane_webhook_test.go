@@ -277,11 +277,88 @@ func TestValidateLoadBalancerProfile(t *testing.T) {
 	}
 }
 
+func TestValidateNATGatewayProfile(t *testing.T) {
+	tests := []struct {
+		name         string
+		profile      *NATGatewayProfile
+		outboundType *ManagedControlPlaneOutboundType
+		expectedErr  field.Error
+	}{
+		{
+			name:         "Valid NATGatewayProfile",
+			outboundType: ptr.To(ManagedControlPlaneOutboundTypeManagedNATGateway),
+			profile: &NATGatewayProfile{
+				ManagedOutboundIPCount: ptr.To(4),
+				IdleTimeoutInMinutes:   ptr.To(30),
+			},
+		},
+		{
+			name:         "Invalid NATGatewayProfile.ManagedOutboundIPCount",
+			outboundType: ptr.To(ManagedControlPlaneOutboundTypeManagedNATGateway),
+			profile: &NATGatewayProfile{
+				ManagedOutboundIPCount: ptr.To(17),
+			},
+			expectedErr: field.Error{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "spec.natGatewayProfile.managedOutboundIPCount",
+				BadValue: ptr.To(17),
+				Detail:   "value should be in between 1 and 16",
+			},
+		},
+		{
+			name:         "Invalid NATGatewayProfile.IdleTimeoutInMinutes",
+			outboundType: ptr.To(ManagedControlPlaneOutboundTypeManagedNATGateway),
+			profile: &NATGatewayProfile{
+				IdleTimeoutInMinutes: ptr.To(3),
+			},
+			expectedErr: field.Error{
+				Type:     field.ErrorTypeInvalid,
+				Field:    "spec.natGatewayProfile.idleTimeoutInMinutes",
+				BadValue: ptr.To(3),
+				Detail:   "value should be in between 4 and 120",
+			},
+		},
+		{
+			name:         "NATGatewayProfile with loadBalancer outbound type",
+			outboundType: ptr.To(ManagedControlPlaneOutboundTypeLoadBalancer),
+			profile: &NATGatewayProfile{
+				ManagedOutboundIPCount: ptr.To(2),
+			},
+			expectedErr: field.Error{
+				Type:   field.ErrorTypeForbidden,
+				Field:  "spec.natGatewayProfile",
+				Detail: "natGatewayProfile can only be set when outboundType is managedNATGateway",
+			},
+		},
+		{
+			name:    "NATGatewayProfile without outbound type",
+			profile: &NATGatewayProfile{},
+			expectedErr: field.Error{
+				Type:   field.ErrorTypeForbidden,
+				Field:  "spec.natGatewayProfile",
+				Detail: "natGatewayProfile can only be set when outboundType is managedNATGateway",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			allErrs := validateNATGatewayProfile(tt.profile, tt.outboundType, field.NewPath("spec").Child("natGatewayProfile"))
+			if tt.expectedErr != (field.Error{}) {
+				g.Expect(allErrs).To(ContainElement(MatchError(tt.expectedErr.Error())))
+			} else {
+				g.Expect(allErrs).To(BeNil())
+			}
+		})
+	}
+}
+
 func TestValidateAutoScalerProfile(t *testing.T) {
 	tests := []struct {
-		name      string
-		profile   *AutoScalerProfile
-		expectErr bool
+		name                  string
+		profile               *AutoScalerProfile
+		enablePreviewFeatures *bool
+		expectErr             bool
 	}{
 		{
 			name: "Valid AutoScalerProfile",
@@ -453,12 +530,37 @@ func TestValidateAutoScalerProfile(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Testing invalid AutoScalerProfile.DaemonsetEvictionForEmptyNodes without preview features",
+			profile: &AutoScalerProfile{
+				DaemonsetEvictionForEmptyNodes: ptr.To(true),
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid AutoScalerProfile.IgnoreDaemonsetsUtilization without preview features",
+			profile: &AutoScalerProfile{
+				IgnoreDaemonsetsUtilization: ptr.To(true),
+			},
+			enablePreviewFeatures: ptr.To(false),
+			expectErr:             true,
+		},
+		{
+			name: "Testing valid AutoScalerProfile DaemonSet settings with preview features",
+			profile: &AutoScalerProfile{
+				DaemonsetEvictionForEmptyNodes:    ptr.To(true),
+				DaemonsetEvictionForOccupiedNodes: ptr.To(false),
+				IgnoreDaemonsetsUtilization:       ptr.To(true),
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			g := NewWithT(t)
-			allErrs := validateAutoScalerProfile(tt.profile, field.NewPath("spec").Child("autoScalerProfile"))
+			allErrs := validateAutoScalerProfile(tt.profile, tt.enablePreviewFeatures, field.NewPath("spec").Child("autoScalerProfile"))
 			if tt.expectErr {
 				g.Expect(allErrs).NotTo(BeNil())
 			} else {
@@ -1317,6 +1419,67 @@ func TestValidatingWebhook(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name: "Testing valid additional role assignments of the system-assigned identity",
+			amcp: AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+						Identity: &Identity{
+							Type: ManagedControlPlaneIdentityTypeSystemAssigned,
+							AdditionalRoleAssignments: []RoleAssignment{
+								{
+									DefinitionID: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7",
+									Scope:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet-rg",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing invalid additional role assignments of a user-assigned identity",
+			amcp: AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+						Identity: &Identity{
+							Type:                           ManagedControlPlaneIdentityTypeUserAssigned,
+							UserAssignedIdentityResourceID: "not empty",
+							AdditionalRoleAssignments: []RoleAssignment{
+								{
+									DefinitionID: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7",
+									Scope:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet-rg",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid additional role assignment scope",
+			amcp: AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.17.8",
+						Identity: &Identity{
+							Type: ManagedControlPlaneIdentityTypeSystemAssigned,
+							AdditionalRoleAssignments: []RoleAssignment{
+								{
+									DefinitionID: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7",
+									Scope:        "vnet-rg",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
 		{
 			name: "Testing invalid NetworkDataplane: cilium dataplane requires network policy to be cilium",
 			amcp: AzureManagedControlPlane{
@@ -1669,6 +1832,15 @@ func TestAzureManagedControlPlane_ValidateCreateFailure(t *testing.T) {
 			featureGateEnabled: nil,
 			expectError:        false,
 		},
+		{
+			name: "cluster cannot be created stopped",
+			amcp: func() *AzureManagedControlPlane {
+				amcp := getKnownValidAzureManagedControlPlane()
+				amcp.Spec.PowerState = ptr.To(ManagedClusterPowerStateStopped)
+				return amcp
+			}(),
+			expectError: true,
+		},
 	}
 	client := mockClient{ReturnError: false}
 	for _, tc := range tests {
@@ -1930,6 +2102,35 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "AzureManagedControlPlane node OS upgrade channel cannot be set to nil",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP:   ptr.To("192.168.0.10"),
+						SubscriptionID: "212ec1q8",
+						Version:        "v1.18.0",
+						AutoUpgradeProfile: &ManagedClusterAutoUpgradeProfile{
+							UpgradeChannel:       ptr.To(UpgradeChannelStable),
+							NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelSecurityPatch),
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP:   ptr.To("192.168.0.10"),
+						SubscriptionID: "212ec1q8",
+						Version:        "v1.18.0",
+						AutoUpgradeProfile: &ManagedClusterAutoUpgradeProfile{
+							UpgradeChannel: ptr.To(UpgradeChannelStable),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "AzureManagedControlPlane Autoupgrade is mutable",
 			oldAMCP: &AzureManagedControlPlane{
@@ -2458,6 +2659,31 @@ func TestAzureManagedControlPlane_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "AzureManagedControlPlane DisableRunCommand is mutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						DNSServiceIP: ptr.To("192.168.0.10"),
+						Version:      "v1.18.0",
+						APIServerAccessProfile: &APIServerAccessProfile{
+							APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+								DisableRunCommand: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "AzureManagedControlPlane.VirtualNetwork Name is mutable",
 			oldAMCP: &AzureManagedControlPlane{
@@ -3864,84 +4090,353 @@ func TestAzureClusterSecurityProfileValidateUpdate(t *testing.T) {
 			},
 			wantErr: "",
 		},
-	}
-	client := mockClient{ReturnError: false}
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			g := NewWithT(t)
-			mcpw := &azureManagedControlPlaneWebhook{
-				Client: client,
-			}
-			_, err := mcpw.ValidateUpdate(context.Background(), tc.oldAMCP, tc.amcp)
-			if tc.wantErr != "" {
-				g.Expect(err).To(HaveOccurred())
-				g.Expect(err.Error()).To(Equal(tc.wantErr))
-			} else {
-				g.Expect(err).NotTo(HaveOccurred())
-			}
-		})
-	}
-}
-
-func TestValidateAPIServerAccessProfile(t *testing.T) {
-	tests := []struct {
-		name      string
-		profile   *APIServerAccessProfile
-		expectErr bool
-	}{
 		{
-			name: "Testing valid PrivateDNSZone:System",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					PrivateDNSZone: ptr.To("System"),
+			name: "AzureManagedControlPlane AzureMonitorProfile is mutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &ManagedClusterAzureMonitorProfile{
+							Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+								Enabled: false,
+							},
+						},
+					},
 				},
 			},
-			expectErr: false,
-		},
-		{
-			name: "Testing valid PrivateDNSZone:None",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					PrivateDNSZone: ptr.To("None"),
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AzureMonitorProfile: &ManagedClusterAzureMonitorProfile{
+							Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+								Enabled: true,
+								KubeStateMetrics: &ManagedClusterAzureMonitorProfileKubeStateMetrics{
+									MetricLabelsAllowlist: ptr.To("pods=[app]"),
+								},
+							},
+						},
+					},
 				},
 			},
-			expectErr: false,
+			wantErr: "",
 		},
 		{
-			name: "Testing valid PrivateDNSZone:With privatelink region",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/privatelink.eastus.azmk8s.io"),
+			name: "AzureManagedControlPlane MetricsProfile is mutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						SKU: &AKSSku{
+							Tier: StandardManagedControlPlaneTier,
+						},
+					},
 				},
 			},
-			expectErr: false,
-		},
-		{
-			name: "Testing valid PrivateDNSZone:With private region",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/private.eastus.azmk8s.io"),
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						SKU: &AKSSku{
+							Tier: StandardManagedControlPlaneTier,
+						},
+						MetricsProfile: &ManagedClusterMetricsProfile{
+							CostAnalysis: &ManagedClusterCostAnalysis{
+								Enabled: true,
+							},
+						},
+					},
 				},
 			},
-			expectErr: false,
+			wantErr: "",
 		},
 		{
-			name: "Testing invalid EnablePrivateCluster and valid PrivateDNSZone",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(false),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/private.eastus.azmk8s.io"),
+			name: "AzureManagedControlPlane SecurityProfile.ImageIntegrity is mutable",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+					},
 				},
 			},
-			expectErr: true,
-		},
-		{
-			name: "Testing valid PrivateDNSZone:With privatelink region and sub-region",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "AzureManagedControlPlane SecurityProfile.ImageIntegrity can be disabled",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: false,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "",
+		},
+		{
+			name: "AzureManagedControlPlane SecurityProfile.ImageIntegrity requires the azurepolicy add-on",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "spec.securityProfile.imageIntegrity.enabled: Invalid value: true: Spec.SecurityProfile.ImageIntegrity cannot be enabled when the azurepolicy add-on is disabled",
+		},
+		{
+			name: "AzureManagedControlPlane SecurityProfile.ImageIntegrity requires EnablePreviewFeatures",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version: "v1.18.0",
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "spec.securityProfile.imageIntegrity: Forbidden: Spec.SecurityProfile.ImageIntegrity can be set only when Spec.EnablePreviewFeatures is true",
+		},
+		{
+			name: "AzureManagedControlPlane SecurityProfile.ImageIntegrity cannot be unset",
+			oldAMCP: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+						SecurityProfile: &ManagedClusterSecurityProfile{
+							ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			amcp: &AzureManagedControlPlane{
+				Spec: AzureManagedControlPlaneSpec{
+					AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+						Version:               "v1.18.0",
+						EnablePreviewFeatures: ptr.To(true),
+						AddonProfiles: []AddonProfile{
+							{
+								Name:    AzurePolicyAddonName,
+								Enabled: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "AzureManagedControlPlane.infrastructure.cluster.x-k8s.io \"\" is invalid: spec.securityProfile.imageIntegrity: Invalid value: \"null\": cannot unset Spec.SecurityProfile.ImageIntegrity, to disable imageIntegrity please set Spec.SecurityProfile.ImageIntegrity.Enabled to false",
+		},
+	}
+	client := mockClient{ReturnError: false}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mcpw := &azureManagedControlPlaneWebhook{
+				Client: client,
+			}
+			_, err := mcpw.ValidateUpdate(context.Background(), tc.oldAMCP, tc.amcp)
+			if tc.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(Equal(tc.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateAPIServerAccessProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *APIServerAccessProfile
+		expectErr bool
+	}{
+		{
+			name: "Testing valid PrivateDNSZone:System",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					PrivateDNSZone: ptr.To("System"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing valid PrivateDNSZone:None",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("None"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone:None without EnablePrivateCluster",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					PrivateDNSZone: ptr.To("None"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone:None with EnablePrivateCluster false",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(false),
+					PrivateDNSZone:       ptr.To("None"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing valid DisableRunCommand with PrivateDNSZone:None",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("None"),
+					DisableRunCommand:    ptr.To(true),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing valid PrivateDNSZone:With privatelink region",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/privatelink.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing valid PrivateDNSZone:With private region",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/private.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "Testing invalid EnablePrivateCluster and valid PrivateDNSZone",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(false),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/private.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing valid PrivateDNSZone:With privatelink region and sub-region",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
 					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/sublocation2.privatelink.eastus.azmk8s.io"),
 				},
 			},
@@ -3958,63 +4453,714 @@ func TestValidateAPIServerAccessProfile(t *testing.T) {
 			expectErr: false,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: privatelink region: len(sub-region) > 32 characters",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/thissublocationismorethan32characters.privatelink.eastus.azmk8s.io"),
-				},
-			},
-			expectErr: true,
+			name: "Testing invalid PrivateDNSZone: privatelink region: len(sub-region) > 32 characters",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/thissublocationismorethan32characters.privatelink.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone: private region: len(sub-region) > 32 characters",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/thissublocationismorethan32characters.private.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone: random string",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("WrongPrivateDNSZone"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone: subzone has an invalid char %",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone%1.privatelink.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone: subzone has an invalid char _",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone_1.privatelink.eastus.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Testing invalid PrivateDNSZone: region has invalid char",
+			profile: &APIServerAccessProfile{
+				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
+					EnablePrivateCluster: ptr.To(true),
+					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone1.privatelink.location@1.azmk8s.io"),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateAPIServerAccessProfile(tc.profile, field.NewPath("profile"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateIngressProfile(t *testing.T) {
+	dnsZoneID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/dnszones/example.com"
+	privateDNSZoneID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/example.internal"
+	tests := []struct {
+		name                  string
+		profile               *ManagedClusterIngressProfile
+		enablePreviewFeatures *bool
+		expectErr             bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "preview features disabled",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled: true,
+				},
+			},
+			enablePreviewFeatures: ptr.To(false),
+			expectErr:             true,
+		},
+		{
+			name: "valid public and private DNS zones",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{dnsZoneID, privateDNSZoneID},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+		{
+			name: "DNS zones with web app routing disabled",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            false,
+					DNSZoneResourceIDs: []string{dnsZoneID},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "duplicate DNS zone resource IDs",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{dnsZoneID, "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG1/providers/Microsoft.Network/dnszones/example.com"},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "invalid DNS zone resource ID",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{"example.com"},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "resource ID is not a DNS zone",
+			profile: &ManagedClusterIngressProfile{
+				WebAppRouting: &ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/virtualNetworks/vnet"},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateIngressProfile(tc.profile, tc.enablePreviewFeatures, field.NewPath("profile"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateMetricsProfile(t *testing.T) {
+	tests := []struct {
+		name                  string
+		profile               *ManagedClusterMetricsProfile
+		sku                   *AKSSku
+		enablePreviewFeatures *bool
+		expectErr             bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "preview features disabled",
+			profile: &ManagedClusterMetricsProfile{
+				CostAnalysis: &ManagedClusterCostAnalysis{
+					Enabled: true,
+				},
+			},
+			sku:                   &AKSSku{Tier: StandardManagedControlPlaneTier},
+			enablePreviewFeatures: ptr.To(false),
+			expectErr:             true,
+		},
+		{
+			name: "cost analysis enabled with Standard tier",
+			profile: &ManagedClusterMetricsProfile{
+				CostAnalysis: &ManagedClusterCostAnalysis{
+					Enabled: true,
+				},
+			},
+			sku:                   &AKSSku{Tier: StandardManagedControlPlaneTier},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+		{
+			name: "cost analysis enabled with Free tier",
+			profile: &ManagedClusterMetricsProfile{
+				CostAnalysis: &ManagedClusterCostAnalysis{
+					Enabled: true,
+				},
+			},
+			sku:                   &AKSSku{Tier: FreeManagedControlPlaneTier},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "cost analysis disabled with Free tier",
+			profile: &ManagedClusterMetricsProfile{
+				CostAnalysis: &ManagedClusterCostAnalysis{
+					Enabled: false,
+				},
+			},
+			sku:                   &AKSSku{Tier: FreeManagedControlPlaneTier},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateMetricsProfile(tc.profile, tc.sku, tc.enablePreviewFeatures, field.NewPath("profile"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateAzureMonitorProfile(t *testing.T) {
+	workspaceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/workspace"
+	tests := []struct {
+		name                  string
+		profile               *ManagedClusterAzureMonitorProfile
+		enablePreviewFeatures *bool
+		expectErr             bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "metrics only without preview features",
+			profile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+					Enabled: true,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "container insights without preview features",
+			profile: &ManagedClusterAzureMonitorProfile{
+				ContainerInsights: &ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled: true,
+				},
+			},
+			enablePreviewFeatures: ptr.To(false),
+			expectErr:             true,
+		},
+		{
+			name: "container insights with workspace",
+			profile: &ManagedClusterAzureMonitorProfile{
+				ContainerInsights: &ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled:                         true,
+					LogAnalyticsWorkspaceResourceID: ptr.To(workspaceID),
+					WindowsHostLogs: &ManagedClusterAzureMonitorProfileWindowsHostLogs{
+						Enabled: true,
+					},
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+		{
+			name: "workspace set while container insights is disabled",
+			profile: &ManagedClusterAzureMonitorProfile{
+				ContainerInsights: &ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled:                         false,
+					LogAnalyticsWorkspaceResourceID: ptr.To(workspaceID),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "invalid workspace resource ID",
+			profile: &ManagedClusterAzureMonitorProfile{
+				ContainerInsights: &ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled:                         true,
+					LogAnalyticsWorkspaceResourceID: ptr.To("not-a-resource-id"),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "workspace resource ID with wrong resource type",
+			profile: &ManagedClusterAzureMonitorProfile{
+				ContainerInsights: &ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled:                         true,
+					LogAnalyticsWorkspaceResourceID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/dnszones/example.com"),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateAzureMonitorProfile(tc.profile, tc.enablePreviewFeatures, field.NewPath("profile"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateKubeProxyConfig(t *testing.T) {
+	tests := []struct {
+		name                  string
+		config                *ManagedClusterKubeProxyConfig
+		enablePreviewFeatures *bool
+		expectErr             bool
+	}{
+		{
+			name:      "nil config",
+			config:    nil,
+			expectErr: false,
+		},
+		{
+			name: "preview features disabled",
+			config: &ManagedClusterKubeProxyConfig{
+				Mode: ptr.To(KubeProxyModeIPVS),
+			},
+			enablePreviewFeatures: ptr.To(false),
+			expectErr:             true,
+		},
+		{
+			name: "IPVS mode with scheduler and timeouts",
+			config: &ManagedClusterKubeProxyConfig{
+				Enabled: ptr.To(true),
+				Mode:    ptr.To(KubeProxyModeIPVS),
+				IPVSConfig: &ManagedClusterKubeProxyIPVSConfig{
+					Scheduler:            ptr.To(IPVSSchedulerLeastConnection),
+					TCPTimeoutSeconds:    ptr.To(900),
+					TCPFinTimeoutSeconds: ptr.To(120),
+					UDPTimeoutSeconds:    ptr.To(300),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+		{
+			name: "IPVS config with IPTABLES mode",
+			config: &ManagedClusterKubeProxyConfig{
+				Mode: ptr.To(KubeProxyModeIPTables),
+				IPVSConfig: &ManagedClusterKubeProxyIPVSConfig{
+					Scheduler: ptr.To(IPVSSchedulerRoundRobin),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "invalid mode",
+			config: &ManagedClusterKubeProxyConfig{
+				Mode: ptr.To(KubeProxyMode("nftables")),
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+		{
+			name: "invalid scheduler",
+			config: &ManagedClusterKubeProxyConfig{
+				Mode: ptr.To(KubeProxyModeIPVS),
+				IPVSConfig: &ManagedClusterKubeProxyIPVSConfig{
+					Scheduler: ptr.To(IPVSScheduler("SourceHashing")),
+				},
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateKubeProxyConfig(tc.config, tc.enablePreviewFeatures, field.NewPath("kubeProxyConfig"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateServiceMeshProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *ManagedClusterServiceMeshProfile
+		expectErr bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "Istio without revisions",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					ExternalIngressGateway: true,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "single revision",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-22"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "canary upgrade between adjacent revisions",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-22", "asm-1-21"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid revision format",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"1.22"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate revisions",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-22", "asm-1-22"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "canary upgrade skipping a minor version",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-20", "asm-1-22"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "more than two revisions",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-20", "asm-1-21", "asm-1-22"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "Istio without Istio configuration",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsupported mode",
+			profile: &ManagedClusterServiceMeshProfile{
+				Mode: ServiceMeshMode("Disabled"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty mode",
+			profile: &ManagedClusterServiceMeshProfile{
+				Istio: &ManagedClusterIstioServiceMesh{
+					Revisions: []string{"asm-1-22"},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateServiceMeshProfile(tc.profile, field.NewPath("serviceMeshProfile"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateSupportPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		supportPlan *KubernetesSupportPlan
+		sku         *AKSSku
+		expectErr   bool
+	}{
+		{
+			name:        "nil support plan",
+			supportPlan: nil,
+			expectErr:   false,
+		},
+		{
+			name:        "KubernetesOfficial with Free tier",
+			supportPlan: ptr.To(KubernetesSupportPlanKubernetesOfficial),
+			sku:         &AKSSku{Tier: FreeManagedControlPlaneTier},
+			expectErr:   false,
+		},
+		{
+			name:        "AKSLongTermSupport with Premium tier",
+			supportPlan: ptr.To(KubernetesSupportPlanAKSLongTermSupport),
+			sku:         &AKSSku{Tier: PremiumManagedControlPlaneTier},
+			expectErr:   false,
+		},
+		{
+			name:        "AKSLongTermSupport with Standard tier",
+			supportPlan: ptr.To(KubernetesSupportPlanAKSLongTermSupport),
+			sku:         &AKSSku{Tier: StandardManagedControlPlaneTier},
+			expectErr:   true,
+		},
+		{
+			name:        "AKSLongTermSupport without SKU",
+			supportPlan: ptr.To(KubernetesSupportPlanAKSLongTermSupport),
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateSupportPlan(tc.supportPlan, tc.sku, field.NewPath("supportPlan"))
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidatePowerStateUpdate(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldAMCP   *AzureManagedControlPlane
+		amcp      *AzureManagedControlPlane
+		expectErr bool
+	}{
+		{
+			name:      "unchanged power state",
+			oldAMCP:   createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			amcp:      createAzureManagedControlPlane("192.168.0.10", "v1.19.0", ""),
+			expectErr: false,
+		},
+		{
+			name:    "unset to Running is not a change",
+			oldAMCP: createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			amcp: func() *AzureManagedControlPlane {
+				amcp := createAzureManagedControlPlane("192.168.0.10", "v1.19.0", "")
+				amcp.Spec.PowerState = ptr.To(ManagedClusterPowerStateRunning)
+				return amcp
+			}(),
+			expectErr: false,
+		},
+		{
+			name:    "stopping the cluster",
+			oldAMCP: createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			amcp: func() *AzureManagedControlPlane {
+				amcp := createAzureManagedControlPlane("192.168.0.10", "v1.18.0", "")
+				amcp.Spec.PowerState = ptr.To(ManagedClusterPowerStateStopped)
+				return amcp
+			}(),
+			expectErr: false,
+		},
+		{
+			name: "starting the cluster",
+			oldAMCP: func() *AzureManagedControlPlane {
+				amcp := createAzureManagedControlPlane("192.168.0.10", "v1.18.0", "")
+				amcp.Spec.PowerState = ptr.To(ManagedClusterPowerStateStopped)
+				return amcp
+			}(),
+			amcp:      createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			expectErr: false,
+		},
+		{
+			name:    "stopping the cluster while changing other fields",
+			oldAMCP: createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			amcp: func() *AzureManagedControlPlane {
+				amcp := createAzureManagedControlPlane("192.168.0.10", "v1.19.0", "")
+				amcp.Spec.PowerState = ptr.To(ManagedClusterPowerStateStopped)
+				return amcp
+			}(),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := tc.amcp.validatePowerStateUpdate(tc.oldAMCP)
+			if tc.expectErr {
+				g.Expect(errs).To(HaveLen(1))
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateServiceMeshProfileUpdate(t *testing.T) {
+	withRevisions := func(revisions ...string) *AzureManagedControlPlane {
+		amcp := createAzureManagedControlPlane("192.168.0.10", "v1.18.0", "")
+		amcp.Spec.ServiceMeshProfile = &ManagedClusterServiceMeshProfile{
+			Mode: ServiceMeshModeIstio,
+			Istio: &ManagedClusterIstioServiceMesh{
+				Revisions: revisions,
+			},
+		}
+		return amcp
+	}
+	tests := []struct {
+		name      string
+		oldAMCP   *AzureManagedControlPlane
+		amcp      *AzureManagedControlPlane
+		expectErr bool
+	}{
+		{
+			name:      "enabling the service mesh",
+			oldAMCP:   createAzureManagedControlPlane("192.168.0.10", "v1.18.0", ""),
+			amcp:      withRevisions("asm-1-22"),
+			expectErr: false,
+		},
+		{
+			name:      "unchanged revision",
+			oldAMCP:   withRevisions("asm-1-22"),
+			amcp:      withRevisions("asm-1-22"),
+			expectErr: false,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: private region: len(sub-region) > 32 characters",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/thissublocationismorethan32characters.private.eastus.azmk8s.io"),
-				},
-			},
-			expectErr: true,
+			name:      "starting a canary upgrade",
+			oldAMCP:   withRevisions("asm-1-21"),
+			amcp:      withRevisions("asm-1-21", "asm-1-22"),
+			expectErr: false,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: random string",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("WrongPrivateDNSZone"),
-				},
-			},
-			expectErr: true,
+			name:      "completing a canary upgrade",
+			oldAMCP:   withRevisions("asm-1-21", "asm-1-22"),
+			amcp:      withRevisions("asm-1-22"),
+			expectErr: false,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: subzone has an invalid char %",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone%1.privatelink.eastus.azmk8s.io"),
-				},
-			},
-			expectErr: true,
+			name:      "rolling back a canary upgrade",
+			oldAMCP:   withRevisions("asm-1-21", "asm-1-22"),
+			amcp:      withRevisions("asm-1-21"),
+			expectErr: false,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: subzone has an invalid char _",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone_1.privatelink.eastus.azmk8s.io"),
-				},
-			},
+			name:      "replacing the revision in place",
+			oldAMCP:   withRevisions("asm-1-21"),
+			amcp:      withRevisions("asm-1-22"),
 			expectErr: true,
 		},
 		{
-			name: "Testing invalid PrivateDNSZone: region has invalid char",
-			profile: &APIServerAccessProfile{
-				APIServerAccessProfileClassSpec: APIServerAccessProfileClassSpec{
-					EnablePrivateCluster: ptr.To(true),
-					PrivateDNSZone:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg1/providers/Microsoft.Network/privateDnsZones/subzone1.privatelink.location@1.azmk8s.io"),
-				},
-			},
+			name:      "replacing a revision during a canary upgrade",
+			oldAMCP:   withRevisions("asm-1-21", "asm-1-22"),
+			amcp:      withRevisions("asm-1-22", "asm-1-23"),
 			expectErr: true,
 		},
 	}
@@ -4022,7 +5168,7 @@ func TestValidateAPIServerAccessProfile(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
-			errs := validateAPIServerAccessProfile(tc.profile, field.NewPath("profile"))
+			errs := tc.amcp.validateServiceMeshProfileUpdate(tc.oldAMCP)
 			if tc.expectErr {
 				g.Expect(errs).To(HaveLen(1))
 			} else {
@@ -4173,3 +5319,402 @@ func TestValidateAMCPVirtualNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSystemNodePool(t *testing.T) {
+	tests := []struct {
+		name      string
+		pool      *ManagedControlPlaneSystemNodePool
+		expectErr bool
+	}{
+		{
+			name:      "nil pool",
+			pool:      nil,
+			expectErr: false,
+		},
+		{
+			name: "valid system pool",
+			pool: &ManagedControlPlaneSystemNodePool{
+				Name:  "system",
+				Mode:  NodePoolModeSystem,
+				SKU:   "Standard_D4s_v3",
+				Count: ptr.To(3),
+			},
+			expectErr: false,
+		},
+		{
+			name: "user mode pool",
+			pool: &ManagedControlPlaneSystemNodePool{
+				Name: "system",
+				Mode: NodePoolModeUser,
+				SKU:  "Standard_D4s_v3",
+			},
+			expectErr: true,
+		},
+		{
+			name: "missing sku",
+			pool: &ManagedControlPlaneSystemNodePool{
+				Name: "system",
+				Mode: NodePoolModeSystem,
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid name",
+			pool: &ManagedControlPlaneSystemNodePool{
+				Name: "System-Pool",
+				Mode: NodePoolModeSystem,
+				SKU:  "Standard_D4s_v3",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateSystemNodePool(tc.pool, field.NewPath("spec").Child("systemNodePool"))
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateSystemNodePoolUpdate(t *testing.T) {
+	pool := func(mutate func(*ManagedControlPlaneSystemNodePool)) *ManagedControlPlaneSystemNodePool {
+		p := &ManagedControlPlaneSystemNodePool{
+			Name:  "system",
+			Mode:  NodePoolModeSystem,
+			SKU:   "Standard_D4s_v3",
+			Count: ptr.To(3),
+		}
+		if mutate != nil {
+			mutate(p)
+		}
+		return p
+	}
+	tests := []struct {
+		name      string
+		old       *ManagedControlPlaneSystemNodePool
+		pool      *ManagedControlPlaneSystemNodePool
+		expectErr bool
+	}{
+		{
+			name:      "unchanged",
+			old:       pool(nil),
+			pool:      pool(nil),
+			expectErr: false,
+		},
+		{
+			name:      "scaled",
+			old:       pool(nil),
+			pool:      pool(func(p *ManagedControlPlaneSystemNodePool) { p.Count = ptr.To(5) }),
+			expectErr: false,
+		},
+		{
+			name:      "added",
+			old:       nil,
+			pool:      pool(nil),
+			expectErr: true,
+		},
+		{
+			name:      "removed",
+			old:       pool(nil),
+			pool:      nil,
+			expectErr: true,
+		},
+		{
+			name:      "renamed",
+			old:       pool(nil),
+			pool:      pool(func(p *ManagedControlPlaneSystemNodePool) { p.Name = "system2" }),
+			expectErr: true,
+		},
+		{
+			name:      "sku changed",
+			old:       pool(nil),
+			pool:      pool(func(p *ManagedControlPlaneSystemNodePool) { p.SKU = "Standard_D8s_v3" }),
+			expectErr: true,
+		},
+		{
+			name:      "os disk size changed",
+			old:       pool(nil),
+			pool:      pool(func(p *ManagedControlPlaneSystemNodePool) { p.OSDiskSizeGB = ptr.To(256) }),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateSystemNodePoolUpdate(tc.old, tc.pool, field.NewPath("spec").Child("systemNodePool"))
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateCIDRBlockFamilies(t *testing.T) {
+	tests := []struct {
+		name       string
+		cidrBlocks []string
+		ipFamilies []IPFamily
+		expectErr  bool
+	}{
+		{
+			name:       "single CIDR block without IP families",
+			cidrBlocks: []string{"10.0.0.0/16"},
+		},
+		{
+			name:       "multiple CIDR blocks without IP families",
+			cidrBlocks: []string{"10.0.0.0/16", "fd12:3456:789a::/108"},
+			expectErr:  true,
+		},
+		{
+			name:       "dual-stack CIDR blocks",
+			cidrBlocks: []string{"10.0.0.0/16", "fd12:3456:789a::/108"},
+			ipFamilies: []IPFamily{IPFamilyIPv4, IPFamilyIPv6},
+		},
+		{
+			name:       "dual-stack CIDR blocks with IPv6 first",
+			cidrBlocks: []string{"fd12:3456:789a::/108", "10.0.0.0/16"},
+			ipFamilies: []IPFamily{IPFamilyIPv6, IPFamilyIPv4},
+		},
+		{
+			name:       "CIDR blocks in the wrong order",
+			cidrBlocks: []string{"fd12:3456:789a::/108", "10.0.0.0/16"},
+			ipFamilies: []IPFamily{IPFamilyIPv4, IPFamilyIPv6},
+			expectErr:  true,
+		},
+		{
+			name:       "missing CIDR block of an IP family",
+			cidrBlocks: []string{"10.0.0.0/16"},
+			ipFamilies: []IPFamily{IPFamilyIPv4, IPFamilyIPv6},
+			expectErr:  true,
+		},
+		{
+			name:       "invalid CIDR block",
+			cidrBlocks: []string{"10.0.0.0"},
+			ipFamilies: []IPFamily{IPFamilyIPv4},
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateCIDRBlockFamilies(tc.cidrBlocks, tc.ipFamilies, field.NewPath("Cluster", "spec", "clusterNetwork", "services", "cidrBlocks"))
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateIPFamilies(t *testing.T) {
+	g := NewWithT(t)
+	g.Expect(validateIPFamilies([]IPFamily{IPFamilyIPv4, IPFamilyIPv6}, field.NewPath("spec").Child("ipFamilies"))).To(BeEmpty())
+	g.Expect(validateIPFamilies([]IPFamily{IPFamilyIPv6, IPFamilyIPv6}, field.NewPath("spec").Child("ipFamilies"))).NotTo(BeEmpty())
+}
+
+func TestValidateAADPodIdentityProfile(t *testing.T) {
+	validIdentity := ManagedClusterAADPodIdentity{
+		Name:      "my-identity",
+		Namespace: "default",
+		Identity: ManagedClusterAADPodIdentityUserAssignedIdentity{
+			ResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+			ClientID:   "00000000-0000-0000-0000-000000000001",
+			ObjectID:   "00000000-0000-0000-0000-000000000002",
+		},
+	}
+
+	tests := []struct {
+		name          string
+		profile       *ManagedClusterAADPodIdentityProfile
+		networkPlugin *string
+		expectErr     bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "enabled with identities and exceptions",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled:                true,
+				UserAssignedIdentities: []ManagedClusterAADPodIdentity{validIdentity},
+				UserAssignedIdentityExceptions: []ManagedClusterAADPodIdentityException{
+					{
+						Name:      "my-exception",
+						Namespace: "kube-system",
+						PodLabels: map[string]string{"app": "my-app"},
+					},
+				},
+			},
+			networkPlugin: ptr.To("azure"),
+			expectErr:     false,
+		},
+		{
+			name: "kubenet without allowNetworkPluginKubenet",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled: true,
+			},
+			networkPlugin: ptr.To("kubenet"),
+			expectErr:     true,
+		},
+		{
+			name: "kubenet with allowNetworkPluginKubenet",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled:                   true,
+				AllowNetworkPluginKubenet: true,
+			},
+			networkPlugin: ptr.To("kubenet"),
+			expectErr:     false,
+		},
+		{
+			name: "identities set while disabled",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled:                false,
+				UserAssignedIdentities: []ManagedClusterAADPodIdentity{validIdentity},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate identities",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled:                true,
+				UserAssignedIdentities: []ManagedClusterAADPodIdentity{validIdentity, validIdentity},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid identity client ID",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled: true,
+				UserAssignedIdentities: []ManagedClusterAADPodIdentity{
+					func() ManagedClusterAADPodIdentity {
+						identity := validIdentity
+						identity.Identity.ClientID = "not-a-uuid"
+						return identity
+					}(),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid identity resource ID",
+			profile: &ManagedClusterAADPodIdentityProfile{
+				Enabled: true,
+				UserAssignedIdentities: []ManagedClusterAADPodIdentity{
+					func() ManagedClusterAADPodIdentity {
+						identity := validIdentity
+						identity.Identity.ResourceID = "my-identity"
+						return identity
+					}(),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateAADPodIdentityProfile(tc.profile, tc.networkPlugin, field.NewPath("spec").Child("aadPodIdentityProfile"))
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateUpgradeChannels(t *testing.T) {
+	tests := []struct {
+		name                  string
+		profile               *ManagedClusterAutoUpgradeProfile
+		enablePreviewFeatures *bool
+		expectErr             bool
+	}{
+		{
+			name:      "nil profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "node-image upgrade channel without node OS upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel: ptr.To(UpgradeChannelNodeImage),
+			},
+			expectErr: false,
+		},
+		{
+			name: "node-image upgrade channel with NodeImage node OS upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(UpgradeChannelNodeImage),
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelNodeImage),
+			},
+			expectErr: false,
+		},
+		{
+			name: "node-image upgrade channel with SecurityPatch node OS upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(UpgradeChannelNodeImage),
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelSecurityPatch),
+			},
+			expectErr: true,
+		},
+		{
+			name: "node-image upgrade channel with None node OS upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(UpgradeChannelNodeImage),
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelNone),
+			},
+			expectErr: true,
+		},
+		{
+			name: "patch upgrade channel with SecurityPatch node OS upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(UpgradeChannelPatch),
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelSecurityPatch),
+			},
+			enablePreviewFeatures: ptr.To(true),
+			expectErr:             false,
+		},
+		{
+			name: "SecurityPatch node OS upgrade channel without preview features",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(UpgradeChannelPatch),
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelSecurityPatch),
+			},
+			expectErr: true,
+		},
+		{
+			name: "node OS upgrade channel without upgrade channel",
+			profile: &ManagedClusterAutoUpgradeProfile{
+				NodeOSUpgradeChannel: ptr.To(NodeOSUpgradeChannelUnmanaged),
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			errs := validateUpgradeChannels(tc.profile, tc.enablePreviewFeatures, field.NewPath("spec").Child("autoUpgradeProfile"))
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
@@ -110,6 +110,13 @@ func (mcpw *azureManagedControlPlaneTemplateWebhook) ValidateUpdate(_ context.Co
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "template", "spec", "ipFamilies"),
+		old.Spec.Template.Spec.IPFamilies,
+		mcp.Spec.Template.Spec.IPFamilies); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "networkPlugin"),
 		old.Spec.Template.Spec.NetworkPlugin,
@@ -207,22 +214,46 @@ func (mcp *AzureManagedControlPlaneTemplate) validateManagedControlPlaneTemplate
 		mcp.Spec.Template.Spec.LoadBalancerProfile,
 		field.NewPath("spec").Child("template").Child("spec").Child("loadBalancerProfile"))...)
 
+	allErrs = append(allErrs, validateNATGatewayProfile(
+		mcp.Spec.Template.Spec.NATGatewayProfile,
+		mcp.Spec.Template.Spec.OutboundType,
+		field.NewPath("spec").Child("template").Child("spec").Child("natGatewayProfile"))...)
+
 	allErrs = append(allErrs, validateManagedClusterNetwork(
 		cli,
 		mcp.Labels,
 		mcp.Namespace,
 		mcp.Spec.Template.Spec.DNSServiceIP,
+		mcp.Spec.Template.Spec.IPFamilies,
 		mcp.Spec.Template.Spec.VirtualNetwork.Subnet,
 		field.NewPath("spec").Child("template").Child("spec"))...)
 
+	allErrs = append(allErrs, validateIPFamilies(mcp.Spec.Template.Spec.IPFamilies, field.NewPath("spec").Child("template").Child("spec").Child("ipFamilies"))...)
+
 	allErrs = append(allErrs, validateName(mcp.Name, field.NewPath("name"))...)
 
-	allErrs = append(allErrs, validateAutoScalerProfile(mcp.Spec.Template.Spec.AutoScalerProfile, field.NewPath("spec").Child("template").Child("spec").Child("autoScalerProfile"))...)
+	allErrs = append(allErrs, validateAutoScalerProfile(mcp.Spec.Template.Spec.AutoScalerProfile, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("autoScalerProfile"))...)
 
 	allErrs = append(allErrs, validateAKSExtensions(mcp.Spec.Template.Spec.Extensions, field.NewPath("spec").Child("extensions"))...)
 
 	allErrs = append(allErrs, mcp.Spec.Template.Spec.AzureManagedControlPlaneClassSpec.validateSecurityProfile()...)
 
+	allErrs = append(allErrs, validateIngressProfile(mcp.Spec.Template.Spec.IngressProfile, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("ingressProfile"))...)
+
+	allErrs = append(allErrs, validateMetricsProfile(mcp.Spec.Template.Spec.MetricsProfile, mcp.Spec.Template.Spec.SKU, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("metricsProfile"))...)
+
+	allErrs = append(allErrs, validateAzureMonitorProfile(mcp.Spec.Template.Spec.AzureMonitorProfile, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("azureMonitorProfile"))...)
+
+	allErrs = append(allErrs, validateKubeProxyConfig(mcp.Spec.Template.Spec.KubeProxyConfig, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("kubeProxyConfig"))...)
+
+	allErrs = append(allErrs, validateServiceMeshProfile(mcp.Spec.Template.Spec.ServiceMeshProfile, field.NewPath("spec").Child("template").Child("spec").Child("serviceMeshProfile"))...)
+
+	allErrs = append(allErrs, validateAADPodIdentityProfile(mcp.Spec.Template.Spec.AADPodIdentityProfile, mcp.Spec.Template.Spec.NetworkPlugin, field.NewPath("spec").Child("template").Child("spec").Child("aadPodIdentityProfile"))...)
+
+	allErrs = append(allErrs, validateUpgradeChannels(mcp.Spec.Template.Spec.AutoUpgradeProfile, mcp.Spec.Template.Spec.EnablePreviewFeatures, field.NewPath("spec").Child("template").Child("spec").Child("autoUpgradeProfile"))...)
+
+	allErrs = append(allErrs, validateSystemNodePool(mcp.Spec.Template.Spec.SystemNodePool, field.NewPath("spec").Child("template").Child("spec").Child("systemNodePool"))...)
+
 	allErrs = append(allErrs, validateNetworkPolicy(mcp.Spec.Template.Spec.NetworkPolicy, mcp.Spec.Template.Spec.NetworkDataplane, field.NewPath("spec").Child("template").Child("spec").Child("networkPolicy"))...)
 
 	allErrs = append(allErrs, validateNetworkDataplane(mcp.Spec.Template.Spec.NetworkDataplane, mcp.Spec.Template.Spec.NetworkPolicy, mcp.Spec.Template.Spec.NetworkPluginMode, field.NewPath("spec").Child("template").Child("spec").Child("networkDataplane"))...)
@@ -39,6 +39,20 @@ const (
 // NodePoolMode enumerates the values for agent pool mode.
 type NodePoolMode string
 
+// OSSKU enumerates the values for the agent pool's OS SKU.
+type OSSKU string
+
+const (
+	// OSSKUUbuntu ...
+	OSSKUUbuntu OSSKU = "Ubuntu"
+	// OSSKUAzureLinux ...
+	OSSKUAzureLinux OSSKU = "AzureLinux"
+	// OSSKUWindows2019 ...
+	OSSKUWindows2019 OSSKU = "Windows2019"
+	// OSSKUWindows2022 ...
+	OSSKUWindows2022 OSSKU = "Windows2022"
+)
+
 // CPUManagerPolicy enumerates the values for KubeletConfig.CPUManagerPolicy.
 type CPUManagerPolicy string
 
@@ -62,6 +76,22 @@ const (
 	KubeletDiskTypeTemporary KubeletDiskType = "Temporary"
 )
 
+// GPUInstanceProfile enumerates the values for the agent pool's GPU instance profile.
+type GPUInstanceProfile string
+
+const (
+	// GPUInstanceProfileMIG1g ...
+	GPUInstanceProfileMIG1g GPUInstanceProfile = "MIG1g"
+	// GPUInstanceProfileMIG2g ...
+	GPUInstanceProfileMIG2g GPUInstanceProfile = "MIG2g"
+	// GPUInstanceProfileMIG3g ...
+	GPUInstanceProfileMIG3g GPUInstanceProfile = "MIG3g"
+	// GPUInstanceProfileMIG4g ...
+	GPUInstanceProfileMIG4g GPUInstanceProfile = "MIG4g"
+	// GPUInstanceProfileMIG7g ...
+	GPUInstanceProfileMIG7g GPUInstanceProfile = "MIG7g"
+)
+
 const (
 	// TopologyManagerPolicyNone ...
 	TopologyManagerPolicyNone TopologyManagerPolicy = "none"
@@ -380,6 +410,31 @@ type SysctlConfig struct {
 	VMVfsCachePressure *int `json:"vmVfsCachePressure,omitempty"`
 }
 
+// AgentPoolUpgradeSettings specifies the upgrade settings of an agent pool.
+type AgentPoolUpgradeSettings struct {
+	// MaxSurge is the maximum number of nodes, e.g. "5", or percentage of the node pool size, e.g. "33%",
+	// that are added to the node pool during an upgrade. AKS defaults it to 10%.
+	// +optional
+	MaxSurge *string `json:"maxSurge,omitempty"`
+
+	// DrainTimeoutInMinutes is the time in minutes to wait for the eviction of pods and their graceful termination
+	// on each node before the upgrade fails. AKS defaults it to 30 minutes.
+	// Valid values are 1-1440 (inclusive).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1440
+	// +optional
+	DrainTimeoutInMinutes *int `json:"drainTimeoutInMinutes,omitempty"`
+
+	// NodeSoakDurationInMinutes is the time in minutes to wait after draining a node and before reimaging it
+	// and moving on to the next node. AKS defaults it to 0 minutes.
+	// Valid values are 0-30 (inclusive).
+	// Requires the AzureManagedControlPlane's `spec.enablePreviewFeatures` to be true.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	NodeSoakDurationInMinutes *int `json:"nodeSoakDurationInMinutes,omitempty"`
+}
+
 // LinuxOSConfig specifies the custom Linux OS settings and configurations.
 // See also [AKS doc].
 //
@@ -28,6 +28,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -44,6 +45,9 @@ import (
 
 var validNodePublicPrefixID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.network/publicipprefixes/[^/]+$`)
 
+// migSupportedSKU matches the VM sizes with NVIDIA A100 or H100 GPUs, which support Multi-Instance GPU.
+var migSupportedSKU = regexp.MustCompile(`(?i)(A100|H100|^Standard_ND96asr_v4$)`)
+
 // SetupAzureManagedMachinePoolWebhookWithManager sets up and registers the webhook with the manager.
 func SetupAzureManagedMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
 	mw := &azureManagedMachinePoolWebhook{Client: mgr.GetClient()}
@@ -111,6 +115,16 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(_ context.Context, obj
 		m.Spec.OSType,
 		field.NewPath("spec", "osType")))
 
+	errs = append(errs, validateOSSKU(
+		m.Spec.AzureManagedMachinePoolClassSpec,
+		field.NewPath("spec")).ToAggregate())
+
+	errs = append(errs, validateEnableArtifactStreaming(
+		m.Spec.EnableArtifactStreaming,
+		m.Spec.OSSKU,
+		m.Spec.OSType,
+		field.NewPath("spec", "enableArtifactStreaming")).ToAggregate())
+
 	errs = append(errs, validateMPName(
 		m.Name,
 		m.Spec.Name,
@@ -121,6 +135,10 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(_ context.Context, obj
 		m.Spec.NodeLabels,
 		field.NewPath("spec", "nodeLabels")))
 
+	errs = append(errs, validateNodeInitializationTaints(
+		m.Spec.NodeInitializationTaints,
+		field.NewPath("spec", "nodeInitializationTaints")).ToAggregate())
+
 	errs = append(errs, validateNodePublicIPPrefixID(
 		m.Spec.NodePublicIPPrefixID,
 		field.NewPath("spec", "nodePublicIPPrefixID")))
@@ -143,6 +161,34 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(_ context.Context, obj
 		m.Spec.SubnetName,
 		field.NewPath("spec", "subnetName")))
 
+	errs = append(errs, validateMPSubnetName(
+		m.Spec.PodSubnetName,
+		field.NewPath("spec", "podSubnetName")))
+
+	errs = append(errs, validatePodSubnet(
+		mw.Client,
+		m,
+		field.NewPath("spec", "podSubnetName")))
+
+	errs = append(errs, validateSystemNodePoolName(
+		mw.Client,
+		m,
+		field.NewPath("spec", "name")))
+
+	errs = append(errs, validateGPUInstanceProfile(
+		m.Spec.GPUInstanceProfile,
+		m.Spec.SKU,
+		field.NewPath("spec", "gpuInstanceProfile")))
+
+	errs = append(errs, validatePreviewFields(
+		mw.Client,
+		m,
+		field.NewPath("spec")).ToAggregate())
+
+	errs = append(errs, validateUpgradeSettings(
+		m.Spec.UpgradeSettings,
+		field.NewPath("spec", "upgradeSettings")).ToAggregate())
+
 	return nil, kerrors.NewAggregate(errs)
 }
 
@@ -173,6 +219,14 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(_ context.Context, oldO
 				err.Error()))
 	}
 
+	allErrs = append(allErrs, validateNodeInitializationTaints(m.Spec.NodeInitializationTaints, field.NewPath("spec", "nodeInitializationTaints"))...)
+
+	allErrs = append(allErrs, validateEnableArtifactStreaming(m.Spec.EnableArtifactStreaming, m.Spec.OSSKU, m.Spec.OSType, field.NewPath("spec", "enableArtifactStreaming"))...)
+
+	allErrs = append(allErrs, validateUpgradeSettings(m.Spec.UpgradeSettings, field.NewPath("spec", "upgradeSettings"))...)
+
+	allErrs = append(allErrs, validatePreviewFields(mw.Client, m, field.NewPath("spec"))...)
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "osType"),
 		old.Spec.OSType,
@@ -180,6 +234,13 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(_ context.Context, oldO
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "osSKU"),
+		old.Spec.OSSKU,
+		m.Spec.OSSKU); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "sku"),
 		old.Spec.SKU,
@@ -201,6 +262,13 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(_ context.Context, oldO
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "podSubnetName"),
+		old.Spec.PodSubnetName,
+		m.Spec.PodSubnetName); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "enableFIPS"),
 		old.Spec.EnableFIPS,
@@ -293,6 +361,13 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(_ context.Context, oldO
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "gpuInstanceProfile"),
+		old.Spec.GPUInstanceProfile,
+		m.Spec.GPUInstanceProfile); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) != 0 {
 		return nil, apierrors.NewInvalid(GroupVersion.WithKind(AzureManagedMachinePoolKind).GroupKind(), m.Name, allErrs)
 	}
@@ -385,6 +460,59 @@ func validateOSType(mode string, osType *string, fldPath *field.Path) error {
 	return nil
 }
 
+// validateOSSKU ensures the OS SKU matches the OSType of the agent pool and isn't combined with options the
+// OS SKU doesn't support.
+func validateOSSKU(spec AzureManagedMachinePoolClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.OSSKU == nil {
+		return allErrs
+	}
+	osSKU := *spec.OSSKU
+	// OSType is defaulted to Linux by the mutating webhook.
+	requiredOSType := LinuxOS
+	switch osSKU {
+	case OSSKUWindows2019, OSSKUWindows2022:
+		requiredOSType = WindowsOS
+	}
+	if ptr.Deref(spec.OSType, LinuxOS) != requiredOSType {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("osSKU"),
+			osSKU,
+			fmt.Sprintf("OSSKU %s requires OSType '%s'", osSKU, requiredOSType)))
+	}
+
+	if osSKU == OSSKUAzureLinux {
+		if spec.GPUInstanceProfile != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Child("gpuInstanceProfile"),
+				fmt.Sprintf("GPU instance profiles are not supported for OSSKU %s", osSKU)))
+		}
+		if spec.LinuxOSConfig != nil && spec.LinuxOSConfig.SwapFileSizeMB != nil {
+			allErrs = append(allErrs, field.Forbidden(
+				fldPath.Child("linuxOSConfig", "swapFileSizeMB"),
+				fmt.Sprintf("swap files are not supported for OSSKU %s", osSKU)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateEnableArtifactStreaming ensures artifact streaming is only enabled for OS SKUs which support it.
+func validateEnableArtifactStreaming(enableArtifactStreaming *bool, osSKU *OSSKU, osType *string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if !ptr.Deref(enableArtifactStreaming, false) {
+		return allErrs
+	}
+	// OSType is defaulted to Linux by the mutating webhook.
+	if ptr.Deref(osType, LinuxOS) != LinuxOS {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "artifact streaming is only supported for OSType 'Linux'"))
+	} else if osSKU != nil && *osSKU != OSSKUUbuntu && *osSKU != OSSKUAzureLinux {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("artifact streaming is not supported for OSSKU %s", *osSKU)))
+	}
+
+	return allErrs
+}
+
 func validateMPName(mpName string, specName *string, osType *string, fldPath *field.Path) error {
 	var name *string
 	var fieldNameMessage string
@@ -455,6 +583,32 @@ func validateNodeLabels(nodeLabels map[string]string, fldPath *field.Path) error
 	return nil
 }
 
+// validateNodeInitializationTaints ensures each taint is in the format "key=value:Effect", where the value is optional.
+func validateNodeInitializationTaints(taints []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, taint := range taints {
+		keyValue, effect, found := strings.Cut(taint, ":")
+		if !found {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), taint, `taint must be in the format "key=value:Effect"`))
+			continue
+		}
+		switch effect {
+		case "NoSchedule", "PreferNoSchedule", "NoExecute":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), effect, []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}))
+		}
+		key, value, _ := strings.Cut(keyValue, "=")
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), taint, fmt.Sprintf("invalid taint key: %s", strings.Join(errs, "; "))))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), taint, fmt.Sprintf("invalid taint value: %s", strings.Join(errs, "; "))))
+		}
+	}
+
+	return allErrs
+}
+
 func validateNodePublicIPPrefixID(nodePublicIPPrefixID *string, fldPath *field.Path) error {
 	if nodePublicIPPrefixID != nil && !validNodePublicPrefixID.MatchString(*nodePublicIPPrefixID) {
 		return field.Invalid(
@@ -488,6 +642,146 @@ func validateMPSubnetName(subnetName *string, fldPath *field.Path) error {
 	return nil
 }
 
+// validatePodSubnet validates the pod subnet of an AzureManagedMachinePool against its node subnet. When spec.subnetName
+// is not set, the node subnet defaults to the subnet of the AzureManagedControlPlane's virtual network, which the pod
+// subnet is then compared to. The pod subnet ID is always built from the AzureManagedControlPlane's virtual network.
+func validatePodSubnet(cli client.Client, m *AzureManagedMachinePool, fldPath *field.Path) error {
+	if m.Spec.PodSubnetName == nil {
+		return nil
+	}
+
+	subnetName := m.Spec.SubnetName
+	if subnetName == nil {
+		controlPlane, err := getOwnerAzureManagedControlPlane(cli, m.Labels, m.Namespace)
+		if err != nil {
+			return err
+		}
+		if controlPlane == nil {
+			return nil
+		}
+		subnetName = ptr.To(controlPlane.Spec.VirtualNetwork.Subnet.Name)
+	}
+
+	return validatePodSubnetName(m.Spec.PodSubnetName, subnetName, fldPath)
+}
+
+// validateSystemNodePoolName validates that the name of an AzureManagedMachinePool does not collide with the name of
+// the system node pool declared on its AzureManagedControlPlane.
+func validateSystemNodePoolName(cli client.Client, m *AzureManagedMachinePool, fldPath *field.Path) error {
+	controlPlane, err := getOwnerAzureManagedControlPlane(cli, m.Labels, m.Namespace)
+	if err != nil {
+		return err
+	}
+	if controlPlane == nil || controlPlane.Spec.SystemNodePool == nil {
+		return nil
+	}
+
+	name := ptr.Deref(m.Spec.Name, m.Name)
+	if name == controlPlane.Spec.SystemNodePool.Name {
+		return field.Invalid(fldPath, name, "must not be the name of the system node pool declared on the AzureManagedControlPlane")
+	}
+	return nil
+}
+
+// validatePreviewFields forbids the fields of an AzureManagedMachinePool which are only applied with the preview AKS
+// API unless its AzureManagedControlPlane enables preview features, as they would otherwise be silently ignored.
+func validatePreviewFields(cli client.Client, m *AzureManagedMachinePool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	var previewFields []*field.Path
+	if len(m.Spec.NodeInitializationTaints) > 0 {
+		previewFields = append(previewFields, fldPath.Child("nodeInitializationTaints"))
+	}
+	if m.Spec.EnableArtifactStreaming != nil {
+		previewFields = append(previewFields, fldPath.Child("enableArtifactStreaming"))
+	}
+	if m.Spec.UpgradeSettings != nil && m.Spec.UpgradeSettings.NodeSoakDurationInMinutes != nil {
+		previewFields = append(previewFields, fldPath.Child("upgradeSettings", "nodeSoakDurationInMinutes"))
+	}
+	if len(previewFields) == 0 {
+		return allErrs
+	}
+
+	controlPlane, err := getOwnerAzureManagedControlPlane(cli, m.Labels, m.Namespace)
+	if err != nil {
+		return append(allErrs, field.InternalError(fldPath, err))
+	}
+	if controlPlane == nil || ptr.Deref(controlPlane.Spec.EnablePreviewFeatures, false) {
+		return allErrs
+	}
+
+	for _, previewField := range previewFields {
+		allErrs = append(allErrs, field.Forbidden(previewField, "can be set only when the AzureManagedControlPlane sets EnablePreviewFeatures to true"))
+	}
+	return allErrs
+}
+
+// getOwnerAzureManagedControlPlane returns the AzureManagedControlPlane of the Cluster an AzureManagedMachinePool
+// belongs to, or nil if the Cluster or its AzureManagedControlPlane cannot be found yet.
+func getOwnerAzureManagedControlPlane(cli client.Client, labels map[string]string, namespace string) (*AzureManagedControlPlane, error) {
+	clusterName, ok := labels[clusterv1.ClusterNameLabel]
+	if !ok || cli == nil {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	ownerCluster := &clusterv1.Cluster{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: clusterName}, ownerCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ref := ownerCluster.Spec.ControlPlaneRef
+	if ref == nil || ref.Kind != AzureManagedControlPlaneKind {
+		return nil, nil
+	}
+
+	controlPlane := &AzureManagedControlPlane{}
+	if err := cli.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, controlPlane); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return controlPlane, nil
+}
+
+// validatePodSubnetName validates that the pod subnet is not the same subnet as the one used for nodes.
+func validatePodSubnetName(podSubnetName *string, subnetName *string, fldPath *field.Path) error {
+	if podSubnetName != nil && subnetName != nil && *podSubnetName == *subnetName {
+		return field.Invalid(fldPath, podSubnetName, "must be different from the node subnet")
+	}
+	return nil
+}
+
+// validateGPUInstanceProfile ensures a GPU instance profile is only set for VM sizes which support Multi-Instance GPU.
+func validateGPUInstanceProfile(gpuInstanceProfile *GPUInstanceProfile, sku string, fldPath *field.Path) error {
+	if gpuInstanceProfile != nil && !migSupportedSKU.MatchString(sku) {
+		return field.Invalid(
+			fldPath,
+			*gpuInstanceProfile,
+			fmt.Sprintf("GPU instance profiles are not supported for SKU %s, which does not have NVIDIA A100 or H100 GPUs", sku))
+	}
+	return nil
+}
+
+// validateUpgradeSettings ensures maxSurge is either a positive number of nodes or a percentage between 1% and 100%.
+func validateUpgradeSettings(upgradeSettings *AgentPoolUpgradeSettings, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if upgradeSettings == nil || upgradeSettings.MaxSurge == nil {
+		return allErrs
+	}
+	maxSurge := *upgradeSettings.MaxSurge
+	value, isPercentage := strings.CutSuffix(maxSurge, "%")
+	surge, err := strconv.Atoi(value)
+	if err != nil || surge < 1 || (isPercentage && surge > 100) || strings.HasPrefix(value, "+") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), maxSurge,
+			"must be a positive number of nodes, e.g. 5, or a percentage between 1% and 100%, e.g. 33%"))
+	}
+	return allErrs
+}
+
 // validateKubeletConfig enforces the AKS API configuration for KubeletConfig.
 // See:  https://learn.microsoft.com/en-us/azure/aks/custom-node-configuration.
 func validateKubeletConfig(kubeletConfig *KubeletConfig, fldPath *field.Path) error {
@@ -22,8 +22,10 @@ import (
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -158,6 +160,32 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Cannot change OSSKU of the agentpool",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						OSType:       ptr.To(LinuxOS),
+						OSSKU:        ptr.To(OSSKUAzureLinux),
+						Mode:         "System",
+						SKU:          "StandardD2S_V3",
+						OSDiskSizeGB: ptr.To(512),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						OSType:       ptr.To(LinuxOS),
+						OSSKU:        ptr.To(OSSKUUbuntu),
+						Mode:         "System",
+						SKU:          "StandardD2S_V3",
+						OSDiskSizeGB: ptr.To(512),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Cannot change OSDiskSizeGB of the agentpool",
 			new: &AzureManagedMachinePool{
@@ -597,6 +625,40 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Cannot update PodSubnetName",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						PodSubnetName: ptr.To("my-pod-subnet-1"),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						PodSubnetName: ptr.To("my-pod-subnet"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Cannot set PodSubnetName after creation",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						PodSubnetName: ptr.To("my-pod-subnet"),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Cannot update enableFIPS",
 			new: &AzureManagedMachinePool{
@@ -633,6 +695,26 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Cannot update gpuInstanceProfile",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SKU:                "Standard_NC24ads_A100_v4",
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG2g),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SKU:                "Standard_NC24ads_A100_v4",
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG1g),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	var client client.Client
 	for _, tc := range tests {
@@ -834,6 +916,43 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid podSubnetName",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SubnetName:    ptr.To("my-subnet"),
+						PodSubnetName: ptr.To("my-pod-subnet"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid podSubnetName",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						PodSubnetName: ptr.To("-_-_"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "podSubnetName same as subnetName",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SubnetName:    ptr.To("my-subnet"),
+						PodSubnetName: ptr.To("my-subnet"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
 		{
 			name: "too few MaxPods",
 			ammp: &AzureManagedMachinePool{
@@ -900,6 +1019,230 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			wantErr:  true,
 			errorLen: 1,
 		},
+		{
+			name: "valid node initialization taints",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "User",
+						OSType: ptr.To(LinuxOS),
+						NodeInitializationTaints: []string{
+							"node.example.com/init=true:NoSchedule",
+							"prepull:NoExecute",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "node initialization taint without effect",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                     "User",
+						OSType:                   ptr.To(LinuxOS),
+						NodeInitializationTaints: []string{"init=true"},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "node initialization taint with invalid effect",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                     "User",
+						OSType:                   ptr.To(LinuxOS),
+						NodeInitializationTaints: []string{"init=true:NoWay"},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "node initialization taint with invalid key",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                     "User",
+						OSType:                   ptr.To(LinuxOS),
+						NodeInitializationTaints: []string{"not a key=true:NoSchedule"},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "Windows OSSKU with OSType Windows",
+			ammp: &AzureManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pool0",
+				},
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "User",
+						OSType: ptr.To(WindowsOS),
+						OSSKU:  ptr.To(OSSKUWindows2022),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Windows OSSKU with OSType Linux is not allowed",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "User",
+						OSType: ptr.To(LinuxOS),
+						OSSKU:  ptr.To(OSSKUWindows2019),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "AzureLinux OSSKU with OSType Linux",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "System",
+						OSType: ptr.To(LinuxOS),
+						OSSKU:  ptr.To(OSSKUAzureLinux),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureLinux OSSKU with GPU instance profile is not allowed",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:               "User",
+						SKU:                "Standard_NC24ads_A100_v4",
+						OSType:             ptr.To(LinuxOS),
+						OSSKU:              ptr.To(OSSKUAzureLinux),
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG1g),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "AzureLinux OSSKU with swap file is not allowed",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "User",
+						OSType: ptr.To(LinuxOS),
+						OSSKU:  ptr.To(OSSKUAzureLinux),
+						KubeletConfig: &KubeletConfig{
+							FailSwapOn: ptr.To(false),
+						},
+						LinuxOSConfig: &LinuxOSConfig{
+							SwapFileSizeMB: ptr.To(1500),
+						},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "Ubuntu OSSKU with swap file and GPU instance profile",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:               "User",
+						SKU:                "Standard_NC24ads_A100_v4",
+						OSType:             ptr.To(LinuxOS),
+						OSSKU:              ptr.To(OSSKUUbuntu),
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG1g),
+						KubeletConfig: &KubeletConfig{
+							FailSwapOn: ptr.To(false),
+						},
+						LinuxOSConfig: &LinuxOSConfig{
+							SwapFileSizeMB: ptr.To(1500),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "AzureLinux OSSKU with OSType Windows is not allowed",
+			ammp: &AzureManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pool0",
+				},
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:   "User",
+						OSType: ptr.To(WindowsOS),
+						OSSKU:  ptr.To(OSSKUAzureLinux),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "artifact streaming with AzureLinux OSSKU",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                    "User",
+						OSType:                  ptr.To(LinuxOS),
+						OSSKU:                   ptr.To(OSSKUAzureLinux),
+						EnableArtifactStreaming: ptr.To(true),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "artifact streaming with Windows OSSKU is not allowed",
+			ammp: &AzureManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pool0",
+				},
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                    "User",
+						OSType:                  ptr.To(WindowsOS),
+						OSSKU:                   ptr.To(OSSKUWindows2022),
+						EnableArtifactStreaming: ptr.To(true),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "artifact streaming disabled with Windows OSSKU",
+			ammp: &AzureManagedMachinePool{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "pool0",
+				},
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						Mode:                    "User",
+						OSType:                  ptr.To(WindowsOS),
+						OSSKU:                   ptr.To(OSSKUWindows2022),
+						EnableArtifactStreaming: ptr.To(false),
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "valid label",
 			ammp: &AzureManagedMachinePool{
@@ -1284,6 +1627,99 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			wantErr:  true,
 			errorLen: 1,
 		},
+		{
+			name: "valid GPUInstanceProfile for an A100 SKU",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SKU:                "Standard_ND96asr_v4",
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG7g),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid GPUInstanceProfile for a SKU without MIG support",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SKU:                "Standard_NC6s_v3",
+						GPUInstanceProfile: ptr.To(GPUInstanceProfileMIG1g),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "valid upgradeSettings maxSurge node count",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						UpgradeSettings: &AgentPoolUpgradeSettings{
+							MaxSurge: ptr.To("5"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid upgradeSettings maxSurge percentage",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						UpgradeSettings: &AgentPoolUpgradeSettings{
+							MaxSurge: ptr.To("33%"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid upgradeSettings maxSurge of zero",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						UpgradeSettings: &AgentPoolUpgradeSettings{
+							MaxSurge: ptr.To("0"),
+						},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "invalid upgradeSettings maxSurge percentage above 100",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						UpgradeSettings: &AgentPoolUpgradeSettings{
+							MaxSurge: ptr.To("150%"),
+						},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "invalid upgradeSettings maxSurge format",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						UpgradeSettings: &AgentPoolUpgradeSettings{
+							MaxSurge: ptr.To("abc"),
+						},
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
 	}
 
 	var client client.Client
@@ -1341,6 +1777,362 @@ func TestAzureManagedMachinePool_ValidateCreateFailure(t *testing.T) {
 	}
 }
 
+func TestAzureManagedMachinePool_validatePodSubnet(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind: AzureManagedControlPlaneKind,
+				Name: "my-control-plane",
+			},
+		},
+	}
+	controlPlane := &AzureManagedControlPlane{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-control-plane",
+			Namespace: "default",
+		},
+		Spec: AzureManagedControlPlaneSpec{
+			AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+				VirtualNetwork: ManagedControlPlaneVirtualNetwork{
+					Name: "my-vnet",
+					ManagedControlPlaneVirtualNetworkClassSpec: ManagedControlPlaneVirtualNetworkClassSpec{
+						Subnet: ManagedControlPlaneSubnet{
+							Name: "my-node-subnet",
+						},
+					},
+				},
+			},
+		},
+	}
+	machinePool := func(subnetName, podSubnetName *string) *AzureManagedMachinePool {
+		return &AzureManagedMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool0",
+				Namespace: "default",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: "my-cluster",
+				},
+			},
+			Spec: AzureManagedMachinePoolSpec{
+				AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+					SubnetName:    subnetName,
+					PodSubnetName: podSubnetName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ammp    *AzureManagedMachinePool
+		objects []runtime.Object
+		wantErr bool
+	}{
+		{
+			name:    "no pod subnet",
+			ammp:    machinePool(nil, nil),
+			objects: []runtime.Object{cluster, controlPlane},
+			wantErr: false,
+		},
+		{
+			name:    "pod subnet different from the node subnet",
+			ammp:    machinePool(ptr.To("my-subnet"), ptr.To("my-pod-subnet")),
+			wantErr: false,
+		},
+		{
+			name:    "pod subnet same as the node subnet",
+			ammp:    machinePool(ptr.To("my-subnet"), ptr.To("my-subnet")),
+			wantErr: true,
+		},
+		{
+			name:    "pod subnet different from the defaulted node subnet",
+			ammp:    machinePool(nil, ptr.To("my-pod-subnet")),
+			objects: []runtime.Object{cluster, controlPlane},
+			wantErr: false,
+		},
+		{
+			name:    "pod subnet same as the defaulted node subnet",
+			ammp:    machinePool(nil, ptr.To("my-node-subnet")),
+			objects: []runtime.Object{cluster, controlPlane},
+			wantErr: true,
+		},
+		{
+			name:    "defaulted node subnet with the control plane not created yet",
+			ammp:    machinePool(nil, ptr.To("my-node-subnet")),
+			objects: []runtime.Object{cluster},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tc.objects...).Build()
+			err := validatePodSubnet(fakeClient, tc.ammp, field.NewPath("spec", "podSubnetName"))
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestAzureManagedMachinePool_validateSystemNodePoolName(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind: AzureManagedControlPlaneKind,
+				Name: "my-control-plane",
+			},
+		},
+	}
+	controlPlane := func(systemNodePool *ManagedControlPlaneSystemNodePool) *AzureManagedControlPlane {
+		return &AzureManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-control-plane",
+				Namespace: "default",
+			},
+			Spec: AzureManagedControlPlaneSpec{
+				AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+					SystemNodePool: systemNodePool,
+				},
+			},
+		}
+	}
+	machinePool := func(name string, azureName *string) *AzureManagedMachinePool {
+		return &AzureManagedMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: "my-cluster",
+				},
+			},
+			Spec: AzureManagedMachinePoolSpec{
+				AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+					Name: azureName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		ammp    *AzureManagedMachinePool
+		objects []runtime.Object
+		wantErr bool
+	}{
+		{
+			name:    "no system node pool",
+			ammp:    machinePool("system", nil),
+			objects: []runtime.Object{cluster, controlPlane(nil)},
+			wantErr: false,
+		},
+		{
+			name:    "different name from the system node pool",
+			ammp:    machinePool("pool1", nil),
+			objects: []runtime.Object{cluster, controlPlane(&ManagedControlPlaneSystemNodePool{Name: "system"})},
+			wantErr: false,
+		},
+		{
+			name:    "same name as the system node pool",
+			ammp:    machinePool("system", nil),
+			objects: []runtime.Object{cluster, controlPlane(&ManagedControlPlaneSystemNodePool{Name: "system"})},
+			wantErr: true,
+		},
+		{
+			name:    "same spec.name as the system node pool",
+			ammp:    machinePool("pool1", ptr.To("system")),
+			objects: []runtime.Object{cluster, controlPlane(&ManagedControlPlaneSystemNodePool{Name: "system"})},
+			wantErr: true,
+		},
+		{
+			name:    "control plane not created yet",
+			ammp:    machinePool("system", nil),
+			objects: []runtime.Object{cluster},
+			wantErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tc.objects...).Build()
+			err := validateSystemNodePoolName(fakeClient, tc.ammp, field.NewPath("spec", "name"))
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestAzureManagedMachinePool_validatePreviewFields(t *testing.T) {
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+		Spec: clusterv1.ClusterSpec{
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind: AzureManagedControlPlaneKind,
+				Name: "my-control-plane",
+			},
+		},
+	}
+	controlPlane := func(enablePreviewFeatures *bool) *AzureManagedControlPlane {
+		return &AzureManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-control-plane",
+				Namespace: "default",
+			},
+			Spec: AzureManagedControlPlaneSpec{
+				AzureManagedControlPlaneClassSpec: AzureManagedControlPlaneClassSpec{
+					EnablePreviewFeatures: enablePreviewFeatures,
+				},
+			},
+		}
+	}
+	machinePool := func(spec AzureManagedMachinePoolClassSpec) *AzureManagedMachinePool {
+		return &AzureManagedMachinePool{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "pool1",
+				Namespace: "default",
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: "my-cluster",
+				},
+			},
+			Spec: AzureManagedMachinePoolSpec{
+				AzureManagedMachinePoolClassSpec: spec,
+			},
+		}
+	}
+	taints := AzureManagedMachinePoolClassSpec{
+		NodeInitializationTaints: []string{"key=value:NoSchedule"},
+	}
+
+	tests := []struct {
+		name       string
+		ammp       *AzureManagedMachinePool
+		objects    []runtime.Object
+		wantFields []string
+	}{
+		{
+			name:    "no preview fields",
+			ammp:    machinePool(AzureManagedMachinePoolClassSpec{}),
+			objects: []runtime.Object{cluster, controlPlane(nil)},
+		},
+		{
+			name:    "node initialization taints with preview features enabled",
+			ammp:    machinePool(taints),
+			objects: []runtime.Object{cluster, controlPlane(ptr.To(true))},
+		},
+		{
+			name:       "node initialization taints without preview features",
+			ammp:       machinePool(taints),
+			objects:    []runtime.Object{cluster, controlPlane(nil)},
+			wantFields: []string{"spec.nodeInitializationTaints"},
+		},
+		{
+			name:       "node initialization taints with preview features disabled",
+			ammp:       machinePool(taints),
+			objects:    []runtime.Object{cluster, controlPlane(ptr.To(false))},
+			wantFields: []string{"spec.nodeInitializationTaints"},
+		},
+		{
+			name: "artifact streaming without preview features",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				EnableArtifactStreaming: ptr.To(true),
+			}),
+			objects:    []runtime.Object{cluster, controlPlane(nil)},
+			wantFields: []string{"spec.enableArtifactStreaming"},
+		},
+		{
+			name: "artifact streaming and node initialization taints without preview features",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				NodeInitializationTaints: []string{"key=value:NoSchedule"},
+				EnableArtifactStreaming:  ptr.To(false),
+			}),
+			objects:    []runtime.Object{cluster, controlPlane(nil)},
+			wantFields: []string{"spec.nodeInitializationTaints", "spec.enableArtifactStreaming"},
+		},
+		{
+			name: "artifact streaming with preview features enabled",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				EnableArtifactStreaming: ptr.To(true),
+			}),
+			objects: []runtime.Object{cluster, controlPlane(ptr.To(true))},
+		},
+		{
+			name: "node soak duration without preview features",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				UpgradeSettings: &AgentPoolUpgradeSettings{
+					MaxSurge:                  ptr.To("33%"),
+					NodeSoakDurationInMinutes: ptr.To(10),
+				},
+			}),
+			objects:    []runtime.Object{cluster, controlPlane(nil)},
+			wantFields: []string{"spec.upgradeSettings.nodeSoakDurationInMinutes"},
+		},
+		{
+			name: "upgrade settings without node soak duration",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				UpgradeSettings: &AgentPoolUpgradeSettings{
+					MaxSurge: ptr.To("33%"),
+				},
+			}),
+			objects: []runtime.Object{cluster, controlPlane(nil)},
+		},
+		{
+			name: "node soak duration with preview features enabled",
+			ammp: machinePool(AzureManagedMachinePoolClassSpec{
+				UpgradeSettings: &AgentPoolUpgradeSettings{
+					NodeSoakDurationInMinutes: ptr.To(10),
+				},
+			}),
+			objects: []runtime.Object{cluster, controlPlane(ptr.To(true))},
+		},
+		{
+			name:    "control plane not created yet",
+			ammp:    machinePool(taints),
+			objects: []runtime.Object{cluster},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = AddToScheme(scheme)
+			_ = clusterv1.AddToScheme(scheme)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(tc.objects...).Build()
+			errs := validatePreviewFields(fakeClient, tc.ammp, field.NewPath("spec"))
+			fields := []string{}
+			for _, err := range errs {
+				g.Expect(err.Type).To(Equal(field.ErrorTypeForbidden))
+				fields = append(fields, err.Field)
+			}
+			g.Expect(fields).To(ConsistOf(tc.wantFields))
+		})
+	}
+}
+
 func TestAzureManagedMachinePool_validateLastSystemNodePool(t *testing.T) {
 	deletionTime := metav1.Now()
 	finalizers := []string{"test"}
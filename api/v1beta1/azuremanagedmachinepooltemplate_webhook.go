@@ -97,6 +97,10 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateCreate(_ context.Cont
 		mp.Spec.Template.Spec.OSType,
 		field.NewPath("spec", "template", "spec", "osType")))
 
+	errs = append(errs, validateOSSKU(
+		mp.Spec.Template.Spec.AzureManagedMachinePoolClassSpec,
+		field.NewPath("spec", "template", "spec")).ToAggregate())
+
 	errs = append(errs, validateMPName(
 		mp.Name,
 		mp.Spec.Template.Spec.Name,
@@ -107,10 +111,29 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateCreate(_ context.Cont
 		mp.Spec.Template.Spec.NodeLabels,
 		field.NewPath("spec", "template", "spec", "nodeLabels")))
 
+	errs = append(errs, validateNodeInitializationTaints(
+		mp.Spec.Template.Spec.NodeInitializationTaints,
+		field.NewPath("spec", "template", "spec", "nodeInitializationTaints")).ToAggregate())
+
+	errs = append(errs, validateEnableArtifactStreaming(
+		mp.Spec.Template.Spec.EnableArtifactStreaming,
+		mp.Spec.Template.Spec.OSSKU,
+		mp.Spec.Template.Spec.OSType,
+		field.NewPath("spec", "template", "spec", "enableArtifactStreaming")).ToAggregate())
+
 	errs = append(errs, validateNodePublicIPPrefixID(
 		mp.Spec.Template.Spec.NodePublicIPPrefixID,
 		field.NewPath("spec", "template", "spec", "nodePublicIPPrefixID")))
 
+	errs = append(errs, validateGPUInstanceProfile(
+		mp.Spec.Template.Spec.GPUInstanceProfile,
+		mp.Spec.Template.Spec.SKU,
+		field.NewPath("spec", "template", "spec", "gpuInstanceProfile")))
+
+	errs = append(errs, validateUpgradeSettings(
+		mp.Spec.Template.Spec.UpgradeSettings,
+		field.NewPath("spec", "template", "spec", "upgradeSettings")).ToAggregate())
+
 	errs = append(errs, validateEnableNodePublicIP(
 		mp.Spec.Template.Spec.EnableNodePublicIP,
 		mp.Spec.Template.Spec.NodePublicIPPrefixID,
@@ -125,6 +148,11 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateCreate(_ context.Cont
 		mp.Spec.Template.Spec.KubeletConfig,
 		field.NewPath("spec", "template", "spec", "linuxOSConfig")))
 
+	errs = append(errs, validatePodSubnetName(
+		mp.Spec.Template.Spec.PodSubnetName,
+		mp.Spec.Template.Spec.SubnetName,
+		field.NewPath("spec", "template", "spec", "podSubnetName")))
+
 	return nil, kerrors.NewAggregate(errs)
 }
 
@@ -155,6 +183,10 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(_ context.Cont
 				err.Error()))
 	}
 
+	allErrs = append(allErrs, validateNodeInitializationTaints(mp.Spec.Template.Spec.NodeInitializationTaints, field.NewPath("spec", "template", "spec", "nodeInitializationTaints"))...)
+
+	allErrs = append(allErrs, validateEnableArtifactStreaming(mp.Spec.Template.Spec.EnableArtifactStreaming, mp.Spec.Template.Spec.OSSKU, mp.Spec.Template.Spec.OSType, field.NewPath("spec", "template", "spec", "enableArtifactStreaming"))...)
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "osType"),
 		old.Spec.Template.Spec.OSType,
@@ -162,6 +194,13 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(_ context.Cont
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "template", "spec", "osSKU"),
+		old.Spec.Template.Spec.OSSKU,
+		mp.Spec.Template.Spec.OSSKU); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "sku"),
 		old.Spec.Template.Spec.SKU,
@@ -183,6 +222,13 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(_ context.Cont
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "template", "spec", "podSubnetName"),
+		old.Spec.Template.Spec.PodSubnetName,
+		mp.Spec.Template.Spec.PodSubnetName); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "template", "spec", "enableFIPS"),
 		old.Spec.Template.Spec.EnableFIPS,
@@ -268,6 +314,13 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(_ context.Cont
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "template", "spec", "gpuInstanceProfile"),
+		old.Spec.Template.Spec.GPUInstanceProfile,
+		mp.Spec.Template.Spec.GPUInstanceProfile); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -40,6 +40,8 @@ const (
 	VMDeletingReason = "VMDeleting"
 	// VMProvisionFailedReason used for failures during vm provisioning.
 	VMProvisionFailedReason = "VMProvisionFailed"
+	// VMDeallocatedReason used when a Spot VM was evicted and is deallocated.
+	VMDeallocatedReason = "VMDeallocated"
 	// UserAssignedIdentityMissingReason used for failures when a user-assigned identity is missing.
 	UserAssignedIdentityMissingReason = "UserAssignedIdentityMissing"
 	// WaitingForClusterInfrastructureReason used when machine is waiting for cluster infrastructure to be ready before proceeding.
@@ -73,11 +75,19 @@ const (
 	ScaleSetScaleUpReason = "ScaleSetScalingUp"
 	// ScaleSetScaleDownReason describes the machine pool scaling down.
 	ScaleSetScaleDownReason = "ScaleSetScalingDown"
+	// ScaleSetScaleDownBlockedReason describes the machine pool being unable to scale down because more instances are
+	// protected from scale down than the desired replica count.
+	ScaleSetScaleDownBlockedReason = "ScaleSetScaleDownBlocked"
 
 	// ScaleSetModelUpdatedCondition reports on the model state of the pool.
 	ScaleSetModelUpdatedCondition clusterv1.ConditionType = "ScaleSetModelUpdated"
 	// ScaleSetModelOutOfDateReason describes the machine pool model being out of date.
 	ScaleSetModelOutOfDateReason = "ScaleSetModelOutOfDate"
+
+	// ScaleSetInstancesUpToDateCondition reports on whether all instances of the pool are running the latest model.
+	ScaleSetInstancesUpToDateCondition clusterv1.ConditionType = "InstancesUpToDate"
+	// ScaleSetInstancesOutOfDateReason describes some instances of the pool not running the latest model.
+	ScaleSetInstancesOutOfDateReason = "InstancesOutOfDate"
 )
 
 // AzureManagedCluster Conditions and Reasons.
@@ -88,6 +98,35 @@ const (
 	AgentPoolsReadyCondition clusterv1.ConditionType = "AgentPoolsReady"
 	// AzureResourceAvailableCondition means the AKS cluster is healthy according to Azure's Resource Health API.
 	AzureResourceAvailableCondition clusterv1.ConditionType = "AzureResourceAvailable"
+	// ManagedClusterDriftDetectedCondition means an AKS cluster was observed to differ from its desired spec, e.g.
+	// after a change made outside of CAPZ; this condition is informational only.
+	ManagedClusterDriftDetectedCondition clusterv1.ConditionType = "ManagedClusterDriftDetected"
+
+	// DriftCorrectingReason means fields of the AKS cluster were changed outside of CAPZ and are corrected when
+	// ASO next applies the desired spec.
+	DriftCorrectingReason = "DriftCorrecting"
+	// DriftNotCorrectedReason means fields of the AKS cluster were changed outside of CAPZ and are not corrected
+	// because the AKS cluster is not reconciled.
+	DriftNotCorrectedReason = "DriftNotCorrected"
+	// ManagedClusterAdoptedCondition means CAPZ has taken over management of an existing AKS cluster as requested
+	// by the adopt annotation on the AzureManagedControlPlane.
+	ManagedClusterAdoptedCondition clusterv1.ConditionType = "ManagedClusterAdopted"
+	// AdoptionFailedReason means the existing AKS cluster could not be adopted because fields that cannot be
+	// changed after creation do not match the AzureManagedControlPlane.
+	AdoptionFailedReason = "AdoptionFailed"
+)
+
+// AzureClusterIdentity Conditions and Reasons.
+const (
+	// ClientSecretReferenceInvalidReason used when the clientSecret of an identity that needs one doesn't name a secret.
+	ClientSecretReferenceInvalidReason = "ClientSecretReferenceInvalid"
+	// ClientSecretNotFoundReason used when the secret referenced by the clientSecret of an identity doesn't exist.
+	ClientSecretNotFoundReason = "ClientSecretNotFound"
+	// ClientSecretKeyMissingReason used when the secret referenced by the clientSecret of an identity has no
+	// clientSecret key, or its value is empty.
+	ClientSecretKeyMissingReason = "ClientSecretKeyMissing"
+	// ClientCertificateInvalidReason used when the certificate of a ServicePrincipalCertificate identity can't be parsed.
+	ClientCertificateInvalidReason = "ClientCertificateInvalid"
 )
 
 // Azure Services Conditions and Reasons.
@@ -130,6 +169,8 @@ const (
 	NetworkInterfaceReadyCondition clusterv1.ConditionType = "NetworkInterfacesReady"
 	// PrivateEndpointsReadyCondition means the private endpoints exist and are ready to be used.
 	PrivateEndpointsReadyCondition clusterv1.ConditionType = "PrivateEndpointsReady"
+	// PrivateLinkServicesReadyCondition means the private link services exist and are ready to be used.
+	PrivateLinkServicesReadyCondition clusterv1.ConditionType = "PrivateLinkServicesReady"
 	// FleetReadyCondition means the Fleet exists and is ready to be used.
 	FleetReadyCondition clusterv1.ConditionType = "FleetReady"
 	// AKSExtensionsReadyCondition means the AKS Extensions exist and are ready to be used.
@@ -147,6 +188,11 @@ const (
 	DeletionFailedReason = "DeletionFailed"
 	// UpdatingReason means the resource is being updated.
 	UpdatingReason = "Updating"
+	// VnetPeeringForbiddenReason means a virtual network peering failed because the identity lacks permissions on one of
+	// the peered virtual networks, e.g. on a remote virtual network in another subscription.
+	VnetPeeringForbiddenReason = "VnetPeeringForbidden"
+	// RemoteVnetNotFoundReason means a virtual network peering failed because one of the peered virtual networks does not exist.
+	RemoteVnetNotFoundReason = "RemoteVnetNotFound"
 )
 
 const (
@@ -165,6 +211,38 @@ const (
 	OwnedByClusterLabelKey = NameAzureProviderPrefix + string(ResourceLifecycleOwned)
 )
 
+const (
+	// DryRunAnnotation is the annotation that, when present on a Cluster, makes CAPZ compute the desired
+	// state of the cluster's Azure resources and log the create, update, and delete operations it would
+	// perform instead of performing them. Reads from Azure are still allowed.
+	DryRunAnnotation = "infrastructure.cluster.x-k8s.io/dry-run"
+)
+
+const (
+	// AdoptAnnotation is the annotation that, when set to AdoptAnnotationValue on an AzureManagedControlPlane,
+	// makes CAPZ take over management of an existing AKS cluster and its agent pools with the same name,
+	// resource group, and subscription instead of leaving them untouched. The cluster is not recreated, and
+	// adoption fails if the fields that cannot be changed after creation differ from the existing cluster.
+	// The same annotation on an ASO ManagedCluster or ManagedClustersAgentPool makes CAPZ scaffold the
+	// Cluster API resources for it.
+	AdoptAnnotation = "infrastructure.cluster.x-k8s.io/adopt"
+	// DeprecatedAdoptAnnotation is the former name of AdoptAnnotation. It is still honored, but AdoptAnnotation
+	// should be used instead.
+	//
+	// Deprecated: use AdoptAnnotation.
+	DeprecatedAdoptAnnotation = "sigs.k8s.io/cluster-api-provider-azure-adopt"
+	// AdoptAnnotationValue is the value of AdoptAnnotation that requests adoption.
+	AdoptAnnotationValue = "true"
+)
+
+const (
+	// ForceReconcileAnnotation is the annotation that, when set on a resource, makes its next reconcile run
+	// immediately even if the resource was reconciled successfully within the coalescing window. The annotation is
+	// removed once that reconcile succeeds. Its value is not interpreted, a timestamp is recommended so that
+	// setting it again is always a change.
+	ForceReconcileAnnotation = "infrastructure.cluster.x-k8s.io/force-reconcile"
+)
+
 const (
 	// AzureNetworkPluginName is the name of the Azure network plugin.
 	AzureNetworkPluginName = "azure"
@@ -19,6 +19,7 @@ package v1beta1
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // Tags defines a map of tags.
@@ -80,6 +81,17 @@ func (t Tags) AddSpecVersionHashTag(hash string) Tags {
 	return t
 }
 
+const (
+	// maxTagKeyLength is the maximum length of the key of an Azure tag.
+	maxTagKeyLength = 512
+
+	// invalidTagKeyCharacters are the characters Azure does not allow in the key of a tag.
+	invalidTagKeyCharacters = `<>%&\?/`
+)
+
+// reservedTagKeyPrefixes are the lowercase prefixes of tag keys reserved by Azure, or used by CAPZ and the Azure cloud provider.
+var reservedTagKeyPrefixes = []string{"microsoft", "azure", "windows", NameAzureProviderPrefix, NameKubernetesAzureCloudProviderPrefix}
+
 // ResourceLifecycle configures the lifecycle of a resource.
 type ResourceLifecycle string
 
@@ -207,3 +219,47 @@ func Build(params BuildParams) Tags {
 
 	return tags
 }
+
+// ClusterLabelTagKey returns the key of the tag a CAPI Cluster label is propagated as. Azure tag keys cannot contain
+// "/", so it is replaced by "_", the same way CAPZ builds its own tag keys.
+func ClusterLabelTagKey(labelKey string) string {
+	return strings.ReplaceAll(labelKey, "/", "_")
+}
+
+// ValidateTagKey returns an error if key is not a valid Azure tag key, or a key reserved by Azure or CAPZ.
+// See https://learn.microsoft.com/azure/azure-resource-manager/management/tag-resources#limitations.
+func ValidateTagKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("tag key must not be empty")
+	}
+	if len(key) > maxTagKeyLength {
+		return fmt.Errorf("tag key must be at most %d characters", maxTagKeyLength)
+	}
+	if strings.ContainsAny(key, invalidTagKeyCharacters) {
+		return fmt.Errorf("tag key must not contain any of the characters %q", invalidTagKeyCharacters)
+	}
+	for _, prefix := range reservedTagKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), prefix) {
+			return fmt.Errorf("tag key must not start with the reserved prefix %q", prefix)
+		}
+	}
+	return nil
+}
+
+// ClusterLabelsToTags returns the labels whose key starts with one of the prefixes as tags.
+// Labels that do not convert to a valid tag key are skipped.
+func ClusterLabelsToTags(labels map[string]string, prefixes []string) Tags {
+	tags := make(Tags)
+	for key, value := range labels {
+		for _, prefix := range prefixes {
+			if !strings.HasPrefix(key, prefix) {
+				continue
+			}
+			if tagKey := ClusterLabelTagKey(key); ValidateTagKey(tagKey) == nil {
+				tags[tagKey] = value
+			}
+			break
+		}
+	}
+	return tags
+}
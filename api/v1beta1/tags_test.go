@@ -86,3 +86,100 @@ func TestTags_Merge(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterLabelsToTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		prefixes []string
+		expected Tags
+	}{
+		{
+			name: "no prefixes",
+			labels: map[string]string{
+				"cost-center": "1234",
+			},
+			prefixes: nil,
+			expected: Tags{},
+		},
+		{
+			name: "labels matching a prefix are propagated",
+			labels: map[string]string{
+				"billing.example.com/cost-center": "1234",
+				"billing.example.com/team":        "platform",
+				"cost-center":                     "5678",
+				"cluster.x-k8s.io/cluster-name":   "my-cluster",
+			},
+			prefixes: []string{"billing.example.com/", "cost-"},
+			expected: Tags{
+				"billing.example.com_cost-center": "1234",
+				"billing.example.com_team":        "platform",
+				"cost-center":                     "5678",
+			},
+		},
+		{
+			name: "labels converting to reserved tag keys are skipped",
+			labels: map[string]string{
+				"azure.example.com/cost-center": "1234",
+				"app.example.com/team":          "platform",
+			},
+			prefixes: []string{"a"},
+			expected: Tags{
+				"app.example.com_team": "platform",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(ClusterLabelsToTags(tc.labels, tc.prefixes)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestValidateTagKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr bool
+	}{
+		{
+			name:    "valid key",
+			key:     "billing.example.com_cost-center",
+			wantErr: false,
+		},
+		{
+			name:    "empty key",
+			key:     "",
+			wantErr: true,
+		},
+		{
+			name:    "key with a slash",
+			key:     "billing.example.com/cost-center",
+			wantErr: true,
+		},
+		{
+			name:    "key reserved by Azure",
+			key:     "Microsoft.cost-center",
+			wantErr: true,
+		},
+		{
+			name:    "key reserved by CAPZ",
+			key:     NameAzureProviderPrefix + "cost-center",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := ValidateTagKey(tc.key)
+			if tc.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -111,6 +111,39 @@ type NetworkSpec struct {
 	// +optional
 	ControlPlaneOutboundLB *LoadBalancerSpec `json:"controlPlaneOutboundLB,omitempty"`
 
+	// NetworkResourceGroup is the name of the resource group for the network resources of the cluster: the virtual
+	// network, subnets, security groups, route tables and NAT gateways. It may be an existing resource group, otherwise
+	// it is created. When set, vnet.resourceGroup defaults to it and must match it.
+	// If unset, NAT gateways are placed in the cluster resource group and the other network resources in vnet.resourceGroup.
+	// Immutable.
+	// +optional
+	NetworkResourceGroup string `json:"networkResourceGroup,omitempty"`
+
+	// ShareAPIServerOutboundIP configures nodes to use the public IP of the API server load balancer for outbound
+	// traffic instead of the public IPs of a separate node outbound load balancer.
+	// Only supported for public clusters and requires the SharedAPIServerOutboundIP feature flag. Immutable.
+	// +optional
+	ShareAPIServerOutboundIP bool `json:"shareAPIServerOutboundIP,omitempty"`
+
+	// AllowedSSHSourceCIDRs restricts the sources allowed by the default SSH security rule of the control plane subnet.
+	// If empty, SSH is allowed from any source. Ignored if the control plane subnet specifies its own security rules.
+	// Immutable.
+	// +optional
+	AllowedSSHSourceCIDRs []string `json:"allowedSSHSourceCIDRs,omitempty"`
+
+	// DisableOutbound disables the outbound connectivity managed by CAPZ: no node or control plane outbound load
+	// balancer and no NAT gateway are created. Egress must be provided by the route tables of the subnets, e.g. to a
+	// firewall reached through ExpressRoute, which must contain a default route. Requires an internal API server
+	// load balancer. Immutable.
+	// +optional
+	DisableOutbound bool `json:"disableOutbound,omitempty"`
+
+	// PrivateLinkService is the configuration for a private link service in front of the internal API server load
+	// balancer, which lets private endpoints in other virtual networks reach the API server.
+	// Requires an internal API server load balancer.
+	// +optional
+	PrivateLinkService *PrivateLinkServiceSpec `json:"privateLinkService,omitempty"`
+
 	NetworkClassSpec `json:",inline"`
 }
 
@@ -212,11 +245,17 @@ type PrivateEndpoints []PrivateEndpointSpec
 // SecurityGroup defines an Azure security group.
 type SecurityGroup struct {
 	// ID is the Azure resource ID of the security group.
-	// READ-ONLY
+	// READ-ONLY, unless Unmanaged is set.
 	// +optional
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
 
+	// Unmanaged indicates that the security group and its rules are managed outside of CAPZ. CAPZ does not
+	// add default security rules to it nor create, update or delete it, and only associates it with the subnet.
+	// Requires ID to reference an existing security group and no security rules to be set.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
+
 	SecurityGroupClass `json:",inline"`
 }
 
@@ -245,6 +284,25 @@ type NatGateway struct {
 // NatGatewayClassSpec defines a NAT gateway class specification.
 type NatGatewayClassSpec struct {
 	Name string `json:"name"`
+
+	// PublicIPPrefixes are existing public IP prefixes to attach to the NAT gateway in addition to its public IP.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	// +kubebuilder:validation:MaxItems=15
+	PublicIPPrefixes []NatGatewayPublicIPPrefix `json:"publicIPPrefixes,omitempty"`
+}
+
+// NatGatewayPublicIPPrefix references an existing public IP prefix attached to a NAT gateway.
+type NatGatewayPublicIPPrefix struct {
+	// ID is the resource ID of the public IP prefix.
+	ID string `json:"id"`
+
+	// PrefixLength is the length of the public IP prefix. NAT gateways accept IPv4 prefixes with a length
+	// between /28 and /31.
+	// +kubebuilder:validation:Minimum=28
+	// +kubebuilder:validation:Maximum=31
+	PrefixLength int32 `json:"prefixLength"`
 }
 
 // SecurityGroupProtocol defines the protocol type for a security group rule.
@@ -327,11 +385,18 @@ type SecurityRules []SecurityRule
 // LoadBalancerSpec defines an Azure load balancer.
 type LoadBalancerSpec struct {
 	// ID is the Azure resource ID of the load balancer.
-	// READ-ONLY
+	// READ-ONLY, unless Unmanaged is set.
 	// +optional
 	ID string `json:"id,omitempty"`
 	// +optional
 	Name string `json:"name,omitempty"`
+	// Unmanaged indicates that the load balancer is managed outside of CAPZ. CAPZ then adds its frontend IP
+	// configuration and backend pool to the load balancer, but does not create, update or delete anything else.
+	// Requires ID to reference an existing load balancer in the cluster resource group. The type of the load
+	// balancer is determined by its frontend IPs, and sku and idleTimeoutInMinutes cannot be set.
+	// It can only be set on the API server load balancer.
+	// +optional
+	Unmanaged bool `json:"unmanaged,omitempty"`
 	// +optional
 	FrontendIPs []FrontendIP `json:"frontendIPs,omitempty"`
 	// FrontendIPsCount specifies the number of frontend IP addresses for the load balancer.
@@ -340,6 +405,14 @@ type LoadBalancerSpec struct {
 	// BackendPool describes the backend pool of the load balancer.
 	// +optional
 	BackendPool BackendPool `json:"backendPool,omitempty"`
+	// OutboundBackendPools are additional backend pools of the load balancer, each with its own frontend IPs and
+	// outbound rule, so that the traffic of the machines joining them egresses through dedicated IPs. Machines join
+	// one of them with nodeOutboundBackendPool instead of the backend pool of the load balancer. Pools can be added
+	// but not changed or removed after creation. It can only be set on the node outbound load balancer.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	OutboundBackendPools []OutboundBackendPool `json:"outboundBackendPools,omitempty"`
 
 	LoadBalancerClassSpec `json:",inline"`
 }
@@ -362,6 +435,18 @@ const (
 	Public = LBType("Public")
 )
 
+// LBProbeProtocol defines the protocol of an Azure load balancer health probe.
+type LBProbeProtocol string
+
+const (
+	// LBProbeProtocolTCP is the value for a TCP load balancer health probe.
+	LBProbeProtocolTCP = LBProbeProtocol("Tcp")
+	// LBProbeProtocolHTTP is the value for an HTTP load balancer health probe.
+	LBProbeProtocolHTTP = LBProbeProtocol("Http")
+	// LBProbeProtocolHTTPS is the value for an HTTPS load balancer health probe.
+	LBProbeProtocolHTTPS = LBProbeProtocol("Https")
+)
+
 // FrontendIP defines a load balancer frontend IP configuration.
 type FrontendIP struct {
 	// +kubebuilder:validation:MinLength=1
@@ -379,6 +464,13 @@ type PublicIPSpec struct {
 	DNSName string `json:"dnsName,omitempty"`
 	// +optional
 	IPTags []IPTag `json:"ipTags,omitempty"`
+	// Zones is the list of availability zones in which the public IP is created. A single zone creates a zonal
+	// public IP and several zones create a zone-redundant public IP. The zones must be available in the cluster location.
+	// When not set, the public IP spans all of the cluster's failure domains.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=3
+	Zones []string `json:"zones,omitempty"`
 }
 
 // IPTag contains the IpTag associated with the object.
@@ -566,9 +658,24 @@ const (
 type UserAssignedIdentity struct {
 	// ProviderID is the identification ID of the user-assigned Identity, the format of an identity is:
 	// 'azure:///subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{identityName}'
+	// The name of an identity in the cluster's resource group and subscription may be given instead of its full ID.
 	ProviderID string `json:"providerID"`
+
+	// Purpose restricts what the identity is used for. When set to ImagePull, the identity is granted
+	// AcrPull on the machine's image pull registry and is not meant to be used for anything else.
+	// +optional
+	Purpose UserAssignedIdentityPurpose `json:"purpose,omitempty"`
 }
 
+// UserAssignedIdentityPurpose defines what a user-assigned identity is used for.
+// +kubebuilder:validation:Enum=ImagePull
+type UserAssignedIdentityPurpose string
+
+const (
+	// UserAssignedIdentityPurposeImagePull marks the identity used only to pull images from a container registry.
+	UserAssignedIdentityPurposeImagePull UserAssignedIdentityPurpose = "ImagePull"
+)
+
 // IdentityType represents different types of identities.
 // +kubebuilder:validation:Enum=ServicePrincipal;UserAssignedMSI;ManualServicePrincipal;ServicePrincipalCertificate;WorkloadIdentity
 type IdentityType string
@@ -599,7 +706,8 @@ type OSDisk struct {
 	// +kubebuilder:default:=Linux
 	OSType string `json:"osType"`
 	// DiskSizeGB is the size in GB to assign to the OS disk.
-	// Will have a default of 30GB if not provided
+	// Will have a default of 30GB if not provided.
+	// It can be increased on an existing AzureMachine, which deallocates the VM while the disk is resized.
 	// +optional
 	DiskSizeGB *int32 `json:"diskSizeGB,omitempty"`
 	// ManagedDisk specifies the Managed Disk parameters for the OS disk.
@@ -620,7 +728,9 @@ type DataDisk struct {
 	// Each disk name will be in format <machineName>_<nameSuffix>.
 	NameSuffix string `json:"nameSuffix"`
 	// DiskSizeGB is the size in GB to assign to the data disk.
-	DiskSizeGB int32 `json:"diskSizeGB"`
+	// Required unless ManagedDiskID is set.
+	// +optional
+	DiskSizeGB int32 `json:"diskSizeGB,omitempty"`
 	// ManagedDisk specifies the Managed Disk parameters for the data disk.
 	// +optional
 	ManagedDisk *ManagedDiskParameters `json:"managedDisk,omitempty"`
@@ -632,6 +742,11 @@ type DataDisk struct {
 	// +optional
 	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
 	CachingType string `json:"cachingType,omitempty"`
+	// ManagedDiskID is the resource ID of an existing managed disk to attach to the VM instead of creating a new one.
+	// The disk is detached, not deleted, when the machine is deleted. DiskSizeGB and ManagedDisk must not be set
+	// and Lun must be set when attaching an existing disk.
+	// +optional
+	ManagedDiskID string `json:"managedDiskID,omitempty"`
 }
 
 // VMExtension specifies the parameters for a custom VM extension.
@@ -650,6 +765,43 @@ type VMExtension struct {
 	ProtectedSettings Tags `json:"protectedSettings,omitempty"`
 }
 
+// VMGalleryApplication specifies a gallery application to install on a virtual machine.
+type VMGalleryApplication struct {
+	// PackageReferenceID is the resource ID of the gallery application version, in the form
+	// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/galleries/{galleryName}/applications/{application}/versions/{version}.
+	PackageReferenceID string `json:"packageReferenceID"`
+	// Order specifies the order in which the applications are installed.
+	// +optional
+	Order *int32 `json:"order,omitempty"`
+	// ConfigurationReference is the URI of an Azure blob that replaces the default configuration of the application.
+	// +optional
+	ConfigurationReference *string `json:"configurationReference,omitempty"`
+	// TreatFailureAsDeploymentFailure fails the virtual machine deployment if the application fails to install.
+	// +optional
+	TreatFailureAsDeploymentFailure *bool `json:"treatFailureAsDeploymentFailure,omitempty"`
+}
+
+// VaultSecretGroup is a set of certificates stored in the same Azure Key Vault.
+type VaultSecretGroup struct {
+	// SourceVaultID is the resource ID of the Key Vault containing the certificates, in the form
+	// /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.KeyVault/vaults/{vaultName}.
+	SourceVaultID string `json:"sourceVaultID"`
+	// VaultCertificates is the list of certificates of the Key Vault to install.
+	// +kubebuilder:validation:MinItems=1
+	VaultCertificates []VaultCertificate `json:"vaultCertificates"`
+}
+
+// VaultCertificate references a certificate stored as a secret in an Azure Key Vault.
+type VaultCertificate struct {
+	// CertificateURL is the URL of the Key Vault secret holding the certificate, in the form
+	// https://{vaultName}.vault.azure.net/secrets/{secretName}/{secretVersion}.
+	CertificateURL string `json:"certificateURL"`
+	// CertificateStore is the certificate store of the account on Windows virtual machines to which the certificate is added.
+	// It is required for Windows and must not be set for Linux, where the certificate is placed under /var/lib/waagent.
+	// +optional
+	CertificateStore string `json:"certificateStore,omitempty"`
+}
+
 // ManagedDiskParameters defines the parameters of a managed disk.
 type ManagedDiskParameters struct {
 	// +optional
@@ -818,6 +970,43 @@ type PrivateEndpointSpec struct {
 	ManualApproval bool `json:"manualApproval,omitempty"`
 }
 
+// PrivateLinkServiceSpec configures an Azure Private Link Service in front of the internal API server load balancer.
+type PrivateLinkServiceSpec struct {
+	// Name is the name of the private link service. Defaults to <cluster name>-apiserver-pls. Immutable.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// NATIPConfigurations are the IP configurations the private link service uses to translate the source addresses of
+	// the traffic from private endpoints. The first one is the primary configuration.
+	// Defaults to a single configuration with a dynamic IP address in the control plane subnet.
+	// +kubebuilder:validation:MaxItems=8
+	// +optional
+	NATIPConfigurations []PrivateLinkServiceNATIPConfiguration `json:"natIPConfigurations,omitempty"`
+
+	// VisibilitySubscriptions are the IDs of the subscriptions which can find the private link service by its alias
+	// and request a private endpoint connection to it. "*" makes it visible to all subscriptions. When empty, only
+	// users with permissions on the private link service can request connections.
+	// +optional
+	VisibilitySubscriptions []string `json:"visibilitySubscriptions,omitempty"`
+
+	// AutoApprovalSubscriptions are the IDs of the subscriptions whose private endpoint connections are approved
+	// automatically. Connections from other subscriptions must be approved manually.
+	// +optional
+	AutoApprovalSubscriptions []string `json:"autoApprovalSubscriptions,omitempty"`
+}
+
+// PrivateLinkServiceNATIPConfiguration configures a NAT IP address of a private link service.
+type PrivateLinkServiceNATIPConfiguration struct {
+	// Subnet is the name of the subnet of the cluster's virtual network the NAT IP address is allocated in.
+	// Network policies for private link services are disabled on this subnet. Defaults to the control plane subnet.
+	// +optional
+	Subnet string `json:"subnet,omitempty"`
+
+	// PrivateIPAddress is the static NAT IP address. When empty, the address is allocated dynamically.
+	// +optional
+	PrivateIPAddress string `json:"privateIPAddress,omitempty"`
+}
+
 // NetworkInterface defines a network interface.
 type NetworkInterface struct {
 	// SubnetName specifies the subnet in which the new network interface will be placed.
@@ -1049,6 +1238,16 @@ type AzureBastion struct {
 	// +kubebuilder:default=false
 	// +optional
 	EnableTunneling bool `json:"enableTunneling,omitempty"`
+	// ScaleUnits is the number of scale units of the Azure Bastion Host, each one supporting about 20 concurrent
+	// RDP or 40 concurrent SSH sessions. Can only be set with the Standard SKU. Defaults to 2.
+	// +kubebuilder:validation:Minimum=2
+	// +kubebuilder:validation:Maximum=50
+	// +optional
+	ScaleUnits *int `json:"scaleUnits,omitempty"`
+	// DisableCopyPaste disables copy and paste in the web-based clients of the Azure Bastion Host.
+	// Can only be set with the Standard SKU.
+	// +optional
+	DisableCopyPaste *bool `json:"disableCopyPaste,omitempty"`
 }
 
 // FleetsMember defines the fleets member configuration.
@@ -1071,11 +1270,32 @@ type BackendPool struct {
 	Name string `json:"name,omitempty"`
 }
 
+// OutboundBackendPool is a backend pool of the node outbound load balancer with dedicated frontend IPs.
+type OutboundBackendPool struct {
+	// Name of the backend pool.
+	Name string `json:"name"`
+	// FrontendIPs are the frontend IPs the machines of the pool egress through. Each of them must have a public IP.
+	// When not set, one frontend IP with a new public IP is used.
+	// +optional
+	FrontendIPs []FrontendIP `json:"frontendIPs,omitempty"`
+	// AllocatedOutboundPorts is the number of SNAT ports allocated to each machine of the pool by its outbound rule.
+	// It must be a multiple of 8 between 0 and 64000. When omitted or 0, Azure allocates ports based on the pool size.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+}
+
 // IsTerminalProvisioningState returns true if the ProvisioningState is a terminal state for an Azure resource.
 func IsTerminalProvisioningState(state ProvisioningState) bool {
 	return state == Failed || state == Succeeded
 }
 
+// IsAdoptRequested returns true if the annotations set AdoptAnnotation, or the deprecated
+// DeprecatedAdoptAnnotation, to AdoptAnnotationValue.
+func IsAdoptRequested(annotations map[string]string) bool {
+	return annotations[AdoptAnnotation] == AdoptAnnotationValue ||
+		annotations[DeprecatedAdoptAnnotation] == AdoptAnnotationValue //nolint:staticcheck // the deprecated annotation is still honored
+}
+
 // Diagnostics is used to configure the diagnostic settings of the virtual machine.
 type Diagnostics struct {
 	// Boot configures the boot diagnostics settings for the virtual machine.
@@ -38,6 +38,12 @@ type AzureClusterClassSpec struct {
 	// +optional
 	AdditionalTags Tags `json:"additionalTags,omitempty"`
 
+	// ClusterLabelTagPrefixes is an optional list of label key prefixes. The labels of the CAPI Cluster whose key starts
+	// with one of the prefixes are added as tags to the Azure resources managed by the Azure provider, with "/" replaced
+	// by "_" in their key as Azure tag keys cannot contain "/". AdditionalTags take precedence over these tags.
+	// +optional
+	ClusterLabelTagPrefixes []string `json:"clusterLabelTagPrefixes,omitempty"`
+
 	// IdentityRef is a reference to an AzureIdentity to be used when reconciling this cluster
 	// +optional
 	IdentityRef *corev1.ObjectReference `json:"identityRef,omitempty"`
@@ -77,6 +83,12 @@ type AzureClusterClassSpec struct {
 	// See: https://learn.microsoft.com/azure/reliability/availability-zones-overview
 	// +optional
 	FailureDomains clusterv1.FailureDomains `json:"failureDomains,omitempty"`
+
+	// RestrictFailureDomains, when true, reports only the failure domains listed in FailureDomains in the
+	// AzureCluster status instead of all the availability zones discovered in the region. Each listed failure
+	// domain must then be an availability zone of the region. It requires FailureDomains to be set.
+	// +optional
+	RestrictFailureDomains bool `json:"restrictFailureDomains,omitempty"`
 }
 
 // AzureManagedControlPlaneClassSpec defines the AzureManagedControlPlane properties that may be shared across several azure managed control planes.
@@ -134,6 +146,11 @@ type AzureManagedControlPlaneClassSpec struct {
 	// +optional
 	NetworkDataplane *NetworkDataplaneType `json:"networkDataplane,omitempty"`
 
+	// KubeProxyConfig defines the kube-proxy configuration of the cluster.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	KubeProxyConfig *ManagedClusterKubeProxyConfig `json:"kubeProxyConfig,omitempty"`
+
 	// Outbound configuration used by Nodes.
 	// +kubebuilder:validation:Enum=loadBalancer;managedNATGateway;userAssignedNATGateway;userDefinedRouting
 	// +optional
@@ -145,6 +162,18 @@ type AzureManagedControlPlaneClassSpec struct {
 	// +optional
 	DNSServiceIP *string `json:"dnsServiceIP,omitempty"`
 
+	// IPFamilies are the IP families of the pod and service IP addresses of the cluster, in the order of the
+	// pod and service CIDR blocks of the Cluster's `spec.clusterNetwork`. Set both IPv4 and IPv6 to create a
+	// dual-stack cluster, in which case the Cluster must have one pod and one service CIDR block of each family.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/configure-kubenet-dual-stack
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=set
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+
 	// LoadBalancerSKU is the SKU of the loadBalancer to be provisioned.
 	// Immutable.
 	// +kubebuilder:validation:Enum=Basic;Standard
@@ -171,8 +200,13 @@ type AzureManagedControlPlaneClassSpec struct {
 	// +optional
 	LoadBalancerProfile *LoadBalancerProfile `json:"loadBalancerProfile,omitempty"`
 
+	// NATGatewayProfile is the profile of the managed NAT gateway of the cluster.
+	// Only applicable when outboundType is managedNATGateway.
+	// +optional
+	NATGatewayProfile *NATGatewayProfile `json:"natGatewayProfile,omitempty"`
+
 	// APIServerAccessProfile is the access profile for AKS API server.
-	// Immutable except for `authorizedIPRanges`.
+	// Immutable except for `authorizedIPRanges` and `disableRunCommand`.
 	// +optional
 	APIServerAccessProfile *APIServerAccessProfile `json:"apiServerAccessProfile,omitempty"`
 
@@ -241,6 +275,36 @@ type AzureManagedControlPlaneClassSpec struct {
 	// +optional
 	SecurityProfile *ManagedClusterSecurityProfile `json:"securityProfile,omitempty"`
 
+	// IngressProfile defines the ingress profile of the cluster.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	IngressProfile *ManagedClusterIngressProfile `json:"ingressProfile,omitempty"`
+
+	// AzureMonitorProfile defines the Azure Monitor profile for the cluster.
+	// +optional
+	AzureMonitorProfile *ManagedClusterAzureMonitorProfile `json:"azureMonitorProfile,omitempty"`
+
+	// MetricsProfile defines the metrics profile of the cluster.
+	// Requires EnablePreviewFeatures to be true.
+	// +optional
+	MetricsProfile *ManagedClusterMetricsProfile `json:"metricsProfile,omitempty"`
+
+	// ServiceMeshProfile defines the service mesh profile of the cluster.
+	// +optional
+	ServiceMeshProfile *ManagedClusterServiceMeshProfile `json:"serviceMeshProfile,omitempty"`
+
+	// AADPodIdentityProfile defines the AAD Pod Identity profile of the cluster.
+	// AAD Pod Identity is deprecated, Microsoft Entra Workload ID should be used instead where possible.
+	// +optional
+	AADPodIdentityProfile *ManagedClusterAADPodIdentityProfile `json:"aadPodIdentityProfile,omitempty"`
+
+	// SystemNodePool defines a system node pool that AKS creates together with the cluster, so the cluster
+	// can be created before any AzureManagedMachinePool with mode System exists. The node pool is not managed
+	// by CAPZ after the cluster is created.
+	// Immutable.
+	// +optional
+	SystemNodePool *ManagedControlPlaneSystemNodePool `json:"systemNodePool,omitempty"`
+
 	// ASOManagedClusterPatches defines JSON merge patches to be applied to the generated ASO ManagedCluster resource.
 	// WARNING: This is meant to be used sparingly to enable features for development and testing that are not
 	// otherwise represented in the CAPZ API. Misconfiguration that conflicts with CAPZ's normal mode of
@@ -260,6 +324,13 @@ type ManagedClusterAutoUpgradeProfile struct {
 	// +kubebuilder:validation:Enum=node-image;none;patch;rapid;stable
 	// +optional
 	UpgradeChannel *UpgradeChannel `json:"upgradeChannel,omitempty"`
+
+	// NodeOSUpgradeChannel determines how the OS of the nodes is automatically updated, separately from
+	// the Kubernetes version. When the UpgradeChannel is node-image, it must be NodeImage or unset.
+	// SecurityPatch requires EnablePreviewFeatures to be true.
+	// +kubebuilder:validation:Enum=None;Unmanaged;NodeImage;SecurityPatch
+	// +optional
+	NodeOSUpgradeChannel *NodeOSUpgradeChannel `json:"nodeOSUpgradeChannel,omitempty"`
 }
 
 // AzureManagedMachinePoolClassSpec defines the AzureManagedMachinePool properties that may be shared across several Azure managed machinepools.
@@ -307,6 +378,16 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	Taints Taints `json:"taints,omitempty"`
 
+	// NodeInitializationTaints specifies the taints added to new nodes of the agent pool, in the format
+	// "key=value:Effect". Unlike Taints, they are not reconciled by AKS on existing nodes and are meant to be
+	// removed, e.g. by a DaemonSet once node initialization is done.
+	// Requires the AzureManagedControlPlane's `spec.enablePreviewFeatures` to be true.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/rest/api/aks/agent-pools/create-or-update?view=rest-aks-2023-11-02-preview&tabs=HTTP#agentpool
+	// +optional
+	NodeInitializationTaints []string `json:"nodeInitializationTaints,omitempty"`
+
 	// Scaling specifies the autoscaling parameters for the node pool.
 	// +optional
 	Scaling *ManagedMachinePoolScaling `json:"scaling,omitempty"`
@@ -346,6 +427,26 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	OSType *string `json:"osType,omitempty"`
 
+	// OSSKU specifies the OS SKU used by the agent pool. Possible values include: 'Ubuntu', 'AzureLinux', 'Windows2019', 'Windows2022'.
+	// 'Ubuntu' and 'AzureLinux' require OSType 'Linux'. 'Windows2019' and 'Windows2022' require OSType 'Windows'.
+	// If not specified, AKS chooses the default OS SKU for the OSType.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/rest/api/aks/agent-pools/create-or-update?tabs=HTTP#ossku
+	// +kubebuilder:validation:Enum=Ubuntu;AzureLinux;Windows2019;Windows2022
+	// +optional
+	OSSKU *OSSKU `json:"osSKU,omitempty"`
+
+	// EnableArtifactStreaming enables artifact streaming on the nodes of the agent pool, which lazily pulls
+	// container image layers to shorten pod startup. Only supported for Linux agent pools with the Ubuntu or
+	// AzureLinux OS SKU. Requires the AzureManagedControlPlane's `spec.enablePreviewFeatures` to be true.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/artifact-streaming
+	// +optional
+	EnableArtifactStreaming *bool `json:"enableArtifactStreaming,omitempty"`
+
 	// EnableNodePublicIP controls whether or not nodes in the pool each have a public IP address.
 	// Immutable.
 	// +optional
@@ -399,6 +500,15 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	SubnetName *string `json:"subnetName,omitempty"`
 
+	// PodSubnetName specifies the Subnet from which pod IPs are dynamically allocated when using Azure CNI.
+	// The subnet must exist in the cluster's virtual network and be different from SubnetName.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/configure-azure-cni-dynamic-ip-allocation
+	// +optional
+	PodSubnetName *string `json:"podSubnetName,omitempty"`
+
 	// EnableFIPS indicates whether FIPS is enabled on the node pool.
 	// Immutable.
 	// +optional
@@ -412,6 +522,23 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	EnableEncryptionAtHost *bool `json:"enableEncryptionAtHost,omitempty"`
 
+	// GPUInstanceProfile specifies the Multi-Instance GPU (MIG) profile used to partition the GPUs of the nodes.
+	// Only supported for VM sizes with NVIDIA A100 or H100 GPUs.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/gpu-multi-instance
+	// +kubebuilder:validation:Enum=MIG1g;MIG2g;MIG3g;MIG4g;MIG7g
+	// +optional
+	GPUInstanceProfile *GPUInstanceProfile `json:"gpuInstanceProfile,omitempty"`
+
+	// UpgradeSettings specifies how the nodes of the pool are surged, drained and soaked during an upgrade.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/upgrade-aks-cluster#customize-node-surge-upgrade
+	// +optional
+	UpgradeSettings *AgentPoolUpgradeSettings `json:"upgradeSettings,omitempty"`
+
 	// ASOManagedClustersAgentPoolPatches defines JSON merge patches to be applied to the generated ASO ManagedClustersAgentPool resource.
 	// WARNING: This is meant to be used sparingly to enable features for development and testing that are not
 	// otherwise represented in the CAPZ API. Misconfiguration that conflicts with CAPZ's normal mode of
@@ -441,6 +568,10 @@ type APIServerAccessProfileClassSpec struct {
 	// EnablePrivateClusterPublicFQDN indicates whether to create additional public FQDN for private cluster or not.
 	// +optional
 	EnablePrivateClusterPublicFQDN *bool `json:"enablePrivateClusterPublicFQDN,omitempty"`
+
+	// DisableRunCommand disables the run command (`az aks command invoke`) for the cluster.
+	// +optional
+	DisableRunCommand *bool `json:"disableRunCommand,omitempty"`
 }
 
 // ExtendedLocationSpec defines the ExtendedLocation properties to enable CAPZ for Azure public MEC.
@@ -466,6 +597,11 @@ type VnetClassSpec struct {
 	// +optional
 	CIDRBlocks []string `json:"cidrBlocks,omitempty"`
 
+	// DNSServers defines the IP addresses of the DNS servers used by the virtual network.
+	// If empty, the Azure-provided DNS is used.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+
 	// Tags is a collection of tags describing the resource.
 	// +optional
 	Tags Tags `json:"tags,omitempty"`
@@ -502,6 +638,51 @@ type LoadBalancerClassSpec struct {
 	// IdleTimeoutInMinutes specifies the timeout for the TCP idle connection.
 	// +optional
 	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+	// AllocatedOutboundPorts specifies the number of SNAT ports allocated to each backend instance by the outbound rule.
+	// It must be a multiple of 8 between 0 and 64000. When omitted or 0, Azure allocates ports based on the backend pool size.
+	// Only supported for the node and control plane outbound load balancers.
+	// +optional
+	AllocatedOutboundPorts *int32 `json:"allocatedOutboundPorts,omitempty"`
+	// HealthProbe configures the health probe of the API server load balancer.
+	// When omitted, an HTTPS probe on the API server port with a request path of /readyz is used.
+	// Only supported for the API server load balancer.
+	// +optional
+	HealthProbe *LoadBalancerHealthProbe `json:"healthProbe,omitempty"`
+	// HAPorts replaces the load balancing rule on the API server port with a high availability ports rule, which load
+	// balances the flows of all protocols on all ports, e.g. to front a network virtual appliance. Immutable.
+	// Only supported for an internal API server load balancer.
+	// +optional
+	HAPorts bool `json:"haPorts,omitempty"`
+	// EnableFloatingIP enables floating IP on the load balancing rule, so the backend instances receive the flows with
+	// the frontend IP as destination instead of their own IP. Immutable.
+	// Only supported for the API server load balancer.
+	// +optional
+	EnableFloatingIP bool `json:"enableFloatingIP,omitempty"`
+}
+
+// LoadBalancerHealthProbe defines the health probe of a load balancer.
+type LoadBalancerHealthProbe struct {
+	// Protocol is the protocol of the health probe. Defaults to Https.
+	// +kubebuilder:validation:Enum=Tcp;Http;Https
+	// +optional
+	Protocol LBProbeProtocol `json:"protocol,omitempty"`
+	// Port is the port the health probe connects to. Defaults to the API server port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// RequestPath is the URI requested for health status. Required for Http and Https probes, and not allowed for Tcp probes.
+	// Defaults to /readyz when the protocol is also defaulted.
+	// +optional
+	RequestPath *string `json:"requestPath,omitempty"`
+	// IntervalInSeconds is the interval between health probes. Defaults to 15.
+	// +kubebuilder:validation:Minimum=5
+	// +optional
+	IntervalInSeconds *int32 `json:"intervalInSeconds,omitempty"`
+	// NumberOfProbes is the number of consecutive failed probes after which a backend is considered unhealthy. Defaults to 4.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NumberOfProbes *int32 `json:"numberOfProbes,omitempty"`
 }
 
 // FleetsMemberClassSpec defines the FleetsMemberSpec properties that may be shared across several Azure clusters.
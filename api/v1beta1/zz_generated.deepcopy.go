@@ -154,6 +154,11 @@ func (in *APIServerAccessProfileClassSpec) DeepCopyInto(out *APIServerAccessProf
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DisableRunCommand != nil {
+		in, out := &in.DisableRunCommand, &out.DisableRunCommand
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerAccessProfileClassSpec.
@@ -223,6 +228,36 @@ func (in *AddressRecord) DeepCopy() *AddressRecord {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolUpgradeSettings) DeepCopyInto(out *AgentPoolUpgradeSettings) {
+	*out = *in
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(string)
+		**out = **in
+	}
+	if in.DrainTimeoutInMinutes != nil {
+		in, out := &in.DrainTimeoutInMinutes, &out.DrainTimeoutInMinutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.NodeSoakDurationInMinutes != nil {
+		in, out := &in.NodeSoakDurationInMinutes, &out.NodeSoakDurationInMinutes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolUpgradeSettings.
+func (in *AgentPoolUpgradeSettings) DeepCopy() *AgentPoolUpgradeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolUpgradeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
 	*out = *in
@@ -256,11 +291,26 @@ func (in *AutoScalerProfile) DeepCopyInto(out *AutoScalerProfile) {
 		*out = new(BalanceSimilarNodeGroups)
 		**out = **in
 	}
+	if in.DaemonsetEvictionForEmptyNodes != nil {
+		in, out := &in.DaemonsetEvictionForEmptyNodes, &out.DaemonsetEvictionForEmptyNodes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DaemonsetEvictionForOccupiedNodes != nil {
+		in, out := &in.DaemonsetEvictionForOccupiedNodes, &out.DaemonsetEvictionForOccupiedNodes
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Expander != nil {
 		in, out := &in.Expander, &out.Expander
 		*out = new(Expander)
 		**out = **in
 	}
+	if in.IgnoreDaemonsetsUtilization != nil {
+		in, out := &in.IgnoreDaemonsetsUtilization, &out.IgnoreDaemonsetsUtilization
+		*out = new(bool)
+		**out = **in
+	}
 	if in.MaxEmptyBulkDelete != nil {
 		in, out := &in.MaxEmptyBulkDelete, &out.MaxEmptyBulkDelete
 		*out = new(string)
@@ -353,6 +403,16 @@ func (in *AzureBastion) DeepCopyInto(out *AzureBastion) {
 	*out = *in
 	in.Subnet.DeepCopyInto(&out.Subnet)
 	in.PublicIP.DeepCopyInto(&out.PublicIP)
+	if in.ScaleUnits != nil {
+		in, out := &in.ScaleUnits, &out.ScaleUnits
+		*out = new(int)
+		**out = **in
+	}
+	if in.DisableCopyPaste != nil {
+		in, out := &in.DisableCopyPaste, &out.DisableCopyPaste
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBastion.
@@ -423,6 +483,11 @@ func (in *AzureClusterClassSpec) DeepCopyInto(out *AzureClusterClassSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ClusterLabelTagPrefixes != nil {
+		in, out := &in.ClusterLabelTagPrefixes, &out.ClusterLabelTagPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.IdentityRef != nil {
 		in, out := &in.IdentityRef, &out.IdentityRef
 		*out = new(corev1.ObjectReference)
@@ -515,6 +580,11 @@ func (in *AzureClusterIdentityList) DeepCopyObject() runtime.Object {
 func (in *AzureClusterIdentitySpec) DeepCopyInto(out *AzureClusterIdentitySpec) {
 	*out = *in
 	out.ClientSecret = in.ClientSecret
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(ClientRateLimit)
+		**out = **in
+	}
 	if in.AllowedNamespaces != nil {
 		in, out := &in.AllowedNamespaces, &out.AllowedNamespaces
 		*out = new(AllowedNamespaces)
@@ -627,6 +697,11 @@ func (in *AzureClusterStatus) DeepCopyInto(out *AzureClusterStatus) {
 		*out = make(Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.ManagedResources != nil {
+		in, out := &in.ManagedResources, &out.ManagedResources
+		*out = new(ManagedResources)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClusterStatus.
@@ -889,6 +964,11 @@ func (in *AzureMachineSpec) DeepCopyInto(out *AzureMachineSpec) {
 		*out = new(SystemAssignedIdentityRole)
 		**out = **in
 	}
+	if in.AdditionalRoleAssignments != nil {
+		in, out := &in.AdditionalRoleAssignments, &out.AdditionalRoleAssignments
+		*out = make([]RoleAssignment, len(*in))
+		copy(*out, *in)
+	}
 	in.OSDisk.DeepCopyInto(&out.OSDisk)
 	if in.DataDisks != nil {
 		in, out := &in.DataDisks, &out.DataDisks
@@ -958,6 +1038,20 @@ func (in *AzureMachineSpec) DeepCopyInto(out *AzureMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GalleryApplications != nil {
+		in, out := &in.GalleryApplications, &out.GalleryApplications
+		*out = make([]VMGalleryApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]VaultSecretGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachineSpec.
@@ -1366,6 +1460,11 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 		*out = new(NetworkDataplaneType)
 		**out = **in
 	}
+	if in.KubeProxyConfig != nil {
+		in, out := &in.KubeProxyConfig, &out.KubeProxyConfig
+		*out = new(ManagedClusterKubeProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OutboundType != nil {
 		in, out := &in.OutboundType, &out.OutboundType
 		*out = new(ManagedControlPlaneOutboundType)
@@ -1376,6 +1475,11 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPFamilies != nil {
+		in, out := &in.IPFamilies, &out.IPFamilies
+		*out = make([]IPFamily, len(*in))
+		copy(*out, *in)
+	}
 	if in.LoadBalancerSKU != nil {
 		in, out := &in.LoadBalancerSKU, &out.LoadBalancerSKU
 		*out = new(string)
@@ -1408,6 +1512,11 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 		*out = new(LoadBalancerProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NATGatewayProfile != nil {
+		in, out := &in.NATGatewayProfile, &out.NATGatewayProfile
+		*out = new(NATGatewayProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.APIServerAccessProfile != nil {
 		in, out := &in.APIServerAccessProfile, &out.APIServerAccessProfile
 		*out = new(APIServerAccessProfile)
@@ -1421,7 +1530,7 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 	if in.Identity != nil {
 		in, out := &in.Identity, &out.Identity
 		*out = new(Identity)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.HTTPProxyConfig != nil {
 		in, out := &in.HTTPProxyConfig, &out.HTTPProxyConfig
@@ -1460,6 +1569,36 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 		*out = new(ManagedClusterSecurityProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IngressProfile != nil {
+		in, out := &in.IngressProfile, &out.IngressProfile
+		*out = new(ManagedClusterIngressProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureMonitorProfile != nil {
+		in, out := &in.AzureMonitorProfile, &out.AzureMonitorProfile
+		*out = new(ManagedClusterAzureMonitorProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsProfile != nil {
+		in, out := &in.MetricsProfile, &out.MetricsProfile
+		*out = new(ManagedClusterMetricsProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceMeshProfile != nil {
+		in, out := &in.ServiceMeshProfile, &out.ServiceMeshProfile
+		*out = new(ManagedClusterServiceMeshProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AADPodIdentityProfile != nil {
+		in, out := &in.AADPodIdentityProfile, &out.AADPodIdentityProfile
+		*out = new(ManagedClusterAADPodIdentityProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemNodePool != nil {
+		in, out := &in.SystemNodePool, &out.SystemNodePool
+		*out = new(ManagedControlPlaneSystemNodePool)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ASOManagedClusterPatches != nil {
 		in, out := &in.ASOManagedClusterPatches, &out.ASOManagedClusterPatches
 		*out = make([]string, len(*in))
@@ -1518,6 +1657,13 @@ func (in *AzureManagedControlPlaneList) DeepCopyObject() runtime.Object {
 func (in *AzureManagedControlPlaneSpec) DeepCopyInto(out *AzureManagedControlPlaneSpec) {
 	*out = *in
 	in.AzureManagedControlPlaneClassSpec.DeepCopyInto(&out.AzureManagedControlPlaneClassSpec)
+	if in.NodeResourceGroupTags != nil {
+		in, out := &in.NodeResourceGroupTags, &out.NodeResourceGroupTags
+		*out = make(Tags, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.SSHPublicKey != nil {
 		in, out := &in.SSHPublicKey, &out.SSHPublicKey
@@ -1534,6 +1680,16 @@ func (in *AzureManagedControlPlaneSpec) DeepCopyInto(out *AzureManagedControlPla
 		*out = new(FleetsMember)
 		**out = **in
 	}
+	if in.SupportPlan != nil {
+		in, out := &in.SupportPlan, &out.SupportPlan
+		*out = new(KubernetesSupportPlan)
+		**out = **in
+	}
+	if in.PowerState != nil {
+		in, out := &in.PowerState, &out.PowerState
+		*out = new(ManagedClusterPowerState)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedControlPlaneSpec.
@@ -1566,6 +1722,11 @@ func (in *AzureManagedControlPlaneStatus) DeepCopyInto(out *AzureManagedControlP
 		*out = new(OIDCIssuerProfileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AvailableUpgrades != nil {
+		in, out := &in.AvailableUpgrades, &out.AvailableUpgrades
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedControlPlaneStatus.
@@ -1763,6 +1924,11 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = make(Taints, len(*in))
 		copy(*out, *in)
 	}
+	if in.NodeInitializationTaints != nil {
+		in, out := &in.NodeInitializationTaints, &out.NodeInitializationTaints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Scaling != nil {
 		in, out := &in.Scaling, &out.Scaling
 		*out = new(ManagedMachinePoolScaling)
@@ -1788,6 +1954,16 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = new(string)
 		**out = **in
 	}
+	if in.OSSKU != nil {
+		in, out := &in.OSSKU, &out.OSSKU
+		*out = new(OSSKU)
+		**out = **in
+	}
+	if in.EnableArtifactStreaming != nil {
+		in, out := &in.EnableArtifactStreaming, &out.EnableArtifactStreaming
+		*out = new(bool)
+		**out = **in
+	}
 	if in.EnableNodePublicIP != nil {
 		in, out := &in.EnableNodePublicIP, &out.EnableNodePublicIP
 		*out = new(bool)
@@ -1833,6 +2009,11 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = new(string)
 		**out = **in
 	}
+	if in.PodSubnetName != nil {
+		in, out := &in.PodSubnetName, &out.PodSubnetName
+		*out = new(string)
+		**out = **in
+	}
 	if in.EnableFIPS != nil {
 		in, out := &in.EnableFIPS, &out.EnableFIPS
 		*out = new(bool)
@@ -1843,6 +2024,16 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = new(bool)
 		**out = **in
 	}
+	if in.GPUInstanceProfile != nil {
+		in, out := &in.GPUInstanceProfile, &out.GPUInstanceProfile
+		*out = new(GPUInstanceProfile)
+		**out = **in
+	}
+	if in.UpgradeSettings != nil {
+		in, out := &in.UpgradeSettings, &out.UpgradeSettings
+		*out = new(AgentPoolUpgradeSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ASOManagedClustersAgentPoolPatches != nil {
 		in, out := &in.ASOManagedClustersAgentPoolPatches, &out.ASOManagedClustersAgentPoolPatches
 		*out = make([]string, len(*in))
@@ -2234,6 +2425,21 @@ func (in *BuildParams) DeepCopy() *BuildParams {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientRateLimit) DeepCopyInto(out *ClientRateLimit) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientRateLimit.
+func (in *ClientRateLimit) DeepCopy() *ClientRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProviderConfigOverrides) DeepCopyInto(out *CloudProviderConfigOverrides) {
 	*out = *in
@@ -2536,6 +2742,11 @@ func (in *IPTag) DeepCopy() *IPTag {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Identity) DeepCopyInto(out *Identity) {
 	*out = *in
+	if in.AdditionalRoleAssignments != nil {
+		in, out := &in.AdditionalRoleAssignments, &out.AdditionalRoleAssignments
+		*out = make([]RoleAssignment, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Identity.
@@ -2711,6 +2922,16 @@ func (in *LoadBalancerClassSpec) DeepCopyInto(out *LoadBalancerClassSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(LoadBalancerHealthProbe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassSpec.
@@ -2723,6 +2944,41 @@ func (in *LoadBalancerClassSpec) DeepCopy() *LoadBalancerClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerHealthProbe) DeepCopyInto(out *LoadBalancerHealthProbe) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequestPath != nil {
+		in, out := &in.RequestPath, &out.RequestPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.IntervalInSeconds != nil {
+		in, out := &in.IntervalInSeconds, &out.IntervalInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NumberOfProbes != nil {
+		in, out := &in.NumberOfProbes, &out.NumberOfProbes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerHealthProbe.
+func (in *LoadBalancerHealthProbe) DeepCopy() *LoadBalancerHealthProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerHealthProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerProfile) DeepCopyInto(out *LoadBalancerProfile) {
 	*out = *in
@@ -2779,6 +3035,13 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		**out = **in
 	}
 	out.BackendPool = in.BackendPool
+	if in.OutboundBackendPools != nil {
+		in, out := &in.OutboundBackendPools, &out.OutboundBackendPools
+		*out = make([]OutboundBackendPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.LoadBalancerClassSpec.DeepCopyInto(&out.LoadBalancerClassSpec)
 }
 
@@ -2793,167 +3056,587 @@ func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterAutoUpgradeProfile) DeepCopyInto(out *ManagedClusterAutoUpgradeProfile) {
+func (in *ManagedClusterAADPodIdentity) DeepCopyInto(out *ManagedClusterAADPodIdentity) {
 	*out = *in
-	if in.UpgradeChannel != nil {
-		in, out := &in.UpgradeChannel, &out.UpgradeChannel
-		*out = new(UpgradeChannel)
-		**out = **in
-	}
+	out.Identity = in.Identity
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAutoUpgradeProfile.
-func (in *ManagedClusterAutoUpgradeProfile) DeepCopy() *ManagedClusterAutoUpgradeProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAADPodIdentity.
+func (in *ManagedClusterAADPodIdentity) DeepCopy() *ManagedClusterAADPodIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterAutoUpgradeProfile)
+	out := new(ManagedClusterAADPodIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfile) DeepCopyInto(out *ManagedClusterSecurityProfile) {
+func (in *ManagedClusterAADPodIdentityException) DeepCopyInto(out *ManagedClusterAADPodIdentityException) {
 	*out = *in
-	if in.AzureKeyVaultKms != nil {
-		in, out := &in.AzureKeyVaultKms, &out.AzureKeyVaultKms
-		*out = new(AzureKeyVaultKms)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Defender != nil {
-		in, out := &in.Defender, &out.Defender
-		*out = new(ManagedClusterSecurityProfileDefender)
-		**out = **in
-	}
-	if in.ImageCleaner != nil {
-		in, out := &in.ImageCleaner, &out.ImageCleaner
-		*out = new(ManagedClusterSecurityProfileImageCleaner)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WorkloadIdentity != nil {
-		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
-		*out = new(ManagedClusterSecurityProfileWorkloadIdentity)
-		**out = **in
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfile.
-func (in *ManagedClusterSecurityProfile) DeepCopy() *ManagedClusterSecurityProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAADPodIdentityException.
+func (in *ManagedClusterAADPodIdentityException) DeepCopy() *ManagedClusterAADPodIdentityException {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfile)
+	out := new(ManagedClusterAADPodIdentityException)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileDefender) DeepCopyInto(out *ManagedClusterSecurityProfileDefender) {
+func (in *ManagedClusterAADPodIdentityProfile) DeepCopyInto(out *ManagedClusterAADPodIdentityProfile) {
 	*out = *in
-	out.SecurityMonitoring = in.SecurityMonitoring
+	if in.UserAssignedIdentities != nil {
+		in, out := &in.UserAssignedIdentities, &out.UserAssignedIdentities
+		*out = make([]ManagedClusterAADPodIdentity, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserAssignedIdentityExceptions != nil {
+		in, out := &in.UserAssignedIdentityExceptions, &out.UserAssignedIdentityExceptions
+		*out = make([]ManagedClusterAADPodIdentityException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefender.
-func (in *ManagedClusterSecurityProfileDefender) DeepCopy() *ManagedClusterSecurityProfileDefender {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAADPodIdentityProfile.
+func (in *ManagedClusterAADPodIdentityProfile) DeepCopy() *ManagedClusterAADPodIdentityProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileDefender)
+	out := new(ManagedClusterAADPodIdentityProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopyInto(out *ManagedClusterSecurityProfileDefenderSecurityMonitoring) {
+func (in *ManagedClusterAADPodIdentityUserAssignedIdentity) DeepCopyInto(out *ManagedClusterAADPodIdentityUserAssignedIdentity) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefenderSecurityMonitoring.
-func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopy() *ManagedClusterSecurityProfileDefenderSecurityMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAADPodIdentityUserAssignedIdentity.
+func (in *ManagedClusterAADPodIdentityUserAssignedIdentity) DeepCopy() *ManagedClusterAADPodIdentityUserAssignedIdentity {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileDefenderSecurityMonitoring)
+	out := new(ManagedClusterAADPodIdentityUserAssignedIdentity)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopyInto(out *ManagedClusterSecurityProfileImageCleaner) {
+func (in *ManagedClusterAutoUpgradeProfile) DeepCopyInto(out *ManagedClusterAutoUpgradeProfile) {
 	*out = *in
-	if in.IntervalHours != nil {
-		in, out := &in.IntervalHours, &out.IntervalHours
-		*out = new(int)
+	if in.UpgradeChannel != nil {
+		in, out := &in.UpgradeChannel, &out.UpgradeChannel
+		*out = new(UpgradeChannel)
+		**out = **in
+	}
+	if in.NodeOSUpgradeChannel != nil {
+		in, out := &in.NodeOSUpgradeChannel, &out.NodeOSUpgradeChannel
+		*out = new(NodeOSUpgradeChannel)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileImageCleaner.
-func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopy() *ManagedClusterSecurityProfileImageCleaner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAutoUpgradeProfile.
+func (in *ManagedClusterAutoUpgradeProfile) DeepCopy() *ManagedClusterAutoUpgradeProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileImageCleaner)
+	out := new(ManagedClusterAutoUpgradeProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopyInto(out *ManagedClusterSecurityProfileWorkloadIdentity) {
+func (in *ManagedClusterAzureMonitorProfile) DeepCopyInto(out *ManagedClusterAzureMonitorProfile) {
 	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ManagedClusterAzureMonitorProfileMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerInsights != nil {
+		in, out := &in.ContainerInsights, &out.ContainerInsights
+		*out = new(ManagedClusterAzureMonitorProfileContainerInsights)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileWorkloadIdentity.
-func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopy() *ManagedClusterSecurityProfileWorkloadIdentity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfile.
+func (in *ManagedClusterAzureMonitorProfile) DeepCopy() *ManagedClusterAzureMonitorProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileWorkloadIdentity)
+	out := new(ManagedClusterAzureMonitorProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedControlPlaneSubnet) DeepCopyInto(out *ManagedControlPlaneSubnet) {
+func (in *ManagedClusterAzureMonitorProfileContainerInsights) DeepCopyInto(out *ManagedClusterAzureMonitorProfileContainerInsights) {
 	*out = *in
-	if in.ServiceEndpoints != nil {
-		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
-		*out = make(ServiceEndpoints, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LogAnalyticsWorkspaceResourceID != nil {
+		in, out := &in.LogAnalyticsWorkspaceResourceID, &out.LogAnalyticsWorkspaceResourceID
+		*out = new(string)
+		**out = **in
 	}
-	if in.PrivateEndpoints != nil {
-		in, out := &in.PrivateEndpoints, &out.PrivateEndpoints
-		*out = make(PrivateEndpoints, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.WindowsHostLogs != nil {
+		in, out := &in.WindowsHostLogs, &out.WindowsHostLogs
+		*out = new(ManagedClusterAzureMonitorProfileWindowsHostLogs)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSubnet.
-func (in *ManagedControlPlaneSubnet) DeepCopy() *ManagedControlPlaneSubnet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileContainerInsights.
+func (in *ManagedClusterAzureMonitorProfileContainerInsights) DeepCopy() *ManagedClusterAzureMonitorProfileContainerInsights {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedControlPlaneSubnet)
+	out := new(ManagedClusterAzureMonitorProfileContainerInsights)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedControlPlaneVirtualNetwork) DeepCopyInto(out *ManagedControlPlaneVirtualNetwork) {
+func (in *ManagedClusterAzureMonitorProfileKubeStateMetrics) DeepCopyInto(out *ManagedClusterAzureMonitorProfileKubeStateMetrics) {
 	*out = *in
-	in.ManagedControlPlaneVirtualNetworkClassSpec.DeepCopyInto(&out.ManagedControlPlaneVirtualNetworkClassSpec)
+	if in.MetricAnnotationsAllowList != nil {
+		in, out := &in.MetricAnnotationsAllowList, &out.MetricAnnotationsAllowList
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricLabelsAllowlist != nil {
+		in, out := &in.MetricLabelsAllowlist, &out.MetricLabelsAllowlist
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneVirtualNetwork.
-func (in *ManagedControlPlaneVirtualNetwork) DeepCopy() *ManagedControlPlaneVirtualNetwork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileKubeStateMetrics.
+func (in *ManagedClusterAzureMonitorProfileKubeStateMetrics) DeepCopy() *ManagedClusterAzureMonitorProfileKubeStateMetrics {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedControlPlaneVirtualNetwork)
+	out := new(ManagedClusterAzureMonitorProfileKubeStateMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAzureMonitorProfileMetrics) DeepCopyInto(out *ManagedClusterAzureMonitorProfileMetrics) {
+	*out = *in
+	if in.KubeStateMetrics != nil {
+		in, out := &in.KubeStateMetrics, &out.KubeStateMetrics
+		*out = new(ManagedClusterAzureMonitorProfileKubeStateMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileMetrics.
+func (in *ManagedClusterAzureMonitorProfileMetrics) DeepCopy() *ManagedClusterAzureMonitorProfileMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAzureMonitorProfileMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAzureMonitorProfileWindowsHostLogs) DeepCopyInto(out *ManagedClusterAzureMonitorProfileWindowsHostLogs) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileWindowsHostLogs.
+func (in *ManagedClusterAzureMonitorProfileWindowsHostLogs) DeepCopy() *ManagedClusterAzureMonitorProfileWindowsHostLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAzureMonitorProfileWindowsHostLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterCostAnalysis) DeepCopyInto(out *ManagedClusterCostAnalysis) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterCostAnalysis.
+func (in *ManagedClusterCostAnalysis) DeepCopy() *ManagedClusterCostAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterCostAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterIngressProfile) DeepCopyInto(out *ManagedClusterIngressProfile) {
+	*out = *in
+	if in.WebAppRouting != nil {
+		in, out := &in.WebAppRouting, &out.WebAppRouting
+		*out = new(ManagedClusterIngressProfileWebAppRouting)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterIngressProfile.
+func (in *ManagedClusterIngressProfile) DeepCopy() *ManagedClusterIngressProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterIngressProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterIngressProfileWebAppRouting) DeepCopyInto(out *ManagedClusterIngressProfileWebAppRouting) {
+	*out = *in
+	if in.DNSZoneResourceIDs != nil {
+		in, out := &in.DNSZoneResourceIDs, &out.DNSZoneResourceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterIngressProfileWebAppRouting.
+func (in *ManagedClusterIngressProfileWebAppRouting) DeepCopy() *ManagedClusterIngressProfileWebAppRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterIngressProfileWebAppRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterIstioServiceMesh) DeepCopyInto(out *ManagedClusterIstioServiceMesh) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterIstioServiceMesh.
+func (in *ManagedClusterIstioServiceMesh) DeepCopy() *ManagedClusterIstioServiceMesh {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterIstioServiceMesh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterKubeProxyConfig) DeepCopyInto(out *ManagedClusterKubeProxyConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(KubeProxyMode)
+		**out = **in
+	}
+	if in.IPVSConfig != nil {
+		in, out := &in.IPVSConfig, &out.IPVSConfig
+		*out = new(ManagedClusterKubeProxyIPVSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterKubeProxyConfig.
+func (in *ManagedClusterKubeProxyConfig) DeepCopy() *ManagedClusterKubeProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterKubeProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterKubeProxyIPVSConfig) DeepCopyInto(out *ManagedClusterKubeProxyIPVSConfig) {
+	*out = *in
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(IPVSScheduler)
+		**out = **in
+	}
+	if in.TCPTimeoutSeconds != nil {
+		in, out := &in.TCPTimeoutSeconds, &out.TCPTimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.TCPFinTimeoutSeconds != nil {
+		in, out := &in.TCPFinTimeoutSeconds, &out.TCPFinTimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.UDPTimeoutSeconds != nil {
+		in, out := &in.UDPTimeoutSeconds, &out.UDPTimeoutSeconds
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterKubeProxyIPVSConfig.
+func (in *ManagedClusterKubeProxyIPVSConfig) DeepCopy() *ManagedClusterKubeProxyIPVSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterKubeProxyIPVSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterMetricsProfile) DeepCopyInto(out *ManagedClusterMetricsProfile) {
+	*out = *in
+	if in.CostAnalysis != nil {
+		in, out := &in.CostAnalysis, &out.CostAnalysis
+		*out = new(ManagedClusterCostAnalysis)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterMetricsProfile.
+func (in *ManagedClusterMetricsProfile) DeepCopy() *ManagedClusterMetricsProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterMetricsProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfile) DeepCopyInto(out *ManagedClusterSecurityProfile) {
+	*out = *in
+	if in.AzureKeyVaultKms != nil {
+		in, out := &in.AzureKeyVaultKms, &out.AzureKeyVaultKms
+		*out = new(AzureKeyVaultKms)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Defender != nil {
+		in, out := &in.Defender, &out.Defender
+		*out = new(ManagedClusterSecurityProfileDefender)
+		**out = **in
+	}
+	if in.ImageCleaner != nil {
+		in, out := &in.ImageCleaner, &out.ImageCleaner
+		*out = new(ManagedClusterSecurityProfileImageCleaner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageIntegrity != nil {
+		in, out := &in.ImageIntegrity, &out.ImageIntegrity
+		*out = new(ManagedClusterSecurityProfileImageIntegrity)
+		**out = **in
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(ManagedClusterSecurityProfileWorkloadIdentity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfile.
+func (in *ManagedClusterSecurityProfile) DeepCopy() *ManagedClusterSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileDefender) DeepCopyInto(out *ManagedClusterSecurityProfileDefender) {
+	*out = *in
+	out.SecurityMonitoring = in.SecurityMonitoring
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefender.
+func (in *ManagedClusterSecurityProfileDefender) DeepCopy() *ManagedClusterSecurityProfileDefender {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileDefender)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopyInto(out *ManagedClusterSecurityProfileDefenderSecurityMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefenderSecurityMonitoring.
+func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopy() *ManagedClusterSecurityProfileDefenderSecurityMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileDefenderSecurityMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopyInto(out *ManagedClusterSecurityProfileImageCleaner) {
+	*out = *in
+	if in.IntervalHours != nil {
+		in, out := &in.IntervalHours, &out.IntervalHours
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileImageCleaner.
+func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopy() *ManagedClusterSecurityProfileImageCleaner {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileImageCleaner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileImageIntegrity) DeepCopyInto(out *ManagedClusterSecurityProfileImageIntegrity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileImageIntegrity.
+func (in *ManagedClusterSecurityProfileImageIntegrity) DeepCopy() *ManagedClusterSecurityProfileImageIntegrity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileImageIntegrity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopyInto(out *ManagedClusterSecurityProfileWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileWorkloadIdentity.
+func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopy() *ManagedClusterSecurityProfileWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterServiceMeshProfile) DeepCopyInto(out *ManagedClusterServiceMeshProfile) {
+	*out = *in
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(ManagedClusterIstioServiceMesh)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterServiceMeshProfile.
+func (in *ManagedClusterServiceMeshProfile) DeepCopy() *ManagedClusterServiceMeshProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterServiceMeshProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneSubnet) DeepCopyInto(out *ManagedControlPlaneSubnet) {
+	*out = *in
+	if in.ServiceEndpoints != nil {
+		in, out := &in.ServiceEndpoints, &out.ServiceEndpoints
+		*out = make(ServiceEndpoints, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrivateEndpoints != nil {
+		in, out := &in.PrivateEndpoints, &out.PrivateEndpoints
+		*out = make(PrivateEndpoints, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSubnet.
+func (in *ManagedControlPlaneSubnet) DeepCopy() *ManagedControlPlaneSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneSystemNodePool) DeepCopyInto(out *ManagedControlPlaneSystemNodePool) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.OSDiskSizeGB != nil {
+		in, out := &in.OSDiskSizeGB, &out.OSDiskSizeGB
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneSystemNodePool.
+func (in *ManagedControlPlaneSystemNodePool) DeepCopy() *ManagedControlPlaneSystemNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneSystemNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedControlPlaneVirtualNetwork) DeepCopyInto(out *ManagedControlPlaneVirtualNetwork) {
+	*out = *in
+	in.ManagedControlPlaneVirtualNetworkClassSpec.DeepCopyInto(&out.ManagedControlPlaneVirtualNetworkClassSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedControlPlaneVirtualNetwork.
+func (in *ManagedControlPlaneVirtualNetwork) DeepCopy() *ManagedControlPlaneVirtualNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedControlPlaneVirtualNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3024,11 +3707,56 @@ func (in *ManagedMachinePoolScaling) DeepCopy() *ManagedMachinePoolScaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedResources) DeepCopyInto(out *ManagedResources) {
+	*out = *in
+	if in.ResourceIDs != nil {
+		in, out := &in.ResourceIDs, &out.ResourceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedResources.
+func (in *ManagedResources) DeepCopy() *ManagedResources {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayProfile) DeepCopyInto(out *NATGatewayProfile) {
+	*out = *in
+	if in.ManagedOutboundIPCount != nil {
+		in, out := &in.ManagedOutboundIPCount, &out.ManagedOutboundIPCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayProfile.
+func (in *NATGatewayProfile) DeepCopy() *NATGatewayProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(NATGatewayProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGateway) DeepCopyInto(out *NatGateway) {
 	*out = *in
 	in.NatGatewayIP.DeepCopyInto(&out.NatGatewayIP)
-	out.NatGatewayClassSpec = in.NatGatewayClassSpec
+	in.NatGatewayClassSpec.DeepCopyInto(&out.NatGatewayClassSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGateway.
@@ -3044,6 +3772,11 @@ func (in *NatGateway) DeepCopy() *NatGateway {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGatewayClassSpec) DeepCopyInto(out *NatGatewayClassSpec) {
 	*out = *in
+	if in.PublicIPPrefixes != nil {
+		in, out := &in.PublicIPPrefixes, &out.PublicIPPrefixes
+		*out = make([]NatGatewayPublicIPPrefix, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayClassSpec.
@@ -3056,6 +3789,21 @@ func (in *NatGatewayClassSpec) DeepCopy() *NatGatewayClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayPublicIPPrefix) DeepCopyInto(out *NatGatewayPublicIPPrefix) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayPublicIPPrefix.
+func (in *NatGatewayPublicIPPrefix) DeepCopy() *NatGatewayPublicIPPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayPublicIPPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkClassSpec) DeepCopyInto(out *NetworkClassSpec) {
 	*out = *in
@@ -3117,6 +3865,16 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = new(LoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AllowedSSHSourceCIDRs != nil {
+		in, out := &in.AllowedSSHSourceCIDRs, &out.AllowedSSHSourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateLinkService != nil {
+		in, out := &in.PrivateLinkService, &out.PrivateLinkService
+		*out = new(PrivateLinkServiceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	out.NetworkClassSpec = in.NetworkClassSpec
 }
 
@@ -3235,6 +3993,33 @@ func (in *OSDisk) DeepCopy() *OSDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutboundBackendPool) DeepCopyInto(out *OutboundBackendPool) {
+	*out = *in
+	if in.FrontendIPs != nil {
+		in, out := &in.FrontendIPs, &out.FrontendIPs
+		*out = make([]FrontendIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllocatedOutboundPorts != nil {
+		in, out := &in.AllocatedOutboundPorts, &out.AllocatedOutboundPorts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutboundBackendPool.
+func (in *OutboundBackendPool) DeepCopy() *OutboundBackendPool {
+	if in == nil {
+		return nil
+	}
+	out := new(OutboundBackendPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateEndpointSpec) DeepCopyInto(out *PrivateEndpointSpec) {
 	*out = *in
@@ -3308,6 +4093,51 @@ func (in *PrivateLinkServiceConnection) DeepCopy() *PrivateLinkServiceConnection
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkServiceNATIPConfiguration) DeepCopyInto(out *PrivateLinkServiceNATIPConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkServiceNATIPConfiguration.
+func (in *PrivateLinkServiceNATIPConfiguration) DeepCopy() *PrivateLinkServiceNATIPConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkServiceNATIPConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateLinkServiceSpec) DeepCopyInto(out *PrivateLinkServiceSpec) {
+	*out = *in
+	if in.NATIPConfigurations != nil {
+		in, out := &in.NATIPConfigurations, &out.NATIPConfigurations
+		*out = make([]PrivateLinkServiceNATIPConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	if in.VisibilitySubscriptions != nil {
+		in, out := &in.VisibilitySubscriptions, &out.VisibilitySubscriptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoApprovalSubscriptions != nil {
+		in, out := &in.AutoApprovalSubscriptions, &out.AutoApprovalSubscriptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateLinkServiceSpec.
+func (in *PrivateLinkServiceSpec) DeepCopy() *PrivateLinkServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateLinkServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIPSpec) DeepCopyInto(out *PublicIPSpec) {
 	*out = *in
@@ -3316,6 +4146,11 @@ func (in *PublicIPSpec) DeepCopyInto(out *PublicIPSpec) {
 		*out = make([]IPTag, len(*in))
 		copy(*out, *in)
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPSpec.
@@ -3369,6 +4204,21 @@ func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleAssignment) DeepCopyInto(out *RoleAssignment) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignment.
+func (in *RoleAssignment) DeepCopy() *RoleAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
@@ -3575,6 +4425,11 @@ func (in *SpotVMOptions) DeepCopyInto(out *SpotVMOptions) {
 		*out = new(SpotEvictionPolicy)
 		**out = **in
 	}
+	if in.MaxStartAttempts != nil {
+		in, out := &in.MaxStartAttempts, &out.MaxStartAttempts
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotVMOptions.
@@ -3645,7 +4500,7 @@ func (in *SubnetTemplateSpec) DeepCopyInto(out *SubnetTemplateSpec) {
 	*out = *in
 	in.SubnetClassSpec.DeepCopyInto(&out.SubnetClassSpec)
 	in.SecurityGroup.DeepCopyInto(&out.SecurityGroup)
-	out.NatGateway = in.NatGateway
+	in.NatGateway.DeepCopyInto(&out.NatGateway)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetTemplateSpec.
@@ -4029,6 +4884,71 @@ func (in *VMExtension) DeepCopy() *VMExtension {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMGalleryApplication) DeepCopyInto(out *VMGalleryApplication) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConfigurationReference != nil {
+		in, out := &in.ConfigurationReference, &out.ConfigurationReference
+		*out = new(string)
+		**out = **in
+	}
+	if in.TreatFailureAsDeploymentFailure != nil {
+		in, out := &in.TreatFailureAsDeploymentFailure, &out.TreatFailureAsDeploymentFailure
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMGalleryApplication.
+func (in *VMGalleryApplication) DeepCopy() *VMGalleryApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(VMGalleryApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCertificate) DeepCopyInto(out *VaultCertificate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCertificate.
+func (in *VaultCertificate) DeepCopy() *VaultCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretGroup) DeepCopyInto(out *VaultSecretGroup) {
+	*out = *in
+	if in.VaultCertificates != nil {
+		in, out := &in.VaultCertificates, &out.VaultCertificates
+		*out = make([]VaultCertificate, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretGroup.
+func (in *VaultSecretGroup) DeepCopy() *VaultSecretGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VnetClassSpec) DeepCopyInto(out *VnetClassSpec) {
 	*out = *in
@@ -4037,6 +4957,11 @@ func (in *VnetClassSpec) DeepCopyInto(out *VnetClassSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(Tags, len(*in))
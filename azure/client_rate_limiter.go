@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	clientRateLimitQPS = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capz_azure_client_rate_limit_qps",
+			Help: "Number of Azure Resource Manager requests per second CAPZ is allowed to make to a subscription.",
+		},
+		[]string{"subscription_id"},
+	)
+	clientRateLimitBurst = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "capz_azure_client_rate_limit_burst",
+			Help: "Number of Azure Resource Manager requests CAPZ is allowed to make to a subscription at once.",
+		},
+		[]string{"subscription_id"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(clientRateLimitQPS, clientRateLimitBurst)
+}
+
+// rateLimit is a limit on the rate of requests.
+type rateLimit struct {
+	qps   float64
+	burst int
+}
+
+// subscriptionRateLimiter limits the rate of the requests made to a subscription.
+type subscriptionRateLimiter struct {
+	// limits holds the limit set by each owner. The lowest of them applies, so that no owner overrides a lower
+	// limit set by another.
+	limits  map[string]rateLimit
+	limiter *rate.Limiter
+}
+
+var (
+	subscriptionRateLimitersMu sync.RWMutex
+	// subscriptionRateLimiters holds the rate limiters of the subscriptions with a limit, keyed by lowercase
+	// subscription ID.
+	subscriptionRateLimiters = map[string]*subscriptionRateLimiter{}
+)
+
+// SetSubscriptionRateLimit sets the limit of an owner, e.g. an identity, on the rate of the requests made to the
+// subscription by clients created with ARMClientOptions to qps requests per second, in bursts of up to burst
+// requests. A burst of zero or less defaults to qps. A qps of zero or less removes the limit of the owner. When
+// several owners set a limit for the same subscription, the lowest qps and burst apply.
+func SetSubscriptionRateLimit(subscriptionID, owner string, qps float64, burst int) {
+	key := strings.ToLower(subscriptionID)
+	if burst <= 0 {
+		burst = max(int(qps), 1)
+	}
+
+	subscriptionRateLimitersMu.Lock()
+	defer subscriptionRateLimitersMu.Unlock()
+
+	existing, ok := subscriptionRateLimiters[key]
+	if qps <= 0 {
+		if ok {
+			delete(existing.limits, owner)
+			updateSubscriptionRateLimiter(key, existing)
+		}
+		return
+	}
+
+	if !ok {
+		existing = &subscriptionRateLimiter{limits: map[string]rateLimit{}}
+		subscriptionRateLimiters[key] = existing
+	}
+	existing.limits[owner] = rateLimit{qps: qps, burst: burst}
+	updateSubscriptionRateLimiter(key, existing)
+}
+
+// RemoveRateLimitOwner removes the limits an owner set on the rate of the requests made to any subscription, e.g.
+// once the identity which set them is deleted.
+func RemoveRateLimitOwner(owner string) {
+	subscriptionRateLimitersMu.Lock()
+	defer subscriptionRateLimitersMu.Unlock()
+
+	for key, l := range subscriptionRateLimiters {
+		if _, ok := l.limits[owner]; ok {
+			delete(l.limits, owner)
+			updateSubscriptionRateLimiter(key, l)
+		}
+	}
+}
+
+// updateSubscriptionRateLimiter applies the lowest of the limits of a subscription to its rate limiter, or removes the
+// rate limiter once no owner sets a limit. It must be called with subscriptionRateLimitersMu held.
+func updateSubscriptionRateLimiter(key string, l *subscriptionRateLimiter) {
+	if len(l.limits) == 0 {
+		delete(subscriptionRateLimiters, key)
+		clientRateLimitQPS.DeleteLabelValues(key)
+		clientRateLimitBurst.DeleteLabelValues(key)
+		return
+	}
+
+	var lowest *rateLimit
+	for _, limit := range l.limits {
+		if lowest == nil {
+			lowest = &rateLimit{qps: limit.qps, burst: limit.burst}
+			continue
+		}
+		lowest.qps = min(lowest.qps, limit.qps)
+		lowest.burst = min(lowest.burst, limit.burst)
+	}
+
+	if l.limiter == nil {
+		l.limiter = rate.NewLimiter(rate.Limit(lowest.qps), lowest.burst)
+	} else {
+		// Update the existing limiter in place so the requests waiting on it observe the new limit.
+		l.limiter.SetLimit(rate.Limit(lowest.qps))
+		l.limiter.SetBurst(lowest.burst)
+	}
+	clientRateLimitQPS.WithLabelValues(key).Set(lowest.qps)
+	clientRateLimitBurst.WithLabelValues(key).Set(float64(lowest.burst))
+}
+
+// getSubscriptionRateLimiter returns the rate limiter of the subscription, or nil if its requests are not limited.
+func getSubscriptionRateLimiter(subscriptionID string) *rate.Limiter {
+	subscriptionRateLimitersMu.RLock()
+	defer subscriptionRateLimitersMu.RUnlock()
+
+	if l, ok := subscriptionRateLimiters[strings.ToLower(subscriptionID)]; ok {
+		return l.limiter
+	}
+	return nil
+}
+
+// subscriptionFromPath returns the subscription ID of an Azure Resource Manager request path, or an empty string if
+// the request is not scoped to a subscription.
+func subscriptionFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if strings.EqualFold(segments[i], "subscriptions") {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
+
+// subscriptionRateLimitPolicy delays requests to subscriptions with a rate limit until the limit allows them.
+// It implements the policy.Policy interface.
+type subscriptionRateLimitPolicy struct{}
+
+// Do waits until the rate limit of the request's subscription, if any, allows the request to be sent.
+func (p subscriptionRateLimitPolicy) Do(req *policy.Request) (*http.Response, error) {
+	if limiter := getSubscriptionRateLimiter(subscriptionFromPath(req.Raw().URL.Path)); limiter != nil {
+		if err := limiter.Wait(req.Raw().Context()); err != nil {
+			return nil, err
+		}
+	}
+	return req.Next()
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+)
+
+func TestSubscriptionFromPath(t *testing.T) {
+	testcases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "resource group scoped request",
+			path:     "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachines/my-vm",
+			expected: "123",
+		},
+		{
+			name:     "subscription scoped request",
+			path:     "/subscriptions/123/providers/Microsoft.Compute/skus",
+			expected: "123",
+		},
+		{
+			name:     "segment casing is ignored",
+			path:     "/Subscriptions/123/resourcegroups/my-rg",
+			expected: "123",
+		},
+		{
+			name:     "tenant scoped request",
+			path:     "/providers/Microsoft.Resources/operations",
+			expected: "",
+		},
+		{
+			name:     "no subscription ID",
+			path:     "/subscriptions",
+			expected: "",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(subscriptionFromPath(tc.path)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestSetSubscriptionRateLimit(t *testing.T) {
+	g := NewWithT(t)
+
+	const subscriptionID = "set-subscription-rate-limit"
+
+	SetSubscriptionRateLimit(subscriptionID, "identity-a", 10, 0)
+	limiter := getSubscriptionRateLimiter(subscriptionID)
+	g.Expect(limiter).NotTo(BeNil())
+	g.Expect(limiter.Limit()).To(Equal(rate.Limit(10)))
+	g.Expect(limiter.Burst()).To(Equal(10))
+
+	// The subscription ID is case insensitive and the limiter is updated in place.
+	SetSubscriptionRateLimit("SET-SUBSCRIPTION-RATE-LIMIT", "identity-a", 5, 20)
+	g.Expect(getSubscriptionRateLimiter(subscriptionID)).To(BeIdenticalTo(limiter))
+	g.Expect(limiter.Limit()).To(Equal(rate.Limit(5)))
+	g.Expect(limiter.Burst()).To(Equal(20))
+
+	// The lowest limit of the owners applies, and another owner does not override it.
+	SetSubscriptionRateLimit(subscriptionID, "identity-b", 8, 10)
+	g.Expect(getSubscriptionRateLimiter(subscriptionID)).To(BeIdenticalTo(limiter))
+	g.Expect(limiter.Limit()).To(Equal(rate.Limit(5)))
+	g.Expect(limiter.Burst()).To(Equal(10))
+
+	// Removing the limit of an owner restores the limit of the remaining owners.
+	SetSubscriptionRateLimit(subscriptionID, "identity-a", 0, 0)
+	g.Expect(getSubscriptionRateLimiter(subscriptionID)).To(BeIdenticalTo(limiter))
+	g.Expect(limiter.Limit()).To(Equal(rate.Limit(8)))
+	g.Expect(limiter.Burst()).To(Equal(10))
+
+	// An owner without a limit does not remove the limit of another owner.
+	SetSubscriptionRateLimit(subscriptionID, "identity-c", 0, 0)
+	g.Expect(getSubscriptionRateLimiter(subscriptionID)).NotTo(BeNil())
+	SetSubscriptionRateLimit(subscriptionID, "identity-b", 0, 0)
+	g.Expect(getSubscriptionRateLimiter(subscriptionID)).To(BeNil())
+}
+
+func TestRemoveRateLimitOwner(t *testing.T) {
+	g := NewWithT(t)
+
+	const (
+		subscriptionA = "remove-rate-limit-owner-a"
+		subscriptionB = "remove-rate-limit-owner-b"
+	)
+
+	SetSubscriptionRateLimit(subscriptionA, "identity-a", 5, 5)
+	SetSubscriptionRateLimit(subscriptionA, "identity-b", 10, 10)
+	SetSubscriptionRateLimit(subscriptionB, "identity-a", 5, 5)
+	defer SetSubscriptionRateLimit(subscriptionA, "identity-b", 0, 0)
+
+	RemoveRateLimitOwner("identity-a")
+
+	limiter := getSubscriptionRateLimiter(subscriptionA)
+	g.Expect(limiter).NotTo(BeNil())
+	g.Expect(limiter.Limit()).To(Equal(rate.Limit(10)))
+	g.Expect(limiter.Burst()).To(Equal(10))
+	g.Expect(getSubscriptionRateLimiter(subscriptionB)).To(BeNil())
+}
+
+func TestSubscriptionRateLimitPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	const subscriptionID = "subscription-rate-limit-policy"
+	SetSubscriptionRateLimit(subscriptionID, "identity", 1, 1)
+	defer SetSubscriptionRateLimit(subscriptionID, "identity", 0, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	pipeline := defaultTestPipeline([]policy.Policy{subscriptionRateLimitPolicy{}})
+	send := func(ctx context.Context, path string) error {
+		req, err := runtime.NewRequest(ctx, http.MethodGet, server.URL+path)
+		g.Expect(err).NotTo(HaveOccurred())
+		resp, err := pipeline.Do(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+
+	// The first request uses the burst of the limiter.
+	g.Expect(send(context.Background(), "/subscriptions/"+subscriptionID+"/resourceGroups/my-rg")).To(Succeed())
+
+	// The second request would have to wait for about a second, longer than its deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	g.Expect(send(ctx, "/subscriptions/"+subscriptionID+"/resourceGroups/my-rg")).NotTo(Succeed())
+
+	// Requests to other subscriptions are not limited.
+	g.Expect(send(ctx, "/subscriptions/other/resourceGroups/my-rg")).To(Succeed())
+}
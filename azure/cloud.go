@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+)
+
+// stackCloudEnvironment is the environment of the AzureStackCloud, or nil when no custom environment was loaded.
+var stackCloudEnvironment atomic.Pointer[azureautorest.Environment]
+
+// LoadStackCloudEnvironment loads the endpoints of the AzureStackCloud from a JSON file in the format of the
+// go-autorest Environment, e.g. for Azure Stack Hub or an air-gapped cloud.
+func LoadStackCloudEnvironment(path string) error {
+	env, err := azureautorest.EnvironmentFromFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the Azure environment file %s", path)
+	}
+	if env.ResourceManagerEndpoint == "" || env.ActiveDirectoryEndpoint == "" || env.TokenAudience == "" {
+		return errors.Errorf("the Azure environment file %s must set resourceManagerEndpoint, activeDirectoryEndpoint and tokenAudience", path)
+	}
+	// The environment is always referred to by the AzureStackCloud name, regardless of the name in the file.
+	env.Name = StackCloudName
+	stackCloudEnvironment.Store(&env)
+	return nil
+}
+
+// EnvironmentFromName returns the environment of the Azure cloud with the given name, or of the public cloud when the
+// name is empty.
+func EnvironmentFromName(name string) (azureautorest.Environment, error) {
+	switch {
+	case name == "":
+		return azureautorest.PublicCloud, nil
+	case strings.EqualFold(name, StackCloudName):
+		env := stackCloudEnvironment.Load()
+		if env == nil {
+			return azureautorest.Environment{}, errors.Errorf("the %s environment requires the controller to be started with --azure-environment-file", StackCloudName)
+		}
+		return *env, nil
+	default:
+		return azureautorest.EnvironmentFromName(name)
+	}
+}
+
+// CloudConfiguration returns the Azure SDK configuration of the Azure cloud with the given name.
+func CloudConfiguration(name string) (cloud.Configuration, error) {
+	switch name {
+	case PublicCloudName, "":
+		return cloud.AzurePublic, nil
+	case ChinaCloudName:
+		return cloud.AzureChina, nil
+	case USGovernmentCloudName:
+		return cloud.AzureGovernment, nil
+	case StackCloudName:
+		env, err := EnvironmentFromName(name)
+		if err != nil {
+			return cloud.Configuration{}, err
+		}
+		return cloud.Configuration{
+			ActiveDirectoryAuthorityHost: env.ActiveDirectoryEndpoint,
+			Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+				cloud.ResourceManager: {
+					Audience: env.TokenAudience,
+					Endpoint: env.ResourceManagerEndpoint,
+				},
+			},
+		}, nil
+	default:
+		return cloud.Configuration{}, fmt.Errorf("invalid cloud name %q", name)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/gomega"
+)
+
+const stackCloudEnvironmentFile = `{
+	"name": "MyStackCloud",
+	"resourceManagerEndpoint": "https://management.local.azurestack.external/",
+	"activeDirectoryEndpoint": "https://login.microsoftonline.com/",
+	"tokenAudience": "https://management.adfs.azurestack.local/4de154de-f8a8-4017-af41-df619da68155",
+	"resourceManagerVMDNSSuffix": "cloudapp.azurestack.external"
+}`
+
+func TestStackCloudEnvironment(t *testing.T) {
+	t.Cleanup(func() { stackCloudEnvironment.Store(nil) })
+
+	g := NewWithT(t)
+
+	_, err := CloudConfiguration(StackCloudName)
+	g.Expect(err).To(MatchError("the AzureStackCloud environment requires the controller to be started with --azure-environment-file"))
+
+	path := filepath.Join(t.TempDir(), "environment.json")
+	g.Expect(os.WriteFile(path, []byte(stackCloudEnvironmentFile), 0600)).To(Succeed())
+	g.Expect(LoadStackCloudEnvironment(path)).To(Succeed())
+
+	env, err := EnvironmentFromName(StackCloudName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(env.Name).To(Equal(StackCloudName))
+	g.Expect(env.ResourceManagerVMDNSSuffix).To(Equal("cloudapp.azurestack.external"))
+
+	cloudConfig, err := CloudConfiguration(StackCloudName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cloudConfig).To(Equal(cloud.Configuration{
+		ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com/",
+		Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+			cloud.ResourceManager: {
+				Audience: "https://management.adfs.azurestack.local/4de154de-f8a8-4017-af41-df619da68155",
+				Endpoint: "https://management.local.azurestack.external/",
+			},
+		},
+	}))
+
+	opts, err := ARMClientOptions(StackCloudName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(opts.Cloud).To(Equal(cloudConfig))
+}
+
+func TestLoadStackCloudEnvironmentInvalid(t *testing.T) {
+	t.Cleanup(func() { stackCloudEnvironment.Store(nil) })
+
+	g := NewWithT(t)
+
+	g.Expect(LoadStackCloudEnvironment(filepath.Join(t.TempDir(), "missing.json"))).To(HaveOccurred())
+
+	path := filepath.Join(t.TempDir(), "environment.json")
+	g.Expect(os.WriteFile(path, []byte(`{"name": "MyStackCloud"}`), 0600)).To(Succeed())
+	g.Expect(LoadStackCloudEnvironment(path)).To(MatchError(ContainSubstring("must set resourceManagerEndpoint, activeDirectoryEndpoint and tokenAudience")))
+	g.Expect(stackCloudEnvironment.Load()).To(BeNil())
+}
+
+func TestEnvironmentFromName(t *testing.T) {
+	g := NewWithT(t)
+
+	env, err := EnvironmentFromName("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(env).To(Equal(azureautorest.PublicCloud))
+
+	env, err = EnvironmentFromName(ChinaCloudName)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(env).To(Equal(azureautorest.ChinaCloud))
+
+	_, err = EnvironmentFromName("AzureUnrecognizedCloud")
+	g.Expect(err).To(HaveOccurred())
+}
@@ -41,6 +41,12 @@ const (
 	// for annotation formatting rules.
 	ManagedClusterTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-tags-managedcluster"
 
+	// NodeResourceGroupTagsLastAppliedAnnotation is the key for the AzureManagedControlPlane
+	// object annotation which tracks the NodeResourceGroupTags for the AKS node resource group.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	NodeResourceGroupTagsLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-tags-noderesourcegroup"
+
 	// SecurityRuleLastAppliedAnnotation is the key for the Azure Cluster
 	// object annotation which tracks the security rules for security groups.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
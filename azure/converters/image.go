@@ -18,6 +18,7 @@ package converters
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
@@ -26,6 +27,11 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 )
 
+const (
+	communityGalleryIDPrefix = "/CommunityGalleries/"
+	sharedGalleryIDPrefix    = "/SharedGalleries/"
+)
+
 // ImageToSDK converts a CAPZ Image (as RawExtension) to a Azure SDK Image Reference.
 func ImageToSDK(image *infrav1.Image) (*armcompute.ImageReference, error) {
 	if image.ID != nil {
@@ -91,11 +97,28 @@ func computeImageToSDK(image *infrav1.Image) (*armcompute.ImageReference, error)
 }
 
 func specificImageToSDK(image *infrav1.Image) (*armcompute.ImageReference, error) {
+	// Images of community galleries and of galleries shared directly with the subscription are not resources of a
+	// subscription, so they are referenced by their own ID fields.
+	switch {
+	case hasPrefixFold(*image.ID, communityGalleryIDPrefix):
+		return &armcompute.ImageReference{
+			CommunityGalleryImageID: image.ID,
+		}, nil
+	case hasPrefixFold(*image.ID, sharedGalleryIDPrefix):
+		return &armcompute.ImageReference{
+			SharedGalleryImageID: image.ID,
+		}, nil
+	}
+
 	return &armcompute.ImageReference{
 		ID: image.ID,
 	}, nil
 }
 
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
 // ImageToPlan converts a CAPZ Image to an Azure Compute Plan.
 func ImageToPlan(image *infrav1.Image) *armcompute.Plan {
 	// Plan is needed when using a Shared Gallery image with Plan details.
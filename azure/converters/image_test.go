@@ -307,6 +307,30 @@ func Test_ImageToSDK(t *testing.T) {
 				}))
 			},
 		},
+		{
+			name: "Should return community gallery image if ID is a community gallery image ID",
+			image: &infrav1.Image{
+				ID: ptr.To("/CommunityGalleries/my-gallery/Images/my-image/Versions/my-version"),
+			},
+			expect: func(g *GomegaWithT, result *armcompute.ImageReference, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(result).To(Equal(&armcompute.ImageReference{
+					CommunityGalleryImageID: ptr.To("/CommunityGalleries/my-gallery/Images/my-image/Versions/my-version"),
+				}))
+			},
+		},
+		{
+			name: "Should return shared gallery image if ID is a direct shared gallery image ID",
+			image: &infrav1.Image{
+				ID: ptr.To("/sharedGalleries/my-gallery-unique-name/images/my-image/versions/my-version"),
+			},
+			expect: func(g *GomegaWithT, result *armcompute.ImageReference, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(result).To(Equal(&armcompute.ImageReference{
+					SharedGalleryImageID: ptr.To("/sharedGalleries/my-gallery-unique-name/images/my-image/versions/my-version"),
+				}))
+			},
+		},
 		{
 			name: "Should return Marketplace image if Marketplace is set",
 			image: &infrav1.Image{
@@ -28,11 +28,13 @@ func AgentPoolToManagedClusterAgentPoolProfile(pool *asocontainerservicev1hub.Ma
 		Name:                        ptr.To(pool.AzureName()),
 		VmSize:                      properties.VmSize,
 		OsType:                      properties.OsType,
+		OsSKU:                       properties.OsSKU,
 		OsDiskSizeGB:                properties.OsDiskSizeGB,
 		Count:                       properties.Count,
 		Type:                        properties.Type,
 		OrchestratorVersion:         properties.OrchestratorVersion,
 		VnetSubnetReference:         properties.VnetSubnetReference,
+		PodSubnetReference:          properties.PodSubnetReference,
 		Mode:                        properties.Mode,
 		EnableAutoScaling:           properties.EnableAutoScaling,
 		MaxCount:                    properties.MaxCount,
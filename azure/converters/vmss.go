@@ -32,6 +32,8 @@ const (
 	RegExpStrCommunityGalleryID = `/CommunityGalleries/(?P<gallery>.*)/Images/(?P<name>.*)/Versions/(?P<version>.*)`
 	// RegExpStrComputeGalleryID is a regexp string used for matching compute gallery IDs and capturing specific values.
 	RegExpStrComputeGalleryID = `/subscriptions/(?P<subID>.*)/resourceGroups/(?P<rg>.*)/providers/Microsoft.Compute/galleries/(?P<gallery>.*)/images/(?P<name>.*)/versions/(?P<version>.*)`
+	// RegExpStrSharedGalleryID is a regexp string used for matching direct shared gallery IDs and capturing specific values.
+	RegExpStrSharedGalleryID = `/SharedGalleries/(?P<gallery>.*)/Images/(?P<name>.*)/Versions/(?P<version>.*)`
 )
 
 // SDKToVMSS converts an Azure SDK VirtualMachineScaleSet to the AzureMachinePool type.
@@ -198,6 +200,11 @@ func IDImageRefToImage(id string) infrav1.Image {
 		}
 	}
 
+	// community gallery image
+	if ok, _ := getParams(RegExpStrCommunityGalleryID, id); ok {
+		return cgImageRefToImage(id)
+	}
+
 	// specific image
 	return infrav1.Image{
 		ID: &id,
@@ -246,6 +253,12 @@ func sgImageRefToImage(id string) infrav1.Image {
 			},
 		}
 	}
+	// images of galleries shared directly with the subscription can only be set by ID
+	if ok, _ := getParams(RegExpStrSharedGalleryID, id); ok {
+		return infrav1.Image{
+			ID: &id,
+		}
+	}
 	return infrav1.Image{}
 }
 
@@ -266,6 +266,15 @@ func Test_SDKImageToImage(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "direct shared gallery image",
+			SDKImageRef: &armcompute.ImageReference{
+				SharedGalleryImageID: ptr.To("/SharedGalleries/gallery/Images/image/Versions/version"),
+			},
+			Image: infrav1.Image{
+				ID: ptr.To("/SharedGalleries/gallery/Images/image/Versions/version"),
+			},
+		},
 		{
 			Name: "community gallery image",
 			SDKImageRef: &armcompute.ImageReference{
@@ -294,6 +303,19 @@ func Test_SDKImageToImage(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "community gallery image by id",
+			SDKImageRef: &armcompute.ImageReference{
+				ID: ptr.To("/CommunityGalleries/gallery/Images/image/Versions/version"),
+			},
+			Image: infrav1.Image{
+				ComputeGallery: &infrav1.AzureComputeGalleryImage{
+					Gallery: "gallery",
+					Name:    "image",
+					Version: "version",
+				},
+			},
+		},
 		{
 			Name: "compute gallery image not formatted as expected",
 			SDKImageRef: &armcompute.ImageReference{
@@ -125,6 +125,15 @@ func (c *credentialCache) GetOrStoreWorkloadIdentity(opts *azidentity.WorkloadId
 	)
 }
 
+// identity returns the key without its secret, identifying the credential regardless of the secret in use.
+func (k credentialCacheKey) identity() credentialCacheKey {
+	k.secret = ""
+	return k
+}
+
+// getOrStore returns the cached credential for the key or stores a new one. Secrets are read from the API server
+// for every reconcile, so a rotated client secret or certificate results in a new key. Storing a credential evicts
+// the credentials of the same identity created from a previous secret, which are no longer valid.
 func (c *credentialCache) getOrStore(key credentialCacheKey, newCredFunc func() (azcore.TokenCredential, error)) (azcore.TokenCredential, error) {
 	c.mut.Lock()
 	defer c.mut.Unlock()
@@ -135,6 +144,11 @@ func (c *credentialCache) getOrStore(key credentialCacheKey, newCredFunc func()
 	if err != nil {
 		return nil, err
 	}
+	for cachedKey := range c.cache {
+		if cachedKey.identity() == key.identity() {
+			delete(c.cache, cachedKey)
+		}
+	}
 	c.cache[key] = cred
 	return cred, nil
 }
@@ -75,6 +75,48 @@ func TestGetOrStore(t *testing.T) {
 	g.Expect(newCredCount).To(Equal(2))
 }
 
+func TestGetOrStoreRotatedSecret(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	credCache := &credentialCache{
+		mut:   new(sync.Mutex),
+		cache: make(map[credentialCacheKey]azcore.TokenCredential),
+	}
+
+	newCredCount := 0
+	newCredFunc := func(cred fakeTokenCredential) func() (azcore.TokenCredential, error) {
+		return func() (azcore.TokenCredential, error) {
+			newCredCount++
+			return cred, nil
+		}
+	}
+
+	oldKey := credentialCacheKey{credentialType: CredentialTypeClientSecret, tenantID: "1", clientID: "client", secret: "old-secret"}
+	newKey := credentialCacheKey{credentialType: CredentialTypeClientSecret, tenantID: "1", clientID: "client", secret: "new-secret"}
+	otherKey := credentialCacheKey{credentialType: CredentialTypeClientSecret, tenantID: "1", clientID: "other-client", secret: "old-secret"}
+
+	_, err := credCache.getOrStore(oldKey, newCredFunc(fakeTokenCredential{tenantID: "old"}))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = credCache.getOrStore(otherKey, newCredFunc(fakeTokenCredential{tenantID: "other"}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(newCredCount).To(Equal(2))
+
+	// a rotated secret creates a new credential and evicts the one created from the old secret
+	cred, err := credCache.getOrStore(newKey, newCredFunc(fakeTokenCredential{tenantID: "new"}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cred).To(Equal(fakeTokenCredential{tenantID: "new"}))
+	g.Expect(newCredCount).To(Equal(3))
+	g.Expect(credCache.cache).NotTo(HaveKey(oldKey))
+	g.Expect(credCache.cache).To(HaveKey(newKey))
+	g.Expect(credCache.cache).To(HaveKey(otherKey))
+
+	// the rotated credential is reused afterwards
+	cred, err = credCache.getOrStore(newKey, newCredFunc(fakeTokenCredential{tenantID: "new"}))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cred).To(Equal(fakeTokenCredential{tenantID: "new"}))
+	g.Expect(newCredCount).To(Equal(3))
+}
+
 func TestGetOrStoreRace(t *testing.T) {
 	// This test makes no assertions, it only fails when the race detector finds race conditions.
 
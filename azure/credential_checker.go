@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// credentialCheckTimeout is the maximum duration of a single credential check.
+const credentialCheckTimeout = 30 * time.Second
+
+// CredentialChecker periodically checks that an Azure credential can fetch a token for Azure Resource Manager.
+// Its Check method is a healthz.Checker and it implements the manager.Runnable interface.
+type CredentialChecker struct {
+	credential azcore.TokenCredential
+	scope      string
+	interval   time.Duration
+	timeout    time.Duration
+
+	mu  sync.RWMutex
+	err error
+}
+
+// NewDefaultCredentialChecker creates a CredentialChecker for the default Azure credential of the controller, i.e.
+// the credential configured with environment variables, workload identity or a managed identity, in the given cloud.
+func NewDefaultCredentialChecker(azureEnvironment string, interval time.Duration) (*CredentialChecker, error) {
+	cloudConfig, err := CloudConfiguration(azureEnvironment)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions: azcore.ClientOptions{Cloud: cloudConfig},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the default Azure credential")
+	}
+	return newCredentialChecker(credential, cloudConfig.Services[cloud.ResourceManager].Audience+"/.default", interval), nil
+}
+
+func newCredentialChecker(credential azcore.TokenCredential, scope string, interval time.Duration) *CredentialChecker {
+	return &CredentialChecker{
+		credential: credential,
+		scope:      scope,
+		interval:   interval,
+		timeout:    credentialCheckTimeout,
+		err:        errors.New("the Azure credential has not been checked yet"),
+	}
+}
+
+// Start checks the credential immediately and then at every interval until the context is done.
+func (c *CredentialChecker) Start(ctx context.Context) error {
+	log := ctrl.Log.WithName("azure-credential-checker")
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		if err := c.check(ctx); err != nil {
+			log.Error(err, "Azure credential check failed")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection returns false so that every replica checks its credential, not only the leader.
+func (c *CredentialChecker) NeedLeaderElection() bool {
+	return false
+}
+
+// Check returns the error of the last credential check, or nil if the credential fetched a token.
+func (c *CredentialChecker) Check(_ *http.Request) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.err
+}
+
+// check fetches a token with the credential and records the result.
+func (c *CredentialChecker) check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	_, err := c.credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{c.scope}})
+	if err != nil {
+		err = errors.Wrap(err, "failed to get a token with the Azure credential")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+	return err
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	. "github.com/onsi/gomega"
+)
+
+type fakeCheckedCredential struct {
+	calls  atomic.Int32
+	scopes []string
+	err    error
+}
+
+func (f *fakeCheckedCredential) GetToken(_ context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	f.calls.Add(1)
+	f.scopes = opts.Scopes
+	if f.err != nil {
+		return azcore.AccessToken{}, f.err
+	}
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestCredentialChecker(t *testing.T) {
+	testcases := []struct {
+		name        string
+		tokenErr    error
+		expectedErr string
+	}{
+		{
+			name: "should be ready when the credential fetches a token",
+		},
+		{
+			name:        "should not be ready when the credential fails to fetch a token",
+			tokenErr:    errors.New("AADSTS7000222: the provided client secret keys are expired"),
+			expectedErr: "failed to get a token with the Azure credential: AADSTS7000222: the provided client secret keys are expired",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			credential := &fakeCheckedCredential{err: tc.tokenErr}
+			checker := newCredentialChecker(credential, "https://management.azure.com/.default", time.Hour)
+
+			g.Expect(checker.Check(nil)).To(MatchError("the Azure credential has not been checked yet"))
+
+			err := checker.check(context.Background())
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(tc.expectedErr))
+				g.Expect(checker.Check(nil)).To(MatchError(tc.expectedErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(checker.Check(nil)).To(Succeed())
+			}
+			g.Expect(credential.scopes).To(Equal([]string{"https://management.azure.com/.default"}))
+		})
+	}
+}
+
+func TestCredentialCheckerStart(t *testing.T) {
+	g := NewWithT(t)
+	credential := &fakeCheckedCredential{}
+	checker := newCredentialChecker(credential, "https://management.azure.com/.default", 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- checker.Start(ctx)
+	}()
+
+	g.Eventually(credential.calls.Load).Should(BeNumerically(">=", 2))
+	g.Expect(checker.Check(nil)).To(Succeed())
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+	g.Expect(checker.NeedLeaderElection()).To(BeFalse())
+}
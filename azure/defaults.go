@@ -24,7 +24,6 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
-	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/tracing/azotel"
@@ -45,6 +44,8 @@ const (
 	ChinaCloudName = "AzureChinaCloud"
 	// USGovernmentCloudName is the name of the Azure US Government cloud.
 	USGovernmentCloudName = "AzureUSGovernmentCloud"
+	// StackCloudName is the name of a custom Azure cloud, e.g. Azure Stack Hub, whose endpoints are loaded from a file.
+	StackCloudName = "AzureStackCloud"
 )
 
 const (
@@ -140,7 +141,10 @@ func GenerateNodeOutboundIPName(clusterName string) string {
 
 // GenerateNodePublicIPName generates a node public IP name, based on the machine name.
 func GenerateNodePublicIPName(machineName string) string {
-	return fmt.Sprintf("pip-%s", machineName)
+	return generateName(NodePublicIPNameKind, ResourceNameParams{
+		Name:    machineName,
+		Default: fmt.Sprintf("pip-%s", machineName),
+	})
 }
 
 // GenerateControlPlaneOutboundLBName generates the name of the control plane outbound LB.
@@ -170,10 +174,15 @@ func GenerateVNetLinkName(vnetName string) string {
 
 // GenerateNICName generates the name of a network interface based on the name of a VM.
 func GenerateNICName(machineName string, multiNIC bool, index int) string {
+	defaultName := fmt.Sprintf("%s-nic", machineName)
 	if multiNIC {
-		return fmt.Sprintf("%s-nic-%d", machineName, index)
+		defaultName = fmt.Sprintf("%s-nic-%d", machineName, index)
 	}
-	return fmt.Sprintf("%s-nic", machineName)
+	return generateName(NetworkInterfaceNameKind, ResourceNameParams{
+		Name:    machineName,
+		Index:   index,
+		Default: defaultName,
+	})
 }
 
 // GeneratePublicNICName generates the name of a public network interface based on the name of a VM.
@@ -183,12 +192,19 @@ func GeneratePublicNICName(machineName string) string {
 
 // GenerateOSDiskName generates the name of an OS disk based on the name of a VM.
 func GenerateOSDiskName(machineName string) string {
-	return fmt.Sprintf("%s_OSDisk", machineName)
+	return generateName(OSDiskNameKind, ResourceNameParams{
+		Name:    machineName,
+		Default: fmt.Sprintf("%s_OSDisk", machineName),
+	})
 }
 
 // GenerateDataDiskName generates the name of a data disk based on the name of a VM.
 func GenerateDataDiskName(machineName, nameSuffix string) string {
-	return fmt.Sprintf("%s_%s", machineName, nameSuffix)
+	return generateName(DataDiskNameKind, ResourceNameParams{
+		Name:    machineName,
+		Suffix:  nameSuffix,
+		Default: fmt.Sprintf("%s_%s", machineName, nameSuffix),
+	})
 }
 
 // GenerateVnetPeeringName generates the name for a peering between two vnets.
@@ -309,6 +325,20 @@ func FleetID(subscriptionID, resourceGroup, fleetName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s", subscriptionID, resourceGroup, fleetName)
 }
 
+// UserAssignedIdentityID returns the azure resource ID for a given user-assigned identity.
+func UserAssignedIdentityID(subscriptionID, resourceGroup, identityName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ManagedIdentity/userAssignedIdentities/%s", subscriptionID, resourceGroup, identityName)
+}
+
+// ResolveUserAssignedIdentityID returns the resource ID of a user-assigned identity referenced either by its
+// resource ID or by its name in the given subscription and resource group.
+func ResolveUserAssignedIdentityID(providerID, subscriptionID, resourceGroup string) string {
+	if strings.Contains(providerID, "/") {
+		return providerID
+	}
+	return UserAssignedIdentityID(subscriptionID, resourceGroup, providerID)
+}
+
 // GetBootstrappingVMExtension returns the CAPZ Bootstrapping VM extension.
 // The CAPZ Bootstrapping extension is a simple clone of https://github.com/Azure/custom-script-extension-linux for Linux or
 // https://learn.microsoft.com/azure/virtual-machines/extensions/custom-script-windows for Windows.
@@ -361,23 +391,23 @@ func UserAgent() string {
 func ARMClientOptions(azureEnvironment string, extraPolicies ...policy.Policy) (*arm.ClientOptions, error) {
 	opts := &arm.ClientOptions{}
 
-	switch azureEnvironment {
-	case PublicCloudName:
-		opts.Cloud = cloud.AzurePublic
-	case ChinaCloudName:
-		opts.Cloud = cloud.AzureChina
-	case USGovernmentCloudName:
-		opts.Cloud = cloud.AzureGovernment
-	case "":
-		// No cloud name provided, so leave at defaults.
-	default:
-		return nil, fmt.Errorf("invalid cloud name %q", azureEnvironment)
+	// No cloud name provided, so leave at defaults.
+	if azureEnvironment != "" {
+		cloudConfig, err := CloudConfiguration(azureEnvironment)
+		if err != nil {
+			return nil, err
+		}
+		opts.Cloud = cloudConfig
 	}
 	opts.PerCallPolicies = []policy.Policy{
 		correlationIDPolicy{},
 		userAgentPolicy{},
+		subscriptionRateLimitPolicy{},
 	}
 	opts.PerCallPolicies = append(opts.PerCallPolicies, extraPolicies...)
+	if limiter := initialGets.Load(); limiter != nil {
+		opts.PerCallPolicies = append(opts.PerCallPolicies, initialGetPolicy{limiter: limiter})
+	}
 	opts.Retry.MaxRetries = -1 // Less than zero means one try and no retries.
 
 	otelTP, err := ot.OTLPTracerProvider(context.TODO())
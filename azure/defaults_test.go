@@ -80,7 +80,7 @@ func TestARMClientOptions(t *testing.T) {
 			g.Expect(err).NotTo(HaveOccurred())
 			g.Expect(opts.Cloud).To(Equal(tc.expectedCloud))
 			g.Expect(opts.Retry.MaxRetries).To(BeNumerically("==", -1))
-			g.Expect(opts.PerCallPolicies).To(HaveLen(2))
+			g.Expect(opts.PerCallPolicies).To(HaveLen(3))
 		})
 	}
 }
@@ -98,12 +98,13 @@ func TestPerCallPolicies(t *testing.T) {
 	}))
 	defer server.Close()
 
-	// Call the factory function and ensure it has both PerCallPolicies.
+	// Call the factory function and ensure it has all PerCallPolicies.
 	opts, err := ARMClientOptions("")
 	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(opts.PerCallPolicies).To(HaveLen(2))
+	g.Expect(opts.PerCallPolicies).To(HaveLen(3))
 	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(correlationIDPolicy{})))
 	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(userAgentPolicy{})))
+	g.Expect(opts.PerCallPolicies).To(ContainElement(BeAssignableToTypeOf(subscriptionRateLimitPolicy{})))
 
 	// Create a request with a correlation ID.
 	ctx := context.WithValue(context.Background(), tele.CorrIDKeyVal, tele.CorrID(corrID))
@@ -352,3 +353,33 @@ func TestNormalizeAzureName(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveUserAssignedIdentityID(t *testing.T) {
+	testCases := []struct {
+		name       string
+		providerID string
+		expected   string
+	}{
+		{
+			name:       "should return the resource ID unchanged",
+			providerID: "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+			expected:   "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+		},
+		{
+			name:       "should return the provider ID unchanged",
+			providerID: "azure:///subscriptions/123/resourceGroups/other-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+			expected:   "azure:///subscriptions/123/resourceGroups/other-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+		},
+		{
+			name:       "should resolve an identity name in the given resource group",
+			providerID: "my-identity",
+			expected:   "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(ResolveUserAssignedIdentityID(tc.providerID, "123", "my-rg")).To(Equal(tc.expected))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+// DryRunSkippedReason is the reason of the Event recorded when a mutating Azure operation is skipped in dry-run mode.
+const DryRunSkippedReason = "DryRunSkipped"
+
+// SkipInDryRun returns true if scope is in dry-run mode, in which case the mutating operation described by message
+// must not be performed. The skipped operation is recorded as an Event by the scope.
+func SkipInDryRun(scope interface{}, message string) bool {
+	dryRunner, ok := scope.(DryRunner)
+	if !ok || !dryRunner.IsDryRun() {
+		return false
+	}
+	dryRunner.RecordDryRunSkip(message)
+	return true
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+type fakeDryRunner struct {
+	dryRun  bool
+	skipped []string
+}
+
+func (f *fakeDryRunner) IsDryRun() bool {
+	return f.dryRun
+}
+
+func (f *fakeDryRunner) RecordDryRunSkip(message string) {
+	f.skipped = append(f.skipped, message)
+}
+
+func TestSkipInDryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(SkipInDryRun(struct{}{}, "create")).To(BeFalse())
+
+	scope := &fakeDryRunner{}
+	g.Expect(SkipInDryRun(scope, "create")).To(BeFalse())
+	g.Expect(scope.skipped).To(BeEmpty())
+
+	scope.dryRun = true
+	g.Expect(SkipInDryRun(scope, "create")).To(BeTrue())
+	g.Expect(scope.skipped).To(Equal([]string{"create"}))
+}
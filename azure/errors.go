@@ -30,8 +30,29 @@ import (
 
 // ResourceNotFound parses an error to check if its status code is Not Found (404).
 func ResourceNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// ResourceForbidden parses an error to check if its status code is Forbidden (403),
+// e.g. because the identity lacks permissions on the resource.
+func ResourceForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// ResourceConflict parses an error to check if its status code is Conflict (409).
+func ResourceConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// hasStatusCode checks if an error wraps an Azure response error with the given status code, including
+// when it is wrapped in a ReconcileError, which does not unwrap to the error it wraps.
+func hasStatusCode(err error, statusCode int) bool {
+	var reconcileErr ReconcileError
+	if errors.As(err, &reconcileErr) {
+		err = reconcileErr.error
+	}
 	var rerr *azcore.ResponseError
-	return errors.As(err, &rerr) && rerr.StatusCode == http.StatusNotFound
+	return errors.As(err, &rerr) && rerr.StatusCode == statusCode
 }
 
 // VMDeletedError is returned when a virtual machine is deleted outside of capz.
@@ -81,11 +81,31 @@ func TestResourceNotFound(t *testing.T) {
 			err:     &azcore.ResponseError{StatusCode: http.StatusNotFound},
 			success: true,
 		},
+		{
+			name:    "wrapped Not Found response error",
+			err:     errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusNotFound}, "failed to get resource"),
+			success: true,
+		},
+		{
+			name:    "transient Not Found response error",
+			err:     WithTransientError(errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusNotFound}, "failed to get resource"), time.Minute),
+			success: true,
+		},
+		{
+			name:    "wrapped terminal Not Found response error",
+			err:     errors.Wrap(WithTerminalError(&azcore.ResponseError{StatusCode: http.StatusNotFound}), "failed to create resource"),
+			success: true,
+		},
 		{
 			name:    "Conflict response error",
 			err:     &azcore.ResponseError{StatusCode: http.StatusConflict},
 			success: false,
 		},
+		{
+			name:    "transient error without response error",
+			err:     WithTransientError(errors.New("404: Not Found"), time.Minute),
+			success: false,
+		},
 		{
 			name:    "Not Found generic error",
 			err:     errors.New("404: Not Found"),
@@ -101,3 +121,82 @@ func TestResourceNotFound(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceForbidden(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		success bool
+	}{
+		{
+			name:    "Forbidden response error",
+			err:     &azcore.ResponseError{StatusCode: http.StatusForbidden},
+			success: true,
+		},
+		{
+			name:    "wrapped Forbidden response error",
+			err:     errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusForbidden}, "failed to create resource"),
+			success: true,
+		},
+		{
+			name:    "transient Forbidden response error",
+			err:     WithTransientError(errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusForbidden}, "failed to get resource"), time.Minute),
+			success: true,
+		},
+		{
+			name:    "Not Found response error",
+			err:     &azcore.ResponseError{StatusCode: http.StatusNotFound},
+			success: false,
+		},
+		{
+			name:    "Forbidden generic error",
+			err:     errors.New("403: Forbidden"),
+			success: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ResourceForbidden(tc.err); got != tc.success {
+				t.Errorf("ResourceForbidden() = %v, want %v", got, tc.success)
+			}
+		})
+	}
+}
+
+func TestResourceConflict(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		success bool
+	}{
+		{
+			name:    "Conflict response error",
+			err:     &azcore.ResponseError{StatusCode: http.StatusConflict},
+			success: true,
+		},
+		{
+			name:    "wrapped Conflict response error",
+			err:     errors.Wrap(&azcore.ResponseError{StatusCode: http.StatusConflict}, "failed to create resource"),
+			success: true,
+		},
+		{
+			name:    "Not Found response error",
+			err:     &azcore.ResponseError{StatusCode: http.StatusNotFound},
+			success: false,
+		},
+		{
+			name:    "Conflict generic error",
+			err:     errors.New("409: Conflict"),
+			success: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ResourceConflict(tc.err); got != tc.success {
+				t.Errorf("ResourceConflict() = %v, want %v", got, tc.success)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// DefaultInitialGetWindow is the default duration, starting with the first Azure GET request, during which
+// the number of concurrent Azure GET requests is capped.
+const DefaultInitialGetWindow = 5 * time.Minute
+
+// initialGetLimiter caps the number of concurrent Azure GET requests during a warmup window that starts with the
+// first GET request, when every cluster reconciles at once after the controller starts.
+type initialGetLimiter struct {
+	tokens chan struct{}
+	window time.Duration
+	start  sync.Once
+	warm   chan struct{}
+}
+
+// initialGets is the limiter used by the clients created with ARMClientOptions, or nil when GETs are not capped.
+var initialGets atomic.Pointer[initialGetLimiter]
+
+// SetInitialGetConcurrency caps the number of concurrent Azure GET requests made by clients created with
+// ARMClientOptions for the given window after the first GET request. A concurrency of zero or less disables the cap.
+func SetInitialGetConcurrency(concurrency int, window time.Duration) {
+	if concurrency <= 0 || window <= 0 {
+		initialGets.Store(nil)
+		return
+	}
+	initialGets.Store(newInitialGetLimiter(concurrency, window))
+}
+
+func newInitialGetLimiter(concurrency int, window time.Duration) *initialGetLimiter {
+	return &initialGetLimiter{
+		tokens: make(chan struct{}, concurrency),
+		window: window,
+		warm:   make(chan struct{}),
+	}
+}
+
+// acquire waits until a GET request may be sent and returns a function releasing its slot.
+func (l *initialGetLimiter) acquire(ctx context.Context) (func(), error) {
+	l.start.Do(func() {
+		time.AfterFunc(l.window, func() {
+			close(l.warm)
+			ctrl.Log.WithName("azure").Info("Azure client warmup complete, no longer limiting concurrent GET requests",
+				"concurrency", cap(l.tokens), "window", l.window)
+		})
+	})
+	select {
+	case <-l.warm:
+		return func() {}, nil
+	case l.tokens <- struct{}{}:
+		return func() { <-l.tokens }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// initialGetPolicy limits the number of concurrent GET requests until the client warmup window has elapsed.
+// It implements the policy.Policy interface.
+type initialGetPolicy struct {
+	limiter *initialGetLimiter
+}
+
+// Do waits for a free slot before sending a GET request during the warmup window.
+func (p initialGetPolicy) Do(req *policy.Request) (*http.Response, error) {
+	if req.Raw().Method != http.MethodGet {
+		return req.Next()
+	}
+	release, err := p.limiter.acquire(req.Raw().Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return req.Next()
+}
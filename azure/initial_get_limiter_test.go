@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	. "github.com/onsi/gomega"
+)
+
+func TestInitialGetPolicy(t *testing.T) {
+	testcases := []struct {
+		name                string
+		method              string
+		window              time.Duration
+		expectedMaxInFlight int32
+	}{
+		{
+			name:                "should limit concurrent GET requests during the warmup window",
+			method:              http.MethodGet,
+			window:              time.Hour,
+			expectedMaxInFlight: 2,
+		},
+		{
+			name:                "should not limit concurrent PUT requests",
+			method:              http.MethodPut,
+			window:              time.Hour,
+			expectedMaxInFlight: 6,
+		},
+		{
+			name:                "should not limit concurrent GET requests after the warmup window",
+			method:              http.MethodGet,
+			window:              time.Nanosecond,
+			expectedMaxInFlight: 6,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var inFlight, maxInFlight atomic.Int32
+			arrived := make(chan struct{}, 6)
+			unblock := make(chan struct{})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+				for {
+					observed := maxInFlight.Load()
+					if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+				arrived <- struct{}{}
+				<-unblock
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			limiter := newInitialGetLimiter(2, tc.window)
+			if tc.window == time.Nanosecond {
+				// Start the warmup window and wait for it to elapse.
+				release, err := limiter.acquire(context.Background())
+				g.Expect(err).NotTo(HaveOccurred())
+				release()
+				g.Eventually(limiter.warm).Should(BeClosed())
+			}
+			pipeline := defaultTestPipeline([]policy.Policy{initialGetPolicy{limiter: limiter}})
+
+			var wg sync.WaitGroup
+			for range 6 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					req, err := runtime.NewRequest(context.Background(), tc.method, server.URL)
+					g.Expect(err).NotTo(HaveOccurred())
+					resp, err := pipeline.Do(req)
+					g.Expect(err).NotTo(HaveOccurred())
+					defer resp.Body.Close()
+				}()
+			}
+			for range tc.expectedMaxInFlight {
+				<-arrived
+			}
+			g.Consistently(inFlight.Load, 100*time.Millisecond).Should(Equal(tc.expectedMaxInFlight))
+			close(unblock)
+			wg.Wait()
+			g.Expect(maxInFlight.Load()).To(Equal(tc.expectedMaxInFlight))
+		})
+	}
+}
+
+func TestInitialGetPolicyContextCanceled(t *testing.T) {
+	g := NewWithT(t)
+
+	limiter := newInitialGetLimiter(1, time.Hour)
+	release, err := limiter.acquire(context.Background())
+	g.Expect(err).NotTo(HaveOccurred())
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = limiter.acquire(ctx)
+	g.Expect(err).To(MatchError(context.Canceled))
+}
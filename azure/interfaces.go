@@ -114,6 +114,27 @@ type AsyncReconciler interface {
 	DefaultedReconcilerRequeue() time.Duration
 }
 
+// DryRunner may be implemented by a scope whose mutating Azure operations should only be logged, not performed.
+type DryRunner interface {
+	IsDryRun() bool
+	// RecordDryRunSkip records an Event for a mutating Azure operation which was skipped in dry-run mode.
+	RecordDryRunSkip(message string)
+}
+
+// NodeOutboundBackendPoolDescriber may be implemented by a scope whose node outbound load balancer can have outbound
+// backend pools that machines join instead of its default backend pool.
+type NodeOutboundBackendPoolDescriber interface {
+	NodeOutboundBackendPoolNames() []string
+}
+
+// ResourceEventRecorder may be implemented by a scope that records a Kubernetes Event for each Azure resource
+// created, updated, or deleted on its behalf.
+type ResourceEventRecorder interface {
+	// RecordsResourceEvents returns whether Events are recorded for the Azure resources of the scope.
+	RecordsResourceEvents() bool
+	RecordResourceEvent(reason, message string)
+}
+
 // ClusterScoper combines the ClusterDescriber and NetworkDescriber interfaces.
 type ClusterScoper interface {
 	ClusterDescriber
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// ResourceNameKind is the kind of Azure resource a name is generated for.
+type ResourceNameKind string
+
+const (
+	// NetworkInterfaceNameKind is the kind of the network interfaces of virtual machines.
+	NetworkInterfaceNameKind ResourceNameKind = "networkInterface"
+	// OSDiskNameKind is the kind of the OS disks of virtual machines.
+	OSDiskNameKind ResourceNameKind = "osDisk"
+	// DataDiskNameKind is the kind of the data disks of virtual machines.
+	DataDiskNameKind ResourceNameKind = "dataDisk"
+	// NodePublicIPNameKind is the kind of the public IPs of virtual machines.
+	NodePublicIPNameKind ResourceNameKind = "nodePublicIP"
+)
+
+// resourceNameRules are the naming rules of Azure for each kind of resource.
+// See https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
+var resourceNameRules = map[ResourceNameKind]*regexp.Regexp{
+	// Network interfaces and public IPs: 1-80 alphanumerics, underscores, periods and hyphens,
+	// starting with an alphanumeric and ending with an alphanumeric or an underscore.
+	NetworkInterfaceNameKind: regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+	NodePublicIPNameKind:     regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+	// Managed disks: 1-80 alphanumerics, underscores and hyphens.
+	OSDiskNameKind:   regexp.MustCompile(`^[a-zA-Z0-9_-]{1,80}$`),
+	DataDiskNameKind: regexp.MustCompile(`^[a-zA-Z0-9_-]{1,80}$`),
+}
+
+// ResourceNameParams are the parameters a resource name is generated from.
+type ResourceNameParams struct {
+	// Name is the name of the virtual machine the resource belongs to.
+	Name string
+	// Index is the index of the network interface of a virtual machine, starting at 0.
+	Index int
+	// Suffix is the name suffix of a data disk.
+	Suffix string
+	// Default is the name CAPZ generates for the resource when no naming strategy is configured.
+	Default string
+}
+
+// NameGenerator generates the names of Azure resources.
+type NameGenerator interface {
+	// GenerateName returns the name of a resource of the given kind.
+	GenerateName(kind ResourceNameKind, params ResourceNameParams) string
+}
+
+// defaultNameGenerator generates the default CAPZ names.
+type defaultNameGenerator struct{}
+
+// GenerateName returns the default name of the resource.
+func (defaultNameGenerator) GenerateName(_ ResourceNameKind, params ResourceNameParams) string {
+	return params.Default
+}
+
+type nameGeneratorHolder struct {
+	NameGenerator
+}
+
+// nameGenerator is the NameGenerator used by the Generate functions of this package.
+var nameGenerator atomic.Pointer[nameGeneratorHolder]
+
+func init() {
+	nameGenerator.Store(&nameGeneratorHolder{defaultNameGenerator{}})
+}
+
+// SetNameGenerator sets the NameGenerator used to generate the names of Azure resources.
+// Changing the names of resources of existing clusters orphans their existing resources, so the
+// generator must only be set when the controller starts.
+func SetNameGenerator(generator NameGenerator) {
+	nameGenerator.Store(&nameGeneratorHolder{generator})
+}
+
+// generateName generates the name of a resource of the given kind with the current NameGenerator.
+func generateName(kind ResourceNameKind, params ResourceNameParams) string {
+	return nameGenerator.Load().GenerateName(kind, params)
+}
+
+// TemplateNameGenerator generates the names of Azure resources from Go templates, e.g.
+// `prod-eastus-{{ .Name }}-nic-{{ printf "%03d" (add .Index 1) }}`. The templates are executed with the
+// ResourceNameParams of the resource. Resources without a template get their default name.
+type TemplateNameGenerator struct {
+	templates map[ResourceNameKind]*template.Template
+}
+
+// NewTemplateNameGenerator returns a TemplateNameGenerator from templates keyed by resource kind.
+// Each template is checked by generating a name for a sample resource and validating it against the
+// naming rules of Azure.
+func NewTemplateNameGenerator(templates map[string]string) (*TemplateNameGenerator, error) {
+	generator := &TemplateNameGenerator{templates: make(map[ResourceNameKind]*template.Template, len(templates))}
+	for kind, text := range templates {
+		rule, ok := resourceNameRules[ResourceNameKind(kind)]
+		if !ok {
+			return nil, errors.Errorf("unknown resource kind %q, expected one of [%s]", kind, strings.Join(ResourceNameKinds(), ", "))
+		}
+		tmpl, err := template.New(kind).Option("missingkey=error").Funcs(template.FuncMap{
+			"add": func(a, b int) int { return a + b },
+		}).Parse(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid name template for resource kind %q", kind)
+		}
+		generator.templates[ResourceNameKind(kind)] = tmpl
+
+		sample, err := generator.execute(ResourceNameKind(kind), ResourceNameParams{
+			Name:    "my-cluster-md-0-abcde-fghij",
+			Index:   0,
+			Suffix:  "etcddisk",
+			Default: "default",
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid name template for resource kind %q", kind)
+		}
+		if !rule.MatchString(sample) {
+			return nil, errors.Errorf("name template for resource kind %q generates the name %q, which does not match %s", kind, sample, rule.String())
+		}
+	}
+	return generator, nil
+}
+
+// GenerateName returns the name of a resource of the given kind from its template, or its default name
+// if there is no template for the kind.
+func (g *TemplateNameGenerator) GenerateName(kind ResourceNameKind, params ResourceNameParams) string {
+	if _, ok := g.templates[kind]; !ok {
+		return params.Default
+	}
+	name, err := g.execute(kind, params)
+	if err != nil {
+		// The templates are executed successfully when the generator is created, and the parameters
+		// do not change the fields they may refer to.
+		return params.Default
+	}
+	return name
+}
+
+func (g *TemplateNameGenerator) execute(kind ResourceNameKind, params ResourceNameParams) (string, error) {
+	var buf bytes.Buffer
+	if err := g.templates[kind].Execute(&buf, params); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ResourceNameKinds returns the kinds of resources whose names can be generated from templates, sorted.
+func ResourceNameKinds() []string {
+	kinds := make([]string, 0, len(resourceNameRules))
+	for kind := range resourceNameRules {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// LoadResourceNameTemplates loads the name templates of Azure resources from a YAML or JSON file mapping
+// resource kinds to templates, e.g. mounted from a ConfigMap, and uses them to generate resource names.
+func LoadResourceNameTemplates(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open the resource name templates file %s", path)
+	}
+	defer f.Close()
+
+	templates := map[string]string{}
+	if err := yaml.NewYAMLOrJSONDecoder(f, 4096).Decode(&templates); err != nil {
+		return errors.Wrapf(err, "failed to decode the resource name templates file %s", path)
+	}
+	generator, err := NewTemplateNameGenerator(templates)
+	if err != nil {
+		return errors.Wrapf(err, "invalid resource name templates file %s", path)
+	}
+	SetNameGenerator(generator)
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+const resourceNameTemplatesFile = `
+networkInterface: 'prod-eastus-{{ .Name }}-nic-{{ printf "%03d" (add .Index 1) }}'
+osDisk: 'prod-eastus-{{ .Name }}-osdisk'
+dataDisk: 'prod-eastus-{{ .Name }}-{{ .Suffix }}'
+`
+
+func TestLoadResourceNameTemplates(t *testing.T) {
+	t.Cleanup(func() { SetNameGenerator(defaultNameGenerator{}) })
+
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "templates.yaml")
+	g.Expect(os.WriteFile(path, []byte(resourceNameTemplatesFile), 0600)).To(Succeed())
+	g.Expect(LoadResourceNameTemplates(path)).To(Succeed())
+
+	g.Expect(GenerateNICName("my-vm", false, 0)).To(Equal("prod-eastus-my-vm-nic-001"))
+	g.Expect(GenerateNICName("my-vm", true, 1)).To(Equal("prod-eastus-my-vm-nic-002"))
+	g.Expect(GenerateOSDiskName("my-vm")).To(Equal("prod-eastus-my-vm-osdisk"))
+	g.Expect(GenerateDataDiskName("my-vm", "etcddisk")).To(Equal("prod-eastus-my-vm-etcddisk"))
+	// Resources without a template keep their default name.
+	g.Expect(GenerateNodePublicIPName("my-vm")).To(Equal("pip-my-vm"))
+}
+
+func TestNewTemplateNameGeneratorInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		templates   map[string]string
+		expectedErr string
+	}{
+		{
+			name:        "unknown resource kind",
+			templates:   map[string]string{"loadBalancer": "{{ .Name }}-lb"},
+			expectedErr: `unknown resource kind "loadBalancer", expected one of [dataDisk, networkInterface, nodePublicIP, osDisk]`,
+		},
+		{
+			name:        "template that does not parse",
+			templates:   map[string]string{"osDisk": "{{ .Name "},
+			expectedErr: `invalid name template for resource kind "osDisk"`,
+		},
+		{
+			name:        "template referring to an unknown field",
+			templates:   map[string]string{"osDisk": "{{ .Location }}-{{ .Name }}"},
+			expectedErr: `invalid name template for resource kind "osDisk"`,
+		},
+		{
+			name:        "template generating a name with invalid characters",
+			templates:   map[string]string{"osDisk": "{{ .Name }}.osdisk"},
+			expectedErr: `name template for resource kind "osDisk" generates the name "my-cluster-md-0-abcde-fghij.osdisk"`,
+		},
+		{
+			name:        "template generating a name that is too long",
+			templates:   map[string]string{"networkInterface": "{{ .Name }}-{{ .Name }}-{{ .Name }}-nic"},
+			expectedErr: `name template for resource kind "networkInterface" generates the name`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := NewTemplateNameGenerator(tc.templates)
+			g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+		})
+	}
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import "fmt"
+
+const (
+	// ResourceCreatedReason is the reason of the Event recorded when an Azure resource is created.
+	ResourceCreatedReason = "AzureResourceCreated"
+	// ResourceUpdatedReason is the reason of the Event recorded when an Azure resource is updated.
+	ResourceUpdatedReason = "AzureResourceUpdated"
+	// ResourceCreatedOrUpdatedReason is the reason of the Event recorded when a long-running create or update
+	// operation resumed from a previous reconciliation completes.
+	ResourceCreatedOrUpdatedReason = "AzureResourceCreatedOrUpdated"
+	// ResourceDeletedReason is the reason of the Event recorded when an Azure resource is deleted.
+	ResourceDeletedReason = "AzureResourceDeleted"
+)
+
+// RecordsResourceEvents returns true if scope records an Event for each Azure resource mutated on its behalf.
+func RecordsResourceEvents(scope interface{}) bool {
+	recorder, ok := scope.(ResourceEventRecorder)
+	return ok && recorder.RecordsResourceEvents()
+}
+
+// RecordResourceEvent records an Event for the Azure resource mutated by the named service if scope records
+// resource Events. The resource is named by its Azure resource ID when it is known.
+func RecordResourceEvent(scope interface{}, reason, resource, serviceName string) {
+	if !RecordsResourceEvents(scope) {
+		return
+	}
+	scope.(ResourceEventRecorder).RecordResourceEvent(reason, fmt.Sprintf("resource %s (service: %s)", resource, serviceName))
+}
@@ -25,10 +25,10 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
-	azureautorest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 )
 
 // AzureClients contains all the Azure clients used by the scopes.
@@ -86,6 +86,12 @@ func (c *AzureClients) setCredentialsWithProvider(ctx context.Context, subscript
 		return fmt.Errorf("credentials provider cannot have an empty value")
 	}
 
+	// The cloud of the identity takes precedence over the cloud of the cluster, since the identity can only
+	// authenticate with its own cloud.
+	if identityEnvironment := credentialsProvider.GetAzureEnvironment(); identityEnvironment != "" {
+		environmentName = identityEnvironment
+	}
+
 	settings, err := c.getSettingsFromEnvironment(environmentName)
 	if err != nil {
 		return err
@@ -113,6 +119,15 @@ func (c *AzureClients) setCredentialsWithProvider(ctx context.Context, subscript
 
 	c.authType = credentialsProvider.Type()
 
+	// The limit is removed when the identity no longer sets it. It is keyed by the identity so that identities
+	// used with the same subscription neither override nor remove the limit of each other.
+	var qps float64
+	var burst int
+	if rateLimit := credentialsProvider.GetRateLimit(); rateLimit != nil {
+		qps, burst = float64(rateLimit.QPS), int(rateLimit.Burst)
+	}
+	azure.SetSubscriptionRateLimit(c.SubscriptionID(), credentialsProvider.GetRateLimitOwner(), qps, burst)
+
 	tokenCredential, err := credentialsProvider.GetTokenCredential(ctx, c.ResourceManagerEndpoint, c.Environment.ActiveDirectoryEndpoint, c.Environment.TokenAudience)
 	if err != nil {
 		return err
@@ -136,11 +151,7 @@ func (c *AzureClients) getSettingsFromEnvironment(environmentName string) (s aut
 	setValue(s, "AZURE_USERNAME")
 	setValue(s, "AZURE_PASSWORD")
 	setValue(s, "AZURE_AD_RESOURCE")
-	if v := s.Values["AZURE_ENVIRONMENT"]; v == "" {
-		s.Environment = azureautorest.PublicCloud
-	} else {
-		s.Environment, err = azureautorest.EnvironmentFromName(v)
-	}
+	s.Environment, err = azure.EnvironmentFromName(s.Values["AZURE_ENVIRONMENT"])
 	if s.Values["AZURE_AD_RESOURCE"] == "" {
 		s.Values["AZURE_AD_RESOURCE"] = s.Environment.ResourceManagerEndpoint
 	}
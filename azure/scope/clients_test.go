@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	. "github.com/onsi/gomega"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+type fakeCredentialsProvider struct {
+	azureEnvironment        string
+	resourceManagerEndpoint string
+}
+
+func (p *fakeCredentialsProvider) GetClientID() string { return "fake-client-id" }
+
+func (p *fakeCredentialsProvider) GetClientSecret(_ context.Context) (string, error) { return "", nil }
+
+func (p *fakeCredentialsProvider) GetTenantID() string { return "fake-tenant-id" }
+
+func (p *fakeCredentialsProvider) GetAzureEnvironment() string { return p.azureEnvironment }
+
+func (p *fakeCredentialsProvider) GetRateLimit() *infrav1.ClientRateLimit { return nil }
+
+func (p *fakeCredentialsProvider) GetRateLimitOwner() string { return "fake-namespace/fake-identity" }
+
+func (p *fakeCredentialsProvider) GetTokenCredential(_ context.Context, resourceManagerEndpoint, _, _ string) (azcore.TokenCredential, error) {
+	p.resourceManagerEndpoint = resourceManagerEndpoint
+	return nil, nil
+}
+
+func (p *fakeCredentialsProvider) Type() infrav1.IdentityType { return infrav1.ServicePrincipal }
+
+func TestSetCredentialsWithProviderAzureEnvironment(t *testing.T) {
+	tests := []struct {
+		name                     string
+		clusterEnvironment       string
+		identityEnvironment      string
+		expectedEnvironment      string
+		expectedResourceEndpoint string
+	}{
+		{
+			name:                     "uses the environment of the cluster",
+			clusterEnvironment:       "AzureUSGovernmentCloud",
+			expectedEnvironment:      "AzureUSGovernmentCloud",
+			expectedResourceEndpoint: azureautorest.USGovernmentCloud.ResourceManagerEndpoint,
+		},
+		{
+			name:                     "uses the environment of the identity over the environment of the cluster",
+			clusterEnvironment:       "AzurePublicCloud",
+			identityEnvironment:      "AzureChinaCloud",
+			expectedEnvironment:      "AzureChinaCloud",
+			expectedResourceEndpoint: azureautorest.ChinaCloud.ResourceManagerEndpoint,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			provider := &fakeCredentialsProvider{azureEnvironment: tc.identityEnvironment}
+			clients := &AzureClients{}
+
+			err := clients.setCredentialsWithProvider(context.Background(), "fake-subscription-id", tc.clusterEnvironment, provider)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(clients.CloudEnvironment()).To(Equal(tc.expectedEnvironment))
+			g.Expect(clients.ResourceManagerEndpoint).To(Equal(tc.expectedResourceEndpoint))
+			g.Expect(provider.resourceManagerEndpoint).To(Equal(tc.expectedResourceEndpoint))
+		})
+	}
+}
@@ -24,17 +24,21 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	asonetworkv1api20201101 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kuberecord "k8s.io/client-go/tools/record"
 	"k8s.io/utils/net"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -43,8 +47,10 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/ownedresources"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatedns"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatelinkservices"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/routetables"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/securitygroups"
@@ -56,6 +62,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
+// maxManagedResourceIDs is the maximum number of owned resource IDs recorded in the AzureCluster status.
+const maxManagedResourceIDs = 50
+
 // ClusterScopeParams defines the input parameters used to create a new Scope.
 type ClusterScopeParams struct {
 	AzureClients
@@ -65,6 +74,14 @@ type ClusterScopeParams struct {
 	Cache           *ClusterCache
 	Timeouts        azure.AsyncReconciler
 	CredentialCache azure.CredentialCache
+
+	// PublicIPDeletionGrace is how long an unreferenced managed public IP is kept before it is deleted.
+	// Unreferenced public IPs are not deleted when it is zero.
+	PublicIPDeletionGrace time.Duration
+
+	// ResourceEventRecorder, when set, records an Event on the AzureCluster for each Azure resource created,
+	// updated, or deleted by the cluster's services.
+	ResourceEventRecorder kuberecord.EventRecorder
 }
 
 // NewClusterScope creates a new Scope from the supplied parameters.
@@ -106,6 +123,9 @@ func NewClusterScope(ctx context.Context, params ClusterScopeParams) (*ClusterSc
 		patchHelper:     helper,
 		cache:           params.Cache,
 		AsyncReconciler: params.Timeouts,
+
+		publicIPDeletionGrace: params.PublicIPDeletionGrace,
+		resourceEventRecorder: params.ResourceEventRecorder,
 	}, nil
 }
 
@@ -119,6 +139,9 @@ type ClusterScope struct {
 	Cluster      *clusterv1.Cluster
 	AzureCluster *infrav1.AzureCluster
 	azure.AsyncReconciler
+
+	publicIPDeletionGrace time.Duration
+	resourceEventRecorder kuberecord.EventRecorder
 }
 
 // ClusterCache stores ClusterCache data locally so we don't have to hit the API multiple times within the same reconcile loop.
@@ -146,6 +169,24 @@ func (s *ClusterScope) ASOOwner() client.Object {
 	return s.AzureCluster
 }
 
+// PublicIPDeletionGracePeriod returns how long an unreferenced managed public IP is kept before it is deleted.
+func (s *ClusterScope) PublicIPDeletionGracePeriod() time.Duration {
+	return s.publicIPDeletionGrace
+}
+
+// MachinePublicIPNames returns the names of the public IPs of the AzureMachines in the cluster.
+func (s *ClusterScope) MachinePublicIPNames(ctx context.Context) ([]string, error) {
+	machines := &infrav1.AzureMachineList{}
+	if err := s.Client.List(ctx, machines, client.InNamespace(s.Namespace()), s.ListOptionsLabelSelector()); err != nil {
+		return nil, errors.Wrap(err, "failed to list AzureMachines")
+	}
+	names := make([]string, 0, len(machines.Items))
+	for _, machine := range machines.Items {
+		names = append(names, azure.GenerateNodePublicIPName(machine.Name))
+	}
+	return names, nil
+}
+
 // PublicIPSpecs returns the public IP specs.
 func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	var publicIPSpecs []azure.ResourceSpecGetter
@@ -164,7 +205,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 					IsIPv6:           false, // Set to default value
 					Location:         s.Location(),
 					ExtendedLocation: s.ExtendedLocation(),
-					FailureDomains:   s.FailureDomains(),
+					FailureDomains:   s.publicIPZones(ip.PublicIP),
 					AdditionalTags:   s.AdditionalTags(),
 				})
 			}
@@ -180,7 +221,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 					ClusterName:      s.ClusterName(),
 					Location:         s.Location(),
 					ExtendedLocation: s.ExtendedLocation(),
-					FailureDomains:   s.FailureDomains(),
+					FailureDomains:   s.publicIPZones(s.APIServerPublicIP()),
 					AdditionalTags:   s.AdditionalTags(),
 					IPTags:           s.APIServerPublicIP().IPTags,
 				},
@@ -190,8 +231,13 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	publicIPSpecs = append(publicIPSpecs, controlPlaneOutboundIPSpecs...)
 
 	// Public IP specs for node outbound lb
-	if s.NodeOutboundLB() != nil {
-		for _, ip := range s.NodeOutboundLB().FrontendIPs {
+	if s.NodeOutboundLB() != nil && !s.IsAPIServerOutboundIPShared() {
+		// The outbound backend pools have their own public IPs.
+		frontendIPs := append([]infrav1.FrontendIP{}, s.NodeOutboundLB().FrontendIPs...)
+		for _, pool := range s.NodeOutboundLB().OutboundBackendPools {
+			frontendIPs = append(frontendIPs, pool.FrontendIPs...)
+		}
+		for _, ip := range frontendIPs {
 			publicIPSpecs = append(publicIPSpecs, &publicips.PublicIPSpec{
 				Name:             ip.PublicIP.Name,
 				ResourceGroup:    s.ResourceGroup(),
@@ -200,7 +246,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				IsIPv6:           false, // Set to default value
 				Location:         s.Location(),
 				ExtendedLocation: s.ExtendedLocation(),
-				FailureDomains:   s.FailureDomains(),
+				FailureDomains:   s.publicIPZones(ip.PublicIP),
 				AdditionalTags:   s.AdditionalTags(),
 			})
 		}
@@ -212,12 +258,12 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 		if subnet.IsNatGatewayEnabled() {
 			nodeNatGatewayIPSpecs = append(nodeNatGatewayIPSpecs, &publicips.PublicIPSpec{
 				Name:           subnet.NatGateway.NatGatewayIP.Name,
-				ResourceGroup:  s.ResourceGroup(),
+				ResourceGroup:  s.NetworkResourceGroup(),
 				DNSName:        subnet.NatGateway.NatGatewayIP.DNSName,
 				IsIPv6:         false, // Public IP is IPv4 by default
 				ClusterName:    s.ClusterName(),
 				Location:       s.Location(),
-				FailureDomains: s.FailureDomains(),
+				FailureDomains: s.publicIPZones(&subnet.NatGateway.NatGatewayIP),
 				AdditionalTags: s.AdditionalTags(),
 				IPTags:         subnet.NatGateway.NatGatewayIP.IPTags,
 			})
@@ -234,7 +280,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 			IsIPv6:         false, // Public IP is IPv4 by default
 			ClusterName:    s.ClusterName(),
 			Location:       s.Location(),
-			FailureDomains: s.FailureDomains(),
+			FailureDomains: s.publicIPZones(&azureBastion.PublicIP),
 			AdditionalTags: s.AdditionalTags(),
 			IPTags:         azureBastion.PublicIP.IPTags,
 		}
@@ -244,6 +290,39 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	return publicIPSpecs
 }
 
+// publicIPZones returns the zones of a public IP, defaulting to all of the cluster's failure domains.
+func (s *ClusterScope) publicIPZones(ip *infrav1.PublicIPSpec) []*string {
+	if ip == nil || len(ip.Zones) == 0 {
+		return s.FailureDomains()
+	}
+	return azure.PtrSlice(&ip.Zones)
+}
+
+// PublicIPZones returns the zones explicitly set on the cluster's public IPs, keyed by public IP name.
+func (s *ClusterScope) PublicIPZones() map[string][]string {
+	zones := make(map[string][]string)
+	addZones := func(ip *infrav1.PublicIPSpec) {
+		if ip != nil && len(ip.Zones) > 0 {
+			zones[ip.Name] = ip.Zones
+		}
+	}
+	for _, lb := range []*infrav1.LoadBalancerSpec{s.APIServerLB(), s.NodeOutboundLB(), s.ControlPlaneOutboundLB()} {
+		if lb == nil {
+			continue
+		}
+		for _, ip := range lb.FrontendIPs {
+			addZones(ip.PublicIP)
+		}
+	}
+	for _, subnet := range s.NodeSubnets() {
+		addZones(&subnet.NatGateway.NatGatewayIP)
+	}
+	if azureBastion := s.AzureBastion(); azureBastion != nil {
+		addZones(&azureBastion.PublicIP)
+	}
+	return zones
+}
+
 // LBSpecs returns the load balancer specs.
 func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 	var specs []azure.ResourceSpecGetter
@@ -265,7 +344,16 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			Role:                 infrav1.APIServerRole,
 			BackendPoolName:      s.APIServerLB().BackendPool.Name,
 			IdleTimeoutInMinutes: s.APIServerLB().IdleTimeoutInMinutes,
+			HealthProbe:          s.APIServerLB().HealthProbe,
+			HAPorts:              s.APIServerLB().HAPorts,
+			EnableFloatingIP:     s.APIServerLB().EnableFloatingIP,
 			AdditionalTags:       s.AdditionalTags(),
+			AllowTypeMigration:   feature.Gates.Enabled(feature.APIServerLBTypeMigration),
+			Unmanaged:            s.APIServerLB().Unmanaged,
+		}
+
+		if s.IsAPIServerOutboundIPShared() {
+			frontendLB.NodeOutboundBackendPoolName = s.OutboundPoolName(infrav1.Node)
 		}
 
 		if s.APIServerLB().FrontendIPs != nil {
@@ -282,6 +370,12 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 	}
 
 	if s.APIServerLB().Type != infrav1.Internal && feature.Gates.Enabled(feature.APIServerILB) {
+		// The SKU and idle timeout of an unmanaged API server load balancer are not set, so the internal load
+		// balancer CAPZ creates next to it uses the defaults.
+		sku, idleTimeoutInMinutes := s.APIServerLB().SKU, s.APIServerLB().IdleTimeoutInMinutes
+		if s.APIServerLB().Unmanaged {
+			sku, idleTimeoutInMinutes = infrav1.SKUStandard, ptr.To[int32](infrav1.DefaultOutboundRuleIdleTimeoutInMinutes)
+		}
 		internalLB := &loadbalancers.LBSpec{
 			Name:                 s.APIServerLB().Name + "-internal",
 			ResourceGroup:        s.ResourceGroup(),
@@ -294,10 +388,11 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			SubnetName:           s.ControlPlaneSubnet().Name,
 			APIServerPort:        s.APIServerPort(),
 			Type:                 infrav1.Internal,
-			SKU:                  s.APIServerLB().SKU,
+			SKU:                  sku,
 			Role:                 infrav1.APIServerRoleInternal,
 			BackendPoolName:      s.APIServerLB().BackendPool.Name + "-internal",
-			IdleTimeoutInMinutes: s.APIServerLB().IdleTimeoutInMinutes,
+			IdleTimeoutInMinutes: idleTimeoutInMinutes,
+			HealthProbe:          s.APIServerLB().HealthProbe,
 			AdditionalTags:       s.AdditionalTags(),
 		}
 
@@ -329,44 +424,47 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 	}
 
 	// Node outbound LB
-	if s.NodeOutboundLB() != nil {
+	if s.NodeOutboundLB() != nil && !s.IsAPIServerOutboundIPShared() {
 		specs = append(specs, &loadbalancers.LBSpec{
-			Name:                 s.NodeOutboundLB().Name,
-			ResourceGroup:        s.ResourceGroup(),
-			SubscriptionID:       s.SubscriptionID(),
-			ClusterName:          s.ClusterName(),
-			Location:             s.Location(),
-			ExtendedLocation:     s.ExtendedLocation(),
-			VNetName:             s.Vnet().Name,
-			VNetResourceGroup:    s.Vnet().ResourceGroup,
-			FrontendIPConfigs:    s.NodeOutboundLB().FrontendIPs,
-			Type:                 s.NodeOutboundLB().Type,
-			SKU:                  s.NodeOutboundLB().SKU,
-			BackendPoolName:      s.NodeOutboundLB().BackendPool.Name,
-			IdleTimeoutInMinutes: s.NodeOutboundLB().IdleTimeoutInMinutes,
-			Role:                 infrav1.NodeOutboundRole,
-			AdditionalTags:       s.AdditionalTags(),
+			Name:                   s.NodeOutboundLB().Name,
+			ResourceGroup:          s.ResourceGroup(),
+			SubscriptionID:         s.SubscriptionID(),
+			ClusterName:            s.ClusterName(),
+			Location:               s.Location(),
+			ExtendedLocation:       s.ExtendedLocation(),
+			VNetName:               s.Vnet().Name,
+			VNetResourceGroup:      s.Vnet().ResourceGroup,
+			FrontendIPConfigs:      s.NodeOutboundLB().FrontendIPs,
+			Type:                   s.NodeOutboundLB().Type,
+			SKU:                    s.NodeOutboundLB().SKU,
+			BackendPoolName:        s.NodeOutboundLB().BackendPool.Name,
+			IdleTimeoutInMinutes:   s.NodeOutboundLB().IdleTimeoutInMinutes,
+			AllocatedOutboundPorts: s.NodeOutboundLB().AllocatedOutboundPorts,
+			OutboundBackendPools:   s.NodeOutboundLB().OutboundBackendPools,
+			Role:                   infrav1.NodeOutboundRole,
+			AdditionalTags:         s.AdditionalTags(),
 		})
 	}
 
 	// Control Plane Outbound LB
 	if s.ControlPlaneOutboundLB() != nil {
 		specs = append(specs, &loadbalancers.LBSpec{
-			Name:                 s.ControlPlaneOutboundLB().Name,
-			ResourceGroup:        s.ResourceGroup(),
-			SubscriptionID:       s.SubscriptionID(),
-			ClusterName:          s.ClusterName(),
-			Location:             s.Location(),
-			ExtendedLocation:     s.ExtendedLocation(),
-			VNetName:             s.Vnet().Name,
-			VNetResourceGroup:    s.Vnet().ResourceGroup,
-			FrontendIPConfigs:    s.ControlPlaneOutboundLB().FrontendIPs,
-			Type:                 s.ControlPlaneOutboundLB().Type,
-			SKU:                  s.ControlPlaneOutboundLB().SKU,
-			BackendPoolName:      s.ControlPlaneOutboundLB().BackendPool.Name,
-			IdleTimeoutInMinutes: s.ControlPlaneOutboundLB().IdleTimeoutInMinutes,
-			Role:                 infrav1.ControlPlaneOutboundRole,
-			AdditionalTags:       s.AdditionalTags(),
+			Name:                   s.ControlPlaneOutboundLB().Name,
+			ResourceGroup:          s.ResourceGroup(),
+			SubscriptionID:         s.SubscriptionID(),
+			ClusterName:            s.ClusterName(),
+			Location:               s.Location(),
+			ExtendedLocation:       s.ExtendedLocation(),
+			VNetName:               s.Vnet().Name,
+			VNetResourceGroup:      s.Vnet().ResourceGroup,
+			FrontendIPConfigs:      s.ControlPlaneOutboundLB().FrontendIPs,
+			Type:                   s.ControlPlaneOutboundLB().Type,
+			SKU:                    s.ControlPlaneOutboundLB().SKU,
+			BackendPoolName:        s.ControlPlaneOutboundLB().BackendPool.Name,
+			IdleTimeoutInMinutes:   s.ControlPlaneOutboundLB().IdleTimeoutInMinutes,
+			AllocatedOutboundPorts: s.ControlPlaneOutboundLB().AllocatedOutboundPorts,
+			Role:                   infrav1.ControlPlaneOutboundRole,
+			AdditionalTags:         s.AdditionalTags(),
 		})
 	}
 
@@ -401,16 +499,21 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 		if subnet.IsNatGatewayEnabled() {
 			if _, ok := natGatewaySet[subnet.NatGateway.Name]; !ok {
 				natGatewaySet[subnet.NatGateway.Name] = struct{}{} // empty struct to represent hash set
+				var publicIPPrefixes []string
+				for _, prefix := range subnet.NatGateway.PublicIPPrefixes {
+					publicIPPrefixes = append(publicIPPrefixes, prefix.ID)
+				}
 				natGateways = append(natGateways, &natgateways.NatGatewaySpec{
 					Name:           subnet.NatGateway.Name,
-					ResourceGroup:  s.ResourceGroup(),
+					ResourceGroup:  s.NetworkResourceGroup(),
 					SubscriptionID: s.SubscriptionID(),
 					Location:       s.Location(),
 					ClusterName:    s.ClusterName(),
 					NatGatewayIP: infrav1.PublicIPSpec{
 						Name: subnet.NatGateway.NatGatewayIP.Name,
 					},
-					AdditionalTags: s.AdditionalTags(),
+					PublicIPPrefixes: publicIPPrefixes,
+					AdditionalTags:   s.AdditionalTags(),
 					// We need to know if the VNet is managed to decide if this NAT Gateway was-managed or not.
 					IsVnetManaged: s.IsVnetManaged(),
 				})
@@ -421,11 +524,14 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 	return natGateways
 }
 
-// NSGSpecs returns the security group specs.
+// NSGSpecs returns the security group specs. Unmanaged security groups are skipped.
 func (s *ClusterScope) NSGSpecs() []azure.ResourceSpecGetter {
-	nsgspecs := make([]azure.ResourceSpecGetter, len(s.AzureCluster.Spec.NetworkSpec.Subnets))
-	for i, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
-		nsgspecs[i] = &securitygroups.NSGSpec{
+	nsgspecs := make([]azure.ResourceSpecGetter, 0, len(s.AzureCluster.Spec.NetworkSpec.Subnets))
+	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
+		if subnet.SecurityGroup.Unmanaged {
+			continue
+		}
+		nsgspecs = append(nsgspecs, &securitygroups.NSGSpec{
 			Name:                     subnet.SecurityGroup.Name,
 			SecurityRules:            subnet.SecurityGroup.SecurityRules,
 			ResourceGroup:            s.Vnet().ResourceGroup,
@@ -433,7 +539,7 @@ func (s *ClusterScope) NSGSpecs() []azure.ResourceSpecGetter {
 			ClusterName:              s.ClusterName(),
 			AdditionalTags:           s.AdditionalTags(),
 			LastAppliedSecurityRules: s.getLastAppliedSecurityRules(subnet.SecurityGroup.Name),
-		}
+		})
 	}
 
 	return nsgspecs
@@ -451,7 +557,7 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
 		subnetSpec := &subnets.SubnetSpec{
 			Name:              subnet.Name,
-			ResourceGroup:     s.ResourceGroup(),
+			ResourceGroup:     s.NetworkResourceGroup(),
 			SubscriptionID:    s.SubscriptionID(),
 			CIDRs:             subnet.CIDRBlocks,
 			VNetName:          s.Vnet().Name,
@@ -461,15 +567,20 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 			SecurityGroupName: subnet.SecurityGroup.Name,
 			NatGatewayName:    subnet.NatGateway.Name,
 			ServiceEndpoints:  subnet.ServiceEndpoints,
+
+			DisablePrivateLinkServiceNetworkPolicies: s.isPrivateLinkServiceSubnet(subnet.Name),
+		}
+		if subnet.SecurityGroup.Unmanaged {
+			subnetSpec.SecurityGroupID = subnet.SecurityGroup.ID
 		}
 		subnetSpecs = append(subnetSpecs, subnetSpec)
 	}
 
 	if s.IsAzureBastionEnabled() {
 		azureBastionSubnet := s.AzureCluster.Spec.BastionSpec.AzureBastion.Subnet
-		subnetSpecs = append(subnetSpecs, &subnets.SubnetSpec{
+		bastionSubnetSpec := &subnets.SubnetSpec{
 			Name:              azureBastionSubnet.Name,
-			ResourceGroup:     s.ResourceGroup(),
+			ResourceGroup:     s.NetworkResourceGroup(),
 			SubscriptionID:    s.SubscriptionID(),
 			CIDRs:             azureBastionSubnet.CIDRBlocks,
 			VNetName:          s.Vnet().Name,
@@ -478,13 +589,18 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 			SecurityGroupName: azureBastionSubnet.SecurityGroup.Name,
 			RouteTableName:    azureBastionSubnet.RouteTable.Name,
 			ServiceEndpoints:  azureBastionSubnet.ServiceEndpoints,
-		})
+		}
+		if azureBastionSubnet.SecurityGroup.Unmanaged {
+			bastionSubnetSpec.SecurityGroupID = azureBastionSubnet.SecurityGroup.ID
+		}
+		subnetSpecs = append(subnetSpecs, bastionSubnetSpec)
 	}
 
 	return subnetSpecs
 }
 
-// GroupSpecs returns the resource group spec.
+// GroupSpecs returns the resource group specs. The cluster resource group comes first so that it is deleted before
+// the vnet resource group, whose subnets may be in use by resources in the cluster resource group.
 func (s *ClusterScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup] {
 	specs := []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
 		&groups.GroupSpec{
@@ -548,6 +664,7 @@ func (s *ClusterScope) VNetSpec() azure.ASOResourceSpecGetter[*asonetworkv1api20
 		ResourceGroup:    s.Vnet().ResourceGroup,
 		Name:             s.Vnet().Name,
 		CIDRs:            s.Vnet().CIDRBlocks,
+		DNSServers:       s.Vnet().DNSServers,
 		ExtendedLocation: s.ExtendedLocation(),
 		Location:         s.Location(),
 		ClusterName:      s.ClusterName(),
@@ -622,14 +739,16 @@ func (s *ClusterScope) AzureBastionSpec() azure.ASOResourceSpecGetter[*asonetwor
 		publicIPID := azure.PublicIPID(s.SubscriptionID(), s.ResourceGroup(), s.AzureBastion().PublicIP.Name)
 
 		return &bastionhosts.AzureBastionSpec{
-			Name:            s.AzureBastion().Name,
-			ResourceGroup:   s.ResourceGroup(),
-			Location:        s.Location(),
-			ClusterName:     s.ClusterName(),
-			SubnetID:        subnetID,
-			PublicIPID:      publicIPID,
-			Sku:             s.AzureBastion().Sku,
-			EnableTunneling: s.AzureBastion().EnableTunneling,
+			Name:             s.AzureBastion().Name,
+			ResourceGroup:    s.ResourceGroup(),
+			Location:         s.Location(),
+			ClusterName:      s.ClusterName(),
+			SubnetID:         subnetID,
+			PublicIPID:       publicIPID,
+			Sku:              s.AzureBastion().Sku,
+			EnableTunneling:  s.AzureBastion().EnableTunneling,
+			ScaleUnits:       s.AzureBastion().ScaleUnits,
+			DisableCopyPaste: s.AzureBastion().DisableCopyPaste,
 		}
 	}
 
@@ -800,9 +919,26 @@ func (s *ClusterScope) APIServerLBPoolName() string {
 	return s.APIServerLB().BackendPool.Name
 }
 
+// IsAPIServerOutboundIPShared returns true if nodes use the public IP of the API server LB for outbound traffic.
+// The SharedAPIServerOutboundIP feature flag only gates opting in: a cluster that already shares the IP has no node
+// outbound LB, so it keeps sharing the IP when the flag is turned off.
+func (s *ClusterScope) IsAPIServerOutboundIPShared() bool {
+	return s.AzureCluster.Spec.NetworkSpec.ShareAPIServerOutboundIP &&
+		s.ControlPlaneEnabled() && s.APIServerLB() != nil && s.APIServerLB().Type == infrav1.Public
+}
+
+// IsOutboundDisabled returns true if the cluster's egress is provided by the route tables of its subnets
+// rather than by CAPZ-managed load balancers or NAT gateways.
+func (s *ClusterScope) IsOutboundDisabled() bool {
+	return s.AzureCluster.Spec.NetworkSpec.DisableOutbound
+}
+
 // OutboundLB returns the outbound LB.
 func (s *ClusterScope) outboundLB(role string) *infrav1.LoadBalancerSpec {
 	if role == infrav1.Node {
+		if s.IsAPIServerOutboundIPShared() {
+			return s.APIServerLB()
+		}
 		return s.NodeOutboundLB()
 	}
 	if s.IsAPIServerPrivate() {
@@ -826,14 +962,38 @@ func (s *ClusterScope) OutboundPoolName(role string) string {
 	if lb == nil {
 		return ""
 	}
+	if role == infrav1.Node && s.IsAPIServerOutboundIPShared() {
+		// Nodes use a dedicated backend pool of the API server LB.
+		return azure.GenerateOutboundBackendAddressPoolName(lb.Name)
+	}
 	return lb.BackendPool.Name
 }
 
+// NodeOutboundBackendPoolNames returns the names of the outbound backend pools of the node outbound LB.
+func (s *ClusterScope) NodeOutboundBackendPoolNames() []string {
+	if s.NodeOutboundLB() == nil || s.IsAPIServerOutboundIPShared() {
+		return nil
+	}
+	names := make([]string, 0, len(s.NodeOutboundLB().OutboundBackendPools))
+	for _, pool := range s.NodeOutboundLB().OutboundBackendPools {
+		names = append(names, pool.Name)
+	}
+	return names
+}
+
 // ResourceGroup returns the cluster resource group.
 func (s *ClusterScope) ResourceGroup() string {
 	return s.AzureCluster.Spec.ResourceGroup
 }
 
+// NetworkResourceGroup returns the network resource group, or the cluster resource group if none is set.
+func (s *ClusterScope) NetworkResourceGroup() string {
+	if s.AzureCluster.Spec.NetworkSpec.NetworkResourceGroup != "" {
+		return s.AzureCluster.Spec.NetworkSpec.NetworkResourceGroup
+	}
+	return s.ResourceGroup()
+}
+
 // NodeResourceGroup returns the resource group where nodes live.
 // For AzureClusters this is the same as the cluster RG.
 func (s *ClusterScope) NodeResourceGroup() string {
@@ -845,6 +1005,38 @@ func (s *ClusterScope) ClusterName() string {
 	return s.Cluster.Name
 }
 
+// IsDryRun returns true if the cluster has the dry-run annotation, in which case mutating Azure
+// operations are logged instead of performed.
+func (s *ClusterScope) IsDryRun() bool {
+	return isDryRun(s.Cluster)
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (s *ClusterScope) RecordDryRunSkip(message string) {
+	record.Event(s.AzureCluster, azure.DryRunSkippedReason, message)
+}
+
+// RecordsResourceEvents implements azure.ResourceEventRecorder.
+func (s *ClusterScope) RecordsResourceEvents() bool {
+	return s.resourceEventRecorder != nil
+}
+
+// RecordResourceEvent implements azure.ResourceEventRecorder.
+func (s *ClusterScope) RecordResourceEvent(reason, message string) {
+	if s.resourceEventRecorder != nil {
+		s.resourceEventRecorder.Event(s.AzureCluster, corev1.EventTypeNormal, reason, message)
+	}
+}
+
+// isDryRun returns true if the cluster has the dry-run annotation.
+func isDryRun(cluster *clusterv1.Cluster) bool {
+	if cluster == nil {
+		return false
+	}
+	_, ok := cluster.GetAnnotations()[infrav1.DryRunAnnotation]
+	return ok
+}
+
 // Namespace returns the cluster namespace.
 func (s *ClusterScope) Namespace() string {
 	return s.Cluster.Namespace
@@ -950,12 +1142,16 @@ func (s *ClusterScope) Close(ctx context.Context) error {
 	return s.PatchObject(ctx)
 }
 
-// AdditionalTags returns AdditionalTags from the scope's AzureCluster.
+// AdditionalTags returns AdditionalTags from the scope's AzureCluster, merged on top of the CAPI Cluster labels
+// matching the AzureCluster's ClusterLabelTagPrefixes.
 func (s *ClusterScope) AdditionalTags() infrav1.Tags {
 	tags := make(infrav1.Tags)
-	if s.AzureCluster.Spec.AdditionalTags != nil {
-		tags = s.AzureCluster.Spec.AdditionalTags.DeepCopy()
+	// Start with the labels of the CAPI Cluster to propagate...
+	if s.Cluster != nil {
+		tags.Merge(infrav1.ClusterLabelsToTags(s.Cluster.Labels, s.AzureCluster.Spec.ClusterLabelTagPrefixes))
 	}
+	// ... and merge in the AzureCluster's
+	tags.Merge(s.AzureCluster.Spec.AdditionalTags)
 	return tags
 }
 
@@ -977,18 +1173,40 @@ func (s *ClusterScope) APIServerHost() string {
 
 // SetFailureDomain sets a failure domain in a cluster's status by its id.
 // The provided failure domain spec may be overridden to false by cluster's spec property.
+// If the cluster's spec restricts the failure domains, ids not listed in its spec are removed from the status instead.
 func (s *ClusterScope) SetFailureDomain(id string, spec clusterv1.FailureDomainSpec) {
 	if s.AzureCluster.Status.FailureDomains == nil {
 		s.AzureCluster.Status.FailureDomains = make(clusterv1.FailureDomains)
 	}
 
-	if fd, ok := s.AzureCluster.Spec.FailureDomains[id]; ok && !fd.ControlPlane {
+	fd, ok := s.AzureCluster.Spec.FailureDomains[id]
+	if !ok && s.AzureCluster.Spec.RestrictFailureDomains {
+		delete(s.AzureCluster.Status.FailureDomains, id)
+		return
+	}
+	if ok && !fd.ControlPlane {
 		spec.ControlPlane = false
 	}
 
 	s.AzureCluster.Status.FailureDomains[id] = spec
 }
 
+// OwnedResourceIDs returns the IDs of the resources in the cluster's resource group that are tagged as owned by the
+// cluster. Results are cached for a few minutes to limit the number of list calls made to Azure.
+func (s *ClusterScope) OwnedResourceIDs(ctx context.Context) ([]string, error) {
+	return ownedresources.List(ctx, s, s.ResourceGroup(), s.ClusterName())
+}
+
+// SetManagedResources records a summary of the resources owned by the cluster in the AzureCluster status.
+// At most maxManagedResourceIDs IDs are recorded to keep the object small.
+func (s *ClusterScope) SetManagedResources(ids []string) {
+	managed := &infrav1.ManagedResources{Count: len(ids)}
+	if len(ids) > 0 {
+		managed.ResourceIDs = append([]string{}, ids[:min(len(ids), maxManagedResourceIDs)]...)
+	}
+	s.AzureCluster.Status.ManagedResources = managed
+}
+
 // FailureDomains returns the failure domains for the cluster.
 func (s *ClusterScope) FailureDomains() []*string {
 	fds := make([]*string, len(s.AzureCluster.Status.FailureDomains))
@@ -1008,25 +1226,36 @@ func (s *ClusterScope) FailureDomains() []*string {
 
 // SetControlPlaneSecurityRules sets the default security rules of the control plane subnet.
 // Note that this is not done in a webhook as it requires a valid Cluster object to exist to get the API Server port.
+// Unmanaged security groups are left untouched.
 func (s *ClusterScope) SetControlPlaneSecurityRules() {
-	if !s.ControlPlaneEnabled() {
+	if !s.ControlPlaneEnabled() || s.ControlPlaneSubnet().SecurityGroup.Unmanaged {
 		return
 	}
 	if s.ControlPlaneSubnet().SecurityGroup.SecurityRules == nil {
 		subnet := s.ControlPlaneSubnet()
+		sshRule := infrav1.SecurityRule{
+			Name:             "allow_ssh",
+			Description:      "Allow SSH",
+			Priority:         2200,
+			Protocol:         infrav1.SecurityGroupProtocolTCP,
+			Direction:        infrav1.SecurityRuleDirectionInbound,
+			Source:           ptr.To("*"),
+			SourcePorts:      ptr.To("*"),
+			Destination:      ptr.To("*"),
+			DestinationPorts: ptr.To("22"),
+			Action:           infrav1.SecurityRuleActionAllow,
+		}
+		if cidrs := s.AzureCluster.Spec.NetworkSpec.AllowedSSHSourceCIDRs; len(cidrs) == 1 {
+			sshRule.Source = ptr.To(cidrs[0])
+		} else if len(cidrs) > 1 {
+			// A security rule cannot have both a source and sources.
+			sshRule.Source = nil
+			for _, cidr := range cidrs {
+				sshRule.Sources = append(sshRule.Sources, ptr.To(cidr))
+			}
+		}
 		subnet.SecurityGroup.SecurityRules = infrav1.SecurityRules{
-			infrav1.SecurityRule{
-				Name:             "allow_ssh",
-				Description:      "Allow SSH",
-				Priority:         2200,
-				Protocol:         infrav1.SecurityGroupProtocolTCP,
-				Direction:        infrav1.SecurityRuleDirectionInbound,
-				Source:           ptr.To("*"),
-				SourcePorts:      ptr.To("*"),
-				Destination:      ptr.To("*"),
-				DestinationPorts: ptr.To("22"),
-				Action:           infrav1.SecurityRuleActionAllow,
-			},
+			sshRule,
 			infrav1.SecurityRule{
 				Name:             "allow_apiserver",
 				Description:      "Allow K8s API Server",
@@ -1120,6 +1349,11 @@ func (s *ClusterScope) UpdatePutStatus(condition clusterv1.ConditionType, servic
 	}
 }
 
+// MarkVnetPeeringFailed marks the VnetPeeringReady condition as failed with the given reason and message.
+func (s *ClusterScope) MarkVnetPeeringFailed(reason, message string) {
+	conditions.MarkFalse(s.AzureCluster, infrav1.VnetPeeringReadyCondition, reason, clusterv1.ConditionSeverityError, "%s", message)
+}
+
 // UpdatePatchStatus updates a condition on the AzureCluster status after a PATCH operation.
 func (s *ClusterScope) UpdatePatchStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
@@ -1211,6 +1445,55 @@ func (s *ClusterScope) PrivateEndpointSpecs() []azure.ASOResourceSpecGetter[*aso
 	return privateEndpointSpecs
 }
 
+// PrivateLinkServiceSpecs returns the spec of the private link service in front of the internal API server load balancer.
+func (s *ClusterScope) PrivateLinkServiceSpecs() []azure.ResourceSpecGetter {
+	pls := s.AzureCluster.Spec.NetworkSpec.PrivateLinkService
+	if pls == nil || !s.ControlPlaneEnabled() || len(s.APIServerLB().FrontendIPs) == 0 {
+		return nil
+	}
+
+	natIPConfigurations := make([]privatelinkservices.NATIPConfiguration, 0, len(pls.NATIPConfigurations))
+	for _, natIPConfig := range pls.NATIPConfigurations {
+		natIPConfigurations = append(natIPConfigurations, privatelinkservices.NATIPConfiguration{
+			SubnetName:       natIPConfig.Subnet,
+			PrivateIPAddress: natIPConfig.PrivateIPAddress,
+		})
+	}
+
+	return []azure.ResourceSpecGetter{
+		&privatelinkservices.PrivateLinkServiceSpec{
+			Name:                      pls.Name,
+			ResourceGroup:             s.ResourceGroup(),
+			SubscriptionID:            s.SubscriptionID(),
+			ClusterName:               s.ClusterName(),
+			Location:                  s.Location(),
+			ExtendedLocation:          s.ExtendedLocation(),
+			LoadBalancerName:          s.APIServerLB().Name,
+			FrontendIPConfigName:      s.APIServerLB().FrontendIPs[0].Name,
+			VNetName:                  s.Vnet().Name,
+			VNetResourceGroup:         s.Vnet().ResourceGroup,
+			NATIPConfigurations:       natIPConfigurations,
+			VisibilitySubscriptions:   pls.VisibilitySubscriptions,
+			AutoApprovalSubscriptions: pls.AutoApprovalSubscriptions,
+			AdditionalTags:            s.AdditionalTags(),
+		},
+	}
+}
+
+// isPrivateLinkServiceSubnet returns true if the private link service allocates NAT IP addresses in the subnet.
+func (s *ClusterScope) isPrivateLinkServiceSubnet(subnetName string) bool {
+	pls := s.AzureCluster.Spec.NetworkSpec.PrivateLinkService
+	if pls == nil || !s.ControlPlaneEnabled() {
+		return false
+	}
+	for _, natIPConfig := range pls.NATIPConfigurations {
+		if natIPConfig.Subnet == subnetName {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *ClusterScope) getLastAppliedSecurityRules(nsgName string) map[string]interface{} {
 	// Retrieve the last applied security rules for all NSGs.
 	lastAppliedSecurityRulesAll, err := s.AnnotationJSON(azure.SecurityRuleLastAppliedAnnotation)
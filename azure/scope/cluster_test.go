@@ -286,6 +286,132 @@ func TestGettingSecurityRules(t *testing.T) {
 	subnet, err := clusterScope.AzureCluster.Spec.NetworkSpec.GetControlPlaneSubnet()
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(subnet.SecurityGroup.SecurityRules).To(HaveLen(2))
+	g.Expect(subnet.SecurityGroup.SecurityRules[0].Source).To(Equal(ptr.To("*")))
+}
+
+func TestGettingSecurityRulesWithUnmanagedSecurityGroup(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+	}
+
+	azureCluster := &infrav1.AzureCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-azure-cluster",
+		},
+		Spec: infrav1.AzureClusterSpec{
+			AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+				SubscriptionID: "123",
+				IdentityRef: &corev1.ObjectReference{
+					Kind: infrav1.AzureClusterIdentityKind,
+				},
+			},
+			ControlPlaneEnabled: true,
+			NetworkSpec: infrav1.NetworkSpec{
+				Subnets: infrav1.Subnets{
+					{
+						SubnetClassSpec: infrav1.SubnetClassSpec{
+							Role: infrav1.SubnetControlPlane,
+							Name: "control-plane",
+						},
+						SecurityGroup: infrav1.SecurityGroup{
+							ID:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/my-nsg",
+							Name:      "my-nsg",
+							Unmanaged: true,
+						},
+					},
+					{
+						SubnetClassSpec: infrav1.SubnetClassSpec{
+							Role: infrav1.SubnetNode,
+							Name: "node",
+						},
+					},
+				},
+			},
+		},
+	}
+	azureCluster.Default()
+
+	clusterScope := &ClusterScope{
+		Cluster:      cluster,
+		AzureCluster: azureCluster,
+	}
+	clusterScope.SetControlPlaneSecurityRules()
+
+	subnet, err := clusterScope.AzureCluster.Spec.NetworkSpec.GetControlPlaneSubnet()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(subnet.SecurityGroup.SecurityRules).To(BeEmpty())
+}
+
+func TestGettingSecurityRulesWithAllowedSSHSourceCIDRs(t *testing.T) {
+	tests := []struct {
+		name            string
+		cidrs           []string
+		expectedSource  *string
+		expectedSources []*string
+	}{
+		{
+			name:           "single source cidr",
+			cidrs:          []string{"10.0.0.0/16"},
+			expectedSource: ptr.To("10.0.0.0/16"),
+		},
+		{
+			name:            "multiple source cidrs",
+			cidrs:           []string{"10.0.0.0/16", "192.168.0.0/24"},
+			expectedSources: []*string{ptr.To("10.0.0.0/16"), ptr.To("192.168.0.0/24")},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "my-cluster",
+					Namespace: "default",
+				},
+			}
+
+			azureCluster := &infrav1.AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-azure-cluster",
+				},
+				Spec: infrav1.AzureClusterSpec{
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						SubscriptionID: "123",
+						IdentityRef: &corev1.ObjectReference{
+							Kind: infrav1.AzureClusterIdentityKind,
+						},
+					},
+					ControlPlaneEnabled: true,
+					NetworkSpec: infrav1.NetworkSpec{
+						AllowedSSHSourceCIDRs: tc.cidrs,
+					},
+				},
+			}
+			azureCluster.Default()
+
+			clusterScope := &ClusterScope{
+				Cluster:      cluster,
+				AzureCluster: azureCluster,
+			}
+			clusterScope.SetControlPlaneSecurityRules()
+
+			subnet, err := clusterScope.AzureCluster.Spec.NetworkSpec.GetControlPlaneSubnet()
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(subnet.SecurityGroup.SecurityRules).To(HaveLen(2))
+			sshRule := subnet.SecurityGroup.SecurityRules[0]
+			g.Expect(sshRule.Name).To(Equal("allow_ssh"))
+			g.Expect(sshRule.Source).To(Equal(tc.expectedSource))
+			g.Expect(sshRule.Sources).To(Equal(tc.expectedSources))
+			// The API server rule is not restricted.
+			g.Expect(subnet.SecurityGroup.SecurityRules[1].Source).To(Equal(ptr.To("*")))
+		})
+	}
 }
 
 func TestPublicIPSpecs(t *testing.T) {
@@ -579,6 +705,64 @@ func TestPublicIPSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Azure cluster with zonal public IP for public type apiserver LB",
+			azureCluster: &infrav1.AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+				},
+				Status: infrav1.AzureClusterStatus{
+					FailureDomains: map[string]clusterv1.FailureDomainSpec{
+						"failure-domain-id-1": {},
+						"failure-domain-id-2": {},
+						"failure-domain-id-3": {},
+					},
+				},
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup:       "my-rg",
+					ControlPlaneEnabled: true,
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						Location: "centralIndia",
+						AdditionalTags: infrav1.Tags{
+							"Name": "my-publicip-ipv6",
+							"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": "owned",
+						},
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						ControlPlaneOutboundLB: &infrav1.LoadBalancerSpec{
+							LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{},
+						},
+						APIServerLB: &infrav1.LoadBalancerSpec{
+							LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{},
+							FrontendIPs: []infrav1.FrontendIP{
+								{
+									PublicIP: &infrav1.PublicIPSpec{
+										Name:    "40.60.89.22",
+										DNSName: "fake-dns",
+										Zones:   []string{"failure-domain-id-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPublicIPSpec: []azure.ResourceSpecGetter{
+				&publicips.PublicIPSpec{
+					Name:           "40.60.89.22",
+					ResourceGroup:  "my-rg",
+					DNSName:        "fake-dns",
+					IsIPv6:         false,
+					ClusterName:    "my-cluster",
+					Location:       "centralIndia",
+					FailureDomains: []*string{ptr.To("failure-domain-id-2")},
+					AdditionalTags: infrav1.Tags{
+						"Name": "my-publicip-ipv6",
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": "owned",
+					},
+				},
+			},
+		},
 		{
 			name: "Azure cluster with public type apiserver LB and public node outbound lb",
 			azureCluster: &infrav1.AzureCluster{
@@ -1294,6 +1478,53 @@ func TestNSGSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "skips unmanaged security groups",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										ID:        "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Network/networkSecurityGroups/unmanaged-nsg",
+										Name:      "unmanaged-nsg",
+										Unmanaged: true,
+									},
+								},
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-2",
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&securitygroups.NSGSpec{
+					Name:                     "fake-security-group-2",
+					ResourceGroup:            "my-rg",
+					Location:                 "centralIndia",
+					ClusterName:              "my-cluster",
+					AdditionalTags:           make(infrav1.Tags),
+					LastAppliedSecurityRules: map[string]interface{}{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2338,10 +2569,11 @@ func TestBackendPoolName(t *testing.T) {
 
 func TestOutboundPoolName(t *testing.T) {
 	tests := []struct {
-		name                   string
-		clusterName            string
-		loadBalancerName       string
-		expectOutboundPoolName string
+		name                     string
+		clusterName              string
+		loadBalancerName         string
+		shareAPIServerOutboundIP bool
+		expectOutboundPoolName   string
 	}{
 		{
 			name:                   "Empty loadBalancerName",
@@ -2355,6 +2587,12 @@ func TestOutboundPoolName(t *testing.T) {
 			loadBalancerName:       "my-loadbalancer",
 			expectOutboundPoolName: "my-loadbalancer-outboundBackendPool",
 		},
+		{
+			name:                     "API server LB public IP shared",
+			clusterName:              "my-cluster",
+			shareAPIServerOutboundIP: true,
+			expectOutboundPoolName:   "my-cluster-public-lb-outboundBackendPool",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2387,6 +2625,7 @@ func TestOutboundPoolName(t *testing.T) {
 					Name: tc.loadBalancerName,
 				}
 			}
+			azureCluster.Spec.NetworkSpec.ShareAPIServerOutboundIP = tc.shareAPIServerOutboundIP
 
 			azureCluster.Default()
 
@@ -2583,6 +2822,45 @@ func TestAPIServerPort(t *testing.T) {
 	}
 }
 
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "no annotations",
+			expected: false,
+		},
+		{
+			name:        "unrelated annotation",
+			annotations: map[string]string{"foo": "bar"},
+			expected:    false,
+		},
+		{
+			name:        "dry-run annotation",
+			annotations: map[string]string{infrav1.DryRunAnnotation: ""},
+			expected:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			clusterScope := &ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "my-cluster",
+						Namespace:   "default",
+						Annotations: tc.annotations,
+					},
+				},
+			}
+			g.Expect(clusterScope.IsDryRun()).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestFailureDomains(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -3754,6 +4032,7 @@ func TestSetFailureDomain(t *testing.T) {
 	cases := map[string]struct {
 		discoveredFDs clusterv1.FailureDomains
 		specifiedFDs  clusterv1.FailureDomains
+		restrict      bool
 		expectedFDs   clusterv1.FailureDomains
 	}{
 		"no failure domains specified": {
@@ -3774,6 +4053,22 @@ func TestSetFailureDomain(t *testing.T) {
 			specifiedFDs:  clusterv1.FailureDomains{"fd2": clusterv1.FailureDomainSpec{ControlPlane: false}},
 			expectedFDs:   clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
 		},
+		"failure domains restricted to the specified subset": {
+			discoveredFDs: clusterv1.FailureDomains{
+				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd3": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+			specifiedFDs: clusterv1.FailureDomains{
+				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+			},
+			restrict: true,
+			expectedFDs: clusterv1.FailureDomains{
+				"fd1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"fd2": clusterv1.FailureDomainSpec{ControlPlane: false},
+			},
+		},
 		"failure domain override to false succeeds": {
 			discoveredFDs: clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: true}},
 			specifiedFDs:  clusterv1.FailureDomains{"fd1": clusterv1.FailureDomainSpec{ControlPlane: false}},
@@ -3795,7 +4090,8 @@ func TestSetFailureDomain(t *testing.T) {
 				AzureCluster: &infrav1.AzureCluster{
 					Spec: infrav1.AzureClusterSpec{
 						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
-							FailureDomains: tc.specifiedFDs,
+							FailureDomains:         tc.specifiedFDs,
+							RestrictFailureDomains: tc.restrict,
 							IdentityRef: &corev1.ObjectReference{
 								Kind: infrav1.AzureClusterIdentityKind,
 							},
@@ -3820,6 +4116,51 @@ func TestSetFailureDomain(t *testing.T) {
 	}
 }
 
+func TestSetManagedResources(t *testing.T) {
+	t.Parallel()
+
+	manyIDs := make([]string, maxManagedResourceIDs+10)
+	for i := range manyIDs {
+		manyIDs[i] = fmt.Sprintf("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/pip-%03d", i)
+	}
+
+	cases := map[string]struct {
+		ids      []string
+		expected *infrav1.ManagedResources
+	}{
+		"no owned resources": {
+			expected: &infrav1.ManagedResources{Count: 0},
+		},
+		"owned resources below the cap": {
+			ids: []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet"},
+			expected: &infrav1.ManagedResources{
+				Count:       1,
+				ResourceIDs: []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet"},
+			},
+		},
+		"owned resources above the cap are truncated": {
+			ids: manyIDs,
+			expected: &infrav1.ManagedResources{
+				Count:       maxManagedResourceIDs + 10,
+				ResourceIDs: manyIDs[:maxManagedResourceIDs],
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			c := ClusterScope{
+				AzureCluster: &infrav1.AzureCluster{},
+			}
+			c.SetManagedResources(tc.ids)
+			g.Expect(c.AzureCluster.Status.ManagedResources).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestGroupSpecs(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -3973,3 +4314,159 @@ func TestGroupSpecs(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkResourceGroupPlacement(t *testing.T) {
+	tests := []struct {
+		name                      string
+		networkResourceGroup      string
+		vnetResourceGroup         string
+		expectedNatGatewayRG      string
+		expectedNetworkResourceRG string
+		expectedGroups            []string
+	}{
+		{
+			name:                      "network resource group not set",
+			vnetResourceGroup:         "cluster-rg",
+			expectedNatGatewayRG:      "cluster-rg",
+			expectedNetworkResourceRG: "cluster-rg",
+			expectedGroups:            []string{"cluster-rg"},
+		},
+		{
+			name:                      "network resource group not set, vnet in a different resource group",
+			vnetResourceGroup:         "vnet-rg",
+			expectedNatGatewayRG:      "cluster-rg",
+			expectedNetworkResourceRG: "vnet-rg",
+			expectedGroups:            []string{"cluster-rg", "vnet-rg"},
+		},
+		{
+			name:                      "network resource group set",
+			networkResourceGroup:      "network-rg",
+			vnetResourceGroup:         "network-rg",
+			expectedNatGatewayRG:      "network-rg",
+			expectedNetworkResourceRG: "network-rg",
+			expectedGroups:            []string{"cluster-rg", "network-rg"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: fakeSubscriptionID,
+						},
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						ResourceGroup: "cluster-rg",
+						NetworkSpec: infrav1.NetworkSpec{
+							NetworkResourceGroup: tc.networkResourceGroup,
+							Vnet: infrav1.VnetSpec{
+								Name:          "my-vnet",
+								ResourceGroup: tc.vnetResourceGroup,
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SubnetClassSpec: infrav1.SubnetClassSpec{
+										Name: "node-subnet",
+										Role: infrav1.SubnetNode,
+									},
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "node-nsg",
+									},
+									RouteTable: infrav1.RouteTable{
+										Name: "node-routetable",
+									},
+									NatGateway: infrav1.NatGateway{
+										NatGatewayIP: infrav1.PublicIPSpec{
+											Name: "node-natgw-ip",
+										},
+										NatGatewayClassSpec: infrav1.NatGatewayClassSpec{
+											Name: "node-natgw",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{isVnetManaged: ptr.To(true)},
+			}
+
+			natGatewaySpecs := clusterScope.NatGatewaySpecs()
+			g.Expect(natGatewaySpecs).To(HaveLen(1))
+			g.Expect(natGatewaySpecs[0].(*natgateways.NatGatewaySpec).ResourceGroup).To(Equal(tc.expectedNatGatewayRG))
+
+			publicIPSpecs := clusterScope.PublicIPSpecs()
+			g.Expect(publicIPSpecs).To(HaveLen(1))
+			g.Expect(publicIPSpecs[0].ResourceGroupName()).To(Equal(tc.expectedNatGatewayRG))
+
+			subnetSpecs := clusterScope.SubnetSpecs()
+			g.Expect(subnetSpecs).To(HaveLen(1))
+			subnetSpec := subnetSpecs[0].(*subnets.SubnetSpec)
+			g.Expect(subnetSpec.VNetResourceGroup).To(Equal(tc.expectedNetworkResourceRG))
+			g.Expect(subnetSpec.ResourceGroup).To(Equal(tc.expectedNatGatewayRG))
+
+			nsgSpecs := clusterScope.NSGSpecs()
+			g.Expect(nsgSpecs).To(HaveLen(1))
+			g.Expect(nsgSpecs[0].ResourceGroupName()).To(Equal(tc.expectedNetworkResourceRG))
+
+			routeTableSpecs := clusterScope.RouteTableSpecs()
+			g.Expect(routeTableSpecs).To(HaveLen(1))
+			g.Expect(routeTableSpecs[0].ResourceGroupName()).To(Equal(tc.expectedNetworkResourceRG))
+
+			var groupNames []string
+			for _, groupSpec := range clusterScope.GroupSpecs() {
+				groupNames = append(groupNames, groupSpec.(*groups.GroupSpec).AzureName)
+			}
+			g.Expect(groupNames).To(Equal(tc.expectedGroups))
+		})
+	}
+}
+
+func TestMachinePublicIPNames(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+	}
+	machine := func(name, namespace, clusterName string) *infrav1.AzureMachine {
+		return &infrav1.AzureMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    map[string]string{clusterv1.ClusterNameLabel: clusterName},
+			},
+		}
+	}
+	initObjects := []runtime.Object{
+		machine("my-machine-0", "default", "my-cluster"),
+		machine("my-machine-1", "default", "my-cluster"),
+		machine("other-machine", "default", "other-cluster"),
+		machine("other-namespace-machine", "other", "my-cluster"),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(initObjects...).Build()
+
+	s := &ClusterScope{
+		Client:  fakeClient,
+		Cluster: cluster,
+	}
+	names, err := s.MachinePublicIPNames(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names).To(ConsistOf("pip-my-machine-0", "pip-my-machine-1"))
+}
@@ -45,6 +45,9 @@ type CredentialsProvider interface {
 	GetClientID() string
 	GetClientSecret(ctx context.Context) (string, error)
 	GetTenantID() string
+	GetAzureEnvironment() string
+	GetRateLimit() *infrav1.ClientRateLimit
+	GetRateLimitOwner() string
 	GetTokenCredential(ctx context.Context, resourceManagerEndpoint, activeDirectoryEndpoint, tokenAudience string) (azcore.TokenCredential, error)
 	Type() infrav1.IdentityType
 }
@@ -100,6 +103,15 @@ func (p *AzureCredentialsProvider) GetTokenCredential(ctx context.Context, resou
 		cred, authErr = p.cache.GetOrStoreWorkloadIdentity(&azidentity.WorkloadIdentityCredentialOptions{
 			ClientOptions: azcore.ClientOptions{
 				TracingProvider: tracingProvider,
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: activeDirectoryEndpoint,
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Audience: tokenAudience,
+							Endpoint: resourceManagerEndpoint,
+						},
+					},
+				},
 			},
 			TenantID:      p.Identity.Spec.TenantID,
 			ClientID:      p.Identity.Spec.ClientID,
@@ -147,6 +159,15 @@ func (p *AzureCredentialsProvider) GetTokenCredential(ctx context.Context, resou
 		cred, authErr = p.cache.GetOrStoreClientCert(p.GetTenantID(), p.Identity.Spec.ClientID, certsContent, nil, &azidentity.ClientCertificateCredentialOptions{
 			ClientOptions: azcore.ClientOptions{
 				TracingProvider: tracingProvider,
+				Cloud: cloud.Configuration{
+					ActiveDirectoryAuthorityHost: activeDirectoryEndpoint,
+					Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+						cloud.ResourceManager: {
+							Audience: tokenAudience,
+							Endpoint: resourceManagerEndpoint,
+						},
+					},
+				},
 			},
 		})
 
@@ -200,6 +221,24 @@ func (p *AzureCredentialsProvider) GetTenantID() string {
 	return p.Identity.Spec.TenantID
 }
 
+// GetAzureEnvironment returns the name of the Azure cloud of the AzureCredentialsProvider's Identity, or an empty
+// string if the Identity doesn't select one.
+func (p *AzureCredentialsProvider) GetAzureEnvironment() string {
+	return p.Identity.Spec.AzureEnvironment
+}
+
+// GetRateLimit returns the limit on the rate of Azure Resource Manager requests of the AzureClusterIdentity, or nil if
+// its requests are not limited.
+func (p *AzureCredentialsProvider) GetRateLimit() *infrav1.ClientRateLimit {
+	return p.Identity.Spec.RateLimit
+}
+
+// GetRateLimitOwner returns the namespaced name of the AzureClusterIdentity, which identifies the limit returned by
+// GetRateLimit among the limits of other identities used with the same subscription.
+func (p *AzureCredentialsProvider) GetRateLimitOwner() string {
+	return client.ObjectKeyFromObject(p.Identity).String()
+}
+
 // Type returns the auth mechanism used.
 func (p *AzureCredentialsProvider) Type() infrav1.IdentityType {
 	return p.Identity.Spec.Type
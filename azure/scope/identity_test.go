@@ -235,12 +235,14 @@ func TestGetTokenCredential(t *testing.T) {
 					TenantID: fakeTenantID,
 				},
 			},
+			ActiveDirectoryAuthorityHost: "https://login.microsoftonline.com",
 			cacheExpect: func(cache *mock_azure.MockCredentialCache) {
 				cache.EXPECT().GetOrStoreWorkloadIdentity(gomock.Cond(func(opts *azidentity.WorkloadIdentityCredentialOptions) bool {
 					// ignore tracing provider
 					return opts.TenantID == fakeTenantID &&
 						opts.ClientID == fakeClientID &&
-						opts.TokenFilePath == GetProjectedTokenPath()
+						opts.TokenFilePath == GetProjectedTokenPath() &&
+						opts.Cloud.ActiveDirectoryAuthorityHost == "https://login.microsoftonline.com"
 				}))
 			},
 		},
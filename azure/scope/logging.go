@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// WithLogValues returns a copy of ctx whose logger is tagged with the subscription ID, resource group and name of
+// the cluster described by the scope, so that every log line written while reconciling with ctx can be filtered by them.
+func WithLogValues(ctx context.Context, s azure.ClusterDescriber) context.Context {
+	return tele.WithLogValues(ctx,
+		"subscriptionID", s.SubscriptionID(),
+		"resourceGroup", s.ResourceGroup(),
+		"cluster", s.ClusterName(),
+	)
+}
@@ -20,19 +20,24 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	kuberecord "k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	capierrors "sigs.k8s.io/cluster-api/errors"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -61,6 +66,10 @@ type MachineScopeParams struct {
 	AzureMachine *infrav1.AzureMachine
 	Cache        *MachineCache
 	SKUCache     SKUCacher
+
+	// ResourceEventRecorder, when set, records an Event on the AzureMachine for each Azure resource created,
+	// updated, or deleted by the machine's services.
+	ResourceEventRecorder kuberecord.EventRecorder
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
@@ -89,6 +98,8 @@ func NewMachineScope(params MachineScopeParams) (*MachineScope, error) {
 		ClusterScoper: params.ClusterScope,
 		cache:         params.Cache,
 		skuCache:      params.SKUCache,
+
+		resourceEventRecorder: params.ResourceEventRecorder,
 	}, nil
 }
 
@@ -102,11 +113,14 @@ type MachineScope struct {
 	AzureMachine *infrav1.AzureMachine
 	cache        *MachineCache
 	skuCache     SKUCacher
+
+	resourceEventRecorder kuberecord.EventRecorder
 }
 
 // SKUCacher fetches a SKU from its cache.
 type SKUCacher interface {
 	Get(context.Context, string, resourceskus.ResourceType) (resourceskus.SKU, error)
+	GetDiskSKUs(context.Context) ([]string, error)
 }
 
 // MachineCache stores common machine information so we don't have to hit the API multiple times within the same reconcile loop.
@@ -114,6 +128,7 @@ type MachineCache struct {
 	BootstrapData      string
 	VMImage            *infrav1.Image
 	VMSKU              resourceskus.SKU
+	DiskSKUs           []string
 	availabilitySetSKU resourceskus.SKU
 }
 
@@ -150,6 +165,11 @@ func (m *MachineScope) InitMachineCache(ctx context.Context) error {
 			return errors.Wrapf(err, "failed to get VM SKU %s in compute api", m.AzureMachine.Spec.VMSize)
 		}
 
+		m.cache.DiskSKUs, err = skuCache.GetDiskSKUs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get disk SKUs in compute api")
+		}
+
 		m.cache.availabilitySetSKU, err = skuCache.Get(ctx, string(armcompute.AvailabilitySetSKUTypesAligned), resourceskus.AvailabilitySets)
 		if err != nil {
 			return errors.Wrapf(err, "failed to get availability set SKU %s in compute api", string(armcompute.AvailabilitySetSKUTypesAligned))
@@ -176,7 +196,7 @@ func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 		AvailabilitySetID:          m.AvailabilitySetID(),
 		Zone:                       m.AvailabilityZone(),
 		Identity:                   m.AzureMachine.Spec.Identity,
-		UserAssignedIdentities:     m.AzureMachine.Spec.UserAssignedIdentities,
+		UserAssignedIdentities:     resolveUserAssignedIdentities(m.AzureMachine.Spec.UserAssignedIdentities, m.SubscriptionID(), m.ResourceGroup()),
 		SpotVMOptions:              m.AzureMachine.Spec.SpotVMOptions,
 		SecurityProfile:            m.AzureMachine.Spec.SecurityProfile,
 		DiagnosticsProfile:         m.AzureMachine.Spec.Diagnostics,
@@ -184,10 +204,14 @@ func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 		AdditionalTags:             m.AdditionalTags(),
 		AdditionalCapabilities:     m.AzureMachine.Spec.AdditionalCapabilities,
 		CapacityReservationGroupID: m.GetCapacityReservationGroupID(),
+		GalleryApplications:        m.AzureMachine.Spec.GalleryApplications,
+		Secrets:                    m.AzureMachine.Spec.Secrets,
+		LicenseType:                m.AzureMachine.Spec.LicenseType,
 		ProviderID:                 m.ProviderID(),
 	}
 	if m.cache != nil {
 		spec.SKU = m.cache.VMSKU
+		spec.DiskSKUs = m.cache.DiskSKUs
 		spec.Image = m.cache.VMImage
 		spec.BootstrapData = m.cache.BootstrapData
 	}
@@ -314,7 +338,7 @@ func (m *MachineScope) BuildNICSpec(nicName string, infrav1NetworkInterface infr
 		// If the NAT gateway is not enabled and node has no public IP, then the NIC needs to reference the LB to get outbound traffic.
 		if m.Role() == infrav1.Node && !m.Subnet().IsNatGatewayEnabled() && !m.AzureMachine.Spec.AllocatePublicIP {
 			spec.PublicLBName = m.OutboundLBName(m.Role())
-			spec.PublicLBAddressPoolName = m.OutboundPoolName(m.Role())
+			spec.PublicLBAddressPoolName = nodeOutboundPoolName(m.ClusterScoper, m.AzureMachine.Spec.NodeOutboundBackendPool)
 		}
 	}
 
@@ -334,26 +358,53 @@ func (m *MachineScope) NICIDs() []string {
 
 // DiskSpecs returns the disk specs.
 func (m *MachineScope) DiskSpecs() []azure.ResourceSpecGetter {
-	diskSpecs := make([]azure.ResourceSpecGetter, 1+len(m.AzureMachine.Spec.DataDisks))
-	diskSpecs[0] = &disks.DiskSpec{
-		Name:          azure.GenerateOSDiskName(m.Name()),
-		ResourceGroup: m.NodeResourceGroup(),
+	diskSpecs := []azure.ResourceSpecGetter{
+		&disks.DiskSpec{
+			Name:          azure.GenerateOSDiskName(m.Name()),
+			ResourceGroup: m.NodeResourceGroup(),
+		},
 	}
 
-	for i, dd := range m.AzureMachine.Spec.DataDisks {
-		diskSpecs[i+1] = &disks.DiskSpec{
+	for _, dd := range m.AzureMachine.Spec.DataDisks {
+		// Existing disks attached to the VM outlive the machine.
+		if dd.ManagedDiskID != "" {
+			continue
+		}
+		diskSpecs = append(diskSpecs, &disks.DiskSpec{
 			Name:          azure.GenerateDataDiskName(m.Name(), dd.NameSuffix),
 			ResourceGroup: m.NodeResourceGroup(),
-		}
+			DiskSizeGB:    dd.DiskSizeGB,
+		})
 	}
 	return diskSpecs
 }
 
+// RecordDiskResize records an event on the AzureMachine when one of its disks is grown.
+func (m *MachineScope) RecordDiskResize(diskName string, fromGB, toGB int32) {
+	record.Eventf(m.AzureMachine, "DiskResizing", "Resizing disk %s from %d GB to %d GB", diskName, fromGB, toGB)
+}
+
+// resolveUserAssignedIdentities returns the user-assigned identities with any identity referenced by name
+// resolved to its resource ID in the given subscription and resource group.
+func resolveUserAssignedIdentities(identities []infrav1.UserAssignedIdentity, subscriptionID, resourceGroup string) []infrav1.UserAssignedIdentity {
+	if identities == nil {
+		return nil
+	}
+	resolved := make([]infrav1.UserAssignedIdentity, len(identities))
+	for i, identity := range identities {
+		resolved[i] = identity
+		resolved[i].ProviderID = azure.ResolveUserAssignedIdentityID(identity.ProviderID, subscriptionID, resourceGroup)
+	}
+	return resolved
+}
+
 // RoleAssignmentSpecs returns the role assignment specs.
 func (m *MachineScope) RoleAssignmentSpecs(principalID *string) []azure.ResourceSpecGetter {
-	roles := make([]azure.ResourceSpecGetter, 1)
-	if m.HasSystemAssignedIdentity() {
-		roles[0] = &roleassignments.RoleAssignmentSpec{
+	if !m.HasSystemAssignedIdentity() {
+		return []azure.ResourceSpecGetter{}
+	}
+	roles := []azure.ResourceSpecGetter{
+		&roleassignments.RoleAssignmentSpec{
 			Name:             m.SystemAssignedIdentityName(),
 			MachineName:      m.Name(),
 			ResourceType:     azure.VirtualMachine,
@@ -362,10 +413,54 @@ func (m *MachineScope) RoleAssignmentSpecs(principalID *string) []azure.Resource
 			RoleDefinitionID: m.SystemAssignedIdentityDefinitionID(),
 			PrincipalID:      principalID,
 			PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+		},
+	}
+	for _, role := range m.AzureMachine.Spec.AdditionalRoleAssignments {
+		roles = append(roles, &roleassignments.RoleAssignmentSpec{
+			// Role assignment names must be GUIDs. Derive a stable one from the principal so the assignment is only created once per VM.
+			Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(role.Scope+role.DefinitionID+ptr.Deref(principalID, ""))).String(),
+			MachineName:      m.Name(),
+			ResourceType:     azure.VirtualMachine,
+			ResourceGroup:    m.NodeResourceGroup(),
+			Scope:            role.Scope,
+			RoleDefinitionID: role.DefinitionID,
+			PrincipalID:      principalID,
+			PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+		})
+	}
+	return roles
+}
+
+// ImagePullIdentityID returns the resource ID of the user-assigned identity used for image pulls,
+// or an empty string if the AzureMachine has none.
+func (m *MachineScope) ImagePullIdentityID() string {
+	for _, identity := range m.AzureMachine.Spec.UserAssignedIdentities {
+		if identity.Purpose == infrav1.UserAssignedIdentityPurposeImagePull {
+			providerID := azure.ResolveUserAssignedIdentityID(identity.ProviderID, m.SubscriptionID(), m.ResourceGroup())
+			return strings.TrimPrefix(providerID, azureutil.ProviderIDPrefix)
 		}
-		return roles
 	}
-	return []azure.ResourceSpecGetter{}
+	return ""
+}
+
+// ImagePullRoleAssignmentSpec returns the spec granting the image pull identity AcrPull on the image pull registry.
+func (m *MachineScope) ImagePullRoleAssignmentSpec(principalID *string) azure.ResourceSpecGetter {
+	registryID := m.AzureMachine.Spec.ImagePullRegistryID
+	subscriptionID := m.SubscriptionID()
+	if registry, err := azureutil.ParseResourceID(registryID); err == nil {
+		subscriptionID = registry.SubscriptionID
+	}
+	return &roleassignments.RoleAssignmentSpec{
+		// Role assignment names must be GUIDs. Derive a stable one from the principal so the assignment is only created once.
+		Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(registryID+infrav1.AcrPullRoleID+ptr.Deref(principalID, ""))).String(),
+		MachineName:      m.Name(),
+		ResourceType:     azure.VirtualMachine,
+		ResourceGroup:    m.NodeResourceGroup(),
+		Scope:            registryID,
+		RoleDefinitionID: fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", subscriptionID, infrav1.AcrPullRoleID),
+		PrincipalID:      principalID,
+		PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+	}
 }
 
 // RoleAssignmentResourceType returns the role assignment resource type.
@@ -379,14 +474,29 @@ func (m *MachineScope) HasSystemAssignedIdentity() bool {
 	return m.AzureMachine.Spec.Identity == infrav1.VMIdentitySystemAssigned
 }
 
-// VMExtensionSpecs returns the VM extension specs.
+// VMExtensionSpecs returns the VM extension specs: the user-specified extensions,
+// deduplicated by name, followed by the CAPZ bootstrapping extension.
 func (m *MachineScope) VMExtensionSpecs() []azure.ResourceSpecGetter {
 	if ptr.Deref(m.AzureMachine.Spec.DisableExtensionOperations, false) {
 		return []azure.ResourceSpecGetter{}
 	}
 
+	cpuArchitectureType, _ := m.cache.VMSKU.GetCapability(resourceskus.CPUArchitectureType)
+	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachine.Spec.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType)
+
+	// Extension names are unique per VM, so user extensions that collide with an
+	// earlier extension or with the CAPZ bootstrapping extension are dropped.
+	seen := make(map[string]struct{})
+	if bootstrapExtensionSpec != nil {
+		seen[strings.ToLower(bootstrapExtensionSpec.Name)] = struct{}{}
+	}
+
 	var extensionSpecs = []azure.ResourceSpecGetter{}
 	for _, extension := range m.AzureMachine.Spec.VMExtensions {
+		if _, ok := seen[strings.ToLower(extension.Name)]; ok {
+			continue
+		}
+		seen[strings.ToLower(extension.Name)] = struct{}{}
 		extensionSpecs = append(extensionSpecs, &vmextensions.VMExtensionSpec{
 			ExtensionSpec: azure.ExtensionSpec{
 				Name:              extension.Name,
@@ -401,9 +511,6 @@ func (m *MachineScope) VMExtensionSpecs() []azure.ResourceSpecGetter {
 		})
 	}
 
-	cpuArchitectureType, _ := m.cache.VMSKU.GetCapability(resourceskus.CPUArchitectureType)
-	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachine.Spec.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType)
-
 	if bootstrapExtensionSpec != nil {
 		extensionSpecs = append(extensionSpecs, &vmextensions.VMExtensionSpec{
 			ExtensionSpec: *bootstrapExtensionSpec,
@@ -455,6 +562,59 @@ func (m *MachineScope) Name() string {
 	return m.AzureMachine.Name
 }
 
+// IsDryRun returns true if mutating Azure operations for the cluster are logged instead of performed.
+func (m *MachineScope) IsDryRun() bool {
+	dryRunner, ok := m.ClusterScoper.(azure.DryRunner)
+	return ok && dryRunner.IsDryRun()
+}
+
+// ValidateNodeOutboundBackendPool returns an error if the machine is a node joining an outbound backend pool that is
+// not declared on the node outbound load balancer of the cluster.
+func (m *MachineScope) ValidateNodeOutboundBackendPool() error {
+	if m.Role() != infrav1.Node {
+		return nil
+	}
+	return validateNodeOutboundBackendPool(m.ClusterScoper, m.AzureMachine.Spec.NodeOutboundBackendPool)
+}
+
+// nodeOutboundPoolName returns the backend pool of the node outbound load balancer that nodes egress through, which is
+// pool if set.
+func nodeOutboundPoolName(cluster azure.ClusterScoper, pool string) string {
+	if pool != "" {
+		return pool
+	}
+	return cluster.OutboundPoolName(infrav1.Node)
+}
+
+// validateNodeOutboundBackendPool returns an error if pool is set but is not an outbound backend pool of the node
+// outbound load balancer of the cluster.
+func validateNodeOutboundBackendPool(cluster azure.ClusterScoper, pool string) error {
+	if pool == "" {
+		return nil
+	}
+	if describer, ok := cluster.(azure.NodeOutboundBackendPoolDescriber); ok && slices.Contains(describer.NodeOutboundBackendPoolNames(), pool) {
+		return nil
+	}
+	return errors.Errorf("outbound backend pool %s is not declared on the node outbound load balancer of the cluster", pool)
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (m *MachineScope) RecordDryRunSkip(message string) {
+	record.Event(m.AzureMachine, azure.DryRunSkippedReason, message)
+}
+
+// RecordsResourceEvents implements azure.ResourceEventRecorder.
+func (m *MachineScope) RecordsResourceEvents() bool {
+	return m.resourceEventRecorder != nil
+}
+
+// RecordResourceEvent implements azure.ResourceEventRecorder.
+func (m *MachineScope) RecordResourceEvent(reason, message string) {
+	if m.resourceEventRecorder != nil {
+		m.resourceEventRecorder.Event(m.AzureMachine, corev1.EventTypeNormal, reason, message)
+	}
+}
+
 // Namespace returns the namespace name.
 func (m *MachineScope) Namespace() string {
 	return m.AzureMachine.Namespace
@@ -511,11 +671,15 @@ func (m *MachineScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
 }
 
 // AvailabilitySet returns the availability set for this machine if available.
+// A machine with an availability zone is never placed in an availability set, as the zone takes precedence.
 func (m *MachineScope) AvailabilitySet() (string, bool) {
 	// AvailabilitySet service is not supported on EdgeZone currently.
 	// AvailabilitySet cannot be used with Spot instances.
-	if !m.AvailabilitySetEnabled() || m.AzureMachine.Spec.SpotVMOptions != nil || m.ExtendedLocation() != nil ||
-		m.AzureMachine.Spec.FailureDomain != nil || m.Machine.Spec.FailureDomain != nil {
+	if m.AvailabilityZone() != "" || m.AzureMachine.Spec.SpotVMOptions != nil || m.ExtendedLocation() != nil {
+		return "", false
+	}
+
+	if !m.AvailabilitySetEnabled() {
 		return "", false
 	}
 
@@ -587,6 +751,26 @@ func (m *MachineScope) SetVMState(v infrav1.ProvisioningState) {
 	m.AzureMachine.Status.VMState = &v
 }
 
+// FailedStartAttempts returns the number of consecutive failed attempts to start the deallocated Spot VM.
+func (m *MachineScope) FailedStartAttempts() int32 {
+	return m.AzureMachine.Status.FailedStartAttempts
+}
+
+// SetFailedStartAttempts sets the number of consecutive failed attempts to start the deallocated Spot VM.
+func (m *MachineScope) SetFailedStartAttempts(v int32) {
+	m.AzureMachine.Status.FailedStartAttempts = v
+}
+
+// OSDiskResizing returns true if the VM is being deallocated, resized and started again to grow its OS disk.
+func (m *MachineScope) OSDiskResizing() bool {
+	return m.AzureMachine.Status.OSDiskResizing
+}
+
+// SetOSDiskResizing records whether the VM is being deallocated, resized and started again to grow its OS disk.
+func (m *MachineScope) SetOSDiskResizing(v bool) {
+	m.AzureMachine.Status.OSDiskResizing = v
+}
+
 // SetReady sets the AzureMachine Ready Status to true.
 func (m *MachineScope) SetReady() {
 	m.AzureMachine.Status.Ready = true
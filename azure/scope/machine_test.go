@@ -26,6 +26,7 @@ import (
 	azureautorest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -533,6 +534,66 @@ func TestMachineScope_RoleAssignmentSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns additional RoleAssignmentSpecs on their own scopes",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						Identity: infrav1.VMIdentitySystemAssigned,
+						SystemAssignedIdentityRole: &infrav1.SystemAssignedIdentityRole{
+							Name: "azure-role-assignment-name",
+						},
+						AdditionalRoleAssignments: []infrav1.RoleAssignment{
+							{
+								DefinitionID: "/subscriptions/123/providers/Microsoft.Authorization/roleDefinitions/7f951dda-4ed3-4680-a7ca-43fe172d538d",
+								Scope:        "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ContainerRegistry/registries/myregistry",
+							},
+						},
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Values: map[string]string{
+								auth.SubscriptionID: "123",
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&roleassignments.RoleAssignmentSpec{
+					ResourceType:  azure.VirtualMachine,
+					MachineName:   "machine-name",
+					Name:          "azure-role-assignment-name",
+					ResourceGroup: "my-rg",
+					PrincipalID:   ptr.To("fakePrincipalID"),
+					PrincipalType: armauthorization.PrincipalTypeServicePrincipal,
+				},
+				&roleassignments.RoleAssignmentSpec{
+					ResourceType:     azure.VirtualMachine,
+					MachineName:      "machine-name",
+					Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ContainerRegistry/registries/myregistry/subscriptions/123/providers/Microsoft.Authorization/roleDefinitions/7f951dda-4ed3-4680-a7ca-43fe172d538dfakePrincipalID")).String(),
+					ResourceGroup:    "my-rg",
+					Scope:            "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ContainerRegistry/registries/myregistry",
+					RoleDefinitionID: "/subscriptions/123/providers/Microsoft.Authorization/roleDefinitions/7f951dda-4ed3-4680-a7ca-43fe172d538d",
+					PrincipalID:      ptr.To("fakePrincipalID"),
+					PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -543,6 +604,56 @@ func TestMachineScope_RoleAssignmentSpecs(t *testing.T) {
 	}
 }
 
+func TestMachineScope_ImagePullRoleAssignmentSpec(t *testing.T) {
+	g := NewWithT(t)
+	registryID := "/subscriptions/456/resourceGroups/acr-rg/providers/Microsoft.ContainerRegistry/registries/myregistry"
+	machineScope := MachineScope{
+		Machine: &clusterv1.Machine{},
+		AzureMachine: &infrav1.AzureMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "machine-name",
+			},
+			Spec: infrav1.AzureMachineSpec{
+				Identity: infrav1.VMIdentityUserAssigned,
+				UserAssignedIdentities: []infrav1.UserAssignedIdentity{
+					{ProviderID: "general"},
+					{ProviderID: "pull", Purpose: infrav1.UserAssignedIdentityPurposeImagePull},
+				},
+				ImagePullRegistryID: registryID,
+			},
+		},
+		ClusterScoper: &ClusterScope{
+			AzureClients: AzureClients{
+				EnvironmentSettings: auth.EnvironmentSettings{
+					Values: map[string]string{
+						auth.SubscriptionID: "123",
+					},
+				},
+			},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+				},
+			},
+		},
+	}
+
+	g.Expect(machineScope.ImagePullIdentityID()).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/pull"))
+	g.Expect(machineScope.ImagePullRoleAssignmentSpec(ptr.To("fakePrincipalID"))).To(Equal(&roleassignments.RoleAssignmentSpec{
+		Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(registryID+infrav1.AcrPullRoleID+"fakePrincipalID")).String(),
+		MachineName:      "machine-name",
+		ResourceType:     azure.VirtualMachine,
+		ResourceGroup:    "my-rg",
+		Scope:            registryID,
+		RoleDefinitionID: "/subscriptions/456/providers/Microsoft.Authorization/roleDefinitions/" + infrav1.AcrPullRoleID,
+		PrincipalID:      ptr.To("fakePrincipalID"),
+		PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+	}))
+
+	machineScope.AzureMachine.Spec.UserAssignedIdentities[1].Purpose = ""
+	g.Expect(machineScope.ImagePullIdentityID()).To(BeEmpty())
+}
+
 func TestMachineScope_VMExtensionSpecs(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -917,6 +1028,84 @@ func TestMachineScope_VMExtensionSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "If custom VM extensions share a name with each other or the bootstrapping extension, it deduplicates them",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							OSType: "Linux",
+						},
+						VMExtensions: []infrav1.VMExtension{
+							{
+								Name:      "monitoring-agent",
+								Publisher: "Microsoft.Azure.Monitor",
+								Version:   "1.0",
+							},
+							{
+								Name:      "Monitoring-Agent",
+								Publisher: "Microsoft.Azure.Monitor",
+								Version:   "2.0",
+							},
+							{
+								Name:      "CAPZ.Linux.Bootstrapping",
+								Publisher: "Microsoft.Azure.Extensions",
+								Version:   "2.0",
+							},
+						},
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Environment: azureautorest.Environment{
+								Name: azureautorest.PublicCloud.Name,
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+				cache: &MachineCache{
+					VMSKU: resourceskus.SKU{},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&vmextensions.VMExtensionSpec{
+					ExtensionSpec: azure.ExtensionSpec{
+						Name:      "monitoring-agent",
+						VMName:    "machine-name",
+						Publisher: "Microsoft.Azure.Monitor",
+						Version:   "1.0",
+					},
+					ResourceGroup: "my-rg",
+					Location:      "westus",
+				},
+				&vmextensions.VMExtensionSpec{
+					ExtensionSpec: azure.ExtensionSpec{
+						Name:      "CAPZ.Linux.Bootstrapping",
+						VMName:    "machine-name",
+						Publisher: "Microsoft.Azure.ContainerUpstream",
+						Version:   "1.0",
+						ProtectedSettings: map[string]string{
+							"commandToExecute": azure.LinuxBootstrapExtensionCommand,
+						},
+					},
+					ResourceGroup: "my-rg",
+					Location:      "westus",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3257,9 +3446,11 @@ func TestDiskSpecs(t *testing.T) {
 						DataDisks: []infrav1.DataDisk{
 							{
 								NameSuffix: "etcddisk",
+								DiskSizeGB: 128,
 							},
 							{
 								NameSuffix: "otherdisk",
+								DiskSizeGB: 256,
 							},
 						},
 					},
@@ -3278,10 +3469,66 @@ func TestDiskSpecs(t *testing.T) {
 				&disks.DiskSpec{
 					Name:          "my-azure-machine_etcddisk",
 					ResourceGroup: "my-rg",
+					DiskSizeGB:    128,
 				},
 				&disks.DiskSpec{
 					Name:          "my-azure-machine_otherdisk",
 					ResourceGroup: "my-rg",
+					DiskSizeGB:    256,
+				},
+			},
+		}, {
+			name: "os and existing data disk",
+			machineScope: MachineScope{
+				ClusterScoper: &ClusterScope{
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-azure-machine",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							DiskSizeGB: ptr.To[int32](30),
+							OSType:     "Linux",
+						},
+						DataDisks: []infrav1.DataDisk{
+							{
+								NameSuffix: "etcddisk",
+							},
+							{
+								NameSuffix:    "existingdisk",
+								ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/existing-disk",
+							},
+						},
+					},
+				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine",
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_OSDisk",
+					ResourceGroup: "my-rg",
+				},
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_etcddisk",
+					ResourceGroup: "my-rg",
 				},
 			},
 		},
@@ -3342,3 +3589,73 @@ func TestMachineScope_GetCapacityReservationGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineScope_ValidateNodeOutboundBackendPool(t *testing.T) {
+	clusterScope := &ClusterScope{
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				NetworkSpec: infrav1.NetworkSpec{
+					NodeOutboundLB: &infrav1.LoadBalancerSpec{
+						Name: "my-cluster",
+						OutboundBackendPools: []infrav1.OutboundBackendPool{
+							{Name: "gpu"},
+						},
+					},
+				},
+			},
+		},
+	}
+	controlPlane := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		machine *clusterv1.Machine
+		pool    string
+		wantErr bool
+	}{
+		{
+			name:    "node without an outbound backend pool",
+			machine: &clusterv1.Machine{},
+		},
+		{
+			name:    "node with a declared outbound backend pool",
+			machine: &clusterv1.Machine{},
+			pool:    "gpu",
+		},
+		{
+			name:    "node with an undeclared outbound backend pool",
+			machine: &clusterv1.Machine{},
+			pool:    "batch",
+			wantErr: true,
+		},
+		{
+			name:    "control plane with an undeclared outbound backend pool",
+			machine: controlPlane,
+			pool:    "batch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				ClusterScoper: clusterScope,
+				Machine:       tt.machine,
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						NodeOutboundBackendPool: tt.pool,
+					},
+				},
+			}
+			err := machineScope.ValidateNodeOutboundBackendPool()
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
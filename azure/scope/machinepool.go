@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
@@ -40,6 +41,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/labels/format"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -199,10 +201,10 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		VNetName:                     m.Vnet().Name,
 		VNetResourceGroup:            m.Vnet().ResourceGroup,
 		PublicLBName:                 m.OutboundLBName(infrav1.Node),
-		PublicLBAddressPoolName:      m.OutboundPoolName(infrav1.Node),
+		PublicLBAddressPoolName:      nodeOutboundPoolName(m.ClusterScoper, m.AzureMachinePool.Spec.Template.NodeOutboundBackendPool),
 		AcceleratedNetworking:        m.AzureMachinePool.Spec.Template.NetworkInterfaces[0].AcceleratedNetworking,
 		Identity:                     m.AzureMachinePool.Spec.Identity,
-		UserAssignedIdentities:       m.AzureMachinePool.Spec.UserAssignedIdentities,
+		UserAssignedIdentities:       resolveUserAssignedIdentities(m.AzureMachinePool.Spec.UserAssignedIdentities, m.SubscriptionID(), m.ResourceGroup()),
 		DiagnosticsProfile:           m.AzureMachinePool.Spec.Template.Diagnostics,
 		SecurityProfile:              m.AzureMachinePool.Spec.Template.SecurityProfile,
 		SpotVMOptions:                m.AzureMachinePool.Spec.Template.SpotVMOptions,
@@ -218,6 +220,14 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		AdditionalTags:               m.AdditionalTags(),
 		PlatformFaultDomainCount:     m.AzureMachinePool.Spec.PlatformFaultDomainCount,
 		ZoneBalance:                  m.AzureMachinePool.Spec.ZoneBalance,
+		Overprovision:                m.AzureMachinePool.Spec.Overprovision,
+		SinglePlacementGroup:         m.AzureMachinePool.Spec.SinglePlacementGroup,
+		ScaleInPolicy:                string(m.AzureMachinePool.Spec.ScaleInPolicy),
+		AutomaticOSUpgrade:           m.AzureMachinePool.Spec.AutomaticOSUpgrade,
+		RollingUpgradePolicy:         m.AzureMachinePool.Spec.RollingUpgradePolicy,
+		OSDiskDeleteOption:           string(m.AzureMachinePool.Spec.Template.OSDiskDeleteOption),
+		DataDisksDeleteOption:        string(m.AzureMachinePool.Spec.Template.DataDisksDeleteOption),
+		NICDeleteOption:              string(m.AzureMachinePool.Spec.Template.NetworkInterfacesDeleteOption),
 	}
 
 	if m.AzureMachinePool.Spec.ZoneBalance != nil && len(m.MachinePool.Spec.FailureDomains) <= 1 {
@@ -249,6 +259,23 @@ func (m *MachinePoolScope) Name() string {
 	return m.AzureMachinePool.Name
 }
 
+// IsDryRun returns true if mutating Azure operations for the cluster are logged instead of performed.
+func (m *MachinePoolScope) IsDryRun() bool {
+	dryRunner, ok := m.ClusterScoper.(azure.DryRunner)
+	return ok && dryRunner.IsDryRun()
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (m *MachinePoolScope) RecordDryRunSkip(message string) {
+	record.Event(m.AzureMachinePool, azure.DryRunSkippedReason, message)
+}
+
+// ValidateNodeOutboundBackendPool returns an error if the instances join an outbound backend pool that is not declared
+// on the node outbound load balancer of the cluster.
+func (m *MachinePoolScope) ValidateNodeOutboundBackendPool() error {
+	return validateNodeOutboundBackendPool(m.ClusterScoper, m.AzureMachinePool.Spec.Template.NodeOutboundBackendPool)
+}
+
 // SetInfrastructureMachineKind sets the infrastructure machine kind in the status if it is not set already, returning
 // `true` if the status was updated. This supports MachinePool Machines.
 func (m *MachinePoolScope) SetInfrastructureMachineKind() bool {
@@ -433,9 +460,25 @@ func (m *MachinePoolScope) applyAzureMachinePoolMachines(ctx context.Context) er
 			log.V(4).Info("DeleteMachineAnnotation already set")
 		}
 
+		if _, ampmHasProtectAnnotation := ampm.Annotations[infrav1exp.ProtectMachineAnnotation]; !ampmHasProtectAnnotation {
+			// fetch Machine protect annotation from owner machine to AzureMachinePoolMachine, so protecting either of
+			// them excludes the instance from scale down.
+			if machine != nil && machine.Annotations != nil {
+				if _, hasProtectAnnotation := machine.Annotations[infrav1exp.ProtectMachineAnnotation]; hasProtectAnnotation {
+					log.V(4).Info("fetched ProtectMachineAnnotation", "machine", ampm.Spec.ProviderID)
+					if ampm.Annotations == nil {
+						ampm.Annotations = make(map[string]string)
+					}
+					ampm.Annotations[infrav1exp.ProtectMachineAnnotation] = machine.Annotations[infrav1exp.ProtectMachineAnnotation]
+				}
+			}
+		}
+
 		existingMachinesByProviderID[ampm.Spec.ProviderID] = ampm
 	}
 
+	m.setProtectedInstances(existingMachinesByProviderID)
+
 	// determine which machines need to be created to reflect the current state in Azure
 	azureMachinesByProviderID := m.vmssState.InstancesByProviderID(m.AzureMachinePool.Spec.OrchestrationMode)
 	for key, val := range azureMachinesByProviderID {
@@ -510,6 +553,19 @@ func (m *MachinePoolScope) applyAzureMachinePoolMachines(ctx context.Context) er
 	return nil
 }
 
+// setProtectedInstances records the provider IDs of the AzureMachinePoolMachines annotated with
+// infrav1exp.ProtectMachineAnnotation in the AzureMachinePool status.
+func (m *MachinePoolScope) setProtectedInstances(machinesByProviderID map[string]infrav1exp.AzureMachinePoolMachine) {
+	var protected []string
+	for providerID, ampm := range machinesByProviderID {
+		if _, isProtected := ampm.Annotations[infrav1exp.ProtectMachineAnnotation]; isProtected {
+			protected = append(protected, providerID)
+		}
+	}
+	sort.Strings(protected)
+	m.AzureMachinePool.Status.ProtectedInstances = protected
+}
+
 func (m *MachinePoolScope) createMachine(ctx context.Context, machine azure.VMSSVM) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachinePoolScope.createMachine")
 	defer done()
@@ -613,6 +669,10 @@ func (m *MachinePoolScope) setProvisioningStateAndConditions(v infrav1.Provision
 		m.AzureMachinePool.Status.ProvisioningState = &updatingState
 		if *m.MachinePool.Spec.Replicas > m.AzureMachinePool.Status.Replicas {
 			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleUpReason, clusterv1.ConditionSeverityInfo, "")
+		} else if protected := len(m.AzureMachinePool.Status.ProtectedInstances); protected > int(*m.MachinePool.Spec.Replicas) {
+			// protected machines are never selected to scale down, so the scale down can't complete until some are unprotected
+			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleDownBlockedReason, clusterv1.ConditionSeverityWarning,
+				"%d instances annotated with %s exceed the %d desired replicas", protected, infrav1exp.ProtectMachineAnnotation, *m.MachinePool.Spec.Replicas)
 		} else {
 			conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition, infrav1.ScaleSetScaleDownReason, clusterv1.ConditionSeverityInfo, "")
 		}
@@ -632,6 +692,60 @@ func (m *MachinePoolScope) setProvisioningStateAndConditions(v infrav1.Provision
 	}
 }
 
+// setInstancesUpToDateCondition sets the InstancesUpToDate condition from the number of VMSS instances running the
+// latest model and the number of nodes running the Kubernetes version of the MachinePool.
+func (m *MachinePoolScope) setInstancesUpToDateCondition(machines []infrav1exp.AzureMachinePoolMachine) {
+	if m.vmssState == nil {
+		return
+	}
+
+	upToDate := 0
+	for _, instance := range m.vmssState.Instances {
+		if m.vmssState.HasLatestModelApplied(instance) {
+			upToDate++
+		}
+	}
+	total := len(m.vmssState.Instances)
+
+	var messages []string
+	if upToDate != total {
+		messages = append(messages, fmt.Sprintf("%d of %d instances are running the latest model", upToDate, total))
+	}
+
+	// Only machines whose node reported a version count towards the skew, as new instances have not joined yet.
+	if version := m.desiredKubernetesVersion(); version != "" {
+		var reported, skewed int
+		for _, machine := range machines {
+			if machine.Status.Version == "" {
+				continue
+			}
+			reported++
+			if strings.TrimPrefix(machine.Status.Version, "v") != version {
+				skewed++
+			}
+		}
+		if skewed > 0 {
+			messages = append(messages, fmt.Sprintf("%d of %d nodes are not running Kubernetes version v%s", skewed, reported, version))
+		}
+	}
+
+	if len(messages) == 0 {
+		conditions.MarkTrue(m.AzureMachinePool, infrav1.ScaleSetInstancesUpToDateCondition)
+		return
+	}
+	conditions.MarkFalse(m.AzureMachinePool, infrav1.ScaleSetInstancesUpToDateCondition, infrav1.ScaleSetInstancesOutOfDateReason, clusterv1.ConditionSeverityInfo,
+		"%s", strings.Join(messages, "; "))
+}
+
+// desiredKubernetesVersion returns the Kubernetes version of the MachinePool without its "v" prefix, or an empty
+// string if it is not set.
+func (m *MachinePoolScope) desiredKubernetesVersion() string {
+	if m.MachinePool == nil {
+		return ""
+	}
+	return strings.TrimPrefix(ptr.Deref(m.MachinePool.Spec.Template.Spec.Version, ""), "v")
+}
+
 // SetReady sets the AzureMachinePool Ready Status to true.
 func (m *MachinePoolScope) SetReady() {
 	m.AzureMachinePool.Status.Ready = true
@@ -689,6 +803,7 @@ func (m *MachinePoolScope) PatchObject(ctx context.Context) error {
 			infrav1.ScaleSetDesiredReplicasCondition,
 			infrav1.ScaleSetModelUpdatedCondition,
 			infrav1.ScaleSetRunningCondition,
+			infrav1.ScaleSetInstancesUpToDateCondition,
 		}})
 }
 
@@ -704,6 +819,11 @@ func (m *MachinePoolScope) Close(ctx context.Context) error {
 		}
 
 		m.setProvisioningStateAndConditions(m.vmssState.State)
+		machines, err := m.GetMachinePoolMachines(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to get machine pool machines")
+		}
+		m.setInstancesUpToDateCondition(machines)
 		if err := m.updateReplicasAndProviderIDs(ctx); err != nil {
 			return errors.Wrap(err, "failed to update replicas and providerIDs")
 		}
@@ -834,6 +954,16 @@ func (m *MachinePoolScope) RoleAssignmentSpecs(principalID *string) []azure.Reso
 	return []azure.ResourceSpecGetter{}
 }
 
+// ImagePullIdentityID returns an empty string as AzureMachinePools do not support an image pull identity.
+func (m *MachinePoolScope) ImagePullIdentityID() string {
+	return ""
+}
+
+// ImagePullRoleAssignmentSpec returns nil as AzureMachinePools do not support an image pull identity.
+func (m *MachinePoolScope) ImagePullRoleAssignmentSpec(_ *string) azure.ResourceSpecGetter {
+	return nil
+}
+
 // RoleAssignmentResourceType returns the role assignment resource type.
 func (m *MachinePoolScope) RoleAssignmentResourceType() string {
 	return azure.VirtualMachineScaleSet
@@ -40,6 +40,7 @@ import (
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -632,6 +633,191 @@ func TestMachinePoolScope_NeedsRequeue(t *testing.T) {
 	}
 }
 
+func TestMachinePoolScope_setInstancesUpToDateCondition(t *testing.T) {
+	latest := infrav1.Image{
+		Marketplace: &infrav1.AzureMarketplaceImage{
+			Version: "2.0",
+		},
+	}
+	outdated := infrav1.Image{
+		Marketplace: &infrav1.AzureMarketplaceImage{
+			Version: "1.0",
+		},
+	}
+	cases := []struct {
+		Name            string
+		Instances       []azure.VMSSVM
+		Version         *string
+		Machines        []infrav1exp.AzureMachinePoolMachine
+		ExpectedStatus  corev1.ConditionStatus
+		ExpectedReason  string
+		ExpectedMessage string
+	}{
+		{
+			Name:           "no instances",
+			ExpectedStatus: corev1.ConditionTrue,
+		},
+		{
+			Name: "all instances on the latest model",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: latest},
+				{Name: "instance2", Image: latest},
+			},
+			ExpectedStatus: corev1.ConditionTrue,
+		},
+		{
+			Name: "some instances behind the latest model",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: latest},
+				{Name: "instance2", Image: outdated},
+				{Name: "instance3", Image: outdated},
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedReason:  infrav1.ScaleSetInstancesOutOfDateReason,
+			ExpectedMessage: "1 of 3 instances are running the latest model",
+		},
+		{
+			Name: "all instances behind the latest model",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: outdated},
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedReason:  infrav1.ScaleSetInstancesOutOfDateReason,
+			ExpectedMessage: "0 of 1 instances are running the latest model",
+		},
+		{
+			Name: "all nodes on the desired kubernetes version",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: latest},
+				{Name: "instance2", Image: latest},
+			},
+			Version: ptr.To("v1.30.1"),
+			Machines: []infrav1exp.AzureMachinePoolMachine{
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "v1.30.1"}},
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "1.30.1"}},
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{}},
+			},
+			ExpectedStatus: corev1.ConditionTrue,
+		},
+		{
+			Name: "some nodes behind the desired kubernetes version",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: latest},
+				{Name: "instance2", Image: latest},
+			},
+			Version: ptr.To("v1.30.1"),
+			Machines: []infrav1exp.AzureMachinePoolMachine{
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "v1.30.1"}},
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "v1.29.4"}},
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{}},
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedReason:  infrav1.ScaleSetInstancesOutOfDateReason,
+			ExpectedMessage: "1 of 2 nodes are not running Kubernetes version v1.30.1",
+		},
+		{
+			Name: "instances behind the latest model and nodes behind the desired kubernetes version",
+			Instances: []azure.VMSSVM{
+				{Name: "instance1", Image: latest},
+				{Name: "instance2", Image: outdated},
+			},
+			Version: ptr.To("1.30.1"),
+			Machines: []infrav1exp.AzureMachinePoolMachine{
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "v1.30.1"}},
+				{Status: infrav1exp.AzureMachinePoolMachineStatus{Version: "v1.29.4"}},
+			},
+			ExpectedStatus:  corev1.ConditionFalse,
+			ExpectedReason:  infrav1.ScaleSetInstancesOutOfDateReason,
+			ExpectedMessage: "1 of 2 instances are running the latest model; 1 of 2 nodes are not running Kubernetes version v1.30.1",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &MachinePoolScope{
+				AzureMachinePool: &infrav1exp.AzureMachinePool{},
+				MachinePool: &expv1.MachinePool{
+					Spec: expv1.MachinePoolSpec{
+						Template: clusterv1.MachineTemplateSpec{
+							Spec: clusterv1.MachineSpec{
+								Version: c.Version,
+							},
+						},
+					},
+				},
+				vmssState: &azure.VMSS{
+					Image:     latest,
+					Instances: c.Instances,
+				},
+			}
+			s.setInstancesUpToDateCondition(c.Machines)
+
+			condition := conditions.Get(s.AzureMachinePool, infrav1.ScaleSetInstancesUpToDateCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(c.ExpectedStatus))
+			g.Expect(condition.Reason).To(Equal(c.ExpectedReason))
+			g.Expect(condition.Message).To(Equal(c.ExpectedMessage))
+		})
+	}
+}
+
+func TestMachinePoolScope_setProvisioningStateAndConditions_ScaleDown(t *testing.T) {
+	cases := []struct {
+		Name               string
+		ProtectedInstances []string
+		ExpectedReason     string
+		ExpectedSeverity   clusterv1.ConditionSeverity
+		ExpectedMessage    string
+	}{
+		{
+			Name:             "no protected instances",
+			ExpectedReason:   infrav1.ScaleSetScaleDownReason,
+			ExpectedSeverity: clusterv1.ConditionSeverityInfo,
+		},
+		{
+			Name:               "protected instances within the desired replicas",
+			ProtectedInstances: []string{"azure:///instance1"},
+			ExpectedReason:     infrav1.ScaleSetScaleDownReason,
+			ExpectedSeverity:   clusterv1.ConditionSeverityInfo,
+		},
+		{
+			Name:               "protected instances exceed the desired replicas",
+			ProtectedInstances: []string{"azure:///instance1", "azure:///instance2", "azure:///instance3"},
+			ExpectedReason:     infrav1.ScaleSetScaleDownBlockedReason,
+			ExpectedSeverity:   clusterv1.ConditionSeverityWarning,
+			ExpectedMessage:    "3 instances annotated with infrastructure.cluster.x-k8s.io/protect exceed the 2 desired replicas",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &MachinePoolScope{
+				MachinePool: &expv1.MachinePool{
+					Spec: expv1.MachinePoolSpec{
+						Replicas: ptr.To[int32](2),
+					},
+				},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					Status: infrav1exp.AzureMachinePoolStatus{
+						Replicas:           3,
+						ProtectedInstances: c.ProtectedInstances,
+					},
+				},
+			}
+			s.setProvisioningStateAndConditions(infrav1.Succeeded)
+
+			condition := conditions.Get(s.AzureMachinePool, infrav1.ScaleSetDesiredReplicasCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(c.ExpectedReason))
+			g.Expect(condition.Severity).To(Equal(c.ExpectedSeverity))
+			g.Expect(condition.Message).To(Equal(c.ExpectedMessage))
+		})
+	}
+}
+
 func TestMachinePoolScope_updateReplicasAndProviderIDs(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = clusterv1.AddToScheme(scheme)
@@ -1455,6 +1641,42 @@ func TestMachinePoolScope_applyAzureMachinePoolMachines(t *testing.T) {
 				g.Expect(list.Items[1].Name).Should(Equal("mpm3"))
 			},
 		},
+		{
+			Name: "if MachinePool is not externally managed, and Machines have protect annotation, and overProvisionCount > 0, do not delete protected machines",
+			Setup: func(mp *expv1.MachinePool, amp *infrav1exp.AzureMachinePool, vmssState *azure.VMSS, cb *fake.ClientBuilder) {
+				mp.Spec.Replicas = ptr.To[int32](1)
+
+				mpm1, ampm1 := getAzureMachinePoolMachineWithOwnerMachine(1)
+				mpm1.Annotations = map[string]string{
+					infrav1exp.ProtectMachineAnnotation: "true",
+				}
+
+				mpm2, ampm2 := getAzureMachinePoolMachineWithOwnerMachine(2)
+				objects := []client.Object{&mpm1, &ampm1, &mpm2, &ampm2}
+				cb.WithObjects(objects...)
+
+				vmssState.Instances = []azure.VMSSVM{
+					{
+						ID:   "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/1",
+						Name: "ampm1",
+					},
+					{
+						ID:   "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/2",
+						Name: "ampm2",
+					},
+				}
+			},
+			Verify: func(g *WithT, amp *infrav1exp.AzureMachinePool, c client.Client, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				list := clusterv1.MachineList{}
+				g.Expect(c.List(ctx, &list)).NotTo(HaveOccurred())
+				g.Expect(list.Items).Should(HaveLen(1))
+				g.Expect(list.Items[0].Name).Should(Equal("mpm1"))
+				g.Expect(amp.Status.ProtectedInstances).To(Equal([]string{
+					"azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachineScaleSets/my-vmss/virtualMachines/1",
+				}))
+			},
+		},
 		{
 			Name: "if existing MachinePool is not present, reduce replicas",
 			Setup: func(mp *expv1.MachinePool, amp *infrav1exp.AzureMachinePool, vmssState *azure.VMSS, cb *fake.ClientBuilder) {
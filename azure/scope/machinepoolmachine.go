@@ -32,6 +32,7 @@ import (
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -178,6 +179,17 @@ func (s *MachinePoolMachineScope) Name() string {
 	return s.AzureMachinePoolMachine.Name
 }
 
+// IsDryRun returns true if mutating Azure operations for the cluster are logged instead of performed.
+func (s *MachinePoolMachineScope) IsDryRun() bool {
+	dryRunner, ok := s.ClusterScoper.(azure.DryRunner)
+	return ok && dryRunner.IsDryRun()
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (s *MachinePoolMachineScope) RecordDryRunSkip(message string) {
+	record.Event(s.AzureMachinePoolMachine, azure.DryRunSkippedReason, message)
+}
+
 // InstanceID is the unique ID of the machine within the Machine Pool.
 func (s *MachinePoolMachineScope) InstanceID() string {
 	return s.AzureMachinePoolMachine.Spec.InstanceID
@@ -23,12 +23,15 @@ import (
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20230315preview"
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
 	asonetworkv1api20201101 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"golang.org/x/mod/semver"
 	"gopkg.in/yaml.v3"
@@ -42,21 +45,26 @@ import (
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/cluster-api/util/secret"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/fleetsmembers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/futures"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+	"sigs.k8s.io/cluster-api-provider-azure/util/versions"
 )
 
 const (
@@ -130,6 +138,9 @@ type ManagedControlPlaneScope struct {
 	userKubeConfigData  []byte
 	cache               *ManagedControlPlaneCache
 
+	webAppRoutingIdentityObjectID string
+	identityPrincipalID           string
+
 	AzureClients
 	Cluster             *clusterv1.Cluster
 	ControlPlane        *infrav1.AzureManagedControlPlane
@@ -178,6 +189,17 @@ func (s *ManagedControlPlaneScope) ClusterName() string {
 	return s.Cluster.Name
 }
 
+// IsDryRun returns true if the cluster has the dry-run annotation, in which case mutating Azure
+// operations are logged instead of performed.
+func (s *ManagedControlPlaneScope) IsDryRun() bool {
+	return isDryRun(s.Cluster)
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (s *ManagedControlPlaneScope) RecordDryRunSkip(message string) {
+	record.Event(s.ControlPlane, azure.DryRunSkippedReason, message)
+}
+
 // Location returns the managed control plane's Azure location, or an empty string.
 func (s *ManagedControlPlaneScope) Location() string {
 	if s.ControlPlane == nil {
@@ -215,6 +237,21 @@ func (s *ManagedControlPlaneScope) AdditionalTags() infrav1.Tags {
 	return tags
 }
 
+// TagsSpecs returns the tags for the AKS node resource group.
+func (s *ManagedControlPlaneScope) TagsSpecs() []azure.TagsSpec {
+	// Nothing to reconcile until tags are set, or while none of the tags CAPZ applied have to be removed.
+	if len(s.ControlPlane.Spec.NodeResourceGroupTags) == 0 && s.ControlPlane.GetAnnotations()[azure.NodeResourceGroupTagsLastAppliedAnnotation] == "" {
+		return nil
+	}
+	return []azure.TagsSpec{
+		{
+			Scope:      azure.ResourceGroupID(s.SubscriptionID(), s.NodeResourceGroup()),
+			Tags:       s.ControlPlane.Spec.NodeResourceGroupTags,
+			Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+		},
+	}
+}
+
 // AzureFleetMembership returns the cluster AzureFleetMembership.
 func (s *ManagedControlPlaneScope) AzureFleetMembership() *infrav1.FleetsMember {
 	return s.ControlPlane.Spec.FleetsMember
@@ -248,6 +285,8 @@ func (s *ManagedControlPlaneScope) PatchObject(ctx context.Context) error {
 			infrav1.ManagedClusterRunningCondition,
 			infrav1.AgentPoolsReadyCondition,
 			infrav1.AzureResourceAvailableCondition,
+			infrav1.ManagedClusterDriftDetectedCondition,
+			infrav1.ManagedClusterAdoptedCondition,
 		}})
 }
 
@@ -270,7 +309,8 @@ func (s *ManagedControlPlaneScope) Vnet() *infrav1.VnetSpec {
 	}
 }
 
-// GroupSpecs returns the resource group spec.
+// GroupSpecs returns the resource group specs. The cluster resource group comes first so that it is deleted before
+// the vnet resource group, whose subnets may be in use by resources in the cluster resource group.
 func (s *ManagedControlPlaneScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup] {
 	specs := []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
 		&groups.GroupSpec{
@@ -520,6 +560,21 @@ func (s *ManagedControlPlaneScope) SetVersionStatus(version string) {
 	s.ControlPlane.Status.Version = version
 }
 
+// SetAvailableUpgradesStatus sets the Kubernetes versions the managed cluster can be upgraded to in status.
+func (s *ManagedControlPlaneScope) SetAvailableUpgradesStatus(versions []string) {
+	s.ControlPlane.Status.AvailableUpgrades = versions
+}
+
+// PowerState returns the desired power state of the managed cluster, defaulting to Running.
+func (s *ManagedControlPlaneScope) PowerState() infrav1.ManagedClusterPowerState {
+	return ptr.Deref(s.ControlPlane.Spec.PowerState, infrav1.ManagedClusterPowerStateRunning)
+}
+
+// SetPowerStateStatus sets the power state of the managed cluster in status.
+func (s *ManagedControlPlaneScope) SetPowerStateStatus(powerState infrav1.ManagedClusterPowerState) {
+	s.ControlPlane.Status.PowerState = powerState
+}
+
 // SetAutoUpgradeVersionStatus sets the auto upgrade version in status.
 func (s *ManagedControlPlaneScope) SetAutoUpgradeVersionStatus(version string) {
 	s.ControlPlane.Status.AutoUpgradeVersion = version
@@ -530,6 +585,56 @@ func (s *ManagedControlPlaneScope) IsManagedVersionUpgrade() bool {
 	return isManagedVersionUpgrade(s.ControlPlane)
 }
 
+// IsManagedClusterDrifted returns true if the managed cluster was last observed to have drifted from the desired spec.
+func (s *ManagedControlPlaneScope) IsManagedClusterDrifted() bool {
+	return conditions.IsTrue(s.ControlPlane, infrav1.ManagedClusterDriftDetectedCondition)
+}
+
+// SetManagedClusterDrift records which fields of the managed cluster were observed to have drifted from the
+// desired spec, clearing the condition when there is no drift. corrected indicates whether the drift is
+// corrected on the next sync, which is not the case when the AKS cluster is not reconciled by ASO.
+func (s *ManagedControlPlaneScope) SetManagedClusterDrift(fields []string, corrected bool) {
+	if len(fields) == 0 {
+		conditions.Delete(s.ControlPlane, infrav1.ManagedClusterDriftDetectedCondition)
+		return
+	}
+	if corrected {
+		conditions.Set(s.ControlPlane, &clusterv1.Condition{
+			Type:    infrav1.ManagedClusterDriftDetectedCondition,
+			Status:  corev1.ConditionTrue,
+			Reason:  infrav1.DriftCorrectingReason,
+			Message: fmt.Sprintf("fields drifted from the desired spec and are corrected on the next ASO sync: %s", strings.Join(fields, ", ")),
+		})
+		return
+	}
+	conditions.Set(s.ControlPlane, &clusterv1.Condition{
+		Type:   infrav1.ManagedClusterDriftDetectedCondition,
+		Status: corev1.ConditionTrue,
+		Reason: infrav1.DriftNotCorrectedReason,
+		Message: fmt.Sprintf("fields drifted from the desired spec and are not corrected because the AKS cluster has the %q reconcile policy: %s",
+			asoannotations.ReconcilePolicySkip, strings.Join(fields, ", ")),
+	})
+}
+
+// SetManagedClusterAdoption records whether CAPZ has taken over management of an existing AKS cluster when
+// adoption is requested with the adopt annotation. A nil error means the cluster is managed by CAPZ.
+func (s *ManagedControlPlaneScope) SetManagedClusterAdoption(err error) {
+	if !isAdoptRequested(s.ControlPlane) {
+		conditions.Delete(s.ControlPlane, infrav1.ManagedClusterAdoptedCondition)
+		return
+	}
+	if err != nil {
+		conditions.MarkFalse(s.ControlPlane, infrav1.ManagedClusterAdoptedCondition, infrav1.AdoptionFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		return
+	}
+	conditions.MarkTrue(s.ControlPlane, infrav1.ManagedClusterAdoptedCondition)
+}
+
+// isAdoptRequested returns whether the AzureManagedControlPlane requests adoption of an existing AKS cluster.
+func isAdoptRequested(managedControlPlane *infrav1.AzureManagedControlPlane) bool {
+	return infrav1.IsAdoptRequested(managedControlPlane.GetAnnotations())
+}
+
 func isManagedVersionUpgrade(managedControlPlane *infrav1.AzureManagedControlPlane) bool {
 	return managedControlPlane.Spec.AutoUpgradeProfile != nil &&
 		managedControlPlane.Spec.AutoUpgradeProfile.UpgradeChannel != nil &&
@@ -564,6 +669,8 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		Preview:                     ptr.Deref(s.ControlPlane.Spec.EnablePreviewFeatures, false),
 	}
 
+	managedClusterSpec.Adopt = isAdoptRequested(s.ControlPlane)
+
 	if s.ControlPlane.Spec.SSHPublicKey != nil {
 		managedClusterSpec.SSHPublicKey = *s.ControlPlane.Spec.SSHPublicKey
 	}
@@ -582,13 +689,18 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 	}
 
 	if clusterNetwork := s.Cluster.Spec.ClusterNetwork; clusterNetwork != nil {
-		if clusterNetwork.Services != nil && len(clusterNetwork.Services.CIDRBlocks) == 1 {
+		if clusterNetwork.Services != nil && len(clusterNetwork.Services.CIDRBlocks) > 0 {
 			managedClusterSpec.ServiceCIDR = clusterNetwork.Services.CIDRBlocks[0]
+			managedClusterSpec.ServiceCIDRs = clusterNetwork.Services.CIDRBlocks
 		}
-		if clusterNetwork.Pods != nil && len(clusterNetwork.Pods.CIDRBlocks) == 1 {
+		if clusterNetwork.Pods != nil && len(clusterNetwork.Pods.CIDRBlocks) > 0 {
 			managedClusterSpec.PodCIDR = clusterNetwork.Pods.CIDRBlocks[0]
+			managedClusterSpec.PodCIDRs = clusterNetwork.Pods.CIDRBlocks
 		}
 	}
+	for _, ipFamily := range s.ControlPlane.Spec.IPFamilies {
+		managedClusterSpec.IPFamilies = append(managedClusterSpec.IPFamilies, string(ipFamily))
+	}
 
 	if s.ControlPlane.Spec.AADProfile != nil {
 		managedClusterSpec.AADProfile = &managedclusters.AADProfile{
@@ -627,34 +739,45 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		}
 	}
 
+	if s.ControlPlane.Spec.NATGatewayProfile != nil {
+		managedClusterSpec.NATGatewayProfile = &managedclusters.NATGatewayProfile{
+			ManagedOutboundIPCount: s.ControlPlane.Spec.NATGatewayProfile.ManagedOutboundIPCount,
+			IdleTimeoutInMinutes:   s.ControlPlane.Spec.NATGatewayProfile.IdleTimeoutInMinutes,
+		}
+	}
+
 	if s.ControlPlane.Spec.APIServerAccessProfile != nil {
 		managedClusterSpec.APIServerAccessProfile = &managedclusters.APIServerAccessProfile{
 			AuthorizedIPRanges:             s.ControlPlane.Spec.APIServerAccessProfile.AuthorizedIPRanges,
 			EnablePrivateCluster:           s.ControlPlane.Spec.APIServerAccessProfile.EnablePrivateCluster,
 			PrivateDNSZone:                 s.ControlPlane.Spec.APIServerAccessProfile.PrivateDNSZone,
 			EnablePrivateClusterPublicFQDN: s.ControlPlane.Spec.APIServerAccessProfile.EnablePrivateClusterPublicFQDN,
+			DisableRunCommand:              s.ControlPlane.Spec.APIServerAccessProfile.DisableRunCommand,
 		}
 	}
 
 	if s.ControlPlane.Spec.AutoScalerProfile != nil {
 		managedClusterSpec.AutoScalerProfile = &managedclusters.AutoScalerProfile{
-			BalanceSimilarNodeGroups:      (*string)(s.ControlPlane.Spec.AutoScalerProfile.BalanceSimilarNodeGroups),
-			Expander:                      (*string)(s.ControlPlane.Spec.AutoScalerProfile.Expander),
-			MaxEmptyBulkDelete:            s.ControlPlane.Spec.AutoScalerProfile.MaxEmptyBulkDelete,
-			MaxGracefulTerminationSec:     s.ControlPlane.Spec.AutoScalerProfile.MaxGracefulTerminationSec,
-			MaxNodeProvisionTime:          s.ControlPlane.Spec.AutoScalerProfile.MaxNodeProvisionTime,
-			MaxTotalUnreadyPercentage:     s.ControlPlane.Spec.AutoScalerProfile.MaxTotalUnreadyPercentage,
-			NewPodScaleUpDelay:            s.ControlPlane.Spec.AutoScalerProfile.NewPodScaleUpDelay,
-			OkTotalUnreadyCount:           s.ControlPlane.Spec.AutoScalerProfile.OkTotalUnreadyCount,
-			ScanInterval:                  s.ControlPlane.Spec.AutoScalerProfile.ScanInterval,
-			ScaleDownDelayAfterAdd:        s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterAdd,
-			ScaleDownDelayAfterDelete:     s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterDelete,
-			ScaleDownDelayAfterFailure:    s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterFailure,
-			ScaleDownUnneededTime:         s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUnneededTime,
-			ScaleDownUnreadyTime:          s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUnreadyTime,
-			ScaleDownUtilizationThreshold: s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUtilizationThreshold,
-			SkipNodesWithLocalStorage:     (*string)(s.ControlPlane.Spec.AutoScalerProfile.SkipNodesWithLocalStorage),
-			SkipNodesWithSystemPods:       (*string)(s.ControlPlane.Spec.AutoScalerProfile.SkipNodesWithSystemPods),
+			BalanceSimilarNodeGroups:          (*string)(s.ControlPlane.Spec.AutoScalerProfile.BalanceSimilarNodeGroups),
+			DaemonsetEvictionForEmptyNodes:    s.ControlPlane.Spec.AutoScalerProfile.DaemonsetEvictionForEmptyNodes,
+			DaemonsetEvictionForOccupiedNodes: s.ControlPlane.Spec.AutoScalerProfile.DaemonsetEvictionForOccupiedNodes,
+			Expander:                          (*string)(s.ControlPlane.Spec.AutoScalerProfile.Expander),
+			IgnoreDaemonsetsUtilization:       s.ControlPlane.Spec.AutoScalerProfile.IgnoreDaemonsetsUtilization,
+			MaxEmptyBulkDelete:                s.ControlPlane.Spec.AutoScalerProfile.MaxEmptyBulkDelete,
+			MaxGracefulTerminationSec:         s.ControlPlane.Spec.AutoScalerProfile.MaxGracefulTerminationSec,
+			MaxNodeProvisionTime:              s.ControlPlane.Spec.AutoScalerProfile.MaxNodeProvisionTime,
+			MaxTotalUnreadyPercentage:         s.ControlPlane.Spec.AutoScalerProfile.MaxTotalUnreadyPercentage,
+			NewPodScaleUpDelay:                s.ControlPlane.Spec.AutoScalerProfile.NewPodScaleUpDelay,
+			OkTotalUnreadyCount:               s.ControlPlane.Spec.AutoScalerProfile.OkTotalUnreadyCount,
+			ScanInterval:                      s.ControlPlane.Spec.AutoScalerProfile.ScanInterval,
+			ScaleDownDelayAfterAdd:            s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterAdd,
+			ScaleDownDelayAfterDelete:         s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterDelete,
+			ScaleDownDelayAfterFailure:        s.ControlPlane.Spec.AutoScalerProfile.ScaleDownDelayAfterFailure,
+			ScaleDownUnneededTime:             s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUnneededTime,
+			ScaleDownUnreadyTime:              s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUnreadyTime,
+			ScaleDownUtilizationThreshold:     s.ControlPlane.Spec.AutoScalerProfile.ScaleDownUtilizationThreshold,
+			SkipNodesWithLocalStorage:         (*string)(s.ControlPlane.Spec.AutoScalerProfile.SkipNodesWithLocalStorage),
+			SkipNodesWithSystemPods:           (*string)(s.ControlPlane.Spec.AutoScalerProfile.SkipNodesWithSystemPods),
 		}
 	}
 
@@ -678,12 +801,108 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		if s.ControlPlane.Spec.AutoUpgradeProfile.UpgradeChannel != nil {
 			managedClusterSpec.AutoUpgradeProfile.UpgradeChannel = s.ControlPlane.Spec.AutoUpgradeProfile.UpgradeChannel
 		}
+		if s.ControlPlane.Spec.AutoUpgradeProfile.NodeOSUpgradeChannel != nil {
+			managedClusterSpec.AutoUpgradeProfile.NodeOSUpgradeChannel = s.ControlPlane.Spec.AutoUpgradeProfile.NodeOSUpgradeChannel
+		}
 	}
 
 	if s.ControlPlane.Spec.SecurityProfile != nil {
 		managedClusterSpec.SecurityProfile = s.getManagedClusterSecurityProfile()
 	}
 
+	if s.ControlPlane.Spec.SupportPlan != nil {
+		managedClusterSpec.SupportPlan = s.ControlPlane.Spec.SupportPlan
+	}
+
+	if s.ControlPlane.Spec.IngressProfile != nil {
+		managedClusterSpec.IngressProfile = &managedclusters.IngressProfile{}
+		if s.ControlPlane.Spec.IngressProfile.WebAppRouting != nil {
+			managedClusterSpec.IngressProfile.WebAppRouting = &managedclusters.IngressProfileWebAppRouting{
+				Enabled:            s.ControlPlane.Spec.IngressProfile.WebAppRouting.Enabled,
+				DNSZoneResourceIDs: s.ControlPlane.Spec.IngressProfile.WebAppRouting.DNSZoneResourceIDs,
+			}
+		}
+	}
+
+	if s.ControlPlane.Spec.MetricsProfile != nil {
+		managedClusterSpec.MetricsProfile = &managedclusters.MetricsProfile{}
+		if costAnalysis := s.ControlPlane.Spec.MetricsProfile.CostAnalysis; costAnalysis != nil {
+			managedClusterSpec.MetricsProfile.CostAnalysisEnabled = ptr.To(costAnalysis.Enabled)
+		}
+	}
+
+	if kubeProxyConfig := s.ControlPlane.Spec.KubeProxyConfig; kubeProxyConfig != nil {
+		managedClusterSpec.KubeProxyConfig = &managedclusters.KubeProxyConfig{
+			Enabled: kubeProxyConfig.Enabled,
+			Mode:    kubeProxyConfig.Mode,
+		}
+		if ipvs := kubeProxyConfig.IPVSConfig; ipvs != nil {
+			managedClusterSpec.KubeProxyConfig.IPVSConfig = &managedclusters.KubeProxyIPVSConfig{
+				Scheduler:            ipvs.Scheduler,
+				TCPTimeoutSeconds:    ipvs.TCPTimeoutSeconds,
+				TCPFinTimeoutSeconds: ipvs.TCPFinTimeoutSeconds,
+				UDPTimeoutSeconds:    ipvs.UDPTimeoutSeconds,
+			}
+		}
+	}
+
+	if s.ControlPlane.Spec.AzureMonitorProfile != nil {
+		managedClusterSpec.AzureMonitorProfile = &managedclusters.AzureMonitorProfile{}
+		if metrics := s.ControlPlane.Spec.AzureMonitorProfile.Metrics; metrics != nil {
+			managedClusterSpec.AzureMonitorProfile.Metrics = &managedclusters.AzureMonitorProfileMetrics{
+				Enabled: metrics.Enabled,
+			}
+			if metrics.KubeStateMetrics != nil {
+				managedClusterSpec.AzureMonitorProfile.Metrics.MetricAnnotationsAllowList = metrics.KubeStateMetrics.MetricAnnotationsAllowList
+				managedClusterSpec.AzureMonitorProfile.Metrics.MetricLabelsAllowlist = metrics.KubeStateMetrics.MetricLabelsAllowlist
+			}
+		}
+		if containerInsights := s.ControlPlane.Spec.AzureMonitorProfile.ContainerInsights; containerInsights != nil {
+			managedClusterSpec.AzureMonitorProfile.ContainerInsights = &managedclusters.AzureMonitorProfileContainerInsights{
+				Enabled:                         containerInsights.Enabled,
+				LogAnalyticsWorkspaceResourceID: containerInsights.LogAnalyticsWorkspaceResourceID,
+			}
+			if containerInsights.WindowsHostLogs != nil {
+				managedClusterSpec.AzureMonitorProfile.ContainerInsights.WindowsHostLogsEnabled = ptr.To(containerInsights.WindowsHostLogs.Enabled)
+			}
+		}
+	}
+
+	if serviceMeshProfile := s.ControlPlane.Spec.ServiceMeshProfile; serviceMeshProfile != nil {
+		managedClusterSpec.ServiceMeshProfile = &managedclusters.ServiceMeshProfile{
+			Mode: string(serviceMeshProfile.Mode),
+		}
+		if istio := serviceMeshProfile.Istio; istio != nil {
+			managedClusterSpec.ServiceMeshProfile.IstioRevisions = istio.Revisions
+			managedClusterSpec.ServiceMeshProfile.InternalIngressGateway = istio.InternalIngressGateway
+			managedClusterSpec.ServiceMeshProfile.ExternalIngressGateway = istio.ExternalIngressGateway
+		}
+	}
+
+	if podIdentityProfile := s.ControlPlane.Spec.AADPodIdentityProfile; podIdentityProfile != nil {
+		managedClusterSpec.PodIdentityProfile = &managedclusters.PodIdentityProfile{
+			Enabled:                   podIdentityProfile.Enabled,
+			AllowNetworkPluginKubenet: podIdentityProfile.AllowNetworkPluginKubenet,
+		}
+		for _, identity := range podIdentityProfile.UserAssignedIdentities {
+			managedClusterSpec.PodIdentityProfile.UserAssignedIdentities = append(managedClusterSpec.PodIdentityProfile.UserAssignedIdentities, managedclusters.PodIdentity{
+				Name:            identity.Name,
+				Namespace:       identity.Namespace,
+				BindingSelector: identity.BindingSelector,
+				ResourceID:      identity.Identity.ResourceID,
+				ClientID:        identity.Identity.ClientID,
+				ObjectID:        identity.Identity.ObjectID,
+			})
+		}
+		for _, exception := range podIdentityProfile.UserAssignedIdentityExceptions {
+			managedClusterSpec.PodIdentityProfile.UserAssignedIdentityExceptions = append(managedClusterSpec.PodIdentityProfile.UserAssignedIdentityExceptions, managedclusters.PodIdentityException{
+				Name:      exception.Name,
+				Namespace: exception.Namespace,
+				PodLabels: exception.PodLabels,
+			})
+		}
+	}
+
 	return &managedClusterSpec
 }
 
@@ -719,6 +938,12 @@ func (s *ManagedControlPlaneScope) getManagedClusterSecurityProfile() *managedcl
 		}
 	}
 
+	if s.ControlPlane.Spec.SecurityProfile.ImageIntegrity != nil {
+		securityProfile.ImageIntegrity = &managedclusters.ManagedClusterSecurityProfileImageIntegrity{
+			Enabled: ptr.To(s.ControlPlane.Spec.SecurityProfile.ImageIntegrity.Enabled),
+		}
+	}
+
 	if s.ControlPlane.Spec.SecurityProfile.WorkloadIdentity != nil {
 		securityProfile.WorkloadIdentity = &managedclusters.ManagedClusterSecurityProfileWorkloadIdentity{
 			Enabled: ptr.To(s.ControlPlane.Spec.SecurityProfile.WorkloadIdentity.Enabled),
@@ -751,6 +976,16 @@ func (s *ManagedControlPlaneScope) GetAllAgentPoolSpecs() ([]azure.ASOResourceSp
 		ammps = append(ammps, ammp)
 	}
 
+	if systemNodePool := s.ControlPlane.Spec.SystemNodePool; systemNodePool != nil {
+		for _, pool := range s.ManagedMachinePools {
+			if pool.InfraMachinePool != nil && ptr.Deref(pool.InfraMachinePool.Spec.Name, pool.InfraMachinePool.Name) == systemNodePool.Name {
+				return nil, errors.Errorf("AzureManagedMachinePool %s has the same name as the system node pool %s", pool.InfraMachinePool.Name, systemNodePool.Name)
+			}
+		}
+		foundSystemPool = true
+		ammps = append(ammps, buildSystemNodePoolSpec(s.ControlPlane, systemNodePool))
+	}
+
 	if !foundSystemPool {
 		return nil, errors.New("failed to fetch azuremanagedMachine pool with mode:System, require at least 1 system node pool")
 	}
@@ -758,6 +993,80 @@ func (s *ManagedControlPlaneScope) GetAllAgentPoolSpecs() ([]azure.ASOResourceSp
 	return ammps, nil
 }
 
+// buildSystemNodePoolSpec builds the agent pool spec for the system node pool declared on the control plane.
+// The system node pool runs the Kubernetes version of the control plane.
+func buildSystemNodePoolSpec(controlPlane *infrav1.AzureManagedControlPlane, systemNodePool *infrav1.ManagedControlPlaneSystemNodePool) *agentpools.AgentPoolSpec {
+	var version *string
+	if v := versions.GetHigherK8sVersion(controlPlane.Spec.Version, controlPlane.Status.AutoUpgradeVersion); v != "" {
+		version = ptr.To(strings.TrimPrefix(v, "v"))
+	}
+	return &agentpools.AgentPoolSpec{
+		Name:          controlPlane.Name + "-" + systemNodePool.Name,
+		AzureName:     systemNodePool.Name,
+		ResourceGroup: controlPlane.Spec.ResourceGroupName,
+		Cluster:       controlPlane.Name,
+		SKU:           systemNodePool.SKU,
+		Replicas:      ptr.Deref(systemNodePool.Count, 1),
+		OSDiskSizeGB:  ptr.Deref(systemNodePool.OSDiskSizeGB, 0),
+		Version:       version,
+		VnetSubnetID: azure.SubnetID(
+			controlPlane.Spec.SubscriptionID,
+			controlPlane.Spec.VirtualNetwork.ResourceGroup,
+			controlPlane.Spec.VirtualNetwork.Name,
+			controlPlane.Spec.VirtualNetwork.Subnet.Name,
+		),
+		Mode:    string(infrav1.NodePoolModeSystem),
+		Preview: ptr.Deref(controlPlane.Spec.EnablePreviewFeatures, false),
+	}
+}
+
+// SystemNodePoolScope is the scope of the agent pool reconciling the system node pool declared on the
+// AzureManagedControlPlane. The system node pool has no machine pool, so the agent pool status is not recorded.
+type SystemNodePoolScope struct {
+	*ManagedControlPlaneScope
+}
+
+// SystemNodePoolScope returns the scope of the system node pool declared on the AzureManagedControlPlane.
+func (s *ManagedControlPlaneScope) SystemNodePoolScope() *SystemNodePoolScope {
+	return &SystemNodePoolScope{ManagedControlPlaneScope: s}
+}
+
+// Name returns the name of the system node pool.
+func (s *SystemNodePoolScope) Name() string {
+	return s.ControlPlane.Spec.SystemNodePool.Name
+}
+
+// AgentPoolSpec returns the agent pool spec of the system node pool.
+func (s *SystemNodePoolScope) AgentPoolSpec() azure.ASOResourceSpecGetter[genruntime.MetaObject] {
+	return buildSystemNodePoolSpec(s.ControlPlane, s.ControlPlane.Spec.SystemNodePool)
+}
+
+// IsPreviewEnabled returns the value of the EnablePreviewFeatures field from the AzureManagedControlPlane.
+func (s *SystemNodePoolScope) IsPreviewEnabled() bool {
+	return ptr.Deref(s.ControlPlane.Spec.EnablePreviewFeatures, false)
+}
+
+// SetSubnetName is a no-op as the system node pool always uses the subnet of the control plane.
+func (s *SystemNodePoolScope) SetSubnetName() {}
+
+// SetAgentPoolProviderIDList is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) SetAgentPoolProviderIDList([]string) {}
+
+// SetAgentPoolReplicas is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) SetAgentPoolReplicas(int32) {}
+
+// SetAgentPoolReady is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) SetAgentPoolReady(bool) {}
+
+// SetCAPIMachinePoolReplicas is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) SetCAPIMachinePoolReplicas(*int) {}
+
+// SetCAPIMachinePoolAnnotation is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) SetCAPIMachinePoolAnnotation(string, string) {}
+
+// RemoveCAPIMachinePoolAnnotation is a no-op as the system node pool has no machine pool.
+func (s *SystemNodePoolScope) RemoveCAPIMachinePoolAnnotation(string) {}
+
 // SetControlPlaneEndpoint sets a control plane endpoint.
 func (s *ManagedControlPlaneScope) SetControlPlaneEndpoint(endpoint clusterv1.APIEndpoint) {
 	s.ControlPlane.Spec.ControlPlaneEndpoint.Host = endpoint.Host
@@ -798,6 +1107,91 @@ func (s *ManagedControlPlaneScope) SetUserKubeconfigData(kubeConfigData []byte)
 	s.userKubeConfigData = kubeConfigData
 }
 
+// SetWebAppRoutingIdentityObjectID sets the object ID of the identity of the application routing add-on.
+func (s *ManagedControlPlaneScope) SetWebAppRoutingIdentityObjectID(objectID string) {
+	s.webAppRoutingIdentityObjectID = objectID
+}
+
+// SetIdentityPrincipalID sets the principal ID of the system-assigned identity of the managed cluster.
+func (s *ManagedControlPlaneScope) SetIdentityPrincipalID(principalID string) {
+	s.identityPrincipalID = principalID
+}
+
+// ObserveManagedClusterIdentities records the identities of the managed cluster from the status of its ASO resource
+// without reconciling it, so that the role assignments of the identities can be deleted.
+func (s *ManagedControlPlaneScope) ObserveManagedClusterIdentities(ctx context.Context) error {
+	resource := s.ManagedClusterSpec().ResourceRef()
+	resource.SetNamespace(s.ASOOwner().GetNamespace())
+	if err := s.Client.Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return managedclusters.SetIdentities(s, resource)
+}
+
+// ManagedClusterRoleAssignmentSpecs returns the role assignments required by the managed cluster.
+// The system-assigned identity of the control plane is granted its additional role assignments, and
+// the application routing add-on's identity is granted the DNS Zone Contributor or Private DNS Zone
+// Contributor role on each DNS zone it manages.
+func (s *ManagedControlPlaneScope) ManagedClusterRoleAssignmentSpecs() []azure.ResourceSpecGetter {
+	specs := s.identityRoleAssignmentSpecs()
+
+	ingressProfile := s.ControlPlane.Spec.IngressProfile
+	if ingressProfile == nil || ingressProfile.WebAppRouting == nil || !ingressProfile.WebAppRouting.Enabled ||
+		s.webAppRoutingIdentityObjectID == "" {
+		return specs
+	}
+
+	for _, dnsZoneID := range ingressProfile.WebAppRouting.DNSZoneResourceIDs {
+		resourceID, err := azureutil.ParseResourceID(dnsZoneID)
+		if err != nil {
+			// The webhook only admits valid resource IDs.
+			continue
+		}
+		roleID := infrav1.DNSZoneContributorRoleID
+		if strings.EqualFold(resourceID.ResourceType.String(), infrav1.PrivateDNSZoneResourceType) {
+			roleID = infrav1.PrivateDNSZoneContributorRoleID
+		}
+		specs = append(specs, &roleassignments.RoleAssignmentSpec{
+			// Role assignment names must be GUIDs. Derive a stable one so the assignment is only created once.
+			Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(dnsZoneID+s.webAppRoutingIdentityObjectID+roleID)).String(),
+			ResourceGroup:    resourceID.ResourceGroupName,
+			Scope:            dnsZoneID,
+			RoleDefinitionID: fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Authorization/roleDefinitions/%s", resourceID.SubscriptionID, roleID),
+			PrincipalID:      ptr.To(s.webAppRoutingIdentityObjectID),
+			PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+		})
+	}
+	return specs
+}
+
+// identityRoleAssignmentSpecs returns the additional role assignments of the system-assigned identity of the control plane.
+// They are only returned once the managed cluster reports the principal ID of its identity.
+func (s *ManagedControlPlaneScope) identityRoleAssignmentSpecs() []azure.ResourceSpecGetter {
+	identity := s.ControlPlane.Spec.Identity
+	if identity == nil || identity.Type == infrav1.ManagedControlPlaneIdentityTypeUserAssigned || s.identityPrincipalID == "" {
+		return nil
+	}
+
+	var specs []azure.ResourceSpecGetter
+	for _, role := range identity.AdditionalRoleAssignments {
+		resourceID, err := azureutil.ParseResourceID(role.Scope)
+		if err != nil {
+			// The webhook only admits valid resource IDs.
+			continue
+		}
+		specs = append(specs, &roleassignments.RoleAssignmentSpec{
+			// Role assignment names must be GUIDs. Derive a stable one so the assignment is only created once.
+			Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(role.Scope+s.identityPrincipalID+role.DefinitionID)).String(),
+			ResourceGroup:    resourceID.ResourceGroupName,
+			Scope:            role.Scope,
+			RoleDefinitionID: role.DefinitionID,
+			PrincipalID:      ptr.To(s.identityPrincipalID),
+			PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+		})
+	}
+	return specs
+}
+
 // MakeClusterCA returns a cluster CA Secret for the managed control plane.
 func (s *ManagedControlPlaneScope) MakeClusterCA() *corev1.Secret {
 	return &corev1.Secret{
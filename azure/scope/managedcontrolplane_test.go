@@ -21,10 +21,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	asokubernetesconfigurationv1 "github.com/Azure/azure-service-operator/v2/api/kubernetesconfiguration/v1api20230501"
 	asonetworkv1 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +34,7 @@ import (
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -41,6 +44,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 )
 
 func TestNewManagedControlPlaneScope(t *testing.T) {
@@ -279,6 +283,180 @@ func TestManagedControlPlaneScope_PoolVersion(t *testing.T) {
 	}
 }
 
+func TestManagedControlPlaneScope_SystemNodePool(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Scope    *ManagedControlPlaneScope
+		Expected []azure.ASOResourceSpecGetter[genruntime.MetaObject]
+		Err      string
+	}{
+		{
+			Name: "System node pool on the control plane only",
+			Scope: &ManagedControlPlaneScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							Version:        "v1.29.2",
+							SubscriptionID: "00000000-0000-0000-0000-000000000000",
+							SystemNodePool: &infrav1.ManagedControlPlaneSystemNodePool{
+								Name:  "system",
+								Mode:  infrav1.NodePoolModeSystem,
+								SKU:   "Standard_D4s_v3",
+								Count: ptr.To(3),
+							},
+						},
+					},
+				},
+				ManagedMachinePools: []ManagedMachinePool{
+					{
+						MachinePool:      getMachinePool("pool1"),
+						InfraMachinePool: getAzureMachinePool("pool1", infrav1.NodePoolModeUser),
+					},
+				},
+			},
+			Expected: []azure.ASOResourceSpecGetter[genruntime.MetaObject]{
+				&agentpools.AgentPoolSpec{
+					Name:         "pool1",
+					AzureName:    "pool1",
+					SKU:          "Standard_D2s_v3",
+					Replicas:     1,
+					Mode:         "User",
+					Cluster:      "cluster1",
+					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+				},
+				&agentpools.AgentPoolSpec{
+					Name:         "cluster1-system",
+					AzureName:    "system",
+					SKU:          "Standard_D4s_v3",
+					Replicas:     3,
+					Mode:         "System",
+					Cluster:      "cluster1",
+					Version:      ptr.To("1.29.2"),
+					VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups//providers/Microsoft.Network/virtualNetworks//subnets/",
+				},
+			},
+		},
+		{
+			Name: "Machine pool with the name of the system node pool",
+			Scope: &ManagedControlPlaneScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							SystemNodePool: &infrav1.ManagedControlPlaneSystemNodePool{
+								Name: "pool1",
+								Mode: infrav1.NodePoolModeSystem,
+								SKU:  "Standard_D4s_v3",
+							},
+						},
+					},
+				},
+				ManagedMachinePools: []ManagedMachinePool{
+					{
+						MachinePool:      getMachinePool("pool1"),
+						InfraMachinePool: getAzureMachinePool("pool1", infrav1.NodePoolModeUser),
+					},
+				},
+			},
+			Err: "AzureManagedMachinePool pool1 has the same name as the system node pool pool1",
+		},
+		{
+			Name: "No system node pool",
+			Scope: &ManagedControlPlaneScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ManagedMachinePools: []ManagedMachinePool{
+					{
+						MachinePool:      getMachinePool("pool1"),
+						InfraMachinePool: getAzureMachinePool("pool1", infrav1.NodePoolModeUser),
+					},
+				},
+			},
+			Err: "failed to fetch azuremanagedMachine pool with mode:System, require at least 1 system node pool",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			agentPools, err := c.Scope.GetAllAgentPoolSpecs()
+			if c.Err != "" {
+				g.Expect(err).To(MatchError(c.Err))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(agentPools).To(Equal(c.Expected))
+			}
+		})
+	}
+}
+
+func TestManagedControlPlaneScope_SystemNodePoolScope(t *testing.T) {
+	g := NewWithT(t)
+
+	s := &ManagedControlPlaneScope{
+		ControlPlane: &infrav1.AzureManagedControlPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster1",
+				Namespace: "default",
+			},
+			Spec: infrav1.AzureManagedControlPlaneSpec{
+				AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+					Version:               "v1.29.2",
+					EnablePreviewFeatures: ptr.To(true),
+					SystemNodePool: &infrav1.ManagedControlPlaneSystemNodePool{
+						Name: "system",
+						Mode: infrav1.NodePoolModeSystem,
+						SKU:  "Standard_D4s_v3",
+					},
+				},
+			},
+			Status: infrav1.AzureManagedControlPlaneStatus{
+				AutoUpgradeVersion: "1.30.0",
+			},
+		},
+	}
+
+	systemNodePoolScope := s.SystemNodePoolScope()
+	g.Expect(systemNodePoolScope.Name()).To(Equal("system"))
+	g.Expect(systemNodePoolScope.IsPreviewEnabled()).To(BeTrue())
+	spec, ok := systemNodePoolScope.AgentPoolSpec().(*agentpools.AgentPoolSpec)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(spec.Name).To(Equal("cluster1-system"))
+	g.Expect(spec.AzureName).To(Equal("system"))
+	g.Expect(spec.Version).To(Equal(ptr.To("1.30.0")))
+	g.Expect(spec.Preview).To(BeTrue())
+}
+
 func TestManagedControlPlaneScope_AddonProfiles(t *testing.T) {
 	cases := []struct {
 		Name     string
@@ -1246,6 +1424,42 @@ func TestManagedControlPlaneScope_AutoUpgradeProfile(t *testing.T) {
 				UpgradeChannel: ptr.To(infrav1.UpgradeChannelNodeImage),
 			},
 		},
+		{
+			name: "With AutoUpgradeProfile UpgradeChannelPatch and NodeOSUpgradeChannelSecurityPatch",
+			input: ManagedControlPlaneScopeParams{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							SubscriptionID: "00000000-0000-0000-0000-000000000000",
+							AutoUpgradeProfile: &infrav1.ManagedClusterAutoUpgradeProfile{
+								UpgradeChannel:       ptr.To(infrav1.UpgradeChannelPatch),
+								NodeOSUpgradeChannel: ptr.To(infrav1.NodeOSUpgradeChannelSecurityPatch),
+							},
+						},
+					},
+				},
+				ManagedMachinePools: []ManagedMachinePool{
+					{
+						MachinePool:      getMachinePool("pool0"),
+						InfraMachinePool: getAzureMachinePool("pool0", infrav1.NodePoolModeSystem),
+					},
+				},
+			},
+			expected: &managedclusters.ManagedClusterAutoUpgradeProfile{
+				UpgradeChannel:       ptr.To(infrav1.UpgradeChannelPatch),
+				NodeOSUpgradeChannel: ptr.To(infrav1.NodeOSUpgradeChannelSecurityPatch),
+			},
+		},
 	}
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
@@ -1415,3 +1629,272 @@ func TestManagedControlPlaneScope_GroupSpecs(t *testing.T) {
 		})
 	}
 }
+
+func TestManagedControlPlaneScope_ManagedClusterRoleAssignmentSpecs(t *testing.T) {
+	const (
+		dnsZoneID        = "/subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com"
+		privateDNSZoneID = "/subscriptions/67890/resourceGroups/private-dns-rg/providers/Microsoft.Network/privateDnsZones/example.internal"
+		vnetRGScope      = "/subscriptions/12345/resourceGroups/vnet-rg"
+		networkRoleID    = "/subscriptions/12345/providers/Microsoft.Authorization/roleDefinitions/4d97b98b-1d4f-4787-a291-c67834d212e7"
+	)
+	networkContributor := []infrav1.RoleAssignment{{DefinitionID: networkRoleID, Scope: vnetRGScope}}
+	cases := []struct {
+		name                string
+		ingressProfile      *infrav1.ManagedClusterIngressProfile
+		identityObjectID    string
+		identity            *infrav1.Identity
+		identityPrincipalID string
+		expected            []azure.ResourceSpecGetter
+	}{
+		{
+			name:             "no ingress profile",
+			identityObjectID: "object-id",
+			expected:         nil,
+		},
+		{
+			name: "web app routing disabled",
+			ingressProfile: &infrav1.ManagedClusterIngressProfile{
+				WebAppRouting: &infrav1.ManagedClusterIngressProfileWebAppRouting{
+					Enabled: false,
+				},
+			},
+			identityObjectID: "object-id",
+			expected:         nil,
+		},
+		{
+			name: "web app routing identity not yet known",
+			ingressProfile: &infrav1.ManagedClusterIngressProfile{
+				WebAppRouting: &infrav1.ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{dnsZoneID},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "web app routing with DNS zones",
+			ingressProfile: &infrav1.ManagedClusterIngressProfile{
+				WebAppRouting: &infrav1.ManagedClusterIngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{dnsZoneID, privateDNSZoneID},
+				},
+			},
+			identityObjectID: "object-id",
+			expected: []azure.ResourceSpecGetter{
+				&roleassignments.RoleAssignmentSpec{
+					Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(dnsZoneID+"object-id"+infrav1.DNSZoneContributorRoleID)).String(),
+					ResourceGroup:    "dns-rg",
+					Scope:            dnsZoneID,
+					RoleDefinitionID: "/subscriptions/12345/providers/Microsoft.Authorization/roleDefinitions/" + infrav1.DNSZoneContributorRoleID,
+					PrincipalID:      ptr.To("object-id"),
+					PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+				},
+				&roleassignments.RoleAssignmentSpec{
+					Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(privateDNSZoneID+"object-id"+infrav1.PrivateDNSZoneContributorRoleID)).String(),
+					ResourceGroup:    "private-dns-rg",
+					Scope:            privateDNSZoneID,
+					RoleDefinitionID: "/subscriptions/67890/providers/Microsoft.Authorization/roleDefinitions/" + infrav1.PrivateDNSZoneContributorRoleID,
+					PrincipalID:      ptr.To("object-id"),
+					PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+				},
+			},
+		},
+		{
+			name: "additional role assignments of the system-assigned identity",
+			identity: &infrav1.Identity{
+				Type:                      infrav1.ManagedControlPlaneIdentityTypeSystemAssigned,
+				AdditionalRoleAssignments: networkContributor,
+			},
+			identityPrincipalID: "principal-id",
+			expected: []azure.ResourceSpecGetter{
+				&roleassignments.RoleAssignmentSpec{
+					Name:             uuid.NewSHA1(uuid.NameSpaceURL, []byte(vnetRGScope+"principal-id"+networkRoleID)).String(),
+					ResourceGroup:    "vnet-rg",
+					Scope:            vnetRGScope,
+					RoleDefinitionID: networkRoleID,
+					PrincipalID:      ptr.To("principal-id"),
+					PrincipalType:    armauthorization.PrincipalTypeServicePrincipal,
+				},
+			},
+		},
+		{
+			name: "system-assigned identity principal not yet known",
+			identity: &infrav1.Identity{
+				Type:                      infrav1.ManagedControlPlaneIdentityTypeSystemAssigned,
+				AdditionalRoleAssignments: networkContributor,
+			},
+			expected: nil,
+		},
+		{
+			name: "user-assigned identity",
+			identity: &infrav1.Identity{
+				Type:                           infrav1.ManagedControlPlaneIdentityTypeUserAssigned,
+				UserAssignedIdentityResourceID: "/subscriptions/12345/resourceGroups/rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id",
+				AdditionalRoleAssignments:      networkContributor,
+			},
+			identityPrincipalID: "principal-id",
+			expected:            nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							IngressProfile: c.ingressProfile,
+							Identity:       c.identity,
+						},
+					},
+				},
+				webAppRoutingIdentityObjectID: c.identityObjectID,
+				identityPrincipalID:           c.identityPrincipalID,
+			}
+			g.Expect(s.ManagedClusterRoleAssignmentSpecs()).To(Equal(c.expected))
+		})
+	}
+}
+
+func TestManagedControlPlaneScope_TagsSpecs(t *testing.T) {
+	cases := []struct {
+		name        string
+		tags        infrav1.Tags
+		annotations map[string]string
+		expected    []azure.TagsSpec
+	}{
+		{
+			name:     "no node resource group tags",
+			expected: nil,
+		},
+		{
+			name: "node resource group tags",
+			tags: infrav1.Tags{"costCenter": "1234"},
+			expected: []azure.TagsSpec{
+				{
+					Scope:      azure.ResourceGroupID("", "node-rg"),
+					Tags:       infrav1.Tags{"costCenter": "1234"},
+					Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+				},
+			},
+		},
+		{
+			name: "previously applied node resource group tags are removed",
+			annotations: map[string]string{
+				azure.NodeResourceGroupTagsLastAppliedAnnotation: `{"costCenter":"1234"}`,
+			},
+			expected: []azure.TagsSpec{
+				{
+					Scope:      azure.ResourceGroupID("", "node-rg"),
+					Annotation: azure.NodeResourceGroupTagsLastAppliedAnnotation,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: c.annotations,
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						NodeResourceGroupName: "node-rg",
+						NodeResourceGroupTags: c.tags,
+					},
+				},
+			}
+			g.Expect(s.TagsSpecs()).To(Equal(c.expected))
+		})
+	}
+}
+
+func TestIsAdoptRequested(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Annotations map[string]string
+		Expected    bool
+	}{
+		{
+			Name:     "without annotations",
+			Expected: false,
+		},
+		{
+			Name:        "with the adopt annotation",
+			Annotations: map[string]string{"infrastructure.cluster.x-k8s.io/adopt": "true"},
+			Expected:    true,
+		},
+		{
+			Name:        "with the adopt annotation not set to true",
+			Annotations: map[string]string{"infrastructure.cluster.x-k8s.io/adopt": "false"},
+			Expected:    false,
+		},
+		{
+			Name:        "with the deprecated adopt annotation",
+			Annotations: map[string]string{"sigs.k8s.io/cluster-api-provider-azure-adopt": "true"},
+			Expected:    true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			managedControlPlane := &infrav1.AzureManagedControlPlane{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: c.Annotations,
+				},
+			}
+			g.Expect(isAdoptRequested(managedControlPlane)).To(Equal(c.Expected))
+		})
+	}
+}
+
+func TestManagedControlPlaneScope_SetManagedClusterDrift(t *testing.T) {
+	cases := []struct {
+		Name            string
+		Fields          []string
+		Corrected       bool
+		ExpectedReason  string
+		ExpectedMessage string
+	}{
+		{
+			Name: "no drift",
+		},
+		{
+			Name:            "drift corrected by ASO",
+			Fields:          []string{"sku.tier", "disableLocalAccounts"},
+			Corrected:       true,
+			ExpectedReason:  infrav1.DriftCorrectingReason,
+			ExpectedMessage: "fields drifted from the desired spec and are corrected on the next ASO sync: sku.tier, disableLocalAccounts",
+		},
+		{
+			Name:            "drift not corrected",
+			Fields:          []string{"sku.tier"},
+			ExpectedReason:  infrav1.DriftNotCorrectedReason,
+			ExpectedMessage: `fields drifted from the desired spec and are not corrected because the AKS cluster has the "skip" reconcile policy: sku.tier`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedControlPlaneScope{
+				ControlPlane: &infrav1.AzureManagedControlPlane{},
+			}
+			s.SetManagedClusterDrift(c.Fields, c.Corrected)
+
+			condition := conditions.Get(s.ControlPlane, infrav1.ManagedClusterDriftDetectedCondition)
+			if c.ExpectedReason == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+			g.Expect(condition.Reason).To(Equal(c.ExpectedReason))
+			g.Expect(condition.Message).To(Equal(c.ExpectedMessage))
+		})
+	}
+}
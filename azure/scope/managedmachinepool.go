@@ -28,6 +28,7 @@ import (
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -136,6 +137,17 @@ func (s *ManagedMachinePoolScope) ASOOwner() client.Object {
 	return s.InfraMachinePool
 }
 
+// IsDryRun returns true if the cluster has the dry-run annotation, in which case mutating Azure
+// operations are logged instead of performed.
+func (s *ManagedMachinePoolScope) IsDryRun() bool {
+	return isDryRun(s.Cluster)
+}
+
+// RecordDryRunSkip implements azure.DryRunner.
+func (s *ManagedMachinePoolScope) RecordDryRunSkip(message string) {
+	record.Event(s.InfraMachinePool, azure.DryRunSkippedReason, message)
+}
+
 // Name returns the name of the infra machine pool.
 func (s *ManagedMachinePoolScope) Name() string {
 	return s.InfraMachinePool.Name
@@ -177,6 +189,7 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		Replicas:      int(replicas),
 		Version:       normalizedVersion,
 		OSType:        managedMachinePool.Spec.OSType,
+		OSSKU:         managedMachinePool.Spec.OSSKU,
 		VnetSubnetID: azure.SubnetID(
 			managedControlPlane.Spec.SubscriptionID,
 			managedControlPlane.Spec.VirtualNetwork.ResourceGroup,
@@ -198,6 +211,8 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		LinuxOSConfig:          managedMachinePool.Spec.LinuxOSConfig,
 		EnableFIPS:             managedMachinePool.Spec.EnableFIPS,
 		EnableEncryptionAtHost: managedMachinePool.Spec.EnableEncryptionAtHost,
+		GPUInstanceProfile:     managedMachinePool.Spec.GPUInstanceProfile,
+		UpgradeSettings:        managedMachinePool.Spec.UpgradeSettings,
 		Patches:                managedMachinePool.Spec.ASOManagedClustersAgentPoolPatches,
 		Preview:                ptr.Deref(managedControlPlane.Spec.EnablePreviewFeatures, false),
 	}
@@ -206,6 +221,15 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		agentPoolSpec.OSDiskSizeGB = *managedMachinePool.Spec.OSDiskSizeGB
 	}
 
+	if managedMachinePool.Spec.PodSubnetName != nil {
+		agentPoolSpec.PodSubnetID = azure.SubnetID(
+			managedControlPlane.Spec.SubscriptionID,
+			managedControlPlane.Spec.VirtualNetwork.ResourceGroup,
+			managedControlPlane.Spec.VirtualNetwork.Name,
+			*managedMachinePool.Spec.PodSubnetName,
+		)
+	}
+
 	if len(managedMachinePool.Spec.Taints) > 0 {
 		nodeTaints := make([]string, 0, len(managedMachinePool.Spec.Taints))
 		for _, t := range managedMachinePool.Spec.Taints {
@@ -214,6 +238,9 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 		agentPoolSpec.NodeTaints = nodeTaints
 	}
 
+	agentPoolSpec.NodeInitializationTaints = managedMachinePool.Spec.NodeInitializationTaints
+	agentPoolSpec.EnableArtifactStreaming = managedMachinePool.Spec.EnableArtifactStreaming
+
 	if managedMachinePool.Spec.Scaling != nil {
 		agentPoolSpec.EnableAutoScaling = true
 		agentPoolSpec.MaxCount = managedMachinePool.Spec.Scaling.MaxSize
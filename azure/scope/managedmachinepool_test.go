@@ -634,6 +634,43 @@ func TestManagedMachinePoolScope_SubnetName(t *testing.T) {
 				VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-resource-group/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet",
 			},
 		},
+		{
+			Name: "With Vnet and With PodSubnetName",
+			Scope: &ManagedMachinePoolScope{
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "cluster1",
+						Namespace: "default",
+					},
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							SubscriptionID: "00000000-0000-0000-0000-000000000000",
+							VirtualNetwork: infrav1.ManagedControlPlaneVirtualNetwork{
+								Name: "my-vnet",
+								ManagedControlPlaneVirtualNetworkClassSpec: infrav1.ManagedControlPlaneVirtualNetworkClassSpec{
+									Subnet: infrav1.ManagedControlPlaneSubnet{
+										Name: "my-vnet-subnet",
+									},
+								},
+								ResourceGroup: "my-resource-group",
+							},
+						},
+					},
+				},
+				MachinePool:      getMachinePool("pool1"),
+				InfraMachinePool: getAzureMachinePoolWithPodSubnetName("pool1", ptr.To("my-pod-subnet")),
+			},
+			Expected: &agentpools.AgentPoolSpec{
+				Name:         "pool1",
+				AzureName:    "pool1",
+				SKU:          "Standard_D2s_v3",
+				Mode:         "User",
+				Cluster:      "cluster1",
+				Replicas:     1,
+				VnetSubnetID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-resource-group/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-vnet-subnet",
+				PodSubnetID:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-resource-group/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-pod-subnet",
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -934,6 +971,12 @@ func getAzureMachinePoolWithSubnetName(name string, subnetName *string) *infrav1
 	return managedPool
 }
 
+func getAzureMachinePoolWithPodSubnetName(name string, podSubnetName *string) *infrav1.AzureManagedMachinePool {
+	managedPool := getAzureMachinePool(name, infrav1.NodePoolModeUser)
+	managedPool.Spec.PodSubnetName = podSubnetName
+	return managedPool
+}
+
 func getAzureMachinePoolWithOsDiskType(name string, osDiskType string) *infrav1.AzureManagedMachinePool {
 	managedPool := getAzureMachinePool(name, infrav1.NodePoolModeUser)
 	managedPool.Spec.OsDiskType = ptr.To(osDiskType)
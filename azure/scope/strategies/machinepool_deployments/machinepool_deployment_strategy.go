@@ -182,6 +182,9 @@ func (rollingUpdateStrategy rollingUpdateStrategy) SelectMachinesToDelete(ctx co
 	// we have too many machines, let's choose the oldest to remove
 	if overProvisionCount > 0 {
 		var toDelete []infrav1exp.AzureMachinePoolMachine
+		// machines annotated with ProtectMachineAnnotation are never selected to scale down
+		machinesWithoutLatestModel = withoutProtectedMachines(machinesWithoutLatestModel)
+		readyMachines = withoutProtectedMachines(readyMachines)
 		log.Info("over-provisioned", "desiredReplicaCount", desiredReplicaCount, "overProvisionCount", overProvisionCount, "machinesWithoutLatestModel", getProviderIDs(machinesWithoutLatestModel))
 		// we are over-provisioned try to remove old models
 		for _, v := range machinesWithoutLatestModel {
@@ -335,6 +338,19 @@ func orderByDeleteMachineAnnotation(machines []infrav1exp.AzureMachinePoolMachin
 	return machines
 }
 
+// withoutProtectedMachines returns the AzureMachinePoolMachines that are not annotated with the
+// infrav1exp.ProtectMachineAnnotation, preserving their order.
+func withoutProtectedMachines(machines []infrav1exp.AzureMachinePoolMachine) []infrav1exp.AzureMachinePoolMachine {
+	var unprotected []infrav1exp.AzureMachinePoolMachine
+	for _, v := range machines {
+		if _, isProtected := v.Annotations[infrav1exp.ProtectMachineAnnotation]; !isProtected {
+			unprotected = append(unprotected, v)
+		}
+	}
+
+	return unprotected
+}
+
 func getProviderIDs(machines []infrav1exp.AzureMachinePoolMachine) []string {
 	ids := make([]string, len(machines))
 	for i, machine := range machines {
@@ -253,6 +253,29 @@ func TestMachinePoolRollingUpdateStrategy_SelectMachinesToDelete(t *testing.T) {
 				makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, CreationTime: metav1.NewTime(baseTime.Add(3 * time.Hour)), HasDeleteMachineAnnotation: true}),
 			}),
 		},
+		{
+			name:            "if over-provisioned, do not select machines with the protect annotation",
+			strategy:        makeRollingUpdateStrategy(infrav1exp.MachineRollingUpdateDeployment{DeletePolicy: infrav1exp.OldestDeletePolicyType}),
+			desiredReplicas: 2,
+			input: map[string]infrav1exp.AzureMachinePoolMachine{
+				"foo": makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, CreationTime: metav1.NewTime(baseTime.Add(3 * time.Hour))}),
+				"bin": makeAMPM(ampmOptions{Ready: true, LatestModel: false, ProvisioningState: succeeded, CreationTime: metav1.NewTime(baseTime.Add(2 * time.Hour)), HasProtectAnnotation: true}),
+				"baz": makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, CreationTime: metav1.NewTime(baseTime.Add(1 * time.Hour)), HasProtectAnnotation: true}),
+			},
+			want: gomega.DiffEq([]infrav1exp.AzureMachinePoolMachine{
+				makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, CreationTime: metav1.NewTime(baseTime.Add(3 * time.Hour))}),
+			}),
+		},
+		{
+			name:            "if over-provisioned and all machines are protected, delete nothing",
+			strategy:        makeRollingUpdateStrategy(infrav1exp.MachineRollingUpdateDeployment{DeletePolicy: infrav1exp.OldestDeletePolicyType}),
+			desiredReplicas: 1,
+			input: map[string]infrav1exp.AzureMachinePoolMachine{
+				"foo": makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, HasProtectAnnotation: true}),
+				"bin": makeAMPM(ampmOptions{Ready: true, LatestModel: true, ProvisioningState: succeeded, HasProtectAnnotation: true}),
+			},
+			want: BeEmpty(),
+		},
 		{
 			name:            "if over-provisioned, select machines ordered by creation date",
 			strategy:        makeRollingUpdateStrategy(infrav1exp.MachineRollingUpdateDeployment{DeletePolicy: infrav1exp.OldestDeletePolicyType}),
@@ -425,6 +448,7 @@ type ampmOptions struct {
 	CreationTime               metav1.Time
 	DeletionTime               *metav1.Time
 	HasDeleteMachineAnnotation bool
+	HasProtectAnnotation       bool
 }
 
 func makeAMPM(opts ampmOptions) infrav1exp.AzureMachinePoolMachine {
@@ -445,5 +469,9 @@ func makeAMPM(opts ampmOptions) infrav1exp.AzureMachinePoolMachine {
 		ampm.Annotations[clusterv1.DeleteMachineAnnotation] = "true"
 	}
 
+	if opts.HasProtectAnnotation {
+		ampm.Annotations[infrav1exp.ProtectMachineAnnotation] = "true"
+	}
+
 	return ampm
 }
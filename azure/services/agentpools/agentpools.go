@@ -28,10 +28,15 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
 const serviceName = "agentpools"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupManagedCluster)
+}
+
 // AgentPoolScope defines the scope interface for an agent pool.
 type AgentPoolScope interface {
 	aso.Scope
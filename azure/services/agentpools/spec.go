@@ -90,6 +90,9 @@ type AgentPoolSpec struct {
 	// VnetSubnetID is the Azure Resource ID for the subnet which should contain nodes.
 	VnetSubnetID string
 
+	// PodSubnetID is the Azure Resource ID for the subnet from which pod IPs are dynamically allocated.
+	PodSubnetID string
+
 	// Mode represents mode of an agent pool. Possible values include: 'System', 'User'.
 	Mode string
 
@@ -105,6 +108,12 @@ type AgentPoolSpec struct {
 	// NodeTaints specifies the taints for nodes present in this agent pool.
 	NodeTaints []string `json:"nodeTaints,omitempty"`
 
+	// NodeInitializationTaints specifies the taints added to new nodes of the agent pool. It is only applied with the preview API version.
+	NodeInitializationTaints []string `json:"nodeInitializationTaints,omitempty"`
+
+	// EnableArtifactStreaming indicates whether artifact streaming is enabled on the nodes of the agent pool. It is only applied with the preview API version.
+	EnableArtifactStreaming *bool `json:"enableArtifactStreaming,omitempty"`
+
 	// EnableAutoScaling - Whether to enable auto-scaler
 	EnableAutoScaling bool `json:"enableAutoScaling,omitempty"`
 
@@ -123,6 +132,9 @@ type AgentPoolSpec struct {
 	// OSType specifies the operating system for the node pool. Allowed values are 'Linux' and 'Windows'
 	OSType *string `json:"osType,omitempty"`
 
+	// OSSKU specifies the OS SKU used by the agent pool. Allowed values are 'Ubuntu', 'AzureLinux', 'Windows2019' and 'Windows2022'
+	OSSKU *infrav1.OSSKU `json:"osSKU,omitempty"`
+
 	// EnableNodePublicIP controls whether or not nodes in the agent pool each have a public IP address.
 	EnableNodePublicIP *bool `json:"enableNodePublicIP,omitempty"`
 
@@ -156,6 +168,13 @@ type AgentPoolSpec struct {
 	// EnableEncryptionAtHost indicates whether host encryption is enabled on the node pool
 	EnableEncryptionAtHost *bool
 
+	// GPUInstanceProfile specifies the Multi-Instance GPU profile used to partition the GPUs of the nodes
+	GPUInstanceProfile *infrav1.GPUInstanceProfile
+
+	// UpgradeSettings specifies the surge, drain timeout and node soak duration used to upgrade the agent pool.
+	// The node soak duration is only applied with the preview API version.
+	UpgradeSettings *infrav1.AgentPoolUpgradeSettings
+
 	// Patches are extra patches to be applied to the ASO resource.
 	Patches []string
 
@@ -232,6 +251,7 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 	agentPool.Spec.OsDiskSizeGB = ptr.To(int(asocontainerservicev1.ContainerServiceOSDisk(s.OSDiskSizeGB)))
 	agentPool.Spec.OsDiskType = azure.AliasOrNil[string](s.OsDiskType)
 	agentPool.Spec.OsType = azure.AliasOrNil[string](s.OSType)
+	agentPool.Spec.OsSKU = azure.AliasOrNil[string]((*string)(s.OSSKU))
 	agentPool.Spec.ScaleSetPriority = azure.AliasOrNil[string](s.ScaleSetPriority)
 	agentPool.Spec.ScaleDownMode = azure.AliasOrNil[string](s.ScaleDownMode)
 	agentPool.Spec.Type = ptr.To(string(asocontainerservicev1.AgentPoolType_VirtualMachineScaleSets))
@@ -239,6 +259,7 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 	agentPool.Spec.Tags = s.AdditionalTags
 	agentPool.Spec.EnableFIPS = s.EnableFIPS
 	agentPool.Spec.EnableEncryptionAtHost = s.EnableEncryptionAtHost
+	agentPool.Spec.GpuInstanceProfile = azure.AliasOrNil[string]((*string)(s.GPUInstanceProfile))
 	if kubernetesVersion := s.getManagedMachinePoolVersion(existing); kubernetesVersion != nil {
 		agentPool.Spec.OrchestratorVersion = kubernetesVersion
 	}
@@ -259,6 +280,13 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 		}
 	}
 
+	if s.UpgradeSettings != nil {
+		agentPool.Spec.UpgradeSettings = &asocontainerservicev1hub.AgentPoolUpgradeSettings{
+			MaxSurge:              s.UpgradeSettings.MaxSurge,
+			DrainTimeoutInMinutes: s.UpgradeSettings.DrainTimeoutInMinutes,
+		}
+	}
+
 	if s.SKU != "" {
 		agentPool.Spec.VmSize = &s.SKU
 	}
@@ -273,6 +301,12 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 		}
 	}
 
+	if s.PodSubnetID != "" {
+		agentPool.Spec.PodSubnetReference = &genruntime.ResourceReference{
+			ARMID: s.PodSubnetID,
+		}
+	}
+
 	if s.NodePublicIPPrefixID != "" {
 		agentPool.Spec.NodePublicIPPrefixReference = &genruntime.ResourceReference{
 			ARMID: s.NodePublicIPPrefixID,
@@ -331,6 +365,18 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 		if err := prev.ConvertFrom(agentPool); err != nil {
 			return nil, err
 		}
+		prev.Spec.NodeInitializationTaints = s.NodeInitializationTaints
+		if s.EnableArtifactStreaming != nil {
+			prev.Spec.ArtifactStreamingProfile = &asocontainerservicev1preview.AgentPoolArtifactStreamingProfile{
+				Enabled: s.EnableArtifactStreaming,
+			}
+		}
+		if s.UpgradeSettings != nil && s.UpgradeSettings.NodeSoakDurationInMinutes != nil {
+			if prev.Spec.UpgradeSettings == nil {
+				prev.Spec.UpgradeSettings = &asocontainerservicev1preview.AgentPoolUpgradeSettings{}
+			}
+			prev.Spec.UpgradeSettings.NodeSoakDurationInMinutes = s.UpgradeSettings.NodeSoakDurationInMinutes
+		}
 		return prev, nil
 	}
 
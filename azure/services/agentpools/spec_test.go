@@ -45,6 +45,7 @@ func TestParameters(t *testing.T) {
 			Replicas:             1,
 			OSDiskSizeGB:         2,
 			VnetSubnetID:         "vnet subnet id",
+			PodSubnetID:          "pod subnet id",
 			Mode:                 "mode",
 			MaxCount:             ptr.To(3),
 			MinCount:             ptr.To(4),
@@ -56,6 +57,7 @@ func TestParameters(t *testing.T) {
 			OsDiskType:           ptr.To("disk type"),
 			EnableUltraSSD:       ptr.To(false),
 			OSType:               ptr.To("os type"),
+			OSSKU:                ptr.To(infrav1.OSSKUAzureLinux),
 			EnableNodePublicIP:   ptr.To(true),
 			NodePublicIPPrefixID: "public IP prefix ID",
 			ScaleSetPriority:     ptr.To("scaleset priority"),
@@ -73,6 +75,12 @@ func TestParameters(t *testing.T) {
 			},
 			EnableFIPS:             ptr.To(true),
 			EnableEncryptionAtHost: ptr.To(false),
+			GPUInstanceProfile:     ptr.To(infrav1.GPUInstanceProfileMIG1g),
+			UpgradeSettings: &infrav1.AgentPoolUpgradeSettings{
+				MaxSurge:                  ptr.To("33%"),
+				DrainTimeoutInMinutes:     ptr.To(45),
+				NodeSoakDurationInMinutes: ptr.To(5),
+			},
 		}
 		expected := &asocontainerservicev1.ManagedClustersAgentPool{
 			Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
@@ -85,6 +93,7 @@ func TestParameters(t *testing.T) {
 				EnableAutoScaling:      ptr.To(true),
 				EnableUltraSSD:         ptr.To(false),
 				EnableEncryptionAtHost: ptr.To(false),
+				GpuInstanceProfile:     ptr.To(asocontainerservicev1.GPUInstanceProfile("MIG1g")),
 				KubeletDiskType:        ptr.To(asocontainerservicev1.KubeletDiskType("kubelet disk type")),
 				MaxCount:               ptr.To(3),
 				MaxPods:                ptr.To(5),
@@ -96,6 +105,7 @@ func TestParameters(t *testing.T) {
 				OsDiskSizeGB:           ptr.To(asocontainerservicev1.ContainerServiceOSDisk(2)),
 				OsDiskType:             ptr.To(asocontainerservicev1.OSDiskType("disk type")),
 				OsType:                 ptr.To(asocontainerservicev1.OSType("os type")),
+				OsSKU:                  ptr.To(asocontainerservicev1.OSSKU_AzureLinux),
 				ScaleSetPriority:       ptr.To(asocontainerservicev1.ScaleSetPriority("scaleset priority")),
 				ScaleDownMode:          ptr.To(asocontainerservicev1.ScaleDownMode("scale down mode")),
 				Type:                   ptr.To(asocontainerservicev1.AgentPoolType_VirtualMachineScaleSets),
@@ -105,11 +115,18 @@ func TestParameters(t *testing.T) {
 				KubeletConfig: &asocontainerservicev1.KubeletConfig{
 					CpuManagerPolicy: ptr.To("cpu manager policy"),
 				},
+				UpgradeSettings: &asocontainerservicev1.AgentPoolUpgradeSettings{
+					MaxSurge:              ptr.To("33%"),
+					DrainTimeoutInMinutes: ptr.To(45),
+				},
 				VmSize:       ptr.To("sku"),
 				SpotMaxPrice: ptr.To(ptr.To(resource.MustParse("123")).AsApproximateFloat64()),
 				VnetSubnetReference: &genruntime.ResourceReference{
 					ARMID: "vnet subnet id",
 				},
+				PodSubnetReference: &genruntime.ResourceReference{
+					ARMID: "pod subnet id",
+				},
 				NodePublicIPPrefixReference: &genruntime.ResourceReference{
 					ARMID: "public IP prefix ID",
 				},
@@ -131,32 +148,35 @@ func TestParameters(t *testing.T) {
 		g := NewGomegaWithT(t)
 
 		spec := &AgentPoolSpec{
-			Preview:              true,
-			Name:                 "name",
-			AzureName:            "azure name",
-			ResourceGroup:        "rg",
-			Cluster:              "cluster",
-			Version:              ptr.To("1.26.6"),
-			SKU:                  "sku",
-			Replicas:             1,
-			OSDiskSizeGB:         2,
-			VnetSubnetID:         "vnet subnet id",
-			Mode:                 "mode",
-			MaxCount:             ptr.To(3),
-			MinCount:             ptr.To(4),
-			NodeLabels:           map[string]string{"node": "labels"},
-			NodeTaints:           []string{"node taints"},
-			EnableAutoScaling:    true,
-			AvailabilityZones:    []string{"zones"},
-			MaxPods:              ptr.To(5),
-			OsDiskType:           ptr.To("disk type"),
-			EnableUltraSSD:       ptr.To(false),
-			OSType:               ptr.To("os type"),
-			EnableNodePublicIP:   ptr.To(true),
-			NodePublicIPPrefixID: "public IP prefix ID",
-			ScaleSetPriority:     ptr.To("scaleset priority"),
-			ScaleDownMode:        ptr.To("scale down mode"),
-			SpotMaxPrice:         ptr.To(resource.MustParse("123")),
+			Preview:                  true,
+			Name:                     "name",
+			AzureName:                "azure name",
+			ResourceGroup:            "rg",
+			Cluster:                  "cluster",
+			Version:                  ptr.To("1.26.6"),
+			SKU:                      "sku",
+			Replicas:                 1,
+			OSDiskSizeGB:             2,
+			VnetSubnetID:             "vnet subnet id",
+			Mode:                     "mode",
+			MaxCount:                 ptr.To(3),
+			MinCount:                 ptr.To(4),
+			NodeLabels:               map[string]string{"node": "labels"},
+			NodeTaints:               []string{"node taints"},
+			NodeInitializationTaints: []string{"node.example.com/init=true:NoSchedule"},
+			EnableArtifactStreaming:  ptr.To(true),
+			EnableAutoScaling:        true,
+			AvailabilityZones:        []string{"zones"},
+			MaxPods:                  ptr.To(5),
+			OsDiskType:               ptr.To("disk type"),
+			EnableUltraSSD:           ptr.To(false),
+			OSType:                   ptr.To("os type"),
+			OSSKU:                    ptr.To(infrav1.OSSKUAzureLinux),
+			EnableNodePublicIP:       ptr.To(true),
+			NodePublicIPPrefixID:     "public IP prefix ID",
+			ScaleSetPriority:         ptr.To("scaleset priority"),
+			ScaleDownMode:            ptr.To("scale down mode"),
+			SpotMaxPrice:             ptr.To(resource.MustParse("123")),
 			KubeletConfig: &KubeletConfig{
 				CPUManagerPolicy: ptr.To("cpu manager policy"),
 			},
@@ -169,6 +189,12 @@ func TestParameters(t *testing.T) {
 			},
 			EnableFIPS:             ptr.To(true),
 			EnableEncryptionAtHost: ptr.To(false),
+			GPUInstanceProfile:     ptr.To(infrav1.GPUInstanceProfileMIG1g),
+			UpgradeSettings: &infrav1.AgentPoolUpgradeSettings{
+				MaxSurge:                  ptr.To("33%"),
+				DrainTimeoutInMinutes:     ptr.To(45),
+				NodeSoakDurationInMinutes: ptr.To(5),
+			},
 		}
 		expected := &asocontainerservicev1preview.ManagedClustersAgentPool{
 			Spec: asocontainerservicev1preview.ManagedClusters_AgentPool_Spec{
@@ -176,28 +202,31 @@ func TestParameters(t *testing.T) {
 				Owner: &genruntime.KnownResourceReference{
 					Name: "cluster",
 				},
-				AvailabilityZones:      []string{"zones"},
-				Count:                  ptr.To(1),
-				EnableAutoScaling:      ptr.To(true),
-				EnableUltraSSD:         ptr.To(false),
-				EnableEncryptionAtHost: ptr.To(false),
-				KubeletDiskType:        ptr.To(asocontainerservicev1preview.KubeletDiskType("kubelet disk type")),
-				MaxCount:               ptr.To(3),
-				MaxPods:                ptr.To(5),
-				MinCount:               ptr.To(4),
-				Mode:                   ptr.To(asocontainerservicev1preview.AgentPoolMode("mode")),
-				NodeLabels:             map[string]string{"node": "labels"},
-				NodeTaints:             []string{"node taints"},
-				OrchestratorVersion:    ptr.To("1.26.6"),
-				OsDiskSizeGB:           ptr.To(asocontainerservicev1preview.ContainerServiceOSDisk(2)),
-				OsDiskType:             ptr.To(asocontainerservicev1preview.OSDiskType("disk type")),
-				OsType:                 ptr.To(asocontainerservicev1preview.OSType("os type")),
-				ScaleSetPriority:       ptr.To(asocontainerservicev1preview.ScaleSetPriority("scaleset priority")),
-				ScaleDownMode:          ptr.To(asocontainerservicev1preview.ScaleDownMode("scale down mode")),
-				Type:                   ptr.To(asocontainerservicev1preview.AgentPoolType_VirtualMachineScaleSets),
-				EnableNodePublicIP:     ptr.To(true),
-				Tags:                   map[string]string{"additional": "tags"},
-				EnableFIPS:             ptr.To(true),
+				AvailabilityZones:        []string{"zones"},
+				Count:                    ptr.To(1),
+				EnableAutoScaling:        ptr.To(true),
+				EnableUltraSSD:           ptr.To(false),
+				EnableEncryptionAtHost:   ptr.To(false),
+				GpuInstanceProfile:       ptr.To(asocontainerservicev1preview.GPUInstanceProfile("MIG1g")),
+				KubeletDiskType:          ptr.To(asocontainerservicev1preview.KubeletDiskType("kubelet disk type")),
+				MaxCount:                 ptr.To(3),
+				MaxPods:                  ptr.To(5),
+				MinCount:                 ptr.To(4),
+				Mode:                     ptr.To(asocontainerservicev1preview.AgentPoolMode("mode")),
+				NodeLabels:               map[string]string{"node": "labels"},
+				NodeTaints:               []string{"node taints"},
+				NodeInitializationTaints: []string{"node.example.com/init=true:NoSchedule"},
+				OrchestratorVersion:      ptr.To("1.26.6"),
+				OsDiskSizeGB:             ptr.To(asocontainerservicev1preview.ContainerServiceOSDisk(2)),
+				OsDiskType:               ptr.To(asocontainerservicev1preview.OSDiskType("disk type")),
+				OsType:                   ptr.To(asocontainerservicev1preview.OSType("os type")),
+				OsSKU:                    ptr.To(asocontainerservicev1preview.OSSKU_AzureLinux),
+				ScaleSetPriority:         ptr.To(asocontainerservicev1preview.ScaleSetPriority("scaleset priority")),
+				ScaleDownMode:            ptr.To(asocontainerservicev1preview.ScaleDownMode("scale down mode")),
+				Type:                     ptr.To(asocontainerservicev1preview.AgentPoolType_VirtualMachineScaleSets),
+				EnableNodePublicIP:       ptr.To(true),
+				Tags:                     map[string]string{"additional": "tags"},
+				EnableFIPS:               ptr.To(true),
 				KubeletConfig: &asocontainerservicev1preview.KubeletConfig{
 					CpuManagerPolicy: ptr.To("cpu manager policy"),
 				},
@@ -214,6 +243,14 @@ func TestParameters(t *testing.T) {
 						FsNrOpen: ptr.To(6),
 					},
 				},
+				ArtifactStreamingProfile: &asocontainerservicev1preview.AgentPoolArtifactStreamingProfile{
+					Enabled: ptr.To(true),
+				},
+				UpgradeSettings: &asocontainerservicev1preview.AgentPoolUpgradeSettings{
+					MaxSurge:                  ptr.To("33%"),
+					DrainTimeoutInMinutes:     ptr.To(45),
+					NodeSoakDurationInMinutes: ptr.To(5),
+				},
 			},
 		}
 
@@ -25,11 +25,16 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
 )
 
 const serviceName = "extension"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupManagedCluster)
+}
+
 // AKSExtensionScope defines the scope interface for an AKS extensions service.
 type AKSExtensionScope interface {
 	azure.ClusterScoper
@@ -34,12 +34,14 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	"sigs.k8s.io/cluster-api-provider-azure/util/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
@@ -49,12 +51,22 @@ const (
 	// asoannotations.ReconcilePolicy that was set before pausing.
 	prePauseReconcilePolicyAnnotation = "sigs.k8s.io/cluster-api-provider-azure-pre-pause-reconcile-policy"
 
-	requeueInterval = 20 * time.Second
+	defaultRequeueInterval = 20 * time.Second
+	// fastRequeueInterval is the requeue interval used when the ASOFastRequeue feature gate is enabled.
+	fastRequeueInterval = 2 * time.Second
 
 	createOrUpdateFutureType = "ASOCreateOrUpdate"
 	deleteFutureType         = "ASODelete"
 )
 
+// requeueInterval returns how long to wait before checking again whether an ASO resource is ready or deleted.
+func requeueInterval() time.Duration {
+	if feature.Gates.Enabled(feature.ASOFastRequeue) {
+		return fastRequeueInterval
+	}
+	return defaultRequeueInterval
+}
+
 // reconciler is an implementation of the Reconciler interface. It handles creation
 // and deletion of resources using ASO.
 type reconciler[T genruntime.MetaObject] struct {
@@ -62,6 +74,10 @@ type reconciler[T genruntime.MetaObject] struct {
 
 	clusterName string
 	owner       client.Object
+	// dryRun makes the reconciler log the changes it would make to ASO resources instead of making them.
+	dryRun bool
+	// resourceEventRecorder, when set, records an Event for each ASO resource created, updated, or deleted.
+	resourceEventRecorder azure.ResourceEventRecorder
 }
 
 // New creates a new ASO reconciler.
@@ -73,6 +89,17 @@ func New[T genruntime.MetaObject](ctrlClient client.Client, clusterName string,
 	}
 }
 
+// NewDryRun creates a new ASO reconciler that logs the resources it would create, update, or delete
+// without changing them.
+func NewDryRun[T genruntime.MetaObject](ctrlClient client.Client, clusterName string, owner client.Object) Reconciler[T] {
+	return &reconciler[T]{
+		Client:      ctrlClient,
+		clusterName: clusterName,
+		owner:       owner,
+		dryRun:      true,
+	}
+}
+
 // CreateOrUpdateResource implements the logic for creating a new or updating an
 // existing resource with ASO.
 func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.ASOResourceSpecGetter[T], serviceName string) (T, error) {
@@ -112,7 +139,7 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 		conds := existing.GetConditions()
 		i, readyExists := conds.FindIndexByType(conditions.ConditionTypeReady)
 		if !readyExists {
-			return zero, azure.WithTransientError(errors.New("ready status unknown"), requeueInterval)
+			return zero, azure.WithTransientError(errors.New("ready status unknown"), requeueInterval())
 		}
 		if cond := conds[i]; cond.Status != metav1.ConditionTrue {
 			switch {
@@ -143,7 +170,7 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 				if conds[i].Severity == conditions.ConditionSeverityError {
 					readyErr = azure.WithTerminalError(readyErr)
 				} else {
-					readyErr = azure.WithTransientError(readyErr, requeueInterval)
+					readyErr = azure.WithTransientError(readyErr, requeueInterval())
 				}
 			}
 		}
@@ -226,6 +253,18 @@ func (r *reconciler[T]) CreateOrUpdateResource(ctx context.Context, spec azure.A
 		log.V(2).Info("resource up to date")
 		return existing, nil
 	}
+	if r.dryRun {
+		// Report the change instead of making it. The existing resource, if any, lets dependent
+		// services keep computing their desired state.
+		if resourceExists {
+			log.Info("dry-run: skipping update of resource", "diff", diff)
+			r.recordDryRunSkip(fmt.Sprintf("dry-run: skipped update of resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName))
+			return existing, readyErr
+		}
+		log.Info("dry-run: skipping creation of resource", "diff", diff)
+		r.recordDryRunSkip(fmt.Sprintf("dry-run: skipped creation of resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName))
+		return zero, azure.WithTransientError(errors.Errorf("dry-run: resource %s/%s would be created (service: %s)", resourceNamespace, resourceName, serviceName), requeueInterval())
+	}
 	log.V(2).Info("creating or updating resource", "diff", diff)
 	return r.createOrUpdateResource(ctx, existing, parameters, resourceExists, serviceName)
 }
@@ -287,21 +326,24 @@ func applyPatches[T genruntime.MetaObject](scheme *runtime.Scheme, spec azure.AS
 func (r *reconciler[T]) createOrUpdateResource(ctx context.Context, existing T, parameters client.Object, resourceExists bool, serviceName string) (T, error) {
 	var zero T
 	var err error
-	var logMessageVerbPrefix string
+	var logMessageVerbPrefix, eventReason string
 	if resourceExists {
 		logMessageVerbPrefix = "updat"
+		eventReason = azure.ResourceUpdatedReason
 		err = r.Client.Patch(ctx, parameters, client.MergeFrom(existing))
 	} else {
 		logMessageVerbPrefix = "creat"
+		eventReason = azure.ResourceCreatedReason
 		err = r.Client.Create(ctx, parameters)
 	}
 	if err == nil {
+		r.recordResourceEvent(eventReason, parameters, serviceName)
 		// Resources need to be requeued to wait for the create or update to finish.
 		return zero, azure.WithTransientError(azure.NewOperationNotDoneError(&infrav1.Future{
 			Type:          createOrUpdateFutureType,
 			ResourceGroup: parameters.GetNamespace(),
 			Name:          parameters.GetName(),
-		}), requeueInterval)
+		}), requeueInterval())
 	}
 	return zero, errors.Wrapf(err, "failed to %se resource %s/%s (service: %s)", logMessageVerbPrefix, parameters.GetNamespace(), parameters.GetName(), serviceName)
 }
@@ -331,6 +373,27 @@ func (r *reconciler[T]) DeleteResource(ctx context.Context, resource T, serviceN
 		return nil
 	}
 
+	if r.dryRun {
+		// Keep returning an error so that the owner of the resource isn't considered deleted.
+		log.Info("dry-run: skipping deletion of resource")
+		r.recordDryRunSkip(fmt.Sprintf("dry-run: skipped deletion of resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName))
+		return azure.WithTransientError(errors.Errorf("dry-run: resource %s/%s would be deleted (service: %s)", resourceNamespace, resourceName, serviceName), requeueInterval())
+	}
+
+	if prevReconcilePolicy, ok := resource.GetAnnotations()[prePauseReconcilePolicyAnnotation]; ok {
+		// ASO deletes the resource in Azure only if its reconcile policy allows it, so a resource paused by CAPZ
+		// must get back the reconcile policy it had before being paused, or it would be left behind in Azure.
+		log.V(4).Info("restoring reconcile policy of paused resource before deleting it")
+		before := resource.DeepCopyObject().(client.Object)
+		annotations := resource.GetAnnotations()
+		annotations[asoannotations.ReconcilePolicy] = prevReconcilePolicy
+		delete(annotations, prePauseReconcilePolicyAnnotation)
+		resource.SetAnnotations(annotations)
+		if err := r.Client.Patch(ctx, resource, client.MergeFrom(before)); err != nil {
+			return errors.Wrapf(err, "failed to restore reconcile policy of resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName)
+		}
+	}
+
 	log.V(2).Info("deleting resource")
 	err = r.Client.Delete(ctx, resource)
 	if err != nil {
@@ -341,12 +404,13 @@ func (r *reconciler[T]) DeleteResource(ctx context.Context, resource T, serviceN
 		}
 		return errors.Wrapf(err, "failed to delete resource %s/%s (service: %s)", resourceNamespace, resourceName, serviceName)
 	}
+	r.recordResourceEvent(azure.ResourceDeletedReason, resource, serviceName)
 
 	return azure.WithTransientError(azure.NewOperationNotDoneError(&infrav1.Future{
 		Type:          deleteFutureType,
 		ResourceGroup: resourceNamespace,
 		Name:          resourceName,
-	}), requeueInterval)
+	}), requeueInterval())
 }
 
 // IsManaged returns whether the ASO resource referred to by spec was created by
@@ -419,3 +483,20 @@ func (r *reconciler[T]) PauseResource(ctx context.Context, resource T, serviceNa
 
 	return r.Client.Patch(ctx, resource, client.MergeFrom(before))
 }
+
+// recordDryRunSkip records an Event on the owner of the reconciled resources for an operation skipped in dry-run mode.
+func (r *reconciler[T]) recordDryRunSkip(message string) {
+	if r.owner != nil {
+		record.Event(r.owner, azure.DryRunSkippedReason, message)
+	}
+}
+
+// recordResourceEvent records an Event for an ASO resource created, updated, or deleted by the reconciler. The
+// resource is named by its Azure resource ID once ASO has set it, and by the ASO resource until then.
+func (r *reconciler[T]) recordResourceEvent(reason string, resource client.Object, serviceName string) {
+	id, ok := resource.GetAnnotations()[genruntime.ResourceIDAnnotation]
+	if !ok || id == "" {
+		id = resource.GetNamespace() + "/" + resource.GetName()
+	}
+	azure.RecordResourceEvent(r.resourceEventRecorder, reason, id, serviceName)
+}
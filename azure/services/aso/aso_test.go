@@ -20,15 +20,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +43,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso/mock_aso"
+	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
 )
 
@@ -183,6 +188,41 @@ func TestCreateOrUpdateResource(t *testing.T) {
 		}))
 	})
 
+	t.Run("dry-run does not create resource that doesn't already exist", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		s := NewDryRun[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Nil()).Return(&asoresourcesv1.ResourceGroup{
+			Spec: asoresourcesv1.ResourceGroup_Spec{
+				Location: ptr.To("location"),
+			},
+		}, nil)
+
+		ctx := context.Background()
+		result, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(result).To(BeNil())
+		g.Expect(err).To(MatchError(ContainSubstring("dry-run: resource namespace/name would be created")))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTransient()).To(BeTrue())
+
+		err = c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, &asoresourcesv1.ResourceGroup{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
 	t.Run("resource is not ready in non-terminal state", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -1214,6 +1254,190 @@ func TestDeleteResource(t *testing.T) {
 		g.Expect(err).To(HaveOccurred())
 		g.Expect(err.Error()).To(ContainSubstring("failed to delete resource"))
 	})
+	t.Run("paused resource is deleted with its previous reconcile policy", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		s := New[*asoresourcesv1.ResourceGroup](ErroringDeleteClient{Client: c, err: errors.New("an error")}, clusterName, newOwner())
+
+		resource := &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+				Annotations: map[string]string{
+					asoannotations.ReconcilePolicy:    string(asoannotations.ReconcilePolicySkip),
+					prePauseReconcilePolicyAnnotation: string(asoannotations.ReconcilePolicyManage),
+				},
+			},
+		}
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, resource)).To(Succeed())
+
+		err := s.DeleteResource(ctx, resource, "service")
+		g.Expect(err).To(MatchError(ContainSubstring("failed to delete resource")))
+
+		updated := &asoresourcesv1.ResourceGroup{}
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, updated)).To(Succeed())
+		g.Expect(updated.Annotations).To(HaveKeyWithValue(asoannotations.ReconcilePolicy, string(asoannotations.ReconcilePolicyManage)))
+		g.Expect(updated.Annotations).NotTo(HaveKey(prePauseReconcilePolicyAnnotation))
+	})
+
+	t.Run("dry-run does not delete resource", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		s := NewDryRun[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+
+		ctx := context.Background()
+		resource := &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+			},
+		}
+		g.Expect(c.Create(ctx, resource)).To(Succeed())
+
+		err := s.DeleteResource(ctx, resource, "service")
+		g.Expect(err).To(MatchError(ContainSubstring("dry-run: resource namespace/name would be deleted")))
+		var recerr azure.ReconcileError
+		g.Expect(errors.As(err, &recerr)).To(BeTrue())
+		g.Expect(recerr.IsTransient()).To(BeTrue())
+
+		g.Expect(c.Get(ctx, types.NamespacedName{Name: "name", Namespace: "namespace"}, &asoresourcesv1.ResourceGroup{})).To(Succeed())
+	})
+}
+
+func TestResourceEvents(t *testing.T) {
+	const resourceID = "/subscriptions/123/resourceGroups/name"
+
+	t.Run("create records the ASO resource", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		recorder := &eventRecorder{}
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+		s.(*reconciler[*asoresourcesv1.ResourceGroup]).resourceEventRecorder = recorder
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Nil()).Return(&asoresourcesv1.ResourceGroup{}, nil)
+
+		_, err := s.CreateOrUpdateResource(context.Background(), specMock, "service")
+		g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+		g.Expect(recorder.events).To(Equal([]string{"AzureResourceCreated: resource namespace/name (service: service)"}))
+	})
+
+	t.Run("update records the Azure resource ID", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		recorder := &eventRecorder{}
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+		s.(*reconciler[*asoresourcesv1.ResourceGroup]).resourceEventRecorder = recorder
+
+		mockCtrl := gomock.NewController(t)
+		specMock := mock_azure.NewMockASOResourceSpecGetter[*asoresourcesv1.ResourceGroup](mockCtrl)
+		specMock.EXPECT().ResourceRef().Return(&asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "name",
+			},
+		})
+		specMock.EXPECT().Parameters(gomockinternal.AContext(), gomock.Not(gomock.Nil())).DoAndReturn(func(_ context.Context, group *asoresourcesv1.ResourceGroup) (*asoresourcesv1.ResourceGroup, error) {
+			group.Spec.Location = ptr.To("location")
+			return group, nil
+		})
+		specMock.EXPECT().WasManaged(gomock.Any()).Return(false)
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+				Annotations: map[string]string{
+					genruntime.ResourceIDAnnotation: resourceID,
+				},
+			},
+			Status: asoresourcesv1.ResourceGroup_STATUS{
+				Conditions: []conditions.Condition{
+					{
+						Type:   conditions.ConditionTypeReady,
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		})).To(Succeed())
+
+		_, err := s.CreateOrUpdateResource(ctx, specMock, "service")
+		g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+		g.Expect(recorder.events).To(Equal([]string{"AzureResourceUpdated: resource " + resourceID + " (service: service)"}))
+	})
+
+	t.Run("delete records the Azure resource ID", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		sch := runtime.NewScheme()
+		g.Expect(asoresourcesv1.AddToScheme(sch)).To(Succeed())
+		c := fakeclient.NewClientBuilder().
+			WithScheme(sch).
+			Build()
+		recorder := &eventRecorder{}
+		s := New[*asoresourcesv1.ResourceGroup](c, clusterName, newOwner())
+		s.(*reconciler[*asoresourcesv1.ResourceGroup]).resourceEventRecorder = recorder
+
+		ctx := context.Background()
+		g.Expect(c.Create(ctx, &asoresourcesv1.ResourceGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "name",
+				Namespace:       "namespace",
+				OwnerReferences: ownerRefs(),
+				Annotations: map[string]string{
+					genruntime.ResourceIDAnnotation: resourceID,
+				},
+			},
+		})).To(Succeed())
+
+		err := s.DeleteResource(ctx, &asoresourcesv1.ResourceGroup{ObjectMeta: metav1.ObjectMeta{Name: "name"}}, "service")
+		g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+		g.Expect(recorder.events).To(Equal([]string{"AzureResourceDeleted: resource " + resourceID + " (service: service)"}))
+	})
+}
+
+// eventRecorder implements azure.ResourceEventRecorder.
+type eventRecorder struct {
+	events []string
+}
+
+func (*eventRecorder) RecordsResourceEvents() bool {
+	return true
+}
+
+func (r *eventRecorder) RecordResourceEvent(reason, message string) {
+	r.events = append(r.events, reason+": "+message)
 }
 
 func TestPauseResource(t *testing.T) {
@@ -1416,3 +1640,31 @@ func TestPauseResource(t *testing.T) {
 		})
 	}
 }
+
+func TestRequeueInterval(t *testing.T) {
+	tests := []struct {
+		name           string
+		fastRequeue    bool
+		expectInterval time.Duration
+	}{
+		{
+			name:           "default requeue interval",
+			fastRequeue:    false,
+			expectInterval: 20 * time.Second,
+		},
+		{
+			name:           "fast requeue interval with the ASOFastRequeue feature gate",
+			fastRequeue:    true,
+			expectInterval: 2 * time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			defer utilfeature.SetFeatureGateDuringTest(t, feature.Gates, feature.ASOFastRequeue, tc.fastRequeue)()
+
+			g.Expect(requeueInterval()).To(Equal(tc.expectInterval))
+		})
+	}
+}
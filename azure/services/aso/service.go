@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	reconcilerutils "sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -39,6 +40,12 @@ type Service[T genruntime.MetaObject, S Scope] struct {
 	// may be skipped for a service by leaving this field nil.
 	ListFunc func(ctx context.Context, client client.Client, opts ...client.ListOption) (resources []T, err error)
 
+	// DeleteInOrder makes Delete wait for each spec to be deleted before deleting the next one. It should be set
+	// when a spec may still be in use by resources belonging to the specs before it, which is currently only the
+	// case for resource groups. Ordering between resource types, e.g. subnets before their security groups and
+	// route tables, comes from the order of the services in the reconciler, which are deleted in reverse.
+	DeleteInOrder bool
+
 	ConditionType                  clusterv1.ConditionType
 	PostCreateOrUpdateResourceHook func(ctx context.Context, scope S, result T, err error) error
 	PostReconcileHook              func(ctx context.Context, scope S, err error) error
@@ -49,8 +56,16 @@ type Service[T genruntime.MetaObject, S Scope] struct {
 
 // NewService creates a new Service.
 func NewService[T genruntime.MetaObject, S Scope](name string, scope S) *Service[T, S] {
+	newReconciler := New[T]
+	if dryRunner, ok := any(scope).(azure.DryRunner); ok && dryRunner.IsDryRun() {
+		newReconciler = NewDryRun[T]
+	}
+	resourceReconciler := newReconciler(scope.GetClient(), scope.ClusterName(), scope.ASOOwner())
+	if recorder, ok := any(scope).(azure.ResourceEventRecorder); ok {
+		resourceReconciler.(*reconciler[T]).resourceEventRecorder = recorder
+	}
 	return &Service[T, S]{
-		Reconciler: New[T](scope.GetClient(), scope.ClusterName(), scope.ASOOwner()),
+		Reconciler: resourceReconciler,
 		Scope:      scope,
 		name:       name,
 	}
@@ -66,7 +81,7 @@ func (s *Service[T, S]) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "aso.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconcilerutils.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	// We go through the list of Specs to reconcile each one, independently of the result of the previous one.
@@ -120,14 +135,15 @@ func (s *Service[T, S]) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "aso.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconcilerutils.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	if len(s.Specs) == 0 {
 		return nil
 	}
 
-	// We go through the list of Specs to delete each one, independently of the resultErr of the previous one.
+	// We go through the list of Specs to delete each one, independently of the resultErr of the previous one,
+	// unless DeleteInOrder is set, in which case we stop at the first spec that is not yet deleted.
 	// If multiple errors occur, we return the most pressing one.
 	// Order of precedence (highest -> lowest) is:
 	//   - error that is not an operationNotDoneError (i.e. error deleting)
@@ -139,6 +155,9 @@ func (s *Service[T, S]) Delete(ctx context.Context) error {
 		if err != nil && (!azure.IsOperationNotDoneError(err) || resultErr == nil) {
 			resultErr = err
 		}
+		if err != nil && s.DeleteInOrder {
+			break
+		}
 	}
 
 	if s.PostDeleteHook != nil {
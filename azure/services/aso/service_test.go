@@ -451,6 +451,40 @@ func TestServiceDelete(t *testing.T) {
 		g.Expect(err).To(MatchError(deleteErr))
 	})
 
+	t.Run("DeleteInOrder waits for each resource to be deleted", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		mockCtrl := gomock.NewController(t)
+
+		scope := mock_aso.NewMockScope(mockCtrl)
+		specs := []azure.ASOResourceSpecGetter[*asoresourcesv1.ResourceGroup]{
+			mockSpecExpectingResourceRef(mockCtrl, &asoresourcesv1.ResourceGroup{ObjectMeta: metav1.ObjectMeta{Name: "first"}}),
+			mockSpecExpectingResourceRef(mockCtrl, &asoresourcesv1.ResourceGroup{ObjectMeta: metav1.ObjectMeta{Name: "second"}}),
+			mockSpecExpectingResourceRef(mockCtrl, &asoresourcesv1.ResourceGroup{ObjectMeta: metav1.ObjectMeta{Name: "third"}}),
+		}
+
+		deleteErr := azure.NewOperationNotDoneError(&infrav1.Future{})
+		reconciler := mock_aso.NewMockReconciler[*asoresourcesv1.ResourceGroup](mockCtrl)
+		gomock.InOrder(
+			reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[0].ResourceRef(), serviceName).Return(nil),
+			reconciler.EXPECT().DeleteResource(gomockinternal.AContext(), specs[1].ResourceRef(), serviceName).Return(deleteErr),
+		)
+		scope.EXPECT().UpdateDeleteStatus(conditionType, serviceName, deleteErr)
+		scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout)
+
+		s := &Service[*asoresourcesv1.ResourceGroup, *mock_aso.MockScope]{
+			Reconciler:    reconciler,
+			Scope:         scope,
+			Specs:         specs,
+			name:          serviceName,
+			DeleteInOrder: true,
+			ConditionType: conditionType,
+		}
+
+		err := s.Delete(context.Background())
+		g.Expect(azure.IsOperationNotDoneError(err)).To(BeTrue())
+	})
+
 	t.Run("DeleteResource returns error and runs PostDeleteHook", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -18,12 +18,15 @@ package async
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"reflect"
 	"strconv"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -76,6 +79,7 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 	// Only when no long running operation is currently in progress do we need to get the parameters.
 	// The polling implemented by the SDK does not use parameters when a resume token exists.
 	var parameters interface{}
+	eventReason := azure.ResourceCreatedOrUpdatedReason
 	if resumeToken == "" {
 		// Get the resource if it already exists, and use it to construct the desired resource parameters.
 		var existingResource interface{}
@@ -97,11 +101,25 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 			return existingResource, nil
 		}
 
+		// Report the operation instead of performing it in dry-run mode. The existing resource, if any, lets
+		// dependent services keep computing their desired state.
+		if existingResource != nil {
+			if azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped update of resource %s/%s (service: %s)", rgName, resourceName, serviceName)) {
+				log.Info("dry-run: skipping update of resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+				return existingResource, nil
+			}
+		} else if azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped creation of resource %s/%s (service: %s)", rgName, resourceName, serviceName)) {
+			log.Info("dry-run: skipping creation of resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			return nil, azure.WithTransientError(errors.Errorf("dry-run: resource %s/%s would be created (service: %s)", rgName, resourceName, serviceName), requeueTime(s.Scope))
+		}
+
 		// Create or update the resource with the desired parameters.
 		if existingResource != nil {
 			log.V(2).Info("updating resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			eventReason = azure.ResourceUpdatedReason
 		} else {
 			log.V(2).Info("creating resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+			eventReason = azure.ResourceCreatedReason
 		}
 	}
 
@@ -125,6 +143,7 @@ func (s *Service[C, D]) CreateOrUpdateResource(ctx context.Context, spec azure.R
 	}
 
 	log.V(2).Info("successfully created or updated resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+	s.recordResourceEvent(eventReason, resourceID(result), rgName, resourceName, serviceName)
 	return result, nil
 }
 
@@ -148,6 +167,20 @@ func (s *Service[C, D]) DeleteResource(ctx context.Context, spec azure.ResourceS
 		resumeToken = t
 	}
 
+	if resumeToken == "" && azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped deletion of resource %s/%s (service: %s)", rgName, resourceName, serviceName)) {
+		// Keep returning an error so that the owner of the resource isn't considered deleted.
+		log.Info("dry-run: skipping deletion of resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+		return azure.WithTransientError(errors.Errorf("dry-run: resource %s/%s would be deleted (service: %s)", rgName, resourceName, serviceName), requeueTime(s.Scope))
+	}
+
+	// Get the ID of the resource to name it in the Event recorded once it is deleted.
+	var id string
+	if azure.RecordsResourceEvents(s.Scope) && s.Creator != nil {
+		if existing, err := s.Creator.Get(ctx, spec); err == nil {
+			id = resourceID(existing)
+		}
+	}
+
 	// Delete the resource.
 	log.V(2).Info("deleting resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
 	poller, err := s.Deleter.DeleteAsync(ctx, spec, resumeToken)
@@ -169,9 +202,36 @@ func (s *Service[C, D]) DeleteResource(ctx context.Context, spec azure.ResourceS
 	}
 
 	log.V(2).Info("successfully deleted resource", "service", serviceName, "resource", resourceName, "resourceGroup", rgName)
+	if err == nil {
+		// Only record deletions performed by CAPZ, not resources found to be already gone.
+		s.recordResourceEvent(azure.ResourceDeletedReason, id, rgName, resourceName, serviceName)
+	}
 	return nil
 }
 
+// recordResourceEvent records an Event for a mutated resource if the scope records resource Events. The
+// resource is named by its ID when it is known.
+func (s *Service[C, D]) recordResourceEvent(reason, id, rgName, resourceName, serviceName string) {
+	if id == "" {
+		id = rgName + "/" + resourceName
+	}
+	azure.RecordResourceEvent(s.Scope, reason, id, serviceName)
+}
+
+// resourceID returns the ID of an Azure resource returned by the SDK, or an empty string if it has none.
+func resourceID(resource interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(resource))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	field := v.FieldByName("ID")
+	if !field.IsValid() || !field.CanInterface() {
+		return ""
+	}
+	id, _ := field.Interface().(*string)
+	return ptr.Deref(id, "")
+}
+
 // requeueTime returns the time to wait before requeuing a reconciliation.
 // It would be ideal to use the "retry-after" header from the API response, but
 // that is not readily accessible in the SDK v2 Poller framework.
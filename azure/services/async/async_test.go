@@ -32,6 +32,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -45,6 +46,8 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 	testcases := []struct {
 		name           string
 		serviceName    string
+		dryRun         bool
+		dryRunSkips    []string
 		expectedError  string
 		expectedResult interface{}
 		expect         func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder)
@@ -166,6 +169,40 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:          "dry-run: resource doesn't exist",
+			serviceName:   serviceName,
+			dryRun:        true,
+			dryRunSkips:   []string{"dry-run: skipped creation of resource mock-resourcegroup/mock-resource (service: mock-service)"},
+			expectedError: "dry-run: resource mock-resourcegroup/mock-resource would be created (service: mock-service). Object will be requeued after 15s",
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					r.Parameters(gomockinternal.AContext(), nil).Return(fakeParameters, nil),
+					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+				)
+			},
+		},
+		{
+			name:           "dry-run: resource exists",
+			serviceName:    serviceName,
+			dryRun:         true,
+			dryRunSkips:    []string{"dry-run: skipped update of resource mock-resourcegroup/mock-resource (service: mock-service)"},
+			expectedError:  "",
+			expectedResult: fakeResource,
+			expect: func(g *WithT, s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
+					r.Parameters(gomockinternal.AContext(), fakeResource).Return(fakeParameters, nil),
+				)
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -177,12 +214,14 @@ func TestServiceCreateOrUpdateResource(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_async.NewMockFutureScope(mockCtrl)
 			creatorMock := mock_async.NewMockCreator[MockCreator](mockCtrl)
-			svc := New[MockCreator, MockDeleter](scopeMock, creatorMock, nil)
+			testScope := newTestScope(scopeMock, tc.dryRun)
+			svc := New[MockCreator, MockDeleter](testScope, creatorMock, nil)
 			specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
 
 			tc.expect(g, scopeMock.EXPECT(), creatorMock.EXPECT(), specMock.EXPECT())
 
 			result, err := svc.CreateOrUpdateResource(context.TODO(), specMock, serviceName)
+			expectDryRunSkips(g, testScope, tc.dryRunSkips...)
 			if tc.expectedError != "" {
 				g.Expect(err).To(HaveOccurred())
 				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -202,6 +241,8 @@ func TestServiceDeleteResource(t *testing.T) {
 	testcases := []struct {
 		name           string
 		serviceName    string
+		dryRun         bool
+		dryRunSkips    []string
 		expectedError  string
 		expectedResult interface{}
 		expect         func(g *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder)
@@ -262,6 +303,21 @@ func TestServiceDeleteResource(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:          "dry-run",
+			serviceName:   serviceName,
+			dryRun:        true,
+			dryRunSkips:   []string{"dry-run: skipped deletion of resource mock-resourcegroup/mock-resource (service: mock-service)"},
+			expectedError: "dry-run: resource mock-resourcegroup/mock-resource would be deleted (service: mock-service). Object will be requeued after 15s",
+			expect: func(_ *GomegaWithT, s *mock_async.MockFutureScopeMockRecorder, _ *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetterMockRecorder) {
+				gomock.InOrder(
+					r.ResourceName().Return(resourceName),
+					r.ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+				)
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -273,12 +329,14 @@ func TestServiceDeleteResource(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_async.NewMockFutureScope(mockCtrl)
 			deleterMock := mock_async.NewMockDeleter[MockDeleter](mockCtrl)
-			svc := New[MockCreator, MockDeleter](scopeMock, nil, deleterMock)
+			testScope := newTestScope(scopeMock, tc.dryRun)
+			svc := New[MockCreator, MockDeleter](testScope, nil, deleterMock)
 			specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
 
 			tc.expect(g, scopeMock.EXPECT(), deleterMock.EXPECT(), specMock.EXPECT())
 
 			err := svc.DeleteResource(context.TODO(), specMock, tc.serviceName)
+			expectDryRunSkips(g, testScope, tc.dryRunSkips...)
 			if tc.expectedError != "" {
 				g.Expect(err).To(HaveOccurred())
 				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
@@ -289,6 +347,156 @@ func TestServiceDeleteResource(t *testing.T) {
 	}
 }
 
+func TestServiceRecordsResourceEvents(t *testing.T) {
+	testcases := []struct {
+		name           string
+		expectedEvents []string
+		reconcile      func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter)
+	}{
+		{
+			name:           "create",
+			expectedEvents: []string{"AzureResourceCreated: resource /subscriptions/123/resourceGroups/mock-resourcegroup/providers/Microsoft.Mock/mocks/mock-resource (service: mock-service)"},
+			reconcile: func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], _ *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter) {
+				gomock.InOrder(
+					r.EXPECT().ResourceName().Return(resourceName),
+					r.EXPECT().ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					r.EXPECT().Parameters(gomockinternal.AContext(), nil).Return(fakeParameters, nil),
+					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "", gomock.Any()).Return(fakeResourceWithID, nil, nil),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+				)
+				_, err := svc.CreateOrUpdateResource(context.TODO(), r, serviceName)
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+		},
+		{
+			name:           "update",
+			expectedEvents: []string{"AzureResourceUpdated: resource /subscriptions/123/resourceGroups/mock-resourcegroup/providers/Microsoft.Mock/mocks/mock-resource (service: mock-service)"},
+			reconcile: func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], _ *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter) {
+				gomock.InOrder(
+					r.EXPECT().ResourceName().Return(resourceName),
+					r.EXPECT().ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
+					r.EXPECT().Parameters(gomockinternal.AContext(), fakeResource).Return(fakeParameters, nil),
+					c.CreateOrUpdateAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "", gomock.Any()).Return(fakeResourceWithID, nil, nil),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture),
+				)
+				_, err := svc.CreateOrUpdateResource(context.TODO(), r, serviceName)
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+		},
+		{
+			name:           "resource up to date",
+			expectedEvents: nil,
+			reconcile: func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], _ *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter) {
+				gomock.InOrder(
+					r.EXPECT().ResourceName().Return(resourceName),
+					r.EXPECT().ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.PutFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResource, nil),
+					r.EXPECT().Parameters(gomockinternal.AContext(), fakeResource).Return(nil, nil),
+				)
+				_, err := svc.CreateOrUpdateResource(context.TODO(), r, serviceName)
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+		},
+		{
+			name:           "delete",
+			expectedEvents: []string{"AzureResourceDeleted: resource /subscriptions/123/resourceGroups/mock-resourcegroup/providers/Microsoft.Mock/mocks/mock-resource (service: mock-service)"},
+			reconcile: func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter) {
+				gomock.InOrder(
+					r.EXPECT().ResourceName().Return(resourceName),
+					r.EXPECT().ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(fakeResourceWithID, nil),
+					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "").Return(nil, nil),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+				)
+				g.Expect(svc.DeleteResource(context.TODO(), r, serviceName)).To(Succeed())
+			},
+		},
+		{
+			name:           "resource already deleted",
+			expectedEvents: nil,
+			reconcile: func(g *WithT, svc *Service[MockCreator, MockDeleter], s *mock_async.MockFutureScopeMockRecorder, c *mock_async.MockCreatorMockRecorder[MockCreator], d *mock_async.MockDeleterMockRecorder[MockDeleter], r *mock_azure.MockResourceSpecGetter) {
+				gomock.InOrder(
+					r.EXPECT().ResourceName().Return(resourceName),
+					r.EXPECT().ResourceGroupName().Return(resourceGroupName),
+					s.GetLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture).Return(nil),
+					c.Get(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType)).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					d.DeleteAsync(gomockinternal.AContext(), gomock.AssignableToTypeOf(azureResourceGetterType), "").Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound}),
+					s.DeleteLongRunningOperationState(resourceName, serviceName, infrav1.DeleteFuture),
+				)
+				g.Expect(svc.DeleteResource(context.TODO(), r, serviceName)).To(Succeed())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_async.NewMockFutureScope(mockCtrl)
+			creatorMock := mock_async.NewMockCreator[MockCreator](mockCtrl)
+			deleterMock := mock_async.NewMockDeleter[MockDeleter](mockCtrl)
+			scope := &eventScope{FutureScope: scopeMock}
+			svc := New[MockCreator, MockDeleter](scope, creatorMock, deleterMock)
+			specMock := mock_azure.NewMockResourceSpecGetter(mockCtrl)
+
+			tc.reconcile(g, svc, scopeMock.EXPECT(), creatorMock.EXPECT(), deleterMock.EXPECT(), specMock)
+			g.Expect(scope.events).To(Equal(tc.expectedEvents))
+		})
+	}
+}
+
+// eventScope is a FutureScope that also implements azure.ResourceEventRecorder.
+type eventScope struct {
+	FutureScope
+	events []string
+}
+
+func (*eventScope) RecordsResourceEvents() bool {
+	return true
+}
+
+func (s *eventScope) RecordResourceEvent(reason, message string) {
+	s.events = append(s.events, reason+": "+message)
+}
+
+// dryRunScope is a FutureScope that also implements azure.DryRunner.
+type dryRunScope struct {
+	FutureScope
+	skipped []string
+}
+
+func (*dryRunScope) IsDryRun() bool {
+	return true
+}
+
+func (s *dryRunScope) RecordDryRunSkip(message string) {
+	s.skipped = append(s.skipped, message)
+}
+
+// newTestScope returns scope, wrapped so that it reports dry-run mode if dryRun is true.
+func newTestScope(scope FutureScope, dryRun bool) FutureScope {
+	if dryRun {
+		return &dryRunScope{FutureScope: scope}
+	}
+	return scope
+}
+
+// expectDryRunSkips checks that a dry-run scope recorded one skipped operation per expected message.
+func expectDryRunSkips(g *WithT, scope FutureScope, expected ...string) {
+	if s, ok := scope.(*dryRunScope); ok {
+		g.Expect(s.skipped).To(Equal(expected))
+	}
+}
+
 const (
 	resourceGroupName  = "mock-resourcegroup"
 	resourceName       = "mock-resource"
@@ -327,6 +535,7 @@ var (
 		Data:          invalidResumeToken,
 	}
 	fakeResource            = armresources.GenericResource{}
+	fakeResourceWithID      = armresources.GenericResource{ID: ptr.To("/subscriptions/123/resourceGroups/mock-resourcegroup/providers/Microsoft.Mock/mocks/mock-resource")}
 	fakeParameters          = armresources.GenericResource{}
 	azureResourceGetterType = reflect.TypeOf((*azure.ResourceSpecGetter)(nil)).Elem()
 )
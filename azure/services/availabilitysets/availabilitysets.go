@@ -24,13 +24,19 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "availabilitysets"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupCompute)
+}
+
 // AvailabilitySetScope defines the scope interface for a availability sets service.
 type AvailabilitySetScope interface {
 	azure.ClusterDescriber
@@ -71,7 +77,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "availabilitysets.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	var err error
@@ -91,7 +97,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "availabilitysets.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	var resultingErr error
@@ -111,10 +117,13 @@ func (s *Service) Delete(ctx context.Context) error {
 		if !ok {
 			resultingErr = errors.Errorf("%T is not an armcompute.AvailabilitySet", existingSet)
 		} else {
-			// only delete when the availability set does not have any vms
-			if availabilitySet.Properties != nil && len(availabilitySet.Properties.VirtualMachines) > 0 {
+			// only delete when the availability set does not have any vms and was created by CAPZ for this cluster
+			switch {
+			case availabilitySet.Properties != nil && len(availabilitySet.Properties.VirtualMachines) > 0:
 				log.V(2).Info("skip deleting availability set with VMs", "availability set", setSpec.ResourceName())
-			} else {
+			case !converters.MapToTags(availabilitySet.Tags).HasOwned(s.Scope.ClusterName()):
+				log.V(2).Info("skip deleting availability set not owned by the cluster", "availability set", setSpec.ResourceName())
+			default:
 				resultingErr = s.DeleteResource(ctx, setSpec, serviceName)
 			}
 		}
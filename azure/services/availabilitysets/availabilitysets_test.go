@@ -67,6 +67,11 @@ var (
 	}
 	parameterError = errors.Errorf("some error with parameters")
 	notFoundError  = &azcore.ResponseError{StatusCode: http.StatusNotFound}
+	fakeOwnedSet   = armcompute.AvailabilitySet{
+		Tags: map[string]*string{
+			infrav1.ClusterTagKey("test-cluster"): ptr.To(string(infrav1.ResourceLifecycleOwned)),
+		},
+	}
 	fakeSetWithVMs = armcompute.AvailabilitySet{
 		Properties: &armcompute.AvailabilitySetProperties{
 			VirtualMachines: []*armcompute.SubResource{
@@ -169,9 +174,10 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expectedError: "",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
+				s.ClusterName().Return("test-cluster")
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
-					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(armcompute.AvailabilitySet{}, nil),
+					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(fakeOwnedSet, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
 				)
@@ -190,9 +196,10 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expectedError: "",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpecMissing)
+				s.ClusterName().Return("test-cluster")
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
-					m.Get(gomockinternal.AContext(), &fakeSetSpecMissing).Return(armcompute.AvailabilitySet{}, nil),
+					m.Get(gomockinternal.AContext(), &fakeSetSpecMissing).Return(fakeOwnedSet, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpecMissing, serviceName).Return(nil),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
 				)
@@ -210,6 +217,19 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:          "noop if availability set is not owned by the cluster",
+			expectedError: "",
+			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.AvailabilitySetSpec().Return(&fakeSetSpec)
+				s.ClusterName().Return("test-cluster")
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(armcompute.AvailabilitySet{}, nil),
+					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, nil),
+				)
+			},
+		},
 		{
 			name:          "availability set not found",
 			expectedError: "",
@@ -251,9 +271,10 @@ func TestDeleteAvailabilitySets(t *testing.T) {
 			expectedError: "#: Internal Server Error: StatusCode=500",
 			expect: func(s *mock_availabilitysets.MockAvailabilitySetScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
 				s.AvailabilitySetSpec().Return(&fakeSetSpec)
+				s.ClusterName().Return("test-cluster")
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
-					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(armcompute.AvailabilitySet{}, nil),
+					m.Get(gomockinternal.AContext(), &fakeSetSpec).Return(fakeOwnedSet, nil),
 					r.DeleteResource(gomockinternal.AContext(), &fakeSetSpec, serviceName).Return(internalError()),
 					s.UpdateDeleteStatus(infrav1.AvailabilitySetReadyCondition, serviceName, internalError()),
 				)
@@ -25,11 +25,16 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
 )
 
 const serviceName = "bastionhosts"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupBastion)
+}
+
 // BastionScope defines the scope interface for a bastion host service.
 type BastionScope interface {
 	aso.Scope
@@ -32,14 +32,16 @@ import (
 
 // AzureBastionSpec defines the specification for azure bastion feature.
 type AzureBastionSpec struct {
-	Name            string
-	ResourceGroup   string
-	Location        string
-	ClusterName     string
-	SubnetID        string
-	PublicIPID      string
-	Sku             infrav1.BastionHostSkuName
-	EnableTunneling bool
+	Name             string
+	ResourceGroup    string
+	Location         string
+	ClusterName      string
+	SubnetID         string
+	PublicIPID       string
+	Sku              infrav1.BastionHostSkuName
+	EnableTunneling  bool
+	ScaleUnits       *int
+	DisableCopyPaste *bool
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -74,6 +76,12 @@ func (s *AzureBastionSpec) Parameters(_ context.Context, existingBastionHost *as
 		Name: ptr.To(asonetworkv1.Sku_Name(s.Sku)),
 	}
 	bastionHost.Spec.EnableTunneling = ptr.To(s.EnableTunneling)
+	if s.ScaleUnits != nil {
+		bastionHost.Spec.ScaleUnits = s.ScaleUnits
+	}
+	if s.DisableCopyPaste != nil {
+		bastionHost.Spec.DisableCopyPaste = s.DisableCopyPaste
+	}
 	bastionHost.Spec.DnsName = ptr.To(fmt.Sprintf("%s-bastion", strings.ToLower(s.Name)))
 	bastionHost.Spec.IpConfigurations = []asonetworkv1.BastionHostIPConfiguration{
 		{
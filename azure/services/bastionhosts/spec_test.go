@@ -113,6 +113,32 @@ func TestAzureBastionSpec_Parameters(t *testing.T) {
 				g.Expect(result.Spec).To(Equal(getASOBastionHost().Spec))
 			},
 		},
+		{
+			name: "Creating a new Standard BastionHost with tunneling, scale units and copy paste disabled",
+			spec: &AzureBastionSpec{
+				Name:             fakeAzureBastionSpec1.Name,
+				ClusterName:      fakeAzureBastionSpec1.ClusterName,
+				Location:         fakeAzureBastionSpec1.Location,
+				SubnetID:         fakeAzureBastionSpec1.SubnetID,
+				PublicIPID:       fakeAzureBastionSpec1.PublicIPID,
+				Sku:              infrav1.StandardBastionHostSku,
+				EnableTunneling:  true,
+				ScaleUnits:       ptr.To(4),
+				DisableCopyPaste: ptr.To(true),
+			},
+			existing: nil,
+			expect: func(g *WithT, result asonetworkv1.BastionHost) {
+				g.Expect(result).To(Not(BeNil()))
+				g.Expect(result.Spec).To(Equal(getASOBastionHost(
+					func(bastion *asonetworkv1.BastionHost) {
+						bastion.Spec.Sku = &asonetworkv1.Sku{Name: ptr.To(asonetworkv1.Sku_Name_Standard)}
+						bastion.Spec.EnableTunneling = ptr.To(true)
+						bastion.Spec.ScaleUnits = ptr.To(4)
+						bastion.Spec.DisableCopyPaste = ptr.To(true)
+					},
+				).Spec))
+			},
+		},
 		{
 			name: "user updates to bastion hosts DisableCopyPaste should be accepted",
 			spec: &fakeAzureBastionSpec1,
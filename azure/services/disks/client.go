@@ -48,6 +48,51 @@ func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClien
 	return &azureClient{factory.NewDisksClient(), apiCallTimeout}, nil
 }
 
+// Get gets the specified disk.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.azureClient.Get")
+	defer done()
+
+	resp, err := ac.disks.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Disk, nil
+}
+
+// CreateOrUpdateAsync updates a disk asynchronously. Disks are created along with their VM, so this only sends a PATCH
+// request to Azure for an existing disk, e.g. to grow it. If accepted without error, the func will return a Poller
+// which can be used to track the ongoing progress of the operation.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.DisksClientUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	diskUpdate, ok := parameters.(armcompute.DiskUpdate)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armcompute.DiskUpdate", parameters)
+	}
+
+	opts := &armcompute.DisksClientBeginUpdateOptions{ResumeToken: resumeToken}
+	poller, err = ac.disks.BeginUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), diskUpdate, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.Disk, nil, err
+}
+
 // DeleteAsync deletes a disk asynchronously. DeleteAsync sends a DELETE
 // request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
 // progress of the operation.
@@ -24,16 +24,22 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "disks"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupCompute)
+}
+
 // DiskScope defines the scope interface for a disk service.
 type DiskScope interface {
 	azure.ClusterDescriber
 	azure.AsyncStatusUpdater
 	DiskSpecs() []azure.ResourceSpecGetter
+	RecordDiskResize(diskName string, fromGB, toGB int32)
 }
 
 // Service provides operations on Azure resources.
@@ -50,8 +56,8 @@ func New(scope DiskScope) (*Service, error) {
 	}
 	return &Service{
 		Scope: scope,
-		Reconciler: async.New[armcompute.DisksClientCreateOrUpdateResponse,
-			armcompute.DisksClientDeleteResponse](scope, nil, client),
+		Reconciler: async.New[armcompute.DisksClientUpdateResponse,
+			armcompute.DisksClientDeleteResponse](scope, client, client),
 	}, nil
 }
 
@@ -60,13 +66,47 @@ func (s *Service) Name() string {
 	return serviceName
 }
 
-// Reconcile on disk is currently no-op. OS disks should only be deleted and will create with the VM automatically.
+// Reconcile grows the data disks whose desired size is larger than their current size.
+// Disks are created with the VM automatically, so Reconcile never creates a disk.
 func (s *Service) Reconcile(ctx context.Context) error {
-	_, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.Reconcile")
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "disks.Service.Reconcile")
 	defer done()
 
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	// We go through the list of DiskSpecs to resize each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error resizing) -> operationNotDoneError (i.e. resizing in progress) -> no error (i.e. resized)
+	var result error
+	for _, spec := range s.Scope.DiskSpecs() {
+		diskSpec, ok := spec.(*DiskSpec)
+		if !ok || diskSpec.DiskSizeGB == 0 {
+			continue
+		}
+		_, err := s.CreateOrUpdateResource(ctx, diskSpec, serviceName)
+		if err != nil && !azure.IsOperationNotDoneError(err) {
+			result = err
+			continue
+		}
+		// In dry-run mode the async reconciler skips the resize and records it, so it must not be recorded here.
+		if fromGB := diskSpec.ResizedFromGB(); fromGB > 0 && !isDryRun(s.Scope) {
+			log.V(2).Info("resizing disk", "disk", diskSpec.Name, "fromGB", fromGB, "toGB", diskSpec.DiskSizeGB)
+			s.Scope.RecordDiskResize(diskSpec.Name, fromGB, diskSpec.DiskSizeGB)
+		}
+		if err != nil && result == nil {
+			result = err
+		}
+	}
+
 	// DisksReadyCondition is set in the VM service.
-	return nil
+	return result
+}
+
+// isDryRun returns true if scope is in dry-run mode.
+func isDryRun(scope DiskScope) bool {
+	dryRunner, ok := scope.(azure.DryRunner)
+	return ok && dryRunner.IsDryRun()
 }
 
 // Delete deletes the disk associated with a VM.
@@ -74,7 +114,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.DiskSpecs()
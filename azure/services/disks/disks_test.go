@@ -24,8 +24,10 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -59,6 +61,148 @@ var (
 	}
 )
 
+func TestReconcileDisk(t *testing.T) {
+	osDiskSpec := DiskSpec{
+		Name:          "my-os-disk",
+		ResourceGroup: "my-group",
+	}
+	dataDiskSpec1 := DiskSpec{
+		Name:          "my-disk-1",
+		ResourceGroup: "my-group",
+		DiskSizeGB:    256,
+	}
+	dataDiskSpec2 := DiskSpec{
+		Name:          "my-disk-2",
+		ResourceGroup: "my-group",
+		DiskSizeGB:    128,
+	}
+	existingDisk := armcompute.Disk{
+		Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+	}
+	// resize calls Parameters like the async reconciler does with the existing disk.
+	resize := func(ctx context.Context, spec azure.ResourceSpecGetter, _ string) (interface{}, error) {
+		return spec.Parameters(ctx, existingDisk)
+	}
+
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no data disk has a size",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&osDiskSpec})
+			},
+		},
+		{
+			name:          "resize the data disks that are smaller than their desired size",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&osDiskSpec, &dataDiskSpec1, &dataDiskSpec2})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &dataDiskSpec1, serviceName).DoAndReturn(resize),
+					s.RecordDiskResize("my-disk-1", int32(128), int32(256)),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &dataDiskSpec2, serviceName).DoAndReturn(resize),
+				)
+			},
+		},
+		{
+			name:          "error while trying to resize a data disk",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&dataDiskSpec1, &dataDiskSpec2})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &dataDiskSpec1, serviceName).Return(nil, internalError),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &dataDiskSpec2, serviceName).DoAndReturn(resize),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_disks.NewMockDiskScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestReconcileDiskDryRun(t *testing.T) {
+	g := NewWithT(t)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	scopeMock := mock_disks.NewMockDiskScope(mockCtrl)
+	asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+	dataDiskSpec := DiskSpec{
+		Name:          "my-disk-1",
+		ResourceGroup: "my-group",
+		DiskSizeGB:    256,
+	}
+	existingDisk := armcompute.Disk{
+		Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+	}
+
+	scopeMock.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+	scopeMock.EXPECT().DiskSpecs().Return([]azure.ResourceSpecGetter{&dataDiskSpec})
+	dryRunScope := &dryRunDiskScope{MockDiskScope: scopeMock}
+	// The async reconciler computes the parameters of the resize before skipping it in dry-run mode.
+	asyncMock.EXPECT().CreateOrUpdateResource(gomockinternal.AContext(), &dataDiskSpec, serviceName).
+		DoAndReturn(func(ctx context.Context, spec azure.ResourceSpecGetter, _ string) (interface{}, error) {
+			_, err := spec.Parameters(ctx, existingDisk)
+			azure.SkipInDryRun(dryRunScope, "dry-run: skipped update of resource my-group/my-disk-1 (service: disks)")
+			return nil, err
+		})
+
+	s := &Service{
+		Scope:      dryRunScope,
+		Reconciler: asyncMock,
+	}
+
+	g.Expect(s.Reconcile(context.TODO())).To(Succeed())
+	// The skipped resize is only recorded by the async reconciler, and the resize itself is not recorded.
+	g.Expect(dryRunScope.skipped).To(ConsistOf("dry-run: skipped update of resource my-group/my-disk-1 (service: disks)"))
+}
+
+// dryRunDiskScope is a DiskScope in dry-run mode which records the skipped mutations.
+type dryRunDiskScope struct {
+	*mock_disks.MockDiskScope
+	skipped []string
+}
+
+func (s *dryRunDiskScope) IsDryRun() bool {
+	return true
+}
+
+func (s *dryRunDiskScope) RecordDryRunSkip(message string) {
+	s.skipped = append(s.skipped, message)
+}
+
 func TestDeleteDisk(t *testing.T) {
 	testcases := []struct {
 		name          string
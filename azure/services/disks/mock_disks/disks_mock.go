@@ -351,6 +351,18 @@ func (mr *MockDiskScopeMockRecorder) NodeResourceGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockDiskScope)(nil).NodeResourceGroup))
 }
 
+// RecordDiskResize mocks base method.
+func (m *MockDiskScope) RecordDiskResize(diskName string, fromGB, toGB int32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RecordDiskResize", diskName, fromGB, toGB)
+}
+
+// RecordDiskResize indicates an expected call of RecordDiskResize.
+func (mr *MockDiskScopeMockRecorder) RecordDiskResize(diskName, fromGB, toGB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDiskResize", reflect.TypeOf((*MockDiskScope)(nil).RecordDiskResize), diskName, fromGB, toGB)
+}
+
 // ResourceGroup mocks base method.
 func (m *MockDiskScope) ResourceGroup() string {
 	m.ctrl.T.Helper()
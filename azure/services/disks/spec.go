@@ -16,12 +16,23 @@ limitations under the License.
 
 package disks
 
-import "context"
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+)
 
 // DiskSpec defines the specification for a disk.
 type DiskSpec struct {
 	Name          string
 	ResourceGroup string
+	// DiskSizeGB is the desired size of the disk. Zero means the disk is never resized.
+	DiskSizeGB int32
+
+	// resizedFromGB is the size of the disk before it was grown to DiskSizeGB, or zero if it wasn't grown.
+	resizedFromGB int32
 }
 
 // ResourceName returns the name of the disk.
@@ -39,7 +50,30 @@ func (s *DiskSpec) OwnerResourceName() string {
 	return ""
 }
 
-// Parameters is a no-op for disks.
-func (s *DiskSpec) Parameters(_ context.Context, _ interface{}) (params interface{}, err error) {
-	return nil, nil
+// Parameters returns the parameters to grow an existing disk to DiskSizeGB. Disks are created along with their VM and
+// are never shrunk, so Parameters returns nil when the disk doesn't exist yet or is already large enough.
+func (s *DiskSpec) Parameters(_ context.Context, existing interface{}) (params interface{}, err error) {
+	if existing == nil || s.DiskSizeGB == 0 {
+		return nil, nil
+	}
+
+	disk, ok := existing.(armcompute.Disk)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armcompute.Disk", existing)
+	}
+	if disk.Properties == nil || disk.Properties.DiskSizeGB == nil || *disk.Properties.DiskSizeGB >= s.DiskSizeGB {
+		return nil, nil
+	}
+
+	s.resizedFromGB = *disk.Properties.DiskSizeGB
+	return armcompute.DiskUpdate{
+		Properties: &armcompute.DiskUpdateProperties{
+			DiskSizeGB: ptr.To(s.DiskSizeGB),
+		},
+	}, nil
+}
+
+// ResizedFromGB returns the size of the disk before Parameters grew it to DiskSizeGB, or zero if it wasn't grown.
+func (s *DiskSpec) ResizedFromGB() int32 {
+	return s.resizedFromGB
 }
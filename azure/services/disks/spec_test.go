@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestDiskSpec_Parameters(t *testing.T) {
+	testCases := []struct {
+		name           string
+		spec           *DiskSpec
+		existing       interface{}
+		expect         func(g *WithT, result interface{})
+		expectedFromGB int32
+		expectedError  string
+	}{
+		{
+			name:     "get result as nil when the disk doesn't exist",
+			spec:     &DiskSpec{Name: "my-disk", DiskSizeGB: 256},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "get result as nil when the disk has no desired size",
+			spec: &DiskSpec{Name: "my-disk"},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:     "error when existing is not of Disk type",
+			spec:     &DiskSpec{Name: "my-disk", DiskSizeGB: 256},
+			existing: struct{}{},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "struct {} is not an armcompute.Disk",
+		},
+		{
+			name: "get result as nil when the disk already has the desired size",
+			spec: &DiskSpec{Name: "my-disk", DiskSizeGB: 128},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "get result as nil when the disk is larger than the desired size",
+			spec: &DiskSpec{Name: "my-disk", DiskSizeGB: 64},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "get DiskUpdate when the disk is smaller than the desired size",
+			spec: &DiskSpec{Name: "my-disk", DiskSizeGB: 256},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armcompute.DiskUpdate{
+					Properties: &armcompute.DiskUpdateProperties{DiskSizeGB: ptr.To[int32](256)},
+				}))
+			},
+			expectedFromGB: 128,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+			g.Expect(tc.spec.ResizedFromGB()).To(Equal(tc.expectedFromGB))
+		})
+	}
+}
@@ -26,12 +26,17 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
 )
 
 // ServiceName is the name of this service.
 const ServiceName = "group"
 
+func init() {
+	reconciler.RegisterServiceGroup(ServiceName, reconciler.ServiceGroupResources)
+}
+
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope GroupScope
@@ -49,6 +54,9 @@ func New(scope GroupScope) *Service {
 	svc := aso.NewService[*asoresourcesv1.ResourceGroup](ServiceName, scope)
 	svc.ListFunc = list
 	svc.Specs = scope.GroupSpecs()
+	// A resource group may hold network resources, like a vnet, still in use by resources in another group,
+	// so groups are deleted one at a time in the order given by the scope.
+	svc.DeleteInOrder = true
 	svc.ConditionType = infrav1.ResourceGroupReadyCondition
 	return &Service{
 		Scope:   scope,
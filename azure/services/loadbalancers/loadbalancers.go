@@ -24,6 +24,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -32,9 +33,15 @@ const (
 	httpsProbe            = "HTTPSProbe"
 	httpsProbeRequestPath = "/readyz"
 	lbRuleHTTPS           = "LBRuleHTTPS"
+	lbRuleHAPorts         = "LBRuleHAPorts"
 	outboundNAT           = "OutboundNATAllProtocols"
+	nodeOutboundNAT       = "NodeOutboundNATAllProtocols"
 )
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupNetworking)
+}
+
 // LBScope defines the scope interface for a load balancer service.
 type LBScope interface {
 	azure.ClusterScoper
@@ -71,7 +78,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "loadbalancers.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.LBSpecs()
@@ -100,7 +107,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "loadbalancers.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.LBSpecs()
@@ -113,7 +120,14 @@ func (s *Service) Delete(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
 	var result error
 	for _, lbSpec := range specs {
-		if err := s.DeleteResource(ctx, lbSpec, serviceName); err != nil {
+		var err error
+		if spec, ok := lbSpec.(*LBSpec); ok && spec.Unmanaged {
+			// Load balancers created outside of CAPZ are never deleted, only what CAPZ added to them is removed.
+			_, err = s.CreateOrUpdateResource(ctx, &unmanagedLBCleanupSpec{LBSpec: spec}, serviceName)
+		} else {
+			err = s.DeleteResource(ctx, lbSpec, serviceName)
+		}
+		if err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
@@ -124,7 +138,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	return result
 }
 
-// IsManaged returns always returns true as CAPZ does not support BYO load balancers.
+// IsManaged returns always returns true as load balancers created outside of CAPZ are handled per spec.
 func (s *Service) IsManaged(_ context.Context) (bool, error) {
 	return true, nil
 }
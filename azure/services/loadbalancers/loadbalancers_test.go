@@ -245,6 +245,17 @@ func TestDeleteLoadBalancer(t *testing.T) {
 				s.UpdateDeleteStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "unmanaged load balancer is cleaned up instead of deleted",
+			expectedError: "",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{newUnmanagedPublicAPILBSpec(), &fakeInternalAPILBSpec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &unmanagedLBCleanupSpec{LBSpec: newUnmanagedPublicAPILBSpec()}, serviceName).Return(nil, nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
+			},
+		},
 		{
 			name:          "load balancer deletion fails",
 			expectedError: "#: Internal Server Error: StatusCode=500",
@@ -18,9 +18,14 @@ package loadbalancers
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -46,7 +51,33 @@ type LBSpec struct {
 	FrontendIPConfigs    []infrav1.FrontendIP
 	APIServerPort        int32
 	IdleTimeoutInMinutes *int32
-	AdditionalTags       map[string]string
+	// AllocatedOutboundPorts is the number of SNAT ports allocated per backend instance by the outbound rule.
+	AllocatedOutboundPorts *int32
+	HealthProbe            *infrav1.LoadBalancerHealthProbe
+	AdditionalTags         map[string]string
+
+	// NodeOutboundBackendPoolName is the name of an additional backend pool for nodes that use the frontend IPs of
+	// this load balancer for outbound traffic. It is only used by the API server load balancer.
+	NodeOutboundBackendPoolName string
+
+	// OutboundBackendPools are additional backend pools, each with its own frontend IPs and outbound rule. It is only
+	// used by the node outbound load balancer.
+	OutboundBackendPools []infrav1.OutboundBackendPool
+
+	// HAPorts replaces the load balancing rule on the API server port with a rule for all protocols and all ports.
+	// It is only used by the API server load balancer.
+	HAPorts bool
+
+	// EnableFloatingIP enables floating IP on the load balancing rule. It is only used by the API server load balancer.
+	EnableFloatingIP bool
+
+	// AllowTypeMigration allows switching an existing load balancer between public and internal frontends.
+	// It is only used by the API server load balancer.
+	AllowTypeMigration bool
+
+	// Unmanaged is true when the load balancer was created outside of CAPZ. CAPZ only adds its frontend IP configuration
+	// and backend pools to it and never deletes it. It is only used by the API server load balancer.
+	Unmanaged bool
 }
 
 // ResourceName returns the name of the load balancer.
@@ -76,6 +107,10 @@ func (s *LBSpec) Parameters(_ context.Context, existing interface{}) (parameters
 		probes              []*armnetwork.Probe
 	)
 
+	if s.Unmanaged {
+		return s.unmanagedParameters(existing)
+	}
+
 	if existing != nil {
 		existingLB, ok := existing.(armnetwork.LoadBalancer)
 		if !ok {
@@ -84,53 +119,69 @@ func (s *LBSpec) Parameters(_ context.Context, existing interface{}) (parameters
 		// LB already exists
 		// We append the existing LB etag to the header to ensure we only apply the updates if the LB has not been modified.
 		etag = existingLB.Etag
-		update := false
-
-		// merge existing LB properties with desired properties
-		frontendIPConfigs = existingLB.Properties.FrontendIPConfigurations
-		wantedIPs, wantedFrontendIDs := getFrontendIPConfigs(*s)
-		for _, ip := range wantedIPs {
-			if !ipExists(frontendIPConfigs, *ip) {
-				update = true
-				frontendIPConfigs = append(frontendIPConfigs, ip)
+		if s.AllowTypeMigration && existingLBType(existingLB) != s.Type {
+			// A Standard load balancer cannot have public and internal frontends at the same time, so the frontends and
+			// the rules referencing them are replaced in a single update. Backend pools are kept so control plane
+			// machines remain members of the pool and start serving the new frontend as soon as it is provisioned.
+			frontendIPConfigs, frontendIDs = getFrontendIPConfigs(*s)
+			loadBalancingRules = getLoadBalancingRules(*s, frontendIDs)
+			outboundRules = getOutboundRules(*s, frontendIDs)
+			probes = getProbes(*s)
+			backendAddressPools = existingLB.Properties.BackendAddressPools
+			for _, pool := range getBackendAddressPools(*s) {
+				if !poolExists(backendAddressPools, *pool) {
+					backendAddressPools = append(backendAddressPools, pool)
+				}
 			}
-		}
+		} else {
+			update := false
 
-		loadBalancingRules = existingLB.Properties.LoadBalancingRules
-		for _, rule := range getLoadBalancingRules(*s, wantedFrontendIDs) {
-			if !lbRuleExists(loadBalancingRules, *rule) {
-				update = true
-				loadBalancingRules = append(loadBalancingRules, rule)
+			// merge existing LB properties with desired properties
+			frontendIPConfigs = existingLB.Properties.FrontendIPConfigurations
+			wantedIPs, wantedFrontendIDs := getFrontendIPConfigs(*s)
+			for _, ip := range wantedIPs {
+				if !ipExists(frontendIPConfigs, *ip) {
+					update = true
+					frontendIPConfigs = append(frontendIPConfigs, ip)
+				}
 			}
-		}
 
-		backendAddressPools = existingLB.Properties.BackendAddressPools
-		for _, pool := range getBackendAddressPools(*s) {
-			if !poolExists(backendAddressPools, *pool) {
-				update = true
-				backendAddressPools = append(backendAddressPools, pool)
+			loadBalancingRules = existingLB.Properties.LoadBalancingRules
+			for _, rule := range getLoadBalancingRules(*s, wantedFrontendIDs) {
+				if !lbRuleExists(loadBalancingRules, *rule) {
+					update = true
+					loadBalancingRules = append(loadBalancingRules, rule)
+				}
 			}
-		}
 
-		outboundRules = existingLB.Properties.OutboundRules
-		for _, rule := range getOutboundRules(*s, wantedFrontendIDs) {
-			if !outboundRuleExists(outboundRules, *rule) {
-				update = true
-				outboundRules = append(outboundRules, rule)
+			backendAddressPools = existingLB.Properties.BackendAddressPools
+			for _, pool := range getBackendAddressPools(*s) {
+				if !poolExists(backendAddressPools, *pool) {
+					update = true
+					backendAddressPools = append(backendAddressPools, pool)
+				}
 			}
-		}
 
-		probes = existingLB.Properties.Probes
-		for _, probe := range getProbes(*s) {
-			if !probeExists(probes, *probe) {
-				update = true
-				probes = append(probes, probe)
+			outboundRules = existingLB.Properties.OutboundRules
+			for _, rule := range getOutboundRules(*s, wantedFrontendIDs) {
+				if !outboundRuleExists(outboundRules, *rule) {
+					update = true
+					outboundRules = append(outboundRules, rule)
+				}
+			}
+
+			probes = existingLB.Properties.Probes
+			for _, probe := range getProbes(*s) {
+				if !probeExists(probes, *probe) {
+					update = true
+					probes = append(probes, probe)
+				}
 			}
-		}
 
-		if !update {
-			// load balancer already exists with all required defaults
-			return nil, nil
+			if !update {
+				// load balancer already exists with all required defaults
+				return nil, nil
+			}
 		}
 	} else {
 		frontendIPConfigs, frontendIDs = getFrontendIPConfigs(*s)
@@ -163,6 +214,151 @@ func (s *LBSpec) Parameters(_ context.Context, existing interface{}) (parameters
 	return lb, nil
 }
 
+// unmanagedParameters returns the parameters for a load balancer created outside of CAPZ. Only the frontend IP
+// configuration and backend pools CAPZ needs are added, everything else on the load balancer is left untouched.
+func (s *LBSpec) unmanagedParameters(existing interface{}) (interface{}, error) {
+	if existing == nil {
+		return nil, azure.WithTerminalError(errors.Errorf("load balancer %s does not exist in resource group %s", s.Name, s.ResourceGroup))
+	}
+	existingLB, ok := existing.(armnetwork.LoadBalancer)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armnetwork.LoadBalancer", existing)
+	}
+
+	update := false
+	properties := armnetwork.LoadBalancerPropertiesFormat{}
+	if existingLB.Properties != nil {
+		properties = *existingLB.Properties
+	}
+
+	added := getAddedSubResources(existingLB, s.ClusterName)
+	wantedIPs, _ := getFrontendIPConfigs(*s)
+	for _, ip := range wantedIPs {
+		if !ipExists(properties.FrontendIPConfigurations, *ip) {
+			update = true
+			properties.FrontendIPConfigurations = append(properties.FrontendIPConfigurations, ip)
+			added.Insert(addedFrontendIPConfigPrefix + ptr.Deref(ip.Name, ""))
+		}
+	}
+
+	for _, pool := range getBackendAddressPools(*s) {
+		if !poolExists(properties.BackendAddressPools, *pool) {
+			update = true
+			properties.BackendAddressPools = append(properties.BackendAddressPools, pool)
+			added.Insert(addedBackendPoolPrefix + ptr.Deref(pool.Name, ""))
+		}
+	}
+
+	if !update {
+		// load balancer already has the frontend IP configuration and backend pools CAPZ needs
+		return nil, nil
+	}
+
+	// The existing LB etag is kept to ensure we only apply the update if the LB has not been modified.
+	lb := existingLB
+	lb.Properties = &properties
+	lb.Tags = maps.Clone(existingLB.Tags)
+	if lb.Tags == nil {
+		lb.Tags = map[string]*string{}
+	}
+	lb.Tags[addedSubResourcesTagKey(s.ClusterName)] = ptr.To(strings.Join(sets.List(added), ","))
+	return lb, nil
+}
+
+const (
+	addedFrontendIPConfigPrefix = "frontendIPConfigurations/"
+	addedBackendPoolPrefix      = "backendAddressPools/"
+)
+
+// addedSubResourcesTagKey returns the key of the tag recording the frontend IP configurations and backend pools CAPZ
+// added to a load balancer created outside of CAPZ, so that exactly those are removed when the cluster is deleted.
+func addedSubResourcesTagKey(clusterName string) string {
+	return infrav1.NameAzureProviderPrefix + "lb-additions_" + clusterName
+}
+
+// getAddedSubResources returns the frontend IP configurations and backend pools CAPZ added to the load balancer.
+func getAddedSubResources(lb armnetwork.LoadBalancer, clusterName string) sets.Set[string] {
+	added := sets.New[string]()
+	if value := ptr.Deref(lb.Tags[addedSubResourcesTagKey(clusterName)], ""); value != "" {
+		added.Insert(strings.Split(value, ",")...)
+	}
+	return added
+}
+
+// unmanagedLBCleanupSpec removes the frontend IP configurations and backend pools CAPZ added to a load balancer
+// created outside of CAPZ, and the rules referencing them, instead of deleting the load balancer.
+type unmanagedLBCleanupSpec struct {
+	*LBSpec
+}
+
+// Parameters returns the existing load balancer without the frontend IP configurations and backend pools CAPZ added.
+func (s *unmanagedLBCleanupSpec) Parameters(_ context.Context, existing interface{}) (interface{}, error) {
+	if existing == nil {
+		// load balancer no longer exists, so there is nothing to remove
+		return nil, nil
+	}
+	existingLB, ok := existing.(armnetwork.LoadBalancer)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armnetwork.LoadBalancer", existing)
+	}
+	tagKey := addedSubResourcesTagKey(s.ClusterName)
+	if _, ok := existingLB.Tags[tagKey]; !ok {
+		// CAPZ did not add anything to the load balancer
+		return nil, nil
+	}
+
+	added := getAddedSubResources(existingLB, s.ClusterName)
+	removedIDs := sets.New[string]()
+	for _, name := range added.UnsortedList() {
+		if ipName, ok := strings.CutPrefix(name, addedFrontendIPConfigPrefix); ok {
+			removedIDs.Insert(strings.ToLower(azure.FrontendIPConfigID(s.SubscriptionID, s.ResourceGroup, s.Name, ipName)))
+		}
+		if poolName, ok := strings.CutPrefix(name, addedBackendPoolPrefix); ok {
+			removedIDs.Insert(strings.ToLower(azure.AddressPoolID(s.SubscriptionID, s.ResourceGroup, s.Name, poolName)))
+		}
+	}
+	isRemoved := func(refs ...*armnetwork.SubResource) bool {
+		for _, ref := range refs {
+			if ref != nil && removedIDs.Has(strings.ToLower(ptr.Deref(ref.ID, ""))) {
+				return true
+			}
+		}
+		return false
+	}
+
+	properties := armnetwork.LoadBalancerPropertiesFormat{}
+	if existingLB.Properties != nil {
+		properties = *existingLB.Properties
+	}
+	properties.FrontendIPConfigurations = slices.DeleteFunc(slices.Clone(properties.FrontendIPConfigurations), func(ip *armnetwork.FrontendIPConfiguration) bool {
+		return added.Has(addedFrontendIPConfigPrefix + ptr.Deref(ip.Name, ""))
+	})
+	properties.BackendAddressPools = slices.DeleteFunc(slices.Clone(properties.BackendAddressPools), func(pool *armnetwork.BackendAddressPool) bool {
+		return added.Has(addedBackendPoolPrefix + ptr.Deref(pool.Name, ""))
+	})
+	// Azure rejects removing frontend IP configurations and backend pools which are still referenced by a rule.
+	properties.LoadBalancingRules = slices.DeleteFunc(slices.Clone(properties.LoadBalancingRules), func(rule *armnetwork.LoadBalancingRule) bool {
+		return rule.Properties != nil && (isRemoved(rule.Properties.FrontendIPConfiguration, rule.Properties.BackendAddressPool) ||
+			isRemoved(rule.Properties.BackendAddressPools...))
+	})
+	properties.OutboundRules = slices.DeleteFunc(slices.Clone(properties.OutboundRules), func(rule *armnetwork.OutboundRule) bool {
+		return rule.Properties != nil && (isRemoved(rule.Properties.BackendAddressPool) || isRemoved(rule.Properties.FrontendIPConfigurations...))
+	})
+	properties.InboundNatRules = slices.DeleteFunc(slices.Clone(properties.InboundNatRules), func(rule *armnetwork.InboundNatRule) bool {
+		return rule.Properties != nil && isRemoved(rule.Properties.FrontendIPConfiguration, rule.Properties.BackendAddressPool)
+	})
+	properties.InboundNatPools = slices.DeleteFunc(slices.Clone(properties.InboundNatPools), func(pool *armnetwork.InboundNatPool) bool {
+		return pool.Properties != nil && isRemoved(pool.Properties.FrontendIPConfiguration)
+	})
+
+	// The existing LB etag is kept to ensure we only apply the update if the LB has not been modified.
+	lb := existingLB
+	lb.Properties = &properties
+	lb.Tags = maps.Clone(existingLB.Tags)
+	delete(lb.Tags, tagKey)
+	return lb, nil
+}
+
 func getFrontendIPConfigs(lbSpec LBSpec) ([]*armnetwork.FrontendIPConfiguration, []*armnetwork.SubResource) {
 	frontendIPConfigurations := make([]*armnetwork.FrontendIPConfiguration, 0)
 	frontendIDs := make([]*armnetwork.SubResource, 0)
@@ -191,6 +387,20 @@ func getFrontendIPConfigs(lbSpec LBSpec) ([]*armnetwork.FrontendIPConfiguration,
 			ID: ptr.To(azure.FrontendIPConfigID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, ipConfig.Name)),
 		})
 	}
+	// The frontend IPs of the outbound backend pools are only used by the outbound rules of their pools, so they are
+	// not part of the returned IDs.
+	for _, pool := range lbSpec.OutboundBackendPools {
+		for _, ipConfig := range pool.FrontendIPs {
+			frontendIPConfigurations = append(frontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
+				Properties: &armnetwork.FrontendIPConfigurationPropertiesFormat{
+					PublicIPAddress: &armnetwork.PublicIPAddress{
+						ID: ptr.To(azure.PublicIPID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, ipConfig.PublicIP.Name)),
+					},
+				},
+				Name: ptr.To(ipConfig.Name),
+			})
+		}
+	}
 	return frontendIPConfigurations, frontendIDs
 }
 
@@ -198,12 +408,13 @@ func getOutboundRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*a
 	if lbSpec.Type == infrav1.Internal {
 		return []*armnetwork.OutboundRule{}
 	}
-	return []*armnetwork.OutboundRule{
+	rules := []*armnetwork.OutboundRule{
 		{
 			Name: ptr.To(outboundNAT),
 			Properties: &armnetwork.OutboundRulePropertiesFormat{
 				Protocol:                 ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
 				IdleTimeoutInMinutes:     lbSpec.IdleTimeoutInMinutes,
+				AllocatedOutboundPorts:   lbSpec.AllocatedOutboundPorts,
 				FrontendIPConfigurations: frontendIDs,
 				BackendAddressPool: &armnetwork.SubResource{
 					ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.BackendPoolName)),
@@ -211,6 +422,46 @@ func getOutboundRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*a
 			},
 		},
 	}
+	// Nodes share the frontend IPs through their own backend pool so they are not targeted by the load balancing rules.
+	if lbSpec.NodeOutboundBackendPoolName != "" {
+		rules = append(rules, &armnetwork.OutboundRule{
+			Name: ptr.To(nodeOutboundNAT),
+			Properties: &armnetwork.OutboundRulePropertiesFormat{
+				Protocol:                 ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
+				IdleTimeoutInMinutes:     lbSpec.IdleTimeoutInMinutes,
+				FrontendIPConfigurations: frontendIDs,
+				BackendAddressPool: &armnetwork.SubResource{
+					ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.NodeOutboundBackendPoolName)),
+				},
+			},
+		})
+	}
+	for _, pool := range lbSpec.OutboundBackendPools {
+		poolFrontendIDs := make([]*armnetwork.SubResource, 0, len(pool.FrontendIPs))
+		for _, ipConfig := range pool.FrontendIPs {
+			poolFrontendIDs = append(poolFrontendIDs, &armnetwork.SubResource{
+				ID: ptr.To(azure.FrontendIPConfigID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, ipConfig.Name)),
+			})
+		}
+		rules = append(rules, &armnetwork.OutboundRule{
+			Name: ptr.To(outboundBackendPoolRuleName(pool.Name)),
+			Properties: &armnetwork.OutboundRulePropertiesFormat{
+				Protocol:                 ptr.To(armnetwork.LoadBalancerOutboundRuleProtocolAll),
+				IdleTimeoutInMinutes:     lbSpec.IdleTimeoutInMinutes,
+				AllocatedOutboundPorts:   pool.AllocatedOutboundPorts,
+				FrontendIPConfigurations: poolFrontendIDs,
+				BackendAddressPool: &armnetwork.SubResource{
+					ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, pool.Name)),
+				},
+			},
+		})
+	}
+	return rules
+}
+
+// outboundBackendPoolRuleName returns the name of the outbound rule of an outbound backend pool.
+func outboundBackendPoolRuleName(poolName string) string {
+	return fmt.Sprintf("%s-%s", outboundNAT, poolName)
 }
 
 func getLoadBalancingRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource) []*armnetwork.LoadBalancingRule {
@@ -221,16 +472,22 @@ func getLoadBalancingRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource)
 		if len(frontendIDs) != 0 {
 			frontendIPConfig = frontendIDs[0]
 		}
+		name, protocol, port := lbRuleHTTPS, armnetwork.TransportProtocolTCP, lbSpec.APIServerPort
+		if lbSpec.HAPorts {
+			// An HA ports rule load balances all protocols on all ports, which Azure represents with port 0.
+			// For more information see https://learn.microsoft.com/azure/load-balancer/load-balancer-ha-ports-overview.
+			name, protocol, port = lbRuleHAPorts, armnetwork.TransportProtocolAll, 0
+		}
 		return []*armnetwork.LoadBalancingRule{
 			{
-				Name: ptr.To(lbRuleHTTPS),
+				Name: ptr.To(name),
 				Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
 					DisableOutboundSnat:     ptr.To(true),
-					Protocol:                ptr.To(armnetwork.TransportProtocolTCP),
-					FrontendPort:            ptr.To[int32](lbSpec.APIServerPort),
-					BackendPort:             ptr.To[int32](lbSpec.APIServerPort),
+					Protocol:                ptr.To(protocol),
+					FrontendPort:            ptr.To[int32](port),
+					BackendPort:             ptr.To[int32](port),
 					IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
-					EnableFloatingIP:        ptr.To(false),
+					EnableFloatingIP:        ptr.To(lbSpec.EnableFloatingIP),
 					LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
 					FrontendIPConfiguration: frontendIPConfig,
 					BackendAddressPool: &armnetwork.SubResource{
@@ -247,31 +504,74 @@ func getLoadBalancingRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource)
 }
 
 func getBackendAddressPools(lbSpec LBSpec) []*armnetwork.BackendAddressPool {
-	return []*armnetwork.BackendAddressPool{
+	pools := []*armnetwork.BackendAddressPool{
 		{
 			Name: ptr.To(lbSpec.BackendPoolName),
 		},
 	}
+	if lbSpec.NodeOutboundBackendPoolName != "" {
+		pools = append(pools, &armnetwork.BackendAddressPool{
+			Name: ptr.To(lbSpec.NodeOutboundBackendPoolName),
+		})
+	}
+	for _, pool := range lbSpec.OutboundBackendPools {
+		pools = append(pools, &armnetwork.BackendAddressPool{
+			Name: ptr.To(pool.Name),
+		})
+	}
+	return pools
 }
 
 func getProbes(lbSpec LBSpec) []*armnetwork.Probe {
 	if lbSpec.Role == infrav1.APIServerRole || lbSpec.Role == infrav1.APIServerRoleInternal {
+		properties := &armnetwork.ProbePropertiesFormat{
+			Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
+			Port:              ptr.To[int32](lbSpec.APIServerPort),
+			RequestPath:       ptr.To(httpsProbeRequestPath),
+			IntervalInSeconds: ptr.To[int32](15),
+			NumberOfProbes:    ptr.To[int32](4),
+		}
+		if probe := lbSpec.HealthProbe; probe != nil {
+			if probe.Protocol != "" {
+				properties.Protocol = ptr.To(armnetwork.ProbeProtocol(probe.Protocol))
+				properties.RequestPath = probe.RequestPath
+			}
+			if probe.RequestPath != nil {
+				properties.RequestPath = probe.RequestPath
+			}
+			if probe.Port != nil {
+				properties.Port = probe.Port
+			}
+			if probe.IntervalInSeconds != nil {
+				properties.IntervalInSeconds = probe.IntervalInSeconds
+			}
+			if probe.NumberOfProbes != nil {
+				properties.NumberOfProbes = probe.NumberOfProbes
+			}
+		}
 		return []*armnetwork.Probe{
 			{
-				Name: ptr.To(httpsProbe),
-				Properties: &armnetwork.ProbePropertiesFormat{
-					Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
-					Port:              ptr.To[int32](lbSpec.APIServerPort),
-					RequestPath:       ptr.To(httpsProbeRequestPath),
-					IntervalInSeconds: ptr.To[int32](15),
-					NumberOfProbes:    ptr.To[int32](4),
-				},
+				// The probe keeps its name regardless of protocol so the load balancing rule can reference it.
+				Name:       ptr.To(httpsProbe),
+				Properties: properties,
 			},
 		}
 	}
 	return []*armnetwork.Probe{}
 }
 
+// existingLBType returns the type of an existing load balancer based on its frontend IP configurations.
+func existingLBType(lb armnetwork.LoadBalancer) infrav1.LBType {
+	if lb.Properties != nil {
+		for _, ip := range lb.Properties.FrontendIPConfigurations {
+			if ip.Properties != nil && ip.Properties.PublicIPAddress != nil {
+				return infrav1.Public
+			}
+		}
+	}
+	return infrav1.Internal
+}
+
 func probeExists(probes []*armnetwork.Probe, probe armnetwork.Probe) bool {
 	for _, p := range probes {
 		if ptr.Deref(p.Name, "") == ptr.Deref(probe.Name, "") {
@@ -62,6 +62,90 @@ func getExistingLBWithMissingOutboundRules() armnetwork.LoadBalancer {
 	return existingLB
 }
 
+func newInternalAPILBSpecFromPublic(allowTypeMigration bool) *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.Type = infrav1.Internal
+	spec.VNetName = "my-vnet"
+	spec.VNetResourceGroup = "my-rg"
+	spec.FrontendIPConfigs = []infrav1.FrontendIP{
+		{
+			Name: "my-publiclb-frontEnd-internal",
+			FrontendIPClass: infrav1.FrontendIPClass{
+				PrivateIPAddress: "10.0.0.10",
+			},
+		},
+	}
+	spec.AllowTypeMigration = allowTypeMigration
+
+	return &spec
+}
+
+func newPublicAPILBSpecWithTypeMigration() *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.Name = "my-private-lb"
+	spec.BackendPoolName = "my-private-lb-backendPool"
+	spec.AllowTypeMigration = true
+
+	return &spec
+}
+
+func newUnmanagedPublicAPILBSpec() *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.Unmanaged = true
+
+	return &spec
+}
+
+func getExistingUnmanagedLB() armnetwork.LoadBalancer {
+	return armnetwork.LoadBalancer{
+		Etag: ptr.To("fake-etag"),
+		Tags: map[string]*string{
+			"owner": ptr.To("network-team"),
+		},
+		Properties: &armnetwork.LoadBalancerPropertiesFormat{
+			FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{{Name: ptr.To("existing-frontend")}},
+			BackendAddressPools:      []*armnetwork.BackendAddressPool{{Name: ptr.To("existing-pool")}},
+			LoadBalancingRules:       []*armnetwork.LoadBalancingRule{{Name: ptr.To("existing-rule")}},
+			Probes:                   []*armnetwork.Probe{{Name: ptr.To("existing-probe")}},
+		},
+	}
+}
+
+func newNodeOutboundLBSpecWithAllocatedOutboundPorts() *LBSpec {
+	spec := fakeNodeOutboundLBSpec
+	spec.IdleTimeoutInMinutes = ptr.To[int32](60)
+	spec.AllocatedOutboundPorts = ptr.To[int32](1024)
+
+	return &spec
+}
+
+func newNodeOutboundLBSpecWithOutboundBackendPools() *LBSpec {
+	spec := fakeNodeOutboundLBSpec
+	spec.OutboundBackendPools = []infrav1.OutboundBackendPool{
+		{
+			Name: "batch",
+			FrontendIPs: []infrav1.FrontendIP{
+				{
+					Name: "my-cluster-frontEnd-batch",
+					PublicIP: &infrav1.PublicIPSpec{
+						Name: "outbound-publicip-batch",
+					},
+				},
+			},
+			AllocatedOutboundPorts: ptr.To[int32](2048),
+		},
+	}
+
+	return &spec
+}
+
+func getExistingNodeOutboundLB() armnetwork.LoadBalancer {
+	spec := fakeNodeOutboundLBSpec
+	existing, _ := spec.Parameters(context.TODO(), nil)
+
+	return existing.(armnetwork.LoadBalancer)
+}
+
 func TestParameters(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -147,6 +231,158 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "public API load balancer type change is ignored when type migration is not allowed",
+			spec:     newInternalAPILBSpecFromPublic(false),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer migrated to internal",
+			spec:     newInternalAPILBSpecFromPublic(true),
+			existing: newSamplePublicAPIServerLB(false, true, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(1))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[0].Name).To(Equal("my-publiclb-frontEnd-internal"))
+				g.Expect(lb.Properties.FrontendIPConfigurations[0].Properties.PublicIPAddress).To(BeNil())
+				g.Expect(*lb.Properties.FrontendIPConfigurations[0].Properties.PrivateIPAddress).To(Equal("10.0.0.10"))
+				g.Expect(*lb.Properties.LoadBalancingRules[0].Properties.FrontendIPConfiguration.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/frontendIPConfigurations/my-publiclb-frontEnd-internal"))
+				g.Expect(lb.Properties.OutboundRules).To(BeEmpty())
+				// The existing backend pool is kept as is.
+				g.Expect(lb.Properties.BackendAddressPools).To(Equal(newSamplePublicAPIServerLB(false, true, false, false, false).Properties.BackendAddressPools))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "internal API load balancer migrated to public",
+			spec:     newPublicAPILBSpecWithTypeMigration(),
+			existing: newDefaultInternalAPIServerLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(1))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[0].Properties.PublicIPAddress.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-publicip"))
+				g.Expect(lb.Properties.OutboundRules).To(HaveLen(1))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(1))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer with node outbound backend pool",
+			spec:     newSharedOutboundPublicAPILBSpec(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(2))
+				g.Expect(*lb.Properties.BackendAddressPools[1].Name).To(Equal("my-publiclb-outboundBackendPool"))
+				g.Expect(lb.Properties.OutboundRules).To(HaveLen(2))
+				nodeRule := lb.Properties.OutboundRules[1]
+				g.Expect(*nodeRule.Name).To(Equal(nodeOutboundNAT))
+				g.Expect(*nodeRule.Properties.BackendAddressPool.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-outboundBackendPool"))
+				g.Expect(nodeRule.Properties.FrontendIPConfigurations).To(Equal(lb.Properties.OutboundRules[0].Properties.FrontendIPConfigurations))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "node outbound load balancer with allocated outbound ports",
+			spec:     newNodeOutboundLBSpecWithAllocatedOutboundPorts(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.OutboundRules).To(HaveLen(1))
+				g.Expect(lb.Properties.OutboundRules[0].Properties.IdleTimeoutInMinutes).To(Equal(ptr.To[int32](60)))
+				g.Expect(lb.Properties.OutboundRules[0].Properties.AllocatedOutboundPorts).To(Equal(ptr.To[int32](1024)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "node outbound load balancer with outbound backend pools",
+			spec:     newNodeOutboundLBSpecWithOutboundBackendPools(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[1].Name).To(Equal("my-cluster-frontEnd-batch"))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[1].Properties.PublicIPAddress.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/outbound-publicip-batch"))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(2))
+				g.Expect(*lb.Properties.BackendAddressPools[1].Name).To(Equal("batch"))
+				g.Expect(lb.Properties.OutboundRules).To(HaveLen(2))
+				// The default outbound rule doesn't use the frontend IPs of the pools.
+				g.Expect(lb.Properties.OutboundRules[0].Properties.FrontendIPConfigurations).To(HaveLen(1))
+				poolRule := lb.Properties.OutboundRules[1]
+				g.Expect(*poolRule.Name).To(Equal("OutboundNATAllProtocols-batch"))
+				g.Expect(poolRule.Properties.AllocatedOutboundPorts).To(Equal(ptr.To[int32](2048)))
+				g.Expect(poolRule.Properties.FrontendIPConfigurations).To(ConsistOf(&armnetwork.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/frontendIPConfigurations/my-cluster-frontEnd-batch"),
+				}))
+				g.Expect(*poolRule.Properties.BackendAddressPool.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-cluster/backendAddressPools/batch"))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "outbound backend pool added to an existing node outbound load balancer",
+			spec:     newNodeOutboundLBSpecWithOutboundBackendPools(),
+			existing: getExistingNodeOutboundLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(2))
+				g.Expect(lb.Properties.OutboundRules).To(HaveLen(2))
+				g.Expect(lb.Properties.OutboundRules[0]).To(Equal(getExistingNodeOutboundLB().Properties.OutboundRules[0]))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "unmanaged load balancer does not exist",
+			spec:     newUnmanagedPublicAPILBSpec(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: load balancer my-publiclb does not exist in resource group my-rg. Object will not be requeued",
+		},
+		{
+			name:     "unmanaged load balancer gets only the frontend IP configuration and backend pool",
+			spec:     newUnmanagedPublicAPILBSpec(),
+			existing: getExistingUnmanagedLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Etag).To(Equal(ptr.To("fake-etag")))
+				g.Expect(lb.Tags).To(Equal(map[string]*string{
+					"owner": ptr.To("network-team"),
+					"sigs.k8s.io_cluster-api-provider-azure_lb-additions_my-cluster": ptr.To("backendAddressPools/my-publiclb-backendPool,frontendIPConfigurations/my-publiclb-frontEnd"),
+				}))
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[1].Name).To(Equal("my-publiclb-frontEnd"))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(2))
+				g.Expect(*lb.Properties.BackendAddressPools[1].Name).To(Equal("my-publiclb-backendPool"))
+				g.Expect(lb.Properties.LoadBalancingRules).To(HaveLen(1))
+				g.Expect(lb.Properties.Probes).To(HaveLen(1))
+				g.Expect(lb.Properties.OutboundRules).To(BeEmpty())
+			},
+			expectedError: "",
+		},
+		{
+			name:     "unmanaged load balancer already has the frontend IP configuration and backend pool",
+			spec:     newUnmanagedPublicAPILBSpec(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -165,6 +401,292 @@ func TestParameters(t *testing.T) {
 	}
 }
 
+func TestUnmanagedLBCleanupParameters(t *testing.T) {
+	frontendID := "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/frontendIPConfigurations/my-publiclb-frontEnd"
+	poolID := "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-backendPool"
+	existingPoolID := "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/existing-pool"
+	existingLBWithAdditions := func(added string) armnetwork.LoadBalancer {
+		return armnetwork.LoadBalancer{
+			Etag: ptr.To("fake-etag"),
+			Tags: map[string]*string{
+				"owner": ptr.To("network-team"),
+				"sigs.k8s.io_cluster-api-provider-azure_lb-additions_my-cluster": ptr.To(added),
+			},
+			Properties: &armnetwork.LoadBalancerPropertiesFormat{
+				FrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{
+					{Name: ptr.To("existing-frontend")},
+					{Name: ptr.To("my-publiclb-frontEnd")},
+				},
+				BackendAddressPools: []*armnetwork.BackendAddressPool{
+					{Name: ptr.To("existing-pool")},
+					{Name: ptr.To("my-publiclb-backendPool")},
+				},
+				LoadBalancingRules: []*armnetwork.LoadBalancingRule{
+					{
+						Name: ptr.To("existing-rule"),
+						Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+							FrontendIPConfiguration: &armnetwork.SubResource{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/frontendIPConfigurations/existing-frontend")},
+							BackendAddressPool:      &armnetwork.SubResource{ID: ptr.To(existingPoolID)},
+						},
+					},
+					{
+						Name: ptr.To("apiserver-rule"),
+						Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+							FrontendIPConfiguration: &armnetwork.SubResource{ID: ptr.To(frontendID)},
+							BackendAddressPool:      &armnetwork.SubResource{ID: ptr.To(poolID)},
+						},
+					},
+				},
+				Probes: []*armnetwork.Probe{{Name: ptr.To("existing-probe")}},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name          string
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "load balancer does not exist",
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:     "nothing was added by CAPZ",
+			existing: getExistingUnmanagedLB(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:     "frontend IP configuration and backend pool added by CAPZ are removed with the rules referencing them",
+			existing: existingLBWithAdditions("backendAddressPools/my-publiclb-backendPool,frontendIPConfigurations/my-publiclb-frontEnd"),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Etag).To(Equal(ptr.To("fake-etag")))
+				g.Expect(lb.Tags).To(Equal(map[string]*string{"owner": ptr.To("network-team")}))
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(1))
+				g.Expect(*lb.Properties.FrontendIPConfigurations[0].Name).To(Equal("existing-frontend"))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(1))
+				g.Expect(*lb.Properties.BackendAddressPools[0].Name).To(Equal("existing-pool"))
+				g.Expect(lb.Properties.LoadBalancingRules).To(HaveLen(1))
+				g.Expect(*lb.Properties.LoadBalancingRules[0].Name).To(Equal("existing-rule"))
+				g.Expect(lb.Properties.Probes).To(HaveLen(1))
+			},
+		},
+		{
+			name:     "frontend IP configuration which existed before is kept",
+			existing: existingLBWithAdditions("backendAddressPools/my-publiclb-backendPool"),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(2))
+				g.Expect(lb.Properties.BackendAddressPools).To(HaveLen(1))
+				g.Expect(*lb.Properties.BackendAddressPools[0].Name).To(Equal("existing-pool"))
+				g.Expect(lb.Properties.LoadBalancingRules).To(HaveLen(1))
+				g.Expect(*lb.Properties.LoadBalancingRules[0].Name).To(Equal("existing-rule"))
+			},
+		},
+		{
+			name:          "load balancer has the wrong type",
+			existing:      "not a load balancer",
+			expect:        func(g *WithT, result interface{}) {},
+			expectedError: "string is not an armnetwork.LoadBalancer",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			spec := &unmanagedLBCleanupSpec{LBSpec: newUnmanagedPublicAPILBSpec()}
+			result, err := spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+		})
+	}
+}
+
+func TestGetProbes(t *testing.T) {
+	testcases := []struct {
+		name        string
+		role        string
+		healthProbe *infrav1.LoadBalancerHealthProbe
+		expected    []*armnetwork.Probe
+	}{
+		{
+			name: "default API server probe",
+			role: infrav1.APIServerRole,
+			expected: []*armnetwork.Probe{
+				{
+					Name: ptr.To(httpsProbe),
+					Properties: &armnetwork.ProbePropertiesFormat{
+						Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
+						Port:              ptr.To[int32](6443),
+						RequestPath:       ptr.To(httpsProbeRequestPath),
+						IntervalInSeconds: ptr.To[int32](15),
+						NumberOfProbes:    ptr.To[int32](4),
+					},
+				},
+			},
+		},
+		{
+			name: "custom TCP API server probe",
+			role: infrav1.APIServerRole,
+			healthProbe: &infrav1.LoadBalancerHealthProbe{
+				Protocol:          infrav1.LBProbeProtocolTCP,
+				IntervalInSeconds: ptr.To[int32](5),
+				NumberOfProbes:    ptr.To[int32](2),
+			},
+			expected: []*armnetwork.Probe{
+				{
+					Name: ptr.To(httpsProbe),
+					Properties: &armnetwork.ProbePropertiesFormat{
+						Protocol:          ptr.To(armnetwork.ProbeProtocolTCP),
+						Port:              ptr.To[int32](6443),
+						IntervalInSeconds: ptr.To[int32](5),
+						NumberOfProbes:    ptr.To[int32](2),
+					},
+				},
+			},
+		},
+		{
+			name: "custom HTTP internal API server probe",
+			role: infrav1.APIServerRoleInternal,
+			healthProbe: &infrav1.LoadBalancerHealthProbe{
+				Protocol:    infrav1.LBProbeProtocolHTTP,
+				Port:        ptr.To[int32](8080),
+				RequestPath: ptr.To("/healthz"),
+			},
+			expected: []*armnetwork.Probe{
+				{
+					Name: ptr.To(httpsProbe),
+					Properties: &armnetwork.ProbePropertiesFormat{
+						Protocol:          ptr.To(armnetwork.ProbeProtocolHTTP),
+						Port:              ptr.To[int32](8080),
+						RequestPath:       ptr.To("/healthz"),
+						IntervalInSeconds: ptr.To[int32](15),
+						NumberOfProbes:    ptr.To[int32](4),
+					},
+				},
+			},
+		},
+		{
+			name: "node outbound load balancer has no probes",
+			role: infrav1.NodeOutboundRole,
+			healthProbe: &infrav1.LoadBalancerHealthProbe{
+				Protocol: infrav1.LBProbeProtocolTCP,
+			},
+			expected: []*armnetwork.Probe{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			spec := LBSpec{
+				Role:          tc.role,
+				APIServerPort: 6443,
+				HealthProbe:   tc.healthProbe,
+			}
+			g.Expect(getProbes(spec)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestGetLoadBalancingRules(t *testing.T) {
+	frontendIDs := []*armnetwork.SubResource{
+		{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb/frontendIPConfigurations/my-lb-frontEnd")},
+	}
+	newRule := func(name string, protocol armnetwork.TransportProtocol, port int32, enableFloatingIP bool) *armnetwork.LoadBalancingRule {
+		return &armnetwork.LoadBalancingRule{
+			Name: ptr.To(name),
+			Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+				DisableOutboundSnat:     ptr.To(true),
+				Protocol:                ptr.To(protocol),
+				FrontendPort:            ptr.To(port),
+				BackendPort:             ptr.To(port),
+				EnableFloatingIP:        ptr.To(enableFloatingIP),
+				LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
+				FrontendIPConfiguration: frontendIDs[0],
+				BackendAddressPool: &armnetwork.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb/backendAddressPools/my-lb-backendPool"),
+				},
+				Probe: &armnetwork.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-lb/probes/HTTPSProbe"),
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name             string
+		role             string
+		haPorts          bool
+		enableFloatingIP bool
+		expected         []*armnetwork.LoadBalancingRule
+	}{
+		{
+			name:     "default API server rule",
+			role:     infrav1.APIServerRole,
+			expected: []*armnetwork.LoadBalancingRule{newRule(lbRuleHTTPS, armnetwork.TransportProtocolTCP, 6443, false)},
+		},
+		{
+			name:             "API server rule with floating IP",
+			role:             infrav1.APIServerRole,
+			enableFloatingIP: true,
+			expected:         []*armnetwork.LoadBalancingRule{newRule(lbRuleHTTPS, armnetwork.TransportProtocolTCP, 6443, true)},
+		},
+		{
+			name:             "HA ports rule with floating IP",
+			role:             infrav1.APIServerRole,
+			haPorts:          true,
+			enableFloatingIP: true,
+			expected:         []*armnetwork.LoadBalancingRule{newRule(lbRuleHAPorts, armnetwork.TransportProtocolAll, 0, true)},
+		},
+		{
+			name:     "node outbound load balancer has no rules",
+			role:     infrav1.NodeOutboundRole,
+			haPorts:  true,
+			expected: []*armnetwork.LoadBalancingRule{},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			spec := LBSpec{
+				Name:             "my-lb",
+				ResourceGroup:    "my-rg",
+				SubscriptionID:   "123",
+				Role:             tc.role,
+				BackendPoolName:  "my-lb-backendPool",
+				APIServerPort:    6443,
+				HAPorts:          tc.haPorts,
+				EnableFloatingIP: tc.enableFloatingIP,
+			}
+			g.Expect(getLoadBalancingRules(spec, frontendIDs)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func newSharedOutboundPublicAPILBSpec() *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.NodeOutboundBackendPoolName = "my-publiclb-outboundBackendPool"
+	return &spec
+}
+
 func newDefaultNodeOutboundLB() armnetwork.LoadBalancer {
 	return armnetwork.LoadBalancer{
 		Tags: map[string]*string{
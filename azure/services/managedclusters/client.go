@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// Client wraps go-sdk.
+type Client interface {
+	Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error)
+	GetPowerState(ctx context.Context, resourceGroupName, name string) (powerState string, provisioningState string, err error)
+	GetAvailableUpgrades(ctx context.Context, resourceGroupName, name string) ([]string, error)
+	StartAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStartResponse], error)
+	StopAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStopResponse], error)
+}
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	managedclusters *armcontainerservice.ManagedClustersClient
+	apiCallTimeout  time.Duration
+}
+
+// newClient creates a new managed clusters client from an authorizer.
+func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create managedclusters client options")
+	}
+	factory, err := armcontainerservice.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcontainerservice client factory")
+	}
+	return &azureClient{factory.NewManagedClustersClient(), apiCallTimeout}, nil
+}
+
+// Get gets the specified managed cluster.
+func (ac *azureClient) Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.azureClient.Get")
+	defer done()
+
+	resp, err := ac.managedclusters.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return armcontainerservice.ManagedCluster{}, err
+	}
+	return resp.ManagedCluster, nil
+}
+
+// GetPowerState gets the power state and provisioning state of the specified managed cluster.
+func (ac *azureClient) GetPowerState(ctx context.Context, resourceGroupName, name string) (string, string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.azureClient.GetPowerState")
+	defer done()
+
+	resp, err := ac.managedclusters.Get(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return "", "", err
+	}
+	props := resp.ManagedCluster.Properties
+	if props == nil {
+		return "", "", nil
+	}
+	var powerState string
+	if props.PowerState != nil {
+		powerState = string(ptr.Deref(props.PowerState.Code, ""))
+	}
+	return powerState, ptr.Deref(props.ProvisioningState, ""), nil
+}
+
+// GetAvailableUpgrades gets the Kubernetes versions the control plane of the specified managed cluster
+// can be upgraded to from its upgrade profile.
+func (ac *azureClient) GetAvailableUpgrades(ctx context.Context, resourceGroupName, name string) ([]string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.azureClient.GetAvailableUpgrades")
+	defer done()
+
+	resp, err := ac.managedclusters.GetUpgradeProfile(ctx, resourceGroupName, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	props := resp.ManagedClusterUpgradeProfile.Properties
+	if props == nil || props.ControlPlaneProfile == nil {
+		return nil, nil
+	}
+	var versions []string
+	for _, upgrade := range props.ControlPlaneProfile.Upgrades {
+		if upgrade != nil && upgrade.KubernetesVersion != nil {
+			versions = append(versions, *upgrade.KubernetesVersion)
+		}
+	}
+	return versions, nil
+}
+
+// StartAsync starts the specified managed cluster asynchronously. StartAsync sends a POST request to Azure and if
+// accepted without error, the func will return a Poller which can be used to track the ongoing progress of the operation.
+func (ac *azureClient) StartAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (poller *runtime.Poller[armcontainerservice.ManagedClustersClientStartResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.azureClient.Start")
+	defer done()
+
+	opts := &armcontainerservice.ManagedClustersClientBeginStartOptions{ResumeToken: resumeToken}
+	poller, err = ac.managedclusters.BeginStart(ctx, resourceGroupName, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
+
+// StopAsync stops the specified managed cluster asynchronously. StopAsync sends a POST request to Azure and if
+// accepted without error, the func will return a Poller which can be used to track the ongoing progress of the operation.
+func (ac *azureClient) StopAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (poller *runtime.Poller[armcontainerservice.ManagedClustersClientStopResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.azureClient.Stop")
+	defer done()
+
+	opts := &armcontainerservice.ManagedClustersClientBeginStopOptions{ResumeToken: resumeToken}
+	poller, err = ac.managedclusters.BeginStop(ctx, resourceGroupName, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
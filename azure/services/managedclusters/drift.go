@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	asocontainerservicev1hub "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001/storage"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// managedClusterDriftTotal counts how many times an AKS cluster started to differ from its desired spec.
+var managedClusterDriftTotal = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "capz_managedcluster_drift_total",
+		Help: "Total number of times a managed cluster was observed to start drifting from its desired spec.",
+	},
+	[]string{"namespace", "name"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(managedClusterDriftTotal)
+}
+
+// isStatusCurrent returns true when ASO has successfully reconciled the latest generation of the resource, so
+// its status reflects the Azure resource as it was after the current spec was applied.
+func isStatusCurrent(obj genruntime.MetaObject) bool {
+	conds := obj.GetConditions()
+	i, ok := conds.FindIndexByType(conditions.ConditionTypeReady)
+	return ok && conds[i].Status == metav1.ConditionTrue && conds[i].ObservedGeneration == obj.GetGeneration()
+}
+
+// isDriftObservable returns true when the status of the resource can differ from its spec. ASO only refreshes the
+// status without applying the spec for resources with the "skip" reconcile policy, e.g. adopted clusters which are
+// not managed by ASO. For any other policy ASO reads the status back after applying the spec, so the status
+// converges to the spec and drift must be observed by getting the cluster from Azure before ASO corrects it.
+func isDriftObservable(obj genruntime.MetaObject) bool {
+	return obj.GetAnnotations()[asoannotations.ReconcilePolicy] == string(asoannotations.ReconcilePolicySkip)
+}
+
+// reportDrift records the fields of the managed cluster which drifted from the desired spec in the scope and counts
+// the drift when the cluster starts to drift, not on every reconciliation while it remains drifted. corrected
+// indicates whether ASO corrects the drift.
+func reportDrift(ctx context.Context, scope ManagedClusterScope, namespace, name string, fields []string, corrected bool) {
+	_, log, done := tele.StartSpanWithLogger(ctx, "managedclusters.reportDrift")
+	defer done()
+
+	if len(fields) > 0 && !scope.IsManagedClusterDrifted() {
+		log.Info("detected drift from the desired managed cluster spec", "fields", fields, "corrected", corrected)
+		managedClusterDriftTotal.WithLabelValues(namespace, name).Inc()
+	}
+	scope.SetManagedClusterDrift(fields, corrected)
+}
+
+// observedStatus returns the status of the managed cluster got from Azure, limited to the fields compared by
+// getDriftedFields.
+func observedStatus(managedCluster armcontainerservice.ManagedCluster) asocontainerservicev1hub.ManagedCluster_STATUS {
+	var status asocontainerservicev1hub.ManagedCluster_STATUS
+	if managedCluster.SKU != nil && managedCluster.SKU.Tier != nil {
+		status.Sku = &asocontainerservicev1hub.ManagedClusterSKU_STATUS{Tier: ptr.To(string(*managedCluster.SKU.Tier))}
+	}
+	props := managedCluster.Properties
+	if props == nil {
+		return status
+	}
+	status.DisableLocalAccounts = props.DisableLocalAccounts
+	if props.AutoUpgradeProfile != nil && props.AutoUpgradeProfile.UpgradeChannel != nil {
+		status.AutoUpgradeProfile = &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile_STATUS{
+			UpgradeChannel: ptr.To(string(*props.AutoUpgradeProfile.UpgradeChannel)),
+		}
+	}
+	if props.APIServerAccessProfile != nil {
+		status.ApiServerAccessProfile = &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile_STATUS{}
+		for _, ipRange := range props.APIServerAccessProfile.AuthorizedIPRanges {
+			if ipRange != nil {
+				status.ApiServerAccessProfile.AuthorizedIPRanges = append(status.ApiServerAccessProfile.AuthorizedIPRanges, *ipRange)
+			}
+		}
+	}
+	return status
+}
+
+// getDriftedFields returns the fields of the managed cluster whose observed value in Azure differs from the
+// value set in its spec. Fields which are not set in either the spec or the status are not compared, nor are
+// immutable fields or fields defaulted by Azure, such as enableRBAC and nodeResourceGroup.
+func getDriftedFields(spec asocontainerservicev1hub.ManagedCluster_Spec, status asocontainerservicev1hub.ManagedCluster_STATUS) []string {
+	var fields []string
+	if drifted(spec.DisableLocalAccounts, status.DisableLocalAccounts) {
+		fields = append(fields, "disableLocalAccounts")
+	}
+	if spec.Sku != nil && status.Sku != nil && drifted(spec.Sku.Tier, status.Sku.Tier) {
+		fields = append(fields, "sku.tier")
+	}
+	if spec.AutoUpgradeProfile != nil && status.AutoUpgradeProfile != nil &&
+		drifted(spec.AutoUpgradeProfile.UpgradeChannel, status.AutoUpgradeProfile.UpgradeChannel) {
+		fields = append(fields, "autoUpgradeProfile.upgradeChannel")
+	}
+	if spec.ApiServerAccessProfile != nil && spec.ApiServerAccessProfile.AuthorizedIPRanges != nil {
+		var observed []string
+		if status.ApiServerAccessProfile != nil {
+			observed = status.ApiServerAccessProfile.AuthorizedIPRanges
+		}
+		if !sets.New(spec.ApiServerAccessProfile.AuthorizedIPRanges...).Equal(sets.New(observed...)) {
+			fields = append(fields, "apiServerAccessProfile.authorizedIPRanges")
+		}
+	}
+	return fields
+}
+
+// drifted returns true when both the desired and observed values are set and differ.
+func drifted[T comparable](desired, observed *T) bool {
+	return desired != nil && observed != nil && *desired != *observed
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedclusters
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
+	asocontainerservicev1hub "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001/storage"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetDriftedFields(t *testing.T) {
+	tests := []struct {
+		name           string
+		managedCluster *asocontainerservicev1hub.ManagedCluster
+		expected       []string
+	}{
+		{
+			name:           "no spec or status",
+			managedCluster: &asocontainerservicev1hub.ManagedCluster{},
+			expected:       nil,
+		},
+		{
+			name: "in sync",
+			managedCluster: &asocontainerservicev1hub.ManagedCluster{
+				Spec: asocontainerservicev1hub.ManagedCluster_Spec{
+					EnableRBAC: ptr.To(true),
+					Sku:        &asocontainerservicev1hub.ManagedClusterSKU{Tier: ptr.To("Standard")},
+					ApiServerAccessProfile: &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: []string{"1.2.3.4/32", "5.6.7.8/32"},
+					},
+				},
+				Status: asocontainerservicev1hub.ManagedCluster_STATUS{
+					EnableRBAC: ptr.To(true),
+					Sku:        &asocontainerservicev1hub.ManagedClusterSKU_STATUS{Tier: ptr.To("Standard")},
+					ApiServerAccessProfile: &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile_STATUS{
+						AuthorizedIPRanges: []string{"5.6.7.8/32", "1.2.3.4/32"},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "fields not set in the spec are ignored",
+			managedCluster: &asocontainerservicev1hub.ManagedCluster{
+				Status: asocontainerservicev1hub.ManagedCluster_STATUS{
+					DisableLocalAccounts: ptr.To(true),
+					AutoUpgradeProfile: &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile_STATUS{
+						UpgradeChannel: ptr.To("rapid"),
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "immutable and defaulted fields are ignored",
+			managedCluster: &asocontainerservicev1hub.ManagedCluster{
+				Spec: asocontainerservicev1hub.ManagedCluster_Spec{
+					EnableRBAC:        ptr.To(true),
+					NodeResourceGroup: ptr.To("node-rg"),
+				},
+				Status: asocontainerservicev1hub.ManagedCluster_STATUS{
+					EnableRBAC:        ptr.To(false),
+					NodeResourceGroup: ptr.To("MC_rg_cluster_eastus"),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "drifted",
+			managedCluster: &asocontainerservicev1hub.ManagedCluster{
+				Spec: asocontainerservicev1hub.ManagedCluster_Spec{
+					DisableLocalAccounts: ptr.To(true),
+					Sku:                  &asocontainerservicev1hub.ManagedClusterSKU{Tier: ptr.To("Standard")},
+					AutoUpgradeProfile: &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile{
+						UpgradeChannel: ptr.To("stable"),
+					},
+					ApiServerAccessProfile: &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: []string{"1.2.3.4/32"},
+					},
+				},
+				Status: asocontainerservicev1hub.ManagedCluster_STATUS{
+					DisableLocalAccounts: ptr.To(false),
+					Sku:                  &asocontainerservicev1hub.ManagedClusterSKU_STATUS{Tier: ptr.To("Free")},
+					AutoUpgradeProfile: &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile_STATUS{
+						UpgradeChannel: ptr.To("rapid"),
+					},
+				},
+			},
+			expected: []string{
+				"disableLocalAccounts",
+				"sku.tier",
+				"autoUpgradeProfile.upgradeChannel",
+				"apiServerAccessProfile.authorizedIPRanges",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getDriftedFields(tc.managedCluster.Spec, tc.managedCluster.Status)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsStatusCurrent(t *testing.T) {
+	tests := []struct {
+		name       string
+		generation int64
+		conditions conditions.Conditions
+		expected   bool
+	}{
+		{
+			name:       "no ready condition",
+			generation: 1,
+			expected:   false,
+		},
+		{
+			name:       "ready condition observed the current generation",
+			generation: 2,
+			conditions: conditions.Conditions{
+				{Type: conditions.ConditionTypeReady, Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			},
+			expected: true,
+		},
+		{
+			name:       "ready condition observed a previous generation",
+			generation: 2,
+			conditions: conditions.Conditions{
+				{Type: conditions.ConditionTypeReady, Status: metav1.ConditionTrue, ObservedGeneration: 1},
+			},
+			expected: false,
+		},
+		{
+			name:       "not ready",
+			generation: 2,
+			conditions: conditions.Conditions{
+				{Type: conditions.ConditionTypeReady, Status: metav1.ConditionFalse, ObservedGeneration: 2},
+			},
+			expected: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			managedCluster := &asocontainerservicev1.ManagedCluster{
+				ObjectMeta: metav1.ObjectMeta{Generation: tc.generation},
+				Status:     asocontainerservicev1.ManagedCluster_STATUS{Conditions: tc.conditions},
+			}
+			g.Expect(isStatusCurrent(managedCluster)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestObservedStatus(t *testing.T) {
+	tests := []struct {
+		name           string
+		managedCluster armcontainerservice.ManagedCluster
+		expected       asocontainerservicev1hub.ManagedCluster_STATUS
+	}{
+		{
+			name:     "no properties",
+			expected: asocontainerservicev1hub.ManagedCluster_STATUS{},
+		},
+		{
+			name: "compared fields",
+			managedCluster: armcontainerservice.ManagedCluster{
+				SKU: &armcontainerservice.ManagedClusterSKU{Tier: ptr.To(armcontainerservice.ManagedClusterSKUTierStandard)},
+				Properties: &armcontainerservice.ManagedClusterProperties{
+					DisableLocalAccounts: ptr.To(true),
+					AutoUpgradeProfile: &armcontainerservice.ManagedClusterAutoUpgradeProfile{
+						UpgradeChannel: ptr.To(armcontainerservice.UpgradeChannelStable),
+					},
+					APIServerAccessProfile: &armcontainerservice.ManagedClusterAPIServerAccessProfile{
+						AuthorizedIPRanges: []*string{ptr.To("1.2.3.4/32")},
+					},
+				},
+			},
+			expected: asocontainerservicev1hub.ManagedCluster_STATUS{
+				DisableLocalAccounts: ptr.To(true),
+				Sku:                  &asocontainerservicev1hub.ManagedClusterSKU_STATUS{Tier: ptr.To("Standard")},
+				AutoUpgradeProfile: &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile_STATUS{
+					UpgradeChannel: ptr.To("stable"),
+				},
+				ApiServerAccessProfile: &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile_STATUS{
+					AuthorizedIPRanges: []string{"1.2.3.4/32"},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(observedStatus(tc.managedCluster)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -19,12 +19,19 @@ package managedclusters
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asocontainerservicev1hub "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001/storage"
+	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231102preview"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -34,7 +41,10 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const (
@@ -47,8 +57,27 @@ const (
 
 	// oidcIssuerProfileUrl is a constant representing the key name for the oidc-issuer-profile-url config map.
 	oidcIssuerProfileURL = "oidc-issuer-profile-url"
+
+	// provisioningStateStarting and provisioningStateStopping are the provisioning states reported by AKS
+	// while a start or stop operation is in progress.
+	provisioningStateStarting = "Starting"
+	provisioningStateStopping = "Stopping"
+
+	// startFutureType and stopFutureType are the future types of the start and stop operations of a managed cluster.
+	startFutureType = "ManagedClusterStart"
+	stopFutureType  = "ManagedClusterStop"
 )
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupManagedCluster)
+}
+
+// powerOperations maps the future type of a power operation to the verb used in messages.
+var powerOperations = map[string]string{
+	startFutureType: "start",
+	stopFutureType:  "stop",
+}
+
 // ManagedClusterScope defines the scope interface for a managed cluster.
 type ManagedClusterScope interface {
 	aso.Scope
@@ -63,29 +92,274 @@ type ManagedClusterScope interface {
 	IsAADEnabled() bool
 	AreLocalAccountsDisabled() bool
 	SetOIDCIssuerProfileStatus(*infrav1.OIDCIssuerProfileStatus)
+	SetWebAppRoutingIdentityObjectID(string)
+	SetIdentityPrincipalID(string)
 	MakeClusterCA() *corev1.Secret
 	StoreClusterInfo(context.Context, []byte) error
 	SetAutoUpgradeVersionStatus(version string)
 	SetVersionStatus(version string)
+	SetAvailableUpgradesStatus(versions []string)
 	IsManagedVersionUpgrade() bool
+	IsManagedClusterDrifted() bool
+	SetManagedClusterDrift(fields []string, corrected bool)
+	SetManagedClusterAdoption(err error)
+	PowerState() infrav1.ManagedClusterPowerState
+	SetPowerStateStatus(infrav1.ManagedClusterPowerState)
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	*aso.Service[genruntime.MetaObject, ManagedClusterScope]
+	Client
 }
 
 // New creates a new service.
-func New(scope ManagedClusterScope) *aso.Service[genruntime.MetaObject, ManagedClusterScope] {
+func New(scope ManagedClusterScope) (*Service, error) {
+	cli, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
 	// genruntime.MetaObject is used here instead of an *asocontainerservicev1.ManagedCluster to better
 	// facilitate returning different API versions.
 	svc := aso.NewService[genruntime.MetaObject](serviceName, scope)
 	svc.Specs = []azure.ASOResourceSpecGetter[genruntime.MetaObject]{scope.ManagedClusterSpec()}
 	svc.ConditionType = infrav1.ManagedClusterRunningCondition
 	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
-	return svc
+	return &Service{
+		Service: svc,
+		Client:  cli,
+	}, nil
+}
+
+// Reconcile starts or stops the managed cluster to match its desired power state, then reconciles the
+// managed cluster through ASO. AKS rejects updates to a stopped cluster, so the ASO resource is paused
+// for as long as the cluster is meant to be stopped.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "managedclusters.Service.Reconcile")
+	defer done()
+
+	spec, ok := s.Scope.ManagedClusterSpec().(*ManagedClusterSpec)
+	if !ok {
+		return s.Service.Reconcile(ctx)
+	}
+
+	timeoutCtx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	desired := s.Scope.PowerState()
+	starting := s.Scope.GetLongRunningOperationState(spec.Name, serviceName, startFutureType) != nil
+	stopping := s.Scope.GetLongRunningOperationState(spec.Name, serviceName, stopFutureType) != nil
+	powerState, provisioningState, exists, err := s.observedPowerState(timeoutCtx, spec, desired == infrav1.ManagedClusterPowerStateStopped || starting || stopping)
+	if err != nil {
+		return errors.Wrap(err, "failed to get managed cluster power state")
+	}
+	if !exists {
+		// The cluster has not been created yet.
+		return s.Service.Reconcile(ctx)
+	}
+	s.Scope.SetPowerStateStatus(infrav1.ManagedClusterPowerState(powerState))
+
+	if !starting && !stopping && (provisioningState == provisioningStateStarting || provisioningState == provisioningStateStopping) {
+		return azure.WithTransientError(errors.Errorf("managed cluster %s is %s", spec.Name, strings.ToLower(provisioningState)), s.Scope.DefaultedReconcilerRequeue())
+	}
+
+	actual := infrav1.ManagedClusterPowerState(powerState)
+	switch {
+	case desired == infrav1.ManagedClusterPowerStateStopped:
+		if err := s.Service.Pause(ctx); err != nil {
+			return err
+		}
+		if actual == infrav1.ManagedClusterPowerStateStopped && !stopping {
+			return nil
+		}
+		log.Info("stopping managed cluster", "name", spec.Name)
+		return setPowerState(timeoutCtx, s.Scope, spec, stopFutureType, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStopResponse], error) {
+			return s.Client.StopAsync(ctx, spec.ResourceGroup, spec.Name, resumeToken)
+		})
+	case actual == infrav1.ManagedClusterPowerStateStopped || starting:
+		log.Info("starting managed cluster", "name", spec.Name)
+		return setPowerState(timeoutCtx, s.Scope, spec, startFutureType, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStartResponse], error) {
+			return s.Client.StartAsync(ctx, spec.ResourceGroup, spec.Name, resumeToken)
+		})
+	}
+
+	// The available upgrades are only reported, so failing to get them does not fail the reconciliation.
+	upgrades, err := s.Client.GetAvailableUpgrades(timeoutCtx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		log.Error(err, "failed to get managed cluster upgrade profile", "name", spec.Name)
+	} else {
+		versions := make([]string, 0, len(upgrades))
+		for _, upgrade := range upgrades {
+			versions = append(versions, fmt.Sprintf("v%s", upgrade))
+		}
+		s.Scope.SetAvailableUpgradesStatus(versions)
+	}
+
+	s.reconcileDrift(timeoutCtx, spec)
+
+	return s.Service.Reconcile(ctx)
+}
+
+// reconcileDrift reports the fields of a cluster reconciled by ASO which were changed in Azure since ASO last
+// applied its spec. ASO corrects them on its next sync, so the cluster is got from Azure directly before that.
+// Drift is only reported, so failing to get it does not fail the reconciliation.
+func (s *Service) reconcileDrift(ctx context.Context, spec *ManagedClusterSpec) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "managedclusters.Service.reconcileDrift")
+	defer done()
+
+	resource := spec.ResourceRef()
+	resource.SetNamespace(s.Scope.ASOOwner().GetNamespace())
+	if err := s.Scope.GetClient().Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		log.Error(err, "failed to get managed cluster resource", "name", spec.Name)
+		return
+	}
+	// The drift of a cluster with the "skip" reconcile policy is observed in its status. The spec of any other
+	// cluster is only compared with Azure once ASO has applied it, so changes made by CAPZ are not drift.
+	if isDriftObservable(resource) || !isStatusCurrent(resource) {
+		return
+	}
+	desired := &asocontainerservicev1hub.ManagedCluster{}
+	if err := resource.(conversion.Convertible).ConvertTo(desired); err != nil {
+		log.Error(err, "failed to convert managed cluster resource", "name", spec.Name)
+		return
+	}
+
+	observed, err := s.Client.Get(ctx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		log.Error(err, "failed to get managed cluster", "name", spec.Name)
+		return
+	}
+	reportDrift(ctx, s.Scope, resource.GetNamespace(), resource.GetName(), getDriftedFields(desired.Spec, observedStatus(observed)), true)
+}
+
+// observedPowerState returns the power state and provisioning state of the managed cluster, and whether it exists.
+// ASO keeps the status of a running cluster up to date, so Azure is only queried when the ASO resource is paused,
+// when its status does not report a running cluster, or when the power state of the cluster is being changed.
+func (s *Service) observedPowerState(ctx context.Context, spec *ManagedClusterSpec, changingPowerState bool) (powerState string, provisioningState string, exists bool, err error) {
+	resource := spec.ResourceRef()
+	resource.SetNamespace(s.Scope.ASOOwner().GetNamespace())
+	if err := s.Scope.GetClient().Get(ctx, client.ObjectKeyFromObject(resource), resource); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", "", false, nil
+		}
+		return "", "", false, errors.Wrap(err, "failed to get managed cluster resource")
+	}
+
+	paused := resource.GetAnnotations()[asoannotations.ReconcilePolicy] == string(asoannotations.ReconcilePolicySkip)
+	if !paused && !changingPowerState {
+		powerState, provisioningState = asoPowerState(resource)
+		if powerState == string(infrav1.ManagedClusterPowerStateRunning) &&
+			provisioningState != provisioningStateStarting && provisioningState != provisioningStateStopping {
+			return powerState, provisioningState, true, nil
+		}
+	}
+
+	powerState, provisioningState, err = s.Client.GetPowerState(ctx, spec.ResourceGroup, spec.Name)
+	if azure.ResourceNotFound(err) {
+		return "", "", false, nil
+	}
+	if err != nil {
+		return "", "", false, err
+	}
+	return powerState, provisioningState, true, nil
+}
+
+// asoPowerState returns the power state and provisioning state last reported in the status of the ASO resource.
+func asoPowerState(resource genruntime.MetaObject) (powerState string, provisioningState string) {
+	switch managedCluster := resource.(type) {
+	case *asocontainerservicev1.ManagedCluster:
+		if managedCluster.Status.PowerState != nil {
+			powerState = string(ptr.Deref(managedCluster.Status.PowerState.Code, ""))
+		}
+		provisioningState = ptr.Deref(managedCluster.Status.ProvisioningState, "")
+	case *asocontainerservicev1preview.ManagedCluster:
+		if managedCluster.Status.PowerState != nil {
+			powerState = string(ptr.Deref(managedCluster.Status.PowerState.Code, ""))
+		}
+		provisioningState = ptr.Deref(managedCluster.Status.ProvisioningState, "")
+	}
+	return powerState, provisioningState
+}
+
+// setPowerState starts or stops the managed cluster. Like the async reconciler, it resumes the operation from the
+// future stored in the scope, and stores a new future if the operation does not complete before the context is done
+// so that it is polled again on the next reconcile. Once the operation is done, the reconcile is requeued so that the
+// new power state of the cluster is observed.
+func setPowerState[T any](ctx context.Context, scope ManagedClusterScope, spec *ManagedClusterSpec, futureType string, begin func(context.Context, string) (*runtime.Poller[T], error)) error {
+	operation := powerOperations[futureType]
+
+	resumeToken := ""
+	if future := scope.GetLongRunningOperationState(spec.Name, serviceName, futureType); future != nil {
+		t, err := converters.FutureToResumeToken(*future)
+		if err != nil {
+			scope.DeleteLongRunningOperationState(spec.Name, serviceName, futureType)
+			return errors.Wrap(err, "could not decode future data, resetting long-running operation state")
+		}
+		resumeToken = t
+	}
+
+	if resumeToken == "" && azure.SkipInDryRun(scope, fmt.Sprintf("dry-run: skipped %s of managed cluster %s/%s", operation, spec.ResourceGroup, spec.Name)) {
+		return nil
+	}
+
+	poller, err := begin(ctx, resumeToken)
+	if poller != nil && azure.IsContextDeadlineExceededOrCanceledError(err) {
+		future, err := converters.PollerToFuture(poller, futureType, serviceName, spec.Name, spec.ResourceGroup)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert poller to future")
+		}
+		scope.SetLongRunningOperationState(future)
+		return azure.WithTransientError(azure.NewOperationNotDoneError(future), scope.DefaultedReconcilerRequeue())
+	}
+
+	// Once the operation is done, delete the long-running operation state. Even if the operation ended with
+	// an error, clear out any lingering state to try the operation again.
+	scope.DeleteLongRunningOperationState(spec.Name, serviceName, futureType)
+	if err != nil {
+		return errors.Wrapf(err, "failed to %s managed cluster", operation)
+	}
+	return azure.WithTransientError(errors.Errorf("managed cluster %s power state changed", spec.Name), scope.DefaultedReconcilerRequeue())
+}
+
+// IdentityScope records the identities of a managed cluster.
+type IdentityScope interface {
+	SetWebAppRoutingIdentityObjectID(string)
+	SetIdentityPrincipalID(string)
+}
+
+// SetIdentities records the principal ID of the system-assigned identity of the managed cluster and the object ID
+// of the identity of its application routing add-on, as reported in the status of its ASO resource.
+func SetIdentities(scope IdentityScope, obj genruntime.MetaObject) error {
+	managedCluster := &asocontainerservicev1hub.ManagedCluster{}
+	if err := obj.(conversion.Convertible).ConvertTo(managedCluster); err != nil {
+		return err
+	}
+	if managedCluster.Status.Identity != nil {
+		scope.SetIdentityPrincipalID(ptr.Deref(managedCluster.Status.Identity.PrincipalId, ""))
+	}
+	// The application routing add-on is only available with the preview API version.
+	if preview, ok := obj.(*asocontainerservicev1preview.ManagedCluster); ok &&
+		preview.Status.IngressProfile != nil &&
+		preview.Status.IngressProfile.WebAppRouting != nil &&
+		preview.Status.IngressProfile.WebAppRouting.Identity != nil {
+		scope.SetWebAppRoutingIdentityObjectID(ptr.Deref(preview.Status.IngressProfile.WebAppRouting.Identity.ObjectId, ""))
+	}
+	return nil
 }
 
 func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterScope, obj genruntime.MetaObject, err error) error {
+	var adoptErr *adoptionError
+	if errors.As(err, &adoptErr) {
+		scope.SetManagedClusterAdoption(adoptErr)
+		return azure.WithTerminalError(err)
+	}
 	if err != nil {
 		return err
 	}
 
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "managedclusters.postCreateOrUpdateResourceHook")
+	defer done()
+
 	managedCluster := &asocontainerservicev1hub.ManagedCluster{}
 	if err := obj.(conversion.Convertible).ConvertTo(managedCluster); err != nil {
 		return err
@@ -121,6 +395,10 @@ func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterSco
 			IssuerURL: managedCluster.Status.OidcIssuerProfile.IssuerURL,
 		})
 	}
+	if err := SetIdentities(scope, obj); err != nil {
+		return err
+	}
+
 	if managedCluster.Status.CurrentKubernetesVersion != nil {
 		currentKubernetesVersion := fmt.Sprintf("v%s", *managedCluster.Status.CurrentKubernetesVersion)
 		scope.SetVersionStatus(currentKubernetesVersion)
@@ -129,6 +407,16 @@ func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterSco
 		}
 	}
 
+	// The status of a cluster with the "skip" reconcile policy is refreshed without applying the spec, so it
+	// reveals drift. Otherwise a change CAPZ has just made would be reported as drift, so the previously
+	// detected drift is kept until the status reflects the latest generation. Drift of other clusters is
+	// observed in Azure by Reconcile.
+	if isDriftObservable(obj) && isStatusCurrent(obj) {
+		reportDrift(ctx, scope, managedCluster.Namespace, managedCluster.Name, getDriftedFields(managedCluster.Spec, managedCluster.Status), false)
+	}
+
+	scope.SetManagedClusterAdoption(nil)
+
 	return nil
 }
 
@@ -19,23 +19,412 @@ package managedclusters
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231102preview"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime/conditions"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util/secret"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso/mock_aso"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters/mock_managedclusters"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	reconcilerutils "sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
+func TestServiceReconcile(t *testing.T) {
+	spec := &ManagedClusterSpec{
+		Name:          "cluster",
+		ResourceGroup: "rg",
+	}
+	reconcileErr := errors.New("reconcile error")
+
+	tests := []struct {
+		name          string
+		noResource    bool
+		existing      *asocontainerservicev1.ManagedCluster
+		expect        func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject])
+		dryRun        bool
+		expectedErr   error
+		wantTransient bool
+	}{
+		{
+			name:       "cluster does not exist yet",
+			noResource: true,
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name:     "cluster does not exist in Azure yet",
+			existing: pausedManagedCluster(),
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("", "", &azcore.ResponseError{StatusCode: http.StatusNotFound})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name: "running cluster reported by ASO is reconciled without getting its power state",
+			existing: &asocontainerservicev1.ManagedCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
+				Status: asocontainerservicev1.ManagedCluster_STATUS{
+					PowerState: &asocontainerservicev1.PowerState_STATUS{
+						Code: ptr.To(asocontainerservicev1.PowerState_Code_STATUS_Running),
+					},
+					ProvisioningState: ptr.To("Succeeded"),
+				},
+			},
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return(nil, nil)
+				s.SetAvailableUpgradesStatus([]string{})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name:     "drift of a running cluster reconciled by ASO is reported from Azure",
+			existing: appliedManagedCluster(),
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return(nil, nil)
+				s.SetAvailableUpgradesStatus([]string{})
+				c.Get(gomockinternal.AContext(), "rg", "cluster").Return(armcontainerservice.ManagedCluster{
+					SKU: &armcontainerservice.ManagedClusterSKU{Tier: ptr.To(armcontainerservice.ManagedClusterSKUTierFree)},
+				}, nil)
+				s.IsManagedClusterDrifted().Return(false)
+				s.SetManagedClusterDrift([]string{"sku.tier"}, true)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name:     "running cluster reconciled by ASO without drift",
+			existing: appliedManagedCluster(),
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return(nil, nil)
+				s.SetAvailableUpgradesStatus([]string{})
+				c.Get(gomockinternal.AContext(), "rg", "cluster").Return(armcontainerservice.ManagedCluster{
+					SKU: &armcontainerservice.ManagedClusterSKU{Tier: ptr.To(armcontainerservice.ManagedClusterSKUTierStandard)},
+				}, nil)
+				s.SetManagedClusterDrift(gomock.Nil(), true)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name: "drift is not observed in Azure before ASO has applied the spec",
+			existing: func() *asocontainerservicev1.ManagedCluster {
+				managedCluster := appliedManagedCluster()
+				managedCluster.Status.Conditions[0].ObservedGeneration = 0
+				return managedCluster
+			}(),
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return(nil, nil)
+				s.SetAvailableUpgradesStatus([]string{})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name: "running cluster is reconciled",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return([]string{"1.29.4", "1.30.0"}, nil)
+				s.SetAvailableUpgradesStatus([]string{"v1.29.4", "v1.30.0"})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name: "running cluster is reconciled when its upgrade profile cannot be read",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.GetAvailableUpgrades(gomockinternal.AContext(), "rg", "cluster").Return(nil, errors.New("upgrade profile error"))
+				r.CreateOrUpdateResource(gomockinternal.AContext(), spec, serviceName).Return(nil, reconcileErr)
+				s.UpdatePutStatus(infrav1.ManagedClusterRunningCondition, serviceName, reconcileErr)
+			},
+			expectedErr: reconcileErr,
+		},
+		{
+			name: "operation in progress",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, _ *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Stopping", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+			},
+			wantTransient: true,
+		},
+		{
+			name: "running cluster is stopped",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateStopped)
+				r.PauseResource(gomockinternal.AContext(), gomock.Any(), serviceName).Return(nil)
+				c.StopAsync(gomockinternal.AContext(), "rg", "cluster", "").Return(nil, nil)
+				s.DeleteLongRunningOperationState("cluster", serviceName, stopFutureType)
+			},
+			wantTransient: true,
+		},
+		{
+			name: "stop which does not complete in time is stored as a future",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateStopped)
+				r.PauseResource(gomockinternal.AContext(), gomock.Any(), serviceName).Return(nil)
+				c.StopAsync(gomockinternal.AContext(), "rg", "cluster", "").Return(fakePoller[armcontainerservice.ManagedClustersClientStopResponse](t), context.DeadlineExceeded)
+				s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{}))
+			},
+			wantTransient: true,
+		},
+		{
+			name: "stop in progress is resumed while the cluster is stopping",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				future, err := converters.PollerToFuture(fakePoller[armcontainerservice.ManagedClustersClientStopResponse](t), stopFutureType, serviceName, "cluster", "rg")
+				if err != nil {
+					t.Fatal(err)
+				}
+				resumeToken, err := converters.FutureToResumeToken(*future)
+				if err != nil {
+					t.Fatal(err)
+				}
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Stopping", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.GetLongRunningOperationState("cluster", serviceName, stopFutureType).Return(future).AnyTimes()
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateStopped)
+				r.PauseResource(gomockinternal.AContext(), gomock.Any(), serviceName).Return(nil)
+				c.StopAsync(gomockinternal.AContext(), "rg", "cluster", resumeToken).Return(nil, nil)
+				s.DeleteLongRunningOperationState("cluster", serviceName, stopFutureType)
+			},
+			wantTransient: true,
+		},
+		{
+			name: "running cluster is not stopped in dry-run mode",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Running", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateRunning)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateStopped)
+				r.PauseResource(gomockinternal.AContext(), gomock.Any(), serviceName).Return(nil)
+			},
+			dryRun: true,
+		},
+		{
+			name: "stopped cluster stays paused",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, r *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Stopped", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateStopped)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateStopped)
+				r.PauseResource(gomockinternal.AContext(), gomock.Any(), serviceName).Return(nil)
+			},
+		},
+		{
+			name: "stopped cluster is started",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, _ *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Stopped", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateStopped)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.StartAsync(gomockinternal.AContext(), "rg", "cluster", "").Return(nil, nil)
+				s.DeleteLongRunningOperationState("cluster", serviceName, startFutureType)
+			},
+			wantTransient: true,
+		},
+		{
+			name: "failed start is returned",
+			expect: func(s *mock_managedclusters.MockManagedClusterScopeMockRecorder, c *mock_managedclusters.MockClientMockRecorder, _ *mock_aso.MockReconcilerMockRecorder[genruntime.MetaObject]) {
+				c.GetPowerState(gomockinternal.AContext(), "rg", "cluster").Return("Stopped", "Succeeded", nil)
+				s.SetPowerStateStatus(infrav1.ManagedClusterPowerStateStopped)
+				s.PowerState().Return(infrav1.ManagedClusterPowerStateRunning)
+				c.StartAsync(gomockinternal.AContext(), "rg", "cluster", "").Return(nil, reconcileErr)
+				s.DeleteLongRunningOperationState("cluster", serviceName, startFutureType)
+			},
+			expectedErr: reconcileErr,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scopeMock := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+			clientMock := mock_managedclusters.NewMockClient(mockCtrl)
+			reconcilerMock := mock_aso.NewMockReconciler[genruntime.MetaObject](mockCtrl)
+
+			sch := apimachineryruntime.NewScheme()
+			g.Expect(asocontainerservicev1.AddToScheme(sch)).To(Succeed())
+			clientBuilder := fakeclient.NewClientBuilder().WithScheme(sch)
+			if !tc.noResource {
+				existing := tc.existing
+				if existing == nil {
+					existing = &asocontainerservicev1.ManagedCluster{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}}
+				}
+				clientBuilder = clientBuilder.WithObjects(existing)
+			}
+
+			scopeMock.EXPECT().GetClient().Return(clientBuilder.Build()).AnyTimes()
+			scopeMock.EXPECT().ClusterName().Return("cluster").AnyTimes()
+			scopeMock.EXPECT().ASOOwner().Return(&infrav1.AzureManagedControlPlane{}).AnyTimes()
+			scopeMock.EXPECT().ManagedClusterSpec().Return(spec).AnyTimes()
+			scopeMock.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(reconcilerutils.DefaultAzureServiceReconcileTimeout).AnyTimes()
+			scopeMock.EXPECT().DefaultedReconcilerRequeue().Return(reconcilerutils.DefaultReconcilerRequeue).AnyTimes()
+			tc.expect(scopeMock.EXPECT(), clientMock.EXPECT(), reconcilerMock.EXPECT())
+			scopeMock.EXPECT().GetLongRunningOperationState(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			var scope ManagedClusterScope = scopeMock
+			dryRunScope := &dryRunManagedClusterScope{MockManagedClusterScope: scopeMock}
+			if tc.dryRun {
+				scope = dryRunScope
+			}
+			svc := aso.NewService[genruntime.MetaObject, ManagedClusterScope](serviceName, scope)
+			svc.Reconciler = reconcilerMock
+			svc.Specs = []azure.ASOResourceSpecGetter[genruntime.MetaObject]{spec}
+			svc.ConditionType = infrav1.ManagedClusterRunningCondition
+			svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
+			s := &Service{
+				Service: svc,
+				Client:  clientMock,
+			}
+
+			err := s.Reconcile(context.Background())
+			switch {
+			case tc.expectedErr != nil:
+				g.Expect(err).To(MatchError(tc.expectedErr))
+			case tc.wantTransient:
+				var reconcileErr azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileErr)).To(BeTrue())
+				g.Expect(reconcileErr.IsTransient()).To(BeTrue())
+			default:
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tc.dryRun {
+				g.Expect(dryRunScope.skipped).To(HaveLen(1))
+			}
+		})
+	}
+}
+
+// pausedManagedCluster returns an ASO managed cluster with the skip reconcile policy, as created by CAPZ before it
+// knows whether the cluster exists in Azure.
+func pausedManagedCluster() *asocontainerservicev1.ManagedCluster {
+	return &asocontainerservicev1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster",
+			Annotations: map[string]string{
+				asoannotations.ReconcilePolicy: string(asoannotations.ReconcilePolicySkip),
+			},
+		},
+	}
+}
+
+// appliedManagedCluster returns a running ASO managed cluster whose spec was applied by ASO.
+func appliedManagedCluster() *asocontainerservicev1.ManagedCluster {
+	return &asocontainerservicev1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "cluster",
+			Generation: 1,
+		},
+		Spec: asocontainerservicev1.ManagedCluster_Spec{
+			Sku: &asocontainerservicev1.ManagedClusterSKU{
+				Tier: ptr.To(asocontainerservicev1.ManagedClusterSKU_Tier_Standard),
+			},
+		},
+		Status: asocontainerservicev1.ManagedCluster_STATUS{
+			PowerState: &asocontainerservicev1.PowerState_STATUS{
+				Code: ptr.To(asocontainerservicev1.PowerState_Code_STATUS_Running),
+			},
+			ProvisioningState: ptr.To("Succeeded"),
+			Conditions: []conditions.Condition{
+				{
+					Type:               conditions.ConditionTypeReady,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+			},
+		},
+	}
+}
+
+// dryRunManagedClusterScope is a ManagedClusterScope in dry-run mode which records the skipped mutations.
+type dryRunManagedClusterScope struct {
+	*mock_managedclusters.MockManagedClusterScope
+	skipped []string
+}
+
+func (s *dryRunManagedClusterScope) IsDryRun() bool {
+	return true
+}
+
+func (s *dryRunManagedClusterScope) RecordDryRunSkip(message string) {
+	s.skipped = append(s.skipped, message)
+}
+
+func fakePoller[T any](t *testing.T) *runtime.Poller[T] {
+	t.Helper()
+	response := &http.Response{
+		Body: io.NopCloser(strings.NewReader("")),
+		Request: &http.Request{
+			Method: http.MethodPut,
+			URL:    &url.URL{Path: "/"},
+		},
+		StatusCode: http.StatusAccepted,
+	}
+	pipeline := runtime.NewPipeline("testmodule", "v0.1.0", runtime.PipelineOptions{}, nil)
+	poller, err := runtime.NewPoller[T](response, pipeline, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return poller
+}
+
 func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 	t.Run("error creating or updating", func(t *testing.T) {
 		g := NewGomegaWithT(t)
@@ -46,10 +435,24 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g.Expect(err).To(HaveOccurred())
 	})
 
+	t.Run("existing cluster cannot be adopted", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		adoptErr := &adoptionError{name: "cluster", fields: []string{"networkPlugin"}}
+		scope.EXPECT().SetManagedClusterAdoption(adoptErr)
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, nil, fmt.Errorf("failed to get desired parameters: %w", adoptErr))
+		var reconcileErr azure.ReconcileError
+		g.Expect(errors.As(err, &reconcileErr)).To(BeTrue())
+		g.Expect(reconcileErr.IsTerminal()).To(BeTrue())
+	})
+
 	t.Run("successful create or update", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 		namespace := "default"
 		scope := setupMockScope(t)
+		scope.EXPECT().SetIdentityPrincipalID("identity-principal-id")
 
 		managedCluster := &asocontainerservicev1.ManagedCluster{
 			ObjectMeta: metav1.ObjectMeta{
@@ -68,6 +471,9 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 					IssuerURL: ptr.To("oidc"),
 				},
 				CurrentKubernetesVersion: ptr.To("1.19.0"),
+				Identity: &asocontainerservicev1.ManagedClusterIdentity_STATUS{
+					PrincipalId: ptr.To("identity-principal-id"),
+				},
 			},
 		}
 
@@ -75,6 +481,54 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 
+	t.Run("drift of a cluster with the skip reconcile policy is reported once ASO has refreshed the status", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		scope := setupMockScope(t)
+		scope.EXPECT().IsManagedClusterDrifted().Return(false)
+		scope.EXPECT().SetManagedClusterDrift([]string{"sku.tier"}, false)
+
+		managedCluster := driftedManagedCluster()
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("drift of a cluster with the skip reconcile policy is kept until ASO has refreshed the status", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		scope := setupMockScope(t)
+
+		managedCluster := driftedManagedCluster()
+		managedCluster.Generation = 3
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("drift of a cluster with the skip reconcile policy is not counted again while it remains drifted", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		scope := setupMockScope(t)
+		scope.EXPECT().IsManagedClusterDrifted().Return(true)
+		scope.EXPECT().SetManagedClusterDrift([]string{"sku.tier"}, false)
+
+		managedCluster := driftedManagedCluster()
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
+	t.Run("drift is not reported from the status of a cluster whose spec is applied by ASO", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		scope := setupMockScope(t)
+
+		// The status of a cluster reconciled by ASO is read back after its spec is applied, so any difference
+		// between them is transient. Its drift is observed in Azure by Reconcile instead.
+		managedCluster := driftedManagedCluster()
+		managedCluster.Annotations = nil
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
 	t.Run("successful create or update, preview enabled", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 		namespace := "default"
@@ -104,6 +558,44 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	})
 
+	t.Run("successful create or update, preview enabled with web app routing", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		namespace := "default"
+		scope := setupMockScope(t)
+		scope.EXPECT().SetWebAppRoutingIdentityObjectID("web-app-routing-object-id")
+
+		managedCluster := &asocontainerservicev1preview.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+			},
+			Spec: asocontainerservicev1preview.ManagedCluster_Spec{
+				KubernetesVersion: ptr.To("1.19.0"),
+				AutoUpgradeProfile: &asocontainerservicev1preview.ManagedClusterAutoUpgradeProfile{
+					UpgradeChannel: ptr.To(asocontainerservicev1preview.ManagedClusterAutoUpgradeProfile_UpgradeChannel_Stable),
+				},
+			},
+			Status: asocontainerservicev1preview.ManagedCluster_STATUS{
+				Fqdn:        ptr.To("fdqn"),
+				PrivateFQDN: ptr.To("private fqdn"),
+				OidcIssuerProfile: &asocontainerservicev1preview.ManagedClusterOIDCIssuerProfile_STATUS{
+					IssuerURL: ptr.To("oidc"),
+				},
+				CurrentKubernetesVersion: ptr.To("1.19.0"),
+				IngressProfile: &asocontainerservicev1preview.ManagedClusterIngressProfile_STATUS{
+					WebAppRouting: &asocontainerservicev1preview.ManagedClusterIngressProfileWebAppRouting_STATUS{
+						Enabled: ptr.To(true),
+						Identity: &asocontainerservicev1preview.UserAssignedIdentity_STATUS{
+							ObjectId: ptr.To("web-app-routing-object-id"),
+						},
+					},
+				},
+			},
+		}
+
+		err := postCreateOrUpdateResourceHook(context.Background(), scope, managedCluster, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	})
+
 	t.Run("private cluster fqdn", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 		mockCtrl := gomock.NewController(t)
@@ -141,6 +633,127 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 	})
 }
 
+func TestReconcileKubeconfig(t *testing.T) {
+	namespace := "default"
+	clusterName := "cluster"
+
+	userKubeconfig, err := clientcmd.Write(clientcmdapi.Config{
+		AuthInfos: map[string]*clientcmdapi.AuthInfo{
+			"user": {
+				Exec: &clientcmdapi.ExecConfig{Command: "kubelogin"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secrets := []*corev1.Secret{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      adminKubeconfigSecretName(clusterName),
+			},
+			Data: map[string][]byte{
+				secret.KubeconfigDataName: []byte("admin credentials"),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      userKubeconfigSecretName(clusterName),
+			},
+			Data: map[string][]byte{
+				secret.KubeconfigDataName: userKubeconfig,
+			},
+		},
+	}
+
+	t.Run("local accounts enabled uses the admin credentials", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		kclient := fakeclient.NewClientBuilder().WithObjects(secrets[0], secrets[1]).Build()
+		scope.EXPECT().GetClient().Return(kclient).AnyTimes()
+		scope.EXPECT().ClusterName().Return(clusterName).AnyTimes()
+		scope.EXPECT().IsAADEnabled().Return(true)
+		scope.EXPECT().AreLocalAccountsDisabled().Return(false)
+
+		admin, user, err := reconcileKubeconfig(context.Background(), scope, namespace)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(admin).To(Equal([]byte("admin credentials")))
+		g.Expect(user).To(Equal(userKubeconfig))
+	})
+
+	t.Run("local accounts disabled uses the user credentials with a token", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+		mockCtrl := gomock.NewController(t)
+		scope := mock_managedclusters.NewMockManagedClusterScope(mockCtrl)
+		// The admin credentials cannot be listed when local accounts are disabled, so they are not stored by ASO.
+		kclient := fakeclient.NewClientBuilder().WithObjects(secrets[1]).Build()
+		scope.EXPECT().GetClient().Return(kclient).AnyTimes()
+		scope.EXPECT().ClusterName().Return(clusterName).AnyTimes()
+		scope.EXPECT().IsAADEnabled().Return(true)
+		scope.EXPECT().AreLocalAccountsDisabled().Return(true)
+		scope.EXPECT().Token().Return(fakeTokenCredential{token: "token"})
+
+		admin, user, err := reconcileKubeconfig(context.Background(), scope, namespace)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(user).To(Equal(userKubeconfig))
+		config, err := clientcmd.Load(admin)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(config.AuthInfos).To(HaveKey("user"))
+		g.Expect(config.AuthInfos["user"].Token).To(Equal("token"))
+		g.Expect(config.AuthInfos["user"].Exec).To(BeNil())
+	})
+}
+
+type fakeTokenCredential struct {
+	token string
+}
+
+func (t fakeTokenCredential) GetToken(_ context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	if len(opts.Scopes) != 1 || opts.Scopes[0] != aadResourceID+"/.default" {
+		return azcore.AccessToken{}, errors.New("unexpected token scopes")
+	}
+	return azcore.AccessToken{Token: t.token}, nil
+}
+
+func driftedManagedCluster() *asocontainerservicev1.ManagedCluster {
+	return &asocontainerservicev1.ManagedCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "default",
+			Generation: 2,
+			Annotations: map[string]string{
+				asoannotations.ReconcilePolicy: string(asoannotations.ReconcilePolicySkip),
+			},
+		},
+		Spec: asocontainerservicev1.ManagedCluster_Spec{
+			KubernetesVersion: ptr.To("1.19.0"),
+			Sku: &asocontainerservicev1.ManagedClusterSKU{
+				Tier: ptr.To(asocontainerservicev1.ManagedClusterSKU_Tier_Standard),
+			},
+		},
+		Status: asocontainerservicev1.ManagedCluster_STATUS{
+			Fqdn:        ptr.To("fdqn"),
+			PrivateFQDN: ptr.To("private fqdn"),
+			OidcIssuerProfile: &asocontainerservicev1.ManagedClusterOIDCIssuerProfile_STATUS{
+				IssuerURL: ptr.To("oidc"),
+			},
+			CurrentKubernetesVersion: ptr.To("1.19.0"),
+			Sku: &asocontainerservicev1.ManagedClusterSKU_STATUS{
+				Tier: ptr.To(asocontainerservicev1.ManagedClusterSKU_Tier_STATUS_Free),
+			},
+			Conditions: []conditions.Condition{
+				{
+					Type:               conditions.ConditionTypeReady,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 2,
+				},
+			},
+		},
+	}
+}
+
 func setupMockScope(t *testing.T) *mock_managedclusters.MockManagedClusterScope {
 	t.Helper()
 	mockCtrl := gomock.NewController(t)
@@ -187,6 +800,7 @@ func setupMockScope(t *testing.T) *mock_managedclusters.MockManagedClusterScope
 	scope.EXPECT().SetVersionStatus("v1.19.0")
 	scope.EXPECT().IsManagedVersionUpgrade().Return(true)
 	scope.EXPECT().SetAutoUpgradeVersionStatus("v1.19.0")
+	scope.EXPECT().SetManagedClusterAdoption(nil)
 
 	return scope
 }
@@ -19,8 +19,9 @@ limitations under the License.
 //
 // Generated by this command:
 //
-//	mockgen -destination client_mock.go -package mock_managedclusters -source ../client.go CredentialGetter
+//	mockgen -destination client_mock.go -package mock_managedclusters -source ../client.go Client
 //
+
 // Package mock_managedclusters is a generated GoMock package.
 package mock_managedclusters
 
@@ -28,58 +29,106 @@ import (
 	context "context"
 	reflect "reflect"
 
+	runtime "github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	armcontainerservice "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
 	gomock "go.uber.org/mock/gomock"
 )
 
-// MockCredentialGetter is a mock of CredentialGetter interface.
-type MockCredentialGetter struct {
+// MockClient is a mock of Client interface.
+type MockClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockCredentialGetterMockRecorder
+	recorder *MockClientMockRecorder
 }
 
-// MockCredentialGetterMockRecorder is the mock recorder for MockCredentialGetter.
-type MockCredentialGetterMockRecorder struct {
-	mock *MockCredentialGetter
+// MockClientMockRecorder is the mock recorder for MockClient.
+type MockClientMockRecorder struct {
+	mock *MockClient
 }
 
-// NewMockCredentialGetter creates a new mock instance.
-func NewMockCredentialGetter(ctrl *gomock.Controller) *MockCredentialGetter {
-	mock := &MockCredentialGetter{ctrl: ctrl}
-	mock.recorder = &MockCredentialGetterMockRecorder{mock}
+// NewMockClient creates a new mock instance.
+func NewMockClient(ctrl *gomock.Controller) *MockClient {
+	mock := &MockClient{ctrl: ctrl}
+	mock.recorder = &MockClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCredentialGetter) EXPECT() *MockCredentialGetterMockRecorder {
+func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// GetCredentials mocks base method.
-func (m *MockCredentialGetter) GetCredentials(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+// Get mocks base method.
+func (m *MockClient) Get(ctx context.Context, resourceGroupName, name string) (armcontainerservice.ManagedCluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(armcontainerservice.ManagedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockClientMockRecorder) Get(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClient)(nil).Get), ctx, resourceGroupName, name)
+}
+
+// GetAvailableUpgrades mocks base method.
+func (m *MockClient) GetAvailableUpgrades(ctx context.Context, resourceGroupName, name string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableUpgrades", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableUpgrades indicates an expected call of GetAvailableUpgrades.
+func (mr *MockClientMockRecorder) GetAvailableUpgrades(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableUpgrades", reflect.TypeOf((*MockClient)(nil).GetAvailableUpgrades), ctx, resourceGroupName, name)
+}
+
+// GetPowerState mocks base method.
+func (m *MockClient) GetPowerState(ctx context.Context, resourceGroupName, name string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPowerState", ctx, resourceGroupName, name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPowerState indicates an expected call of GetPowerState.
+func (mr *MockClientMockRecorder) GetPowerState(ctx, resourceGroupName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPowerState", reflect.TypeOf((*MockClient)(nil).GetPowerState), ctx, resourceGroupName, name)
+}
+
+// StartAsync mocks base method.
+func (m *MockClient) StartAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStartResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "StartAsync", ctx, resourceGroupName, name, resumeToken)
+	ret0, _ := ret[0].(*runtime.Poller[armcontainerservice.ManagedClustersClientStartResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCredentials indicates an expected call of GetCredentials.
-func (mr *MockCredentialGetterMockRecorder) GetCredentials(arg0, arg1, arg2 any) *gomock.Call {
+// StartAsync indicates an expected call of StartAsync.
+func (mr *MockClientMockRecorder) StartAsync(ctx, resourceGroupName, name, resumeToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCredentials", reflect.TypeOf((*MockCredentialGetter)(nil).GetCredentials), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAsync", reflect.TypeOf((*MockClient)(nil).StartAsync), ctx, resourceGroupName, name, resumeToken)
 }
 
-// GetUserCredentials mocks base method.
-func (m *MockCredentialGetter) GetUserCredentials(arg0 context.Context, arg1, arg2 string) ([]byte, error) {
+// StopAsync mocks base method.
+func (m *MockClient) StopAsync(ctx context.Context, resourceGroupName, name, resumeToken string) (*runtime.Poller[armcontainerservice.ManagedClustersClientStopResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCredentials", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]byte)
+	ret := m.ctrl.Call(m, "StopAsync", ctx, resourceGroupName, name, resumeToken)
+	ret0, _ := ret[0].(*runtime.Poller[armcontainerservice.ManagedClustersClientStopResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserCredentials indicates an expected call of GetUserCredentials.
-func (mr *MockCredentialGetterMockRecorder) GetUserCredentials(arg0, arg1, arg2 any) *gomock.Call {
+// StopAsync indicates an expected call of StopAsync.
+func (mr *MockClientMockRecorder) StopAsync(ctx, resourceGroupName, name, resumeToken any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCredentials", reflect.TypeOf((*MockCredentialGetter)(nil).GetUserCredentials), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopAsync", reflect.TypeOf((*MockClient)(nil).StopAsync), ctx, resourceGroupName, name, resumeToken)
 }
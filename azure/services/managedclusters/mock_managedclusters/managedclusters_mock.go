@@ -299,6 +299,20 @@ func (mr *MockManagedClusterScopeMockRecorder) IsAADEnabled() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAADEnabled", reflect.TypeOf((*MockManagedClusterScope)(nil).IsAADEnabled))
 }
 
+// IsManagedClusterDrifted mocks base method.
+func (m *MockManagedClusterScope) IsManagedClusterDrifted() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsManagedClusterDrifted")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsManagedClusterDrifted indicates an expected call of IsManagedClusterDrifted.
+func (mr *MockManagedClusterScopeMockRecorder) IsManagedClusterDrifted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsManagedClusterDrifted", reflect.TypeOf((*MockManagedClusterScope)(nil).IsManagedClusterDrifted))
+}
+
 // IsManagedVersionUpgrade mocks base method.
 func (m *MockManagedClusterScope) IsManagedVersionUpgrade() bool {
 	m.ctrl.T.Helper()
@@ -355,6 +369,20 @@ func (mr *MockManagedClusterScopeMockRecorder) ManagedClusterSpec() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManagedClusterSpec", reflect.TypeOf((*MockManagedClusterScope)(nil).ManagedClusterSpec))
 }
 
+// PowerState mocks base method.
+func (m *MockManagedClusterScope) PowerState() v1beta1.ManagedClusterPowerState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PowerState")
+	ret0, _ := ret[0].(v1beta1.ManagedClusterPowerState)
+	return ret0
+}
+
+// PowerState indicates an expected call of PowerState.
+func (mr *MockManagedClusterScopeMockRecorder) PowerState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerState", reflect.TypeOf((*MockManagedClusterScope)(nil).PowerState))
+}
+
 // SetAdminKubeconfigData mocks base method.
 func (m *MockManagedClusterScope) SetAdminKubeconfigData(arg0 []byte) {
 	m.ctrl.T.Helper()
@@ -379,6 +407,18 @@ func (mr *MockManagedClusterScopeMockRecorder) SetAutoUpgradeVersionStatus(versi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoUpgradeVersionStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetAutoUpgradeVersionStatus), version)
 }
 
+// SetAvailableUpgradesStatus mocks base method.
+func (m *MockManagedClusterScope) SetAvailableUpgradesStatus(versions []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAvailableUpgradesStatus", versions)
+}
+
+// SetAvailableUpgradesStatus indicates an expected call of SetAvailableUpgradesStatus.
+func (mr *MockManagedClusterScopeMockRecorder) SetAvailableUpgradesStatus(versions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailableUpgradesStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetAvailableUpgradesStatus), versions)
+}
+
 // SetControlPlaneEndpoint mocks base method.
 func (m *MockManagedClusterScope) SetControlPlaneEndpoint(arg0 v1beta10.APIEndpoint) {
 	m.ctrl.T.Helper()
@@ -391,6 +431,18 @@ func (mr *MockManagedClusterScopeMockRecorder) SetControlPlaneEndpoint(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetControlPlaneEndpoint", reflect.TypeOf((*MockManagedClusterScope)(nil).SetControlPlaneEndpoint), arg0)
 }
 
+// SetIdentityPrincipalID mocks base method.
+func (m *MockManagedClusterScope) SetIdentityPrincipalID(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetIdentityPrincipalID", arg0)
+}
+
+// SetIdentityPrincipalID indicates an expected call of SetIdentityPrincipalID.
+func (mr *MockManagedClusterScopeMockRecorder) SetIdentityPrincipalID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIdentityPrincipalID", reflect.TypeOf((*MockManagedClusterScope)(nil).SetIdentityPrincipalID), arg0)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockManagedClusterScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -403,6 +455,30 @@ func (mr *MockManagedClusterScopeMockRecorder) SetLongRunningOperationState(arg0
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockManagedClusterScope)(nil).SetLongRunningOperationState), arg0)
 }
 
+// SetManagedClusterAdoption mocks base method.
+func (m *MockManagedClusterScope) SetManagedClusterAdoption(err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetManagedClusterAdoption", err)
+}
+
+// SetManagedClusterAdoption indicates an expected call of SetManagedClusterAdoption.
+func (mr *MockManagedClusterScopeMockRecorder) SetManagedClusterAdoption(err any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagedClusterAdoption", reflect.TypeOf((*MockManagedClusterScope)(nil).SetManagedClusterAdoption), err)
+}
+
+// SetManagedClusterDrift mocks base method.
+func (m *MockManagedClusterScope) SetManagedClusterDrift(fields []string, corrected bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetManagedClusterDrift", fields, corrected)
+}
+
+// SetManagedClusterDrift indicates an expected call of SetManagedClusterDrift.
+func (mr *MockManagedClusterScopeMockRecorder) SetManagedClusterDrift(fields, corrected any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManagedClusterDrift", reflect.TypeOf((*MockManagedClusterScope)(nil).SetManagedClusterDrift), fields, corrected)
+}
+
 // SetOIDCIssuerProfileStatus mocks base method.
 func (m *MockManagedClusterScope) SetOIDCIssuerProfileStatus(arg0 *v1beta1.OIDCIssuerProfileStatus) {
 	m.ctrl.T.Helper()
@@ -415,6 +491,18 @@ func (mr *MockManagedClusterScopeMockRecorder) SetOIDCIssuerProfileStatus(arg0 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOIDCIssuerProfileStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetOIDCIssuerProfileStatus), arg0)
 }
 
+// SetPowerStateStatus mocks base method.
+func (m *MockManagedClusterScope) SetPowerStateStatus(arg0 v1beta1.ManagedClusterPowerState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPowerStateStatus", arg0)
+}
+
+// SetPowerStateStatus indicates an expected call of SetPowerStateStatus.
+func (mr *MockManagedClusterScopeMockRecorder) SetPowerStateStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPowerStateStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetPowerStateStatus), arg0)
+}
+
 // SetUserKubeconfigData mocks base method.
 func (m *MockManagedClusterScope) SetUserKubeconfigData(arg0 []byte) {
 	m.ctrl.T.Helper()
@@ -439,6 +527,18 @@ func (mr *MockManagedClusterScopeMockRecorder) SetVersionStatus(version any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersionStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetVersionStatus), version)
 }
 
+// SetWebAppRoutingIdentityObjectID mocks base method.
+func (m *MockManagedClusterScope) SetWebAppRoutingIdentityObjectID(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWebAppRoutingIdentityObjectID", arg0)
+}
+
+// SetWebAppRoutingIdentityObjectID indicates an expected call of SetWebAppRoutingIdentityObjectID.
+func (mr *MockManagedClusterScopeMockRecorder) SetWebAppRoutingIdentityObjectID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWebAppRoutingIdentityObjectID", reflect.TypeOf((*MockManagedClusterScope)(nil).SetWebAppRoutingIdentityObjectID), arg0)
+}
+
 // StoreClusterInfo mocks base method.
 func (m *MockManagedClusterScope) StoreClusterInfo(arg0 context.Context, arg1 []byte) error {
 	m.ctrl.T.Helper()
@@ -22,10 +22,12 @@ import (
 	"fmt"
 	"net"
 	"sort"
+	"strings"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
 	asocontainerservicev1hub "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001/storage"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231102preview"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -99,6 +101,15 @@ type ManagedClusterSpec struct {
 	// ServiceCIDR is the CIDR block for IP addresses distributed to services
 	ServiceCIDR string
 
+	// IPFamilies are the IP families of the pod and service IP addresses, e.g. IPv4 and IPv6 for dual-stack clusters
+	IPFamilies []string
+
+	// PodCIDRs are the CIDR blocks for IP addresses distributed to pods, one for each IP family
+	PodCIDRs []string
+
+	// ServiceCIDRs are the CIDR blocks for IP addresses distributed to services, one for each IP family
+	ServiceCIDRs []string
+
 	// DNSServiceIP is an IP address assigned to the Kubernetes DNS service
 	DNSServiceIP *string
 
@@ -114,6 +125,9 @@ type ManagedClusterSpec struct {
 	// LoadBalancerProfile is the profile of the cluster load balancer.
 	LoadBalancerProfile *LoadBalancerProfile
 
+	// NATGatewayProfile is the profile of the managed NAT gateway of the cluster.
+	NATGatewayProfile *NATGatewayProfile
+
 	// APIServerAccessProfile is the access profile for AKS API server.
 	APIServerAccessProfile *APIServerAccessProfile
 
@@ -144,17 +158,173 @@ type ManagedClusterSpec struct {
 	// SecurityProfile defines the security profile for the cluster.
 	SecurityProfile *ManagedClusterSecurityProfile
 
+	// SupportPlan is the support plan for the Kubernetes version of the cluster.
+	SupportPlan *infrav1.KubernetesSupportPlan
+
+	// IngressProfile defines the ingress profile for the cluster. It is only applied with the preview API version.
+	IngressProfile *IngressProfile
+
+	// AzureMonitorProfile defines the Azure Monitor profile for the cluster.
+	AzureMonitorProfile *AzureMonitorProfile
+
+	// MetricsProfile defines the metrics profile for the cluster. It is only applied with the preview API version.
+	MetricsProfile *MetricsProfile
+
+	// KubeProxyConfig defines the kube-proxy configuration for the cluster. It is only applied with the preview API version.
+	KubeProxyConfig *KubeProxyConfig
+
+	// ServiceMeshProfile defines the service mesh profile for the cluster.
+	ServiceMeshProfile *ServiceMeshProfile
+
+	// PodIdentityProfile defines the AAD Pod Identity profile for the cluster.
+	PodIdentityProfile *PodIdentityProfile
+
 	// Patches are extra patches to be applied to the ASO resource.
 	Patches []string
 
 	// Preview enables the preview API version.
 	Preview bool
+
+	// Adopt validates that the fields which cannot be changed after creation match an existing AKS cluster
+	// CAPZ did not create before taking over its management.
+	Adopt bool
+}
+
+// ServiceMeshProfile defines the service mesh profile for the cluster.
+type ServiceMeshProfile struct {
+	// Mode is the mode of the service mesh.
+	Mode string
+
+	// IstioRevisions are the Istio control plane revisions.
+	IstioRevisions []string
+
+	// InternalIngressGateway enables the internal Istio ingress gateway.
+	InternalIngressGateway bool
+
+	// ExternalIngressGateway enables the external Istio ingress gateway.
+	ExternalIngressGateway bool
+}
+
+// PodIdentityProfile defines the AAD Pod Identity profile for the cluster.
+type PodIdentityProfile struct {
+	// Enabled enables the AAD Pod Identity add-on.
+	Enabled bool
+
+	// AllowNetworkPluginKubenet allows the add-on to run on clusters using the kubenet network plugin.
+	AllowNetworkPluginKubenet bool
+
+	// UserAssignedIdentities are the pod identities to use in the cluster.
+	UserAssignedIdentities []PodIdentity
+
+	// UserAssignedIdentityExceptions are the pods allowed to access IMDS without being bound to a pod identity.
+	UserAssignedIdentityExceptions []PodIdentityException
+}
+
+// PodIdentity defines a pod identity of the AAD Pod Identity add-on.
+type PodIdentity struct {
+	Name            string
+	Namespace       string
+	BindingSelector string
+	ResourceID      string
+	ClientID        string
+	ObjectID        string
+}
+
+// PodIdentityException defines pods allowed to access IMDS without being bound to a pod identity.
+type PodIdentityException struct {
+	Name      string
+	Namespace string
+	PodLabels map[string]string
+}
+
+// AzureMonitorProfile defines the Azure Monitor profile for the cluster.
+type AzureMonitorProfile struct {
+	// Metrics defines the settings of the Azure Monitor managed service for Prometheus add-on.
+	Metrics *AzureMonitorProfileMetrics
+
+	// ContainerInsights defines the settings of the Container Insights add-on. It is only applied with the preview API version.
+	ContainerInsights *AzureMonitorProfileContainerInsights
+}
+
+// AzureMonitorProfileMetrics defines the settings of the Azure Monitor managed service for Prometheus add-on.
+type AzureMonitorProfileMetrics struct {
+	// Enabled enables the add-on.
+	Enabled bool
+
+	// MetricAnnotationsAllowList is the kube-state-metrics annotation keys allowlist.
+	MetricAnnotationsAllowList *string
+
+	// MetricLabelsAllowlist is the kube-state-metrics label keys allowlist.
+	MetricLabelsAllowlist *string
+}
+
+// AzureMonitorProfileContainerInsights defines the settings of the Azure Monitor Container Insights add-on.
+type AzureMonitorProfileContainerInsights struct {
+	// Enabled enables the add-on.
+	Enabled bool
+
+	// LogAnalyticsWorkspaceResourceID is the resource ID of the Log Analytics workspace.
+	LogAnalyticsWorkspaceResourceID *string
+
+	// WindowsHostLogsEnabled enables the collection of Windows host logs.
+	WindowsHostLogsEnabled *bool
+}
+
+// MetricsProfile defines the metrics profile for the cluster.
+type MetricsProfile struct {
+	// CostAnalysisEnabled enables the cost analysis add-on.
+	CostAnalysisEnabled *bool
+}
+
+// KubeProxyConfig defines the kube-proxy configuration for the cluster.
+type KubeProxyConfig struct {
+	// Enabled deploys kube-proxy on the cluster.
+	Enabled *bool
+
+	// Mode is the kube-proxy mode.
+	Mode *infrav1.KubeProxyMode
+
+	// IPVSConfig defines the IPVS settings.
+	IPVSConfig *KubeProxyIPVSConfig
+}
+
+// KubeProxyIPVSConfig defines the IPVS settings of kube-proxy.
+type KubeProxyIPVSConfig struct {
+	// Scheduler is the IPVS scheduler.
+	Scheduler *infrav1.IPVSScheduler
+
+	// TCPTimeoutSeconds is the timeout value used for idle IPVS TCP sessions in seconds.
+	TCPTimeoutSeconds *int
+
+	// TCPFinTimeoutSeconds is the timeout value used for IPVS TCP sessions after receiving a FIN in seconds.
+	TCPFinTimeoutSeconds *int
+
+	// UDPTimeoutSeconds is the timeout value used for IPVS UDP packets in seconds.
+	UDPTimeoutSeconds *int
 }
 
 // ManagedClusterAutoUpgradeProfile auto upgrade profile for a managed cluster.
 type ManagedClusterAutoUpgradeProfile struct {
 	// UpgradeChannel defines the channel for auto upgrade configuration.
 	UpgradeChannel *infrav1.UpgradeChannel
+
+	// NodeOSUpgradeChannel defines the channel for node OS upgrades.
+	NodeOSUpgradeChannel *infrav1.NodeOSUpgradeChannel
+}
+
+// IngressProfile is the ingress profile for the cluster.
+type IngressProfile struct {
+	// WebAppRouting defines the settings of the application routing add-on.
+	WebAppRouting *IngressProfileWebAppRouting
+}
+
+// IngressProfileWebAppRouting defines the settings of the application routing add-on.
+type IngressProfileWebAppRouting struct {
+	// Enabled enables the application routing add-on.
+	Enabled bool
+
+	// DNSZoneResourceIDs are the resource IDs of the DNS zones managed by the add-on.
+	DNSZoneResourceIDs []string
 }
 
 // HTTPProxyConfig is the HTTP proxy configuration for the cluster.
@@ -220,6 +390,15 @@ type LoadBalancerProfile struct {
 	IdleTimeoutInMinutes *int
 }
 
+// NATGatewayProfile is the profile of the managed NAT gateway of the cluster.
+type NATGatewayProfile struct {
+	// ManagedOutboundIPCount is the desired number of outbound IPs created and managed by Azure for the NAT gateway.
+	ManagedOutboundIPCount *int
+
+	// IdleTimeoutInMinutes is the desired outbound flow idle timeout in minutes.
+	IdleTimeoutInMinutes *int
+}
+
 // APIServerAccessProfile is the access profile for AKS API server.
 type APIServerAccessProfile struct {
 	// AuthorizedIPRanges are the authorized IP Ranges to kubernetes API server.
@@ -230,14 +409,22 @@ type APIServerAccessProfile struct {
 	PrivateDNSZone *string
 	// EnablePrivateClusterPublicFQDN defines whether to create additional public FQDN for private cluster or not.
 	EnablePrivateClusterPublicFQDN *bool
+	// DisableRunCommand defines whether to disable run command for the cluster or not.
+	DisableRunCommand *bool
 }
 
 // AutoScalerProfile parameters to be applied to the cluster-autoscaler when enabled.
 type AutoScalerProfile struct {
 	// BalanceSimilarNodeGroups - Valid values are 'true' and 'false'
 	BalanceSimilarNodeGroups *string
+	// DaemonsetEvictionForEmptyNodes - Whether DaemonSet pods are gracefully terminated from empty nodes. It is only applied with the preview API version.
+	DaemonsetEvictionForEmptyNodes *bool
+	// DaemonsetEvictionForOccupiedNodes - Whether DaemonSet pods are gracefully terminated from non-empty nodes. It is only applied with the preview API version.
+	DaemonsetEvictionForOccupiedNodes *bool
 	// Expander - If not specified, the default is 'random'. See [expanders](https://github.com/kubernetes/autoscaler/blob/master/cluster-autoscaler/FAQ.md#what-are-expanders) for more information.
 	Expander *string
+	// IgnoreDaemonsetsUtilization - Whether DaemonSet pods are ignored when calculating resource utilization for scale down. It is only applied with the preview API version.
+	IgnoreDaemonsetsUtilization *bool
 	// MaxEmptyBulkDelete - The default is 10.
 	MaxEmptyBulkDelete *string
 	// MaxGracefulTerminationSec - The default is 600.
@@ -287,6 +474,9 @@ type ManagedClusterSecurityProfile struct {
 	// ImageCleaner settings for the security profile.
 	ImageCleaner *ManagedClusterSecurityProfileImageCleaner
 
+	// ImageIntegrity settings for the security profile. It is only applied with the preview API version.
+	ImageIntegrity *ManagedClusterSecurityProfileImageIntegrity
+
 	// Workloadidentity enables Kubernetes applications to access Azure cloud resources securely with Azure AD.
 	WorkloadIdentity *ManagedClusterSecurityProfileWorkloadIdentity
 }
@@ -316,6 +506,12 @@ type ManagedClusterSecurityProfileImageCleaner struct {
 	IntervalHours *int
 }
 
+// ManagedClusterSecurityProfileImageIntegrity validates the signatures of container images before they are deployed.
+type ManagedClusterSecurityProfileImageIntegrity struct {
+	// Enabled enables Image Integrity on AKS cluster.
+	Enabled *bool
+}
+
 // ManagedClusterSecurityProfileWorkloadIdentity defines Workload identity settings for the security profile.
 type ManagedClusterSecurityProfileWorkloadIdentity struct {
 	// Enabled enables workload identity.
@@ -417,6 +613,12 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 		existing = hub
 	}
 
+	if s.Adopt && existing != nil && isUnmanaged(existing) {
+		if err := s.validateAdoption(existing); err != nil {
+			return nil, err
+		}
+	}
+
 	managedCluster := existing
 	if managedCluster == nil {
 		managedCluster = &asocontainerservicev1hub.ManagedCluster{
@@ -508,6 +710,12 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 		}
 	}
 
+	if len(s.IPFamilies) > 0 {
+		managedCluster.Spec.NetworkProfile.IpFamilies = s.IPFamilies
+		managedCluster.Spec.NetworkProfile.PodCidrs = s.PodCIDRs
+		managedCluster.Spec.NetworkProfile.ServiceCidrs = s.ServiceCIDRs
+	}
+
 	// OperatorSpec defines how the Secrets generated by ASO should look for the AKS cluster kubeconfigs.
 	// There is no prescribed naming convention that must be followed.
 	managedCluster.Spec.OperatorSpec = &asocontainerservicev1hub.ManagedClusterOperatorSpec{
@@ -567,11 +775,90 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 		managedCluster.Spec.NetworkProfile.LoadBalancerProfile = s.GetLoadBalancerProfile()
 	}
 
+	if s.NATGatewayProfile != nil {
+		managedCluster.Spec.NetworkProfile.NatGatewayProfile = &asocontainerservicev1hub.ManagedClusterNATGatewayProfile{
+			IdleTimeoutInMinutes: s.NATGatewayProfile.IdleTimeoutInMinutes,
+		}
+		if s.NATGatewayProfile.ManagedOutboundIPCount != nil {
+			managedCluster.Spec.NetworkProfile.NatGatewayProfile.ManagedOutboundIPProfile = &asocontainerservicev1hub.ManagedClusterManagedOutboundIPProfile{
+				Count: s.NATGatewayProfile.ManagedOutboundIPCount,
+			}
+		}
+	}
+
+	if s.AzureMonitorProfile != nil {
+		managedCluster.Spec.AzureMonitorProfile = &asocontainerservicev1hub.ManagedClusterAzureMonitorProfile{}
+		if s.AzureMonitorProfile.Metrics != nil {
+			managedCluster.Spec.AzureMonitorProfile.Metrics = &asocontainerservicev1hub.ManagedClusterAzureMonitorProfileMetrics{
+				Enabled: ptr.To(s.AzureMonitorProfile.Metrics.Enabled),
+			}
+			if s.AzureMonitorProfile.Metrics.MetricAnnotationsAllowList != nil || s.AzureMonitorProfile.Metrics.MetricLabelsAllowlist != nil {
+				managedCluster.Spec.AzureMonitorProfile.Metrics.KubeStateMetrics = &asocontainerservicev1hub.ManagedClusterAzureMonitorProfileKubeStateMetrics{
+					MetricAnnotationsAllowList: s.AzureMonitorProfile.Metrics.MetricAnnotationsAllowList,
+					MetricLabelsAllowlist:      s.AzureMonitorProfile.Metrics.MetricLabelsAllowlist,
+				}
+			}
+		}
+	}
+
+	if s.ServiceMeshProfile != nil {
+		managedCluster.Spec.ServiceMeshProfile = &asocontainerservicev1hub.ServiceMeshProfile{
+			Mode: ptr.To(s.ServiceMeshProfile.Mode),
+			Istio: &asocontainerservicev1hub.IstioServiceMesh{
+				Revisions: s.ServiceMeshProfile.IstioRevisions,
+				Components: &asocontainerservicev1hub.IstioComponents{
+					IngressGateways: []asocontainerservicev1hub.IstioIngressGateway{
+						{
+							Mode:    ptr.To(string(asocontainerservicev1.IstioIngressGateway_Mode_Internal)),
+							Enabled: ptr.To(s.ServiceMeshProfile.InternalIngressGateway),
+						},
+						{
+							Mode:    ptr.To(string(asocontainerservicev1.IstioIngressGateway_Mode_External)),
+							Enabled: ptr.To(s.ServiceMeshProfile.ExternalIngressGateway),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if s.PodIdentityProfile != nil {
+		managedCluster.Spec.PodIdentityProfile = &asocontainerservicev1hub.ManagedClusterPodIdentityProfile{
+			Enabled:                   ptr.To(s.PodIdentityProfile.Enabled),
+			AllowNetworkPluginKubenet: ptr.To(s.PodIdentityProfile.AllowNetworkPluginKubenet),
+		}
+		for _, identity := range s.PodIdentityProfile.UserAssignedIdentities {
+			podIdentity := asocontainerservicev1hub.ManagedClusterPodIdentity{
+				Name:      ptr.To(identity.Name),
+				Namespace: ptr.To(identity.Namespace),
+				Identity: &asocontainerservicev1hub.UserAssignedIdentity{
+					ClientId: ptr.To(identity.ClientID),
+					ObjectId: ptr.To(identity.ObjectID),
+					ResourceReference: &genruntime.ResourceReference{
+						ARMID: identity.ResourceID,
+					},
+				},
+			}
+			if identity.BindingSelector != "" {
+				podIdentity.BindingSelector = ptr.To(identity.BindingSelector)
+			}
+			managedCluster.Spec.PodIdentityProfile.UserAssignedIdentities = append(managedCluster.Spec.PodIdentityProfile.UserAssignedIdentities, podIdentity)
+		}
+		for _, exception := range s.PodIdentityProfile.UserAssignedIdentityExceptions {
+			managedCluster.Spec.PodIdentityProfile.UserAssignedIdentityExceptions = append(managedCluster.Spec.PodIdentityProfile.UserAssignedIdentityExceptions, asocontainerservicev1hub.ManagedClusterPodIdentityException{
+				Name:      ptr.To(exception.Name),
+				Namespace: ptr.To(exception.Namespace),
+				PodLabels: exception.PodLabels,
+			})
+		}
+	}
+
 	if s.APIServerAccessProfile != nil {
 		managedCluster.Spec.ApiServerAccessProfile = &asocontainerservicev1hub.ManagedClusterAPIServerAccessProfile{
 			EnablePrivateCluster:           s.APIServerAccessProfile.EnablePrivateCluster,
 			PrivateDNSZone:                 s.APIServerAccessProfile.PrivateDNSZone,
 			EnablePrivateClusterPublicFQDN: s.APIServerAccessProfile.EnablePrivateClusterPublicFQDN,
+			DisableRunCommand:              s.APIServerAccessProfile.DisableRunCommand,
 		}
 
 		if s.APIServerAccessProfile.AuthorizedIPRanges != nil {
@@ -628,10 +915,15 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 
 	if s.AutoUpgradeProfile != nil {
 		managedCluster.Spec.AutoUpgradeProfile = &asocontainerservicev1hub.ManagedClusterAutoUpgradeProfile{
-			UpgradeChannel: (*string)(s.AutoUpgradeProfile.UpgradeChannel),
+			UpgradeChannel:       (*string)(s.AutoUpgradeProfile.UpgradeChannel),
+			NodeOSUpgradeChannel: (*string)(s.AutoUpgradeProfile.NodeOSUpgradeChannel),
 		}
 	}
 
+	if s.SupportPlan != nil {
+		managedCluster.Spec.SupportPlan = ptr.To(string(asocontainerservicev1.KubernetesSupportPlan(*s.SupportPlan)))
+	}
+
 	if s.SecurityProfile != nil {
 		securityProfile := &asocontainerservicev1hub.ManagedClusterSecurityProfile{}
 		if s.SecurityProfile.AzureKeyVaultKms != nil {
@@ -729,6 +1021,75 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 		if err := prev.ConvertFrom(managedCluster); err != nil {
 			return nil, err
 		}
+		if s.IngressProfile != nil && s.IngressProfile.WebAppRouting != nil {
+			prev.Spec.IngressProfile = &asocontainerservicev1preview.ManagedClusterIngressProfile{
+				WebAppRouting: &asocontainerservicev1preview.ManagedClusterIngressProfileWebAppRouting{
+					Enabled: ptr.To(s.IngressProfile.WebAppRouting.Enabled),
+				},
+			}
+			for _, id := range s.IngressProfile.WebAppRouting.DNSZoneResourceIDs {
+				prev.Spec.IngressProfile.WebAppRouting.DnsZoneResourceReferences = append(prev.Spec.IngressProfile.WebAppRouting.DnsZoneResourceReferences,
+					genruntime.ResourceReference{ARMID: id})
+			}
+		}
+		if s.SecurityProfile != nil && s.SecurityProfile.ImageIntegrity != nil {
+			if prev.Spec.SecurityProfile == nil {
+				prev.Spec.SecurityProfile = &asocontainerservicev1preview.ManagedClusterSecurityProfile{}
+			}
+			prev.Spec.SecurityProfile.ImageIntegrity = &asocontainerservicev1preview.ManagedClusterSecurityProfileImageIntegrity{
+				Enabled: s.SecurityProfile.ImageIntegrity.Enabled,
+			}
+		}
+		if s.MetricsProfile != nil && s.MetricsProfile.CostAnalysisEnabled != nil {
+			prev.Spec.MetricsProfile = &asocontainerservicev1preview.ManagedClusterMetricsProfile{
+				CostAnalysis: &asocontainerservicev1preview.ManagedClusterCostAnalysis{
+					Enabled: s.MetricsProfile.CostAnalysisEnabled,
+				},
+			}
+		}
+		if s.AzureMonitorProfile != nil && s.AzureMonitorProfile.ContainerInsights != nil {
+			if prev.Spec.AzureMonitorProfile == nil {
+				prev.Spec.AzureMonitorProfile = &asocontainerservicev1preview.ManagedClusterAzureMonitorProfile{}
+			}
+			containerInsights := s.AzureMonitorProfile.ContainerInsights
+			prev.Spec.AzureMonitorProfile.Logs = &asocontainerservicev1preview.ManagedClusterAzureMonitorProfileLogs{
+				ContainerInsights: &asocontainerservicev1preview.ManagedClusterAzureMonitorProfileContainerInsights{
+					Enabled: ptr.To(containerInsights.Enabled),
+				},
+			}
+			if containerInsights.LogAnalyticsWorkspaceResourceID != nil {
+				prev.Spec.AzureMonitorProfile.Logs.ContainerInsights.LogAnalyticsWorkspaceResourceReference = &genruntime.ResourceReference{
+					ARMID: *containerInsights.LogAnalyticsWorkspaceResourceID,
+				}
+			}
+			if containerInsights.WindowsHostLogsEnabled != nil {
+				prev.Spec.AzureMonitorProfile.Logs.ContainerInsights.WindowsHostLogs = &asocontainerservicev1preview.ManagedClusterAzureMonitorProfileWindowsHostLogs{
+					Enabled: containerInsights.WindowsHostLogsEnabled,
+				}
+			}
+		}
+		if autoScalerProfile := s.AutoScalerProfile; autoScalerProfile != nil && prev.Spec.AutoScalerProfile != nil {
+			prev.Spec.AutoScalerProfile.DaemonsetEvictionForEmptyNodes = autoScalerProfile.DaemonsetEvictionForEmptyNodes
+			prev.Spec.AutoScalerProfile.DaemonsetEvictionForOccupiedNodes = autoScalerProfile.DaemonsetEvictionForOccupiedNodes
+			prev.Spec.AutoScalerProfile.IgnoreDaemonsetsUtilization = autoScalerProfile.IgnoreDaemonsetsUtilization
+		}
+		if s.KubeProxyConfig != nil {
+			if prev.Spec.NetworkProfile == nil {
+				prev.Spec.NetworkProfile = &asocontainerservicev1preview.ContainerServiceNetworkProfile{}
+			}
+			prev.Spec.NetworkProfile.KubeProxyConfig = &asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig{
+				Enabled: s.KubeProxyConfig.Enabled,
+				Mode:    azure.AliasOrNil[asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_Mode]((*string)(s.KubeProxyConfig.Mode)),
+			}
+			if ipvs := s.KubeProxyConfig.IPVSConfig; ipvs != nil {
+				prev.Spec.NetworkProfile.KubeProxyConfig.IpvsConfig = &asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_IpvsConfig{
+					Scheduler:            azure.AliasOrNil[asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_IpvsConfig_Scheduler]((*string)(ipvs.Scheduler)),
+					TcpFinTimeoutSeconds: ipvs.TCPFinTimeoutSeconds,
+					TcpTimeoutSeconds:    ipvs.TCPTimeoutSeconds,
+					UdpTimeoutSeconds:    ipvs.UDPTimeoutSeconds,
+				}
+			}
+		}
 		if existing != nil {
 			prev.Status = existingStatus
 		}
@@ -820,6 +1181,51 @@ func (s *ManagedClusterSpec) WasManaged(_ genruntime.MetaObject) bool {
 	return true
 }
 
+// isUnmanaged returns whether ASO found an existing cluster in Azure that it is not yet reconciling.
+func isUnmanaged(existing *asocontainerservicev1hub.ManagedCluster) bool {
+	return existing.GetAnnotations()[asoannotations.ReconcilePolicy] == string(asoannotations.ReconcilePolicySkip) &&
+		existing.Status.ProvisioningState != nil
+}
+
+// validateAdoption checks that the fields which cannot be changed after an AKS cluster is created match
+// the existing cluster being adopted.
+func (s *ManagedClusterSpec) validateAdoption(existing *asocontainerservicev1hub.ManagedCluster) error {
+	var mismatched []string
+	mismatch := func(name string, desired string, actual *string) {
+		if desired != "" && actual != nil && !strings.EqualFold(desired, *actual) {
+			mismatched = append(mismatched, name)
+		}
+	}
+
+	mismatch("location", s.Location, existing.Status.Location)
+	mismatch("nodeResourceGroup", s.NodeResourceGroup, existing.Status.NodeResourceGroup)
+	mismatch("dnsPrefix", ptr.Deref(s.DNSPrefix, ""), existing.Status.DnsPrefix)
+	if networkProfile := existing.Status.NetworkProfile; networkProfile != nil {
+		mismatch("networkPlugin", s.NetworkPlugin, networkProfile.NetworkPlugin)
+		mismatch("loadBalancerSKU", s.LoadBalancerSKU, networkProfile.LoadBalancerSku)
+		mismatch("podCIDR", s.PodCIDR, networkProfile.PodCidr)
+		mismatch("serviceCIDR", s.ServiceCIDR, networkProfile.ServiceCidr)
+		mismatch("dnsServiceIP", ptr.Deref(s.DNSServiceIP, ""), networkProfile.DnsServiceIP)
+	}
+
+	if len(mismatched) > 0 {
+		return &adoptionError{name: s.Name, fields: mismatched}
+	}
+	return nil
+}
+
+// adoptionError is returned when an existing AKS cluster cannot be adopted because fields that cannot be
+// changed after creation do not match the existing cluster.
+type adoptionError struct {
+	name   string
+	fields []string
+}
+
+// Error returns the error message for an adoptionError.
+func (e *adoptionError) Error() string {
+	return fmt.Sprintf("cannot adopt managed cluster %s: %s do not match the existing cluster", e.name, strings.Join(e.fields, ", "))
+}
+
 var _ aso.TagsGetterSetter[genruntime.MetaObject] = (*ManagedClusterSpec)(nil)
 
 // GetAdditionalTags implements aso.TagsGetterSetter.
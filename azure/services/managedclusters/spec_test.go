@@ -22,10 +22,13 @@ import (
 	"testing"
 
 	asocontainerservicev1 "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001"
+	asocontainerservicev1hub "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231001/storage"
 	asocontainerservicev1preview "github.com/Azure/azure-service-operator/v2/api/containerservice/v1api20231102preview"
+	asoannotations "github.com/Azure/azure-service-operator/v2/pkg/common/annotations"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/util/secret"
 
@@ -91,12 +94,21 @@ func TestParameters(t *testing.T) {
 			},
 			APIServerAccessProfile: &APIServerAccessProfile{
 				AuthorizedIPRanges: []string{"authorized ip ranges"},
+				DisableRunCommand:  ptr.To(true),
 			},
 			AutoScalerProfile: &AutoScalerProfile{
 				Expander: ptr.To("expander"),
 			},
 			AutoUpgradeProfile: &ManagedClusterAutoUpgradeProfile{
-				UpgradeChannel: ptr.To(infrav1.UpgradeChannelRapid),
+				UpgradeChannel:       ptr.To(infrav1.UpgradeChannelRapid),
+				NodeOSUpgradeChannel: ptr.To(infrav1.NodeOSUpgradeChannelNodeImage),
+			},
+			AzureMonitorProfile: &AzureMonitorProfile{
+				Metrics: &AzureMonitorProfileMetrics{
+					Enabled:                    true,
+					MetricAnnotationsAllowList: ptr.To("pods=[kubernetes.io/team]"),
+					MetricLabelsAllowlist:      ptr.To("pods=[app]"),
+				},
 			},
 			Identity: &infrav1.Identity{
 				Type:                           infrav1.ManagedControlPlaneIdentityType(asocontainerservicev1.ManagedClusterIdentity_Type_UserAssigned),
@@ -131,6 +143,7 @@ func TestParameters(t *testing.T) {
 					Enabled: ptr.To(true),
 				},
 			},
+			SupportPlan: ptr.To(infrav1.KubernetesSupportPlanAKSLongTermSupport),
 		}
 
 		expected := &asocontainerservicev1.ManagedCluster{
@@ -166,12 +179,23 @@ func TestParameters(t *testing.T) {
 				},
 				ApiServerAccessProfile: &asocontainerservicev1.ManagedClusterAPIServerAccessProfile{
 					AuthorizedIPRanges: []string{"authorized ip ranges"},
+					DisableRunCommand:  ptr.To(true),
 				},
 				AutoScalerProfile: &asocontainerservicev1.ManagedClusterProperties_AutoScalerProfile{
 					Expander: ptr.To(asocontainerservicev1.ManagedClusterProperties_AutoScalerProfile_Expander("expander")),
 				},
 				AutoUpgradeProfile: &asocontainerservicev1.ManagedClusterAutoUpgradeProfile{
-					UpgradeChannel: ptr.To(asocontainerservicev1.ManagedClusterAutoUpgradeProfile_UpgradeChannel_Rapid),
+					UpgradeChannel:       ptr.To(asocontainerservicev1.ManagedClusterAutoUpgradeProfile_UpgradeChannel_Rapid),
+					NodeOSUpgradeChannel: ptr.To(asocontainerservicev1.ManagedClusterAutoUpgradeProfile_NodeOSUpgradeChannel_NodeImage),
+				},
+				AzureMonitorProfile: &asocontainerservicev1.ManagedClusterAzureMonitorProfile{
+					Metrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileMetrics{
+						Enabled: ptr.To(true),
+						KubeStateMetrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileKubeStateMetrics{
+							MetricAnnotationsAllowList: ptr.To("pods=[kubernetes.io/team]"),
+							MetricLabelsAllowlist:      ptr.To("pods=[app]"),
+						},
+					},
 				},
 				AzureName:            "name",
 				DisableLocalAccounts: ptr.To(true),
@@ -297,6 +321,7 @@ func TestParameters(t *testing.T) {
 						Enabled: ptr.To(true),
 					},
 				},
+				SupportPlan: ptr.To(asocontainerservicev1.KubernetesSupportPlan_AKSLongTermSupport),
 			},
 		}
 
@@ -331,6 +356,290 @@ func TestParameters(t *testing.T) {
 		g.Expect(ok).To(BeTrue())
 	})
 
+	t.Run("preview managed cluster with web app routing", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		dnsZoneID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Network/dnszones/example.com"
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			IngressProfile: &IngressProfile{
+				WebAppRouting: &IngressProfileWebAppRouting{
+					Enabled:            true,
+					DNSZoneResourceIDs: []string{dnsZoneID},
+				},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.IngressProfile).To(Equal(&asocontainerservicev1preview.ManagedClusterIngressProfile{
+			WebAppRouting: &asocontainerservicev1preview.ManagedClusterIngressProfileWebAppRouting{
+				Enabled: ptr.To(true),
+				DnsZoneResourceReferences: []genruntime.ResourceReference{
+					{ARMID: dnsZoneID},
+				},
+			},
+		}))
+	})
+
+	t.Run("preview managed cluster with image integrity", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			SecurityProfile: &ManagedClusterSecurityProfile{
+				ImageIntegrity: &ManagedClusterSecurityProfileImageIntegrity{
+					Enabled: ptr.To(true),
+				},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.SecurityProfile).NotTo(BeNil())
+		g.Expect(prev.Spec.SecurityProfile.ImageIntegrity).To(Equal(&asocontainerservicev1preview.ManagedClusterSecurityProfileImageIntegrity{
+			Enabled: ptr.To(true),
+		}))
+	})
+
+	t.Run("preview managed cluster with cost analysis", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			MetricsProfile: &MetricsProfile{
+				CostAnalysisEnabled: ptr.To(true),
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.MetricsProfile).To(Equal(&asocontainerservicev1preview.ManagedClusterMetricsProfile{
+			CostAnalysis: &asocontainerservicev1preview.ManagedClusterCostAnalysis{
+				Enabled: ptr.To(true),
+			},
+		}))
+	})
+
+	t.Run("preview managed cluster with autoscaler DaemonSet settings", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			AutoScalerProfile: &AutoScalerProfile{
+				DaemonsetEvictionForEmptyNodes:    ptr.To(true),
+				DaemonsetEvictionForOccupiedNodes: ptr.To(false),
+				IgnoreDaemonsetsUtilization:       ptr.To(true),
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.AutoScalerProfile).NotTo(BeNil())
+		g.Expect(prev.Spec.AutoScalerProfile.DaemonsetEvictionForEmptyNodes).To(Equal(ptr.To(true)))
+		g.Expect(prev.Spec.AutoScalerProfile.DaemonsetEvictionForOccupiedNodes).To(Equal(ptr.To(false)))
+		g.Expect(prev.Spec.AutoScalerProfile.IgnoreDaemonsetsUtilization).To(Equal(ptr.To(true)))
+	})
+
+	t.Run("preview managed cluster with container insights", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		workspaceID := "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.OperationalInsights/workspaces/workspace"
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			AzureMonitorProfile: &AzureMonitorProfile{
+				ContainerInsights: &AzureMonitorProfileContainerInsights{
+					Enabled:                         true,
+					LogAnalyticsWorkspaceResourceID: ptr.To(workspaceID),
+					WindowsHostLogsEnabled:          ptr.To(true),
+				},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.AzureMonitorProfile).NotTo(BeNil())
+		g.Expect(prev.Spec.AzureMonitorProfile.Logs).NotTo(BeNil())
+		g.Expect(prev.Spec.AzureMonitorProfile.Logs.ContainerInsights).To(Equal(&asocontainerservicev1preview.ManagedClusterAzureMonitorProfileContainerInsights{
+			Enabled: ptr.To(true),
+			LogAnalyticsWorkspaceResourceReference: &genruntime.ResourceReference{
+				ARMID: workspaceID,
+			},
+			WindowsHostLogs: &asocontainerservicev1preview.ManagedClusterAzureMonitorProfileWindowsHostLogs{
+				Enabled: ptr.To(true),
+			},
+		}))
+	})
+
+	t.Run("preview managed cluster with kube-proxy config", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:    "name",
+			Preview: true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			KubeProxyConfig: &KubeProxyConfig{
+				Enabled: ptr.To(true),
+				Mode:    ptr.To(infrav1.KubeProxyModeIPVS),
+				IPVSConfig: &KubeProxyIPVSConfig{
+					Scheduler:            ptr.To(infrav1.IPVSSchedulerLeastConnection),
+					TCPTimeoutSeconds:    ptr.To(900),
+					TCPFinTimeoutSeconds: ptr.To(120),
+					UDPTimeoutSeconds:    ptr.To(300),
+				},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		prev, ok := actual.(*asocontainerservicev1preview.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(prev.Spec.NetworkProfile).NotTo(BeNil())
+		g.Expect(prev.Spec.NetworkProfile.KubeProxyConfig).To(Equal(&asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig{
+			Enabled: ptr.To(true),
+			Mode:    ptr.To(asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_Mode_IPVS),
+			IpvsConfig: &asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_IpvsConfig{
+				Scheduler:            ptr.To(asocontainerservicev1preview.ContainerServiceNetworkProfile_KubeProxyConfig_IpvsConfig_Scheduler_LeastConnection),
+				TcpTimeoutSeconds:    ptr.To(900),
+				TcpFinTimeoutSeconds: ptr.To(120),
+				UdpTimeoutSeconds:    ptr.To(300),
+			},
+		}))
+	})
+
+	t.Run("managed cluster with Istio service mesh", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name: "name",
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			ServiceMeshProfile: &ServiceMeshProfile{
+				Mode:                   "Istio",
+				IstioRevisions:         []string{"asm-1-21", "asm-1-22"},
+				ExternalIngressGateway: true,
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		managedCluster, ok := actual.(*asocontainerservicev1.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(managedCluster.Spec.ServiceMeshProfile).To(Equal(&asocontainerservicev1.ServiceMeshProfile{
+			Mode: ptr.To(asocontainerservicev1.ServiceMeshProfile_Mode_Istio),
+			Istio: &asocontainerservicev1.IstioServiceMesh{
+				Revisions: []string{"asm-1-21", "asm-1-22"},
+				Components: &asocontainerservicev1.IstioComponents{
+					IngressGateways: []asocontainerservicev1.IstioIngressGateway{
+						{
+							Mode:    ptr.To(asocontainerservicev1.IstioIngressGateway_Mode_Internal),
+							Enabled: ptr.To(false),
+						},
+						{
+							Mode:    ptr.To(asocontainerservicev1.IstioIngressGateway_Mode_External),
+							Enabled: ptr.To(true),
+						},
+					},
+				},
+			},
+		}))
+	})
+
+	t.Run("managed cluster with AAD Pod Identity", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name: "name",
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+			PodIdentityProfile: &PodIdentityProfile{
+				Enabled:                   true,
+				AllowNetworkPluginKubenet: true,
+				UserAssignedIdentities: []PodIdentity{
+					{
+						Name:            "my-identity",
+						Namespace:       "default",
+						BindingSelector: "my-selector",
+						ResourceID:      "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+						ClientID:        "00000000-0000-0000-0000-000000000001",
+						ObjectID:        "00000000-0000-0000-0000-000000000002",
+					},
+				},
+				UserAssignedIdentityExceptions: []PodIdentityException{
+					{
+						Name:      "my-exception",
+						Namespace: "kube-system",
+						PodLabels: map[string]string{"app": "my-app"},
+					},
+				},
+			},
+		}
+
+		actual, err := spec.Parameters(context.Background(), nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		managedCluster, ok := actual.(*asocontainerservicev1.ManagedCluster)
+		g.Expect(ok).To(BeTrue())
+		g.Expect(managedCluster.Spec.PodIdentityProfile).To(Equal(&asocontainerservicev1.ManagedClusterPodIdentityProfile{
+			Enabled:                   ptr.To(true),
+			AllowNetworkPluginKubenet: ptr.To(true),
+			UserAssignedIdentities: []asocontainerservicev1.ManagedClusterPodIdentity{
+				{
+					Name:            ptr.To("my-identity"),
+					Namespace:       ptr.To("default"),
+					BindingSelector: ptr.To("my-selector"),
+					Identity: &asocontainerservicev1.UserAssignedIdentity{
+						ClientId: ptr.To("00000000-0000-0000-0000-000000000001"),
+						ObjectId: ptr.To("00000000-0000-0000-0000-000000000002"),
+						ResourceReference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+						},
+					},
+				},
+			},
+			UserAssignedIdentityExceptions: []asocontainerservicev1.ManagedClusterPodIdentityException{
+				{
+					Name:      ptr.To("my-exception"),
+					Namespace: ptr.To("kube-system"),
+					PodLabels: map[string]string{"app": "my-app"},
+				},
+			},
+		}))
+	})
+
 	t.Run("with existing managed cluster", func(t *testing.T) {
 		g := NewGomegaWithT(t)
 
@@ -395,6 +704,225 @@ func TestParameters(t *testing.T) {
 		g.Expect(actual.Spec.NetworkProfile.DnsServiceIP).To(Equal(ptr.To("123.200.198.99")))
 		g.Expect(actual.Spec.NetworkProfile.ServiceCidr).To(Equal(ptr.To("123.200.198.0/10")))
 	})
+
+	t.Run("dual-stack managed cluster", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			DNSPrefix:    ptr.To("managed by CAPZ"),
+			PodCIDR:      "10.244.0.0/16",
+			ServiceCIDR:  "10.0.0.0/16",
+			IPFamilies:   []string{"IPv4", "IPv6"},
+			PodCIDRs:     []string{"10.244.0.0/16", "fd12:3456:789a::/64"},
+			ServiceCIDRs: []string{"10.0.0.0/16", "fd12:3456:789a:1::/108"},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				AgentPoolProfiles: []asocontainerservicev1.ManagedClusterAgentPoolProfile_STATUS{},
+			},
+		}
+
+		actualObj, err := spec.Parameters(context.Background(), existing)
+		g.Expect(err).NotTo(HaveOccurred())
+		actual := actualObj.(*asocontainerservicev1.ManagedCluster)
+
+		g.Expect(actual.Spec.NetworkProfile.IpFamilies).To(HaveExactElements(BeEquivalentTo("IPv4"), BeEquivalentTo("IPv6")))
+		g.Expect(actual.Spec.NetworkProfile.PodCidrs).To(Equal([]string{"10.244.0.0/16", "fd12:3456:789a::/64"}))
+		g.Expect(actual.Spec.NetworkProfile.ServiceCidrs).To(Equal([]string{"10.0.0.0/16", "fd12:3456:789a:1::/108"}))
+		g.Expect(actual.Spec.NetworkProfile.PodCidr).To(Equal(ptr.To("10.244.0.0/16")))
+		g.Expect(actual.Spec.NetworkProfile.ServiceCidr).To(Equal(ptr.To("10.0.0.0/16")))
+		g.Expect(actual.Spec.NetworkProfile.DnsServiceIP).To(Equal(ptr.To("10.0.0.10")))
+	})
+
+	t.Run("updating existing managed cluster from managed outbound IPs to explicit outbound IPs", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		publicIPID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/egress"
+		spec := &ManagedClusterSpec{
+			LoadBalancerProfile: &LoadBalancerProfile{
+				OutboundIPs: []string{publicIPID},
+			},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Spec: asocontainerservicev1.ManagedCluster_Spec{
+				NetworkProfile: &asocontainerservicev1.ContainerServiceNetworkProfile{
+					LoadBalancerProfile: &asocontainerservicev1.ManagedClusterLoadBalancerProfile{
+						ManagedOutboundIPs: &asocontainerservicev1.ManagedClusterLoadBalancerProfile_ManagedOutboundIPs{
+							Count: ptr.To(2),
+						},
+					},
+				},
+			},
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				AgentPoolProfiles: []asocontainerservicev1.ManagedClusterAgentPoolProfile_STATUS{},
+			},
+		}
+
+		actualObj, err := spec.Parameters(context.Background(), existing)
+		g.Expect(err).NotTo(HaveOccurred())
+		actual := actualObj.(*asocontainerservicev1.ManagedCluster)
+
+		g.Expect(actual.Spec.NetworkProfile.LoadBalancerProfile).To(Equal(&asocontainerservicev1.ManagedClusterLoadBalancerProfile{
+			OutboundIPs: &asocontainerservicev1.ManagedClusterLoadBalancerProfile_OutboundIPs{
+				PublicIPs: []asocontainerservicev1.ResourceReference{
+					{Reference: &genruntime.ResourceReference{ARMID: publicIPID}},
+				},
+			},
+		}))
+	})
+
+	t.Run("managed cluster with a managed NAT gateway profile", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			OutboundType: ptr.To(infrav1.ManagedControlPlaneOutboundTypeManagedNATGateway),
+			NATGatewayProfile: &NATGatewayProfile{
+				ManagedOutboundIPCount: ptr.To(4),
+				IdleTimeoutInMinutes:   ptr.To(10),
+			},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				AgentPoolProfiles: []asocontainerservicev1.ManagedClusterAgentPoolProfile_STATUS{},
+			},
+		}
+
+		actualObj, err := spec.Parameters(context.Background(), existing)
+		g.Expect(err).NotTo(HaveOccurred())
+		actual := actualObj.(*asocontainerservicev1.ManagedCluster)
+
+		g.Expect(actual.Spec.NetworkProfile.NatGatewayProfile).To(Equal(&asocontainerservicev1.ManagedClusterNATGatewayProfile{
+			IdleTimeoutInMinutes: ptr.To(10),
+			ManagedOutboundIPProfile: &asocontainerservicev1.ManagedClusterManagedOutboundIPProfile{
+				Count: ptr.To(4),
+			},
+		}))
+	})
+
+	t.Run("adopting an existing managed cluster", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:          "name",
+			Location:      "eastus",
+			NetworkPlugin: "kubenet",
+			PodCIDR:       "10.244.0.0/16",
+			Adopt:         true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					asoannotations.ReconcilePolicy: string(asoannotations.ReconcilePolicySkip),
+				},
+			},
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				Location:          ptr.To("eastus"),
+				ProvisioningState: ptr.To("Succeeded"),
+				NetworkProfile: &asocontainerservicev1.ContainerServiceNetworkProfile_STATUS{
+					NetworkPlugin: ptr.To(asocontainerservicev1.ContainerServiceNetworkProfile_NetworkPlugin_STATUS_Kubenet),
+					PodCidr:       ptr.To("10.244.0.0/16"),
+				},
+			},
+		}
+
+		_, err := spec.Parameters(context.Background(), existing)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(spec.WasManaged(existing)).To(BeTrue())
+	})
+
+	t.Run("adopting an existing managed cluster with a different network plugin", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		spec := &ManagedClusterSpec{
+			Name:          "name",
+			Location:      "eastus",
+			NetworkPlugin: "azure",
+			Adopt:         true,
+			GetAllAgentPools: func() ([]azure.ASOResourceSpecGetter[genruntime.MetaObject], error) {
+				return nil, nil
+			},
+		}
+		existing := &asocontainerservicev1.ManagedCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{
+					asoannotations.ReconcilePolicy: string(asoannotations.ReconcilePolicySkip),
+				},
+			},
+			Status: asocontainerservicev1.ManagedCluster_STATUS{
+				Location:          ptr.To("eastus"),
+				ProvisioningState: ptr.To("Succeeded"),
+				NetworkProfile: &asocontainerservicev1.ContainerServiceNetworkProfile_STATUS{
+					NetworkPlugin: ptr.To(asocontainerservicev1.ContainerServiceNetworkProfile_NetworkPlugin_STATUS_Kubenet),
+				},
+			},
+		}
+
+		_, err := spec.Parameters(context.Background(), existing)
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(err.Error()).To(ContainSubstring("networkPlugin do not match the existing cluster"))
+	})
+}
+
+func TestGetLoadBalancerProfile(t *testing.T) {
+	publicIPID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/egress"
+	publicIPPrefixID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPPrefixes/egress"
+	tests := []struct {
+		name     string
+		profile  *LoadBalancerProfile
+		expected *asocontainerservicev1hub.ManagedClusterLoadBalancerProfile
+	}{
+		{
+			name: "managed outbound IP count",
+			profile: &LoadBalancerProfile{
+				ManagedOutboundIPs:     ptr.To(3),
+				AllocatedOutboundPorts: ptr.To(1024),
+				IdleTimeoutInMinutes:   ptr.To(10),
+			},
+			expected: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile{
+				ManagedOutboundIPs: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile_ManagedOutboundIPs{
+					Count: ptr.To(3),
+				},
+				AllocatedOutboundPorts: ptr.To(1024),
+				IdleTimeoutInMinutes:   ptr.To(10),
+			},
+		},
+		{
+			name: "explicit outbound IPs",
+			profile: &LoadBalancerProfile{
+				OutboundIPs: []string{publicIPID},
+			},
+			expected: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile{
+				OutboundIPs: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile_OutboundIPs{
+					PublicIPs: []asocontainerservicev1hub.ResourceReference{
+						{Reference: &genruntime.ResourceReference{ARMID: publicIPID}},
+					},
+				},
+			},
+		},
+		{
+			name: "outbound IP prefixes",
+			profile: &LoadBalancerProfile{
+				OutboundIPPrefixes: []string{publicIPPrefixID},
+			},
+			expected: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile{
+				OutboundIPPrefixes: &asocontainerservicev1hub.ManagedClusterLoadBalancerProfile_OutboundIPPrefixes{
+					PublicIPPrefixes: []asocontainerservicev1hub.ResourceReference{
+						{Reference: &genruntime.ResourceReference{ARMID: publicIPPrefixID}},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			spec := &ManagedClusterSpec{LoadBalancerProfile: tc.profile}
+			g.Expect(spec.GetLoadBalancerProfile()).To(Equal(tc.expected))
+		})
+	}
 }
 
 func TestOIDCIssuerURLConfigMap(t *testing.T) {
@@ -30,14 +30,15 @@ import (
 
 // NatGatewaySpec defines the specification for a NAT gateway.
 type NatGatewaySpec struct {
-	Name           string
-	ResourceGroup  string
-	SubscriptionID string
-	Location       string
-	NatGatewayIP   infrav1.PublicIPSpec
-	ClusterName    string
-	AdditionalTags infrav1.Tags
-	IsVnetManaged  bool
+	Name             string
+	ResourceGroup    string
+	SubscriptionID   string
+	Location         string
+	NatGatewayIP     infrav1.PublicIPSpec
+	PublicIPPrefixes []string
+	ClusterName      string
+	AdditionalTags   infrav1.Tags
+	IsVnetManaged    bool
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -73,6 +74,14 @@ func (s *NatGatewaySpec) Parameters(_ context.Context, existingNatGateway *asone
 			},
 		},
 	}
+	natGateway.Spec.PublicIpPrefixes = nil
+	for _, prefix := range s.PublicIPPrefixes {
+		natGateway.Spec.PublicIpPrefixes = append(natGateway.Spec.PublicIpPrefixes, asonetworkv1.ApplicationGatewaySubResource{
+			Reference: &genruntime.ResourceReference{
+				ARMID: prefix,
+			},
+		})
+	}
 	natGateway.Spec.Tags = infrav1.Build(infrav1.BuildParams{
 		ClusterName: s.ClusterName,
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
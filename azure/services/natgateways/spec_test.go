@@ -123,6 +123,40 @@ func TestParameters(t *testing.T) {
 				g.Expect(parameters.Spec.Tags).To(HaveKeyWithValue("sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster", "owned"))
 			},
 		},
+		{
+			name: "create a new NAT Gateway spec with public IP prefixes",
+			spec: &NatGatewaySpec{
+				Name:           "my-natgateway",
+				ResourceGroup:  "my-rg",
+				SubscriptionID: "123",
+				Location:       "eastus",
+				NatGatewayIP: infrav1.PublicIPSpec{
+					Name: "my-natgateway-ip",
+				},
+				PublicIPPrefixes: []string{
+					"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix-1",
+					"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix-2",
+				},
+				ClusterName: "my-cluster",
+			},
+			existingSpec: nil,
+			expect: func(g *WithT, existing *asonetworkv1.NatGateway, parameters *asonetworkv1.NatGateway) {
+				g.Expect(parameters).NotTo(BeNil())
+				g.Expect(parameters.Spec.PublicIpAddresses).To(HaveLen(1))
+				g.Expect(parameters.Spec.PublicIpPrefixes).To(Equal([]asonetworkv1.ApplicationGatewaySubResource{
+					{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix-1",
+						},
+					},
+					{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix-2",
+						},
+					},
+				}))
+			},
+		},
 		{
 			name:         "reconcile a NAT Gateway spec when there is an existing aso resource. User added extra spec fields",
 			spec:         fakeNatGatewaySpec,
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownedresources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/cache/ttllru"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// cacheTTL is how long a listing of owned resources is reused before Azure is queried again.
+const cacheTTL = 5 * time.Minute
+
+var (
+	doOnce   sync.Once
+	idsCache ttllru.PeekingCacher
+)
+
+// List returns the sorted IDs of the resources in a resource group that carry the owned tag of a cluster.
+// Results are cached per subscription, resource group and cluster for a few minutes.
+func List(ctx context.Context, auth azure.Authorizer, resourceGroup, clusterName string) ([]string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "ownedresources.List")
+	defer done()
+
+	var err error
+	doOnce.Do(func() {
+		idsCache, err = ttllru.New(1024, cacheTTL)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating LRU cache for owned resources")
+	}
+
+	key := auth.HashKey() + "_" + resourceGroup + "_" + clusterName
+	// Peek does not extend the lifetime of an entry, so the listing is refreshed at least every cacheTTL.
+	if ids, _, ok := idsCache.Peek(key); ok {
+		return ids.([]string), nil
+	}
+
+	cli, err := NewClient(auth)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create resources client")
+	}
+	ids, err := list(ctx, cli, resourceGroup, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	_ = idsCache.Add(key, ids)
+	return ids, nil
+}
+
+func list(ctx context.Context, cli Client, resourceGroup, clusterName string) ([]string, error) {
+	filter := fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", infrav1.ClusterTagKey(clusterName), infrav1.ResourceLifecycleOwned)
+	resources, err := cli.ListByResourceGroup(ctx, resourceGroup, filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list resources owned by cluster %s in resource group %s", clusterName, resourceGroup)
+	}
+
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		if resource.ID != nil {
+			ids = append(ids, *resource.ID)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
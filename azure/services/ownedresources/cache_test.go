@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownedresources
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/ownedresources/mock_ownedresources"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+)
+
+func TestList(t *testing.T) {
+	const filter = "tagName eq 'sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster' and tagValue eq 'owned'"
+
+	testcases := []struct {
+		name          string
+		expect        func(c *mock_ownedresources.MockClientMockRecorder)
+		expectedIDs   []string
+		expectedError string
+	}{
+		{
+			name: "returns sorted resource IDs",
+			expect: func(c *mock_ownedresources.MockClientMockRecorder) {
+				c.ListByResourceGroup(gomockinternal.AContext(), "my-rg", filter).Return([]armresources.GenericResourceExpanded{
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet")},
+					{ID: nil},
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/lb")},
+				}, nil)
+			},
+			expectedIDs: []string{
+				"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/lb",
+				"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet",
+			},
+		},
+		{
+			name: "returns an empty list when nothing is owned",
+			expect: func(c *mock_ownedresources.MockClientMockRecorder) {
+				c.ListByResourceGroup(gomockinternal.AContext(), "my-rg", filter).Return(nil, nil)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "returns an error when listing fails",
+			expect: func(c *mock_ownedresources.MockClientMockRecorder) {
+				c.ListByResourceGroup(gomockinternal.AContext(), "my-rg", filter).Return(nil, errors.New("#: Internal Server Error: StatusCode=500"))
+			},
+			expectedError: "failed to list resources owned by cluster my-cluster in resource group my-rg: #: Internal Server Error: StatusCode=500",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			clientMock := mock_ownedresources.NewMockClient(mockCtrl)
+
+			tc.expect(clientMock.EXPECT())
+
+			ids, err := list(context.TODO(), clientMock, "my-rg", "my-cluster")
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(Equal(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(ids).To(Equal(tc.expectedIDs))
+			}
+		})
+	}
+}
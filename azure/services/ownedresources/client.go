@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownedresources
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// Client wraps go-sdk.
+type Client interface {
+	ListByResourceGroup(context.Context, string, string) ([]armresources.GenericResourceExpanded, error)
+}
+
+// AzureClient contains the Azure go-sdk Client.
+type AzureClient struct {
+	resources *armresources.Client
+}
+
+var _ Client = &AzureClient{}
+
+// NewClient creates a new resources client from an authorizer.
+func NewClient(auth azure.Authorizer) (*AzureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create resources client options")
+	}
+	factory, err := armresources.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armresources client factory")
+	}
+	return &AzureClient{factory.NewClient()}, nil
+}
+
+// ListByResourceGroup returns the resources in a resource group matching the given filter.
+func (ac *AzureClient) ListByResourceGroup(ctx context.Context, resourceGroupName, filter string) ([]armresources.GenericResourceExpanded, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "ownedresources.AzureClient.ListByResourceGroup")
+	defer done()
+
+	var resources []armresources.GenericResourceExpanded
+	opts := armresources.ClientListByResourceGroupOptions{Filter: &filter}
+	pager := ac.resources.NewListByResourceGroupPager(resourceGroupName, &opts)
+	for pager.More() {
+		resp, err := pager.NextPage(ctx)
+		if err != nil {
+			return resources, errors.Wrap(err, "could not iterate resources")
+		}
+		for _, resource := range resp.Value {
+			resources = append(resources, *resource)
+		}
+	}
+
+	return resources, nil
+}
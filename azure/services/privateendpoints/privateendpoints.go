@@ -22,11 +22,16 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
 // ServiceName is the name of this service.
 const ServiceName = "privateendpoints"
 
+func init() {
+	reconciler.RegisterServiceGroup(ServiceName, reconciler.ServiceGroupNetworking)
+}
+
 // PrivateEndpointScope defines the scope interface for a private endpoint.
 type PrivateEndpointScope interface {
 	aso.Scope
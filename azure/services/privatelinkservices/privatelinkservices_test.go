@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatelinkservices
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatelinkservices/mock_privatelinkservices"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakePLSSpec = PrivateLinkServiceSpec{
+		Name:                 "my-cluster-apiserver-pls",
+		ResourceGroup:        "my-rg",
+		SubscriptionID:       "123",
+		ClusterName:          "my-cluster",
+		Location:             "my-location",
+		LoadBalancerName:     "my-private-lb",
+		FrontendIPConfigName: "my-private-lb-frontEnd",
+		VNetName:             "my-vnet",
+		VNetResourceGroup:    "my-rg",
+		NATIPConfigurations: []NATIPConfiguration{
+			{
+				SubnetName: "my-cp-subnet",
+			},
+		},
+		VisibilitySubscriptions:   []string{"*"},
+		AutoApprovalSubscriptions: []string{"00000000-0000-0000-0000-000000000000"},
+	}
+
+	internalError = &azcore.ResponseError{
+		RawResponse: &http.Response{
+			Body:       io.NopCloser(strings.NewReader("#: Internal Server Error: StatusCode=500")),
+			StatusCode: http.StatusInternalServerError,
+		},
+	}
+)
+
+func TestReconcilePrivateLinkService(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no private link service specs are found",
+			expectedError: "",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{})
+			},
+		},
+		{
+			name:          "create private link service",
+			expectedError: "",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{&fakePLSSpec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePLSSpec, serviceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.PrivateLinkServicesReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "fail to create private link service",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{&fakePLSSpec})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePLSSpec, serviceName).Return(nil, internalError)
+				s.UpdatePutStatus(infrav1.PrivateLinkServicesReadyCondition, serviceName, internalError)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scopeMock := mock_privatelinkservices.NewMockPrivateLinkServiceScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeletePrivateLinkService(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no private link service specs are found",
+			expectedError: "",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{})
+			},
+		},
+		{
+			name:          "delete private link service",
+			expectedError: "",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{&fakePLSSpec})
+				r.DeleteResource(gomockinternal.AContext(), &fakePLSSpec, serviceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.PrivateLinkServicesReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "private link service deletion fails",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_privatelinkservices.MockPrivateLinkServiceScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PrivateLinkServiceSpecs().Return([]azure.ResourceSpecGetter{&fakePLSSpec})
+				r.DeleteResource(gomockinternal.AContext(), &fakePLSSpec, serviceName).Return(internalError)
+				s.UpdateDeleteStatus(infrav1.PrivateLinkServicesReadyCondition, serviceName, internalError)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scopeMock := mock_privatelinkservices.NewMockPrivateLinkServiceScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatelinkservices
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// NATIPConfiguration defines a NAT IP configuration of a private link service.
+type NATIPConfiguration struct {
+	SubnetName       string
+	PrivateIPAddress string
+}
+
+// PrivateLinkServiceSpec defines the specification for a private link service in front of a load balancer.
+type PrivateLinkServiceSpec struct {
+	Name                      string
+	ResourceGroup             string
+	SubscriptionID            string
+	ClusterName               string
+	Location                  string
+	ExtendedLocation          *infrav1.ExtendedLocationSpec
+	LoadBalancerName          string
+	FrontendIPConfigName      string
+	VNetName                  string
+	VNetResourceGroup         string
+	NATIPConfigurations       []NATIPConfiguration
+	VisibilitySubscriptions   []string
+	AutoApprovalSubscriptions []string
+	AdditionalTags            infrav1.Tags
+}
+
+// ResourceName returns the name of the private link service.
+func (s *PrivateLinkServiceSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *PrivateLinkServiceSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for private link services.
+func (s *PrivateLinkServiceSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the private link service.
+func (s *PrivateLinkServiceSpec) Parameters(_ context.Context, existing interface{}) (parameters interface{}, err error) {
+	ipConfigurations := s.ipConfigurations()
+
+	if existing != nil {
+		existingPLS, ok := existing.(armnetwork.PrivateLinkService)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armnetwork.PrivateLinkService", existing)
+		}
+		if existingPLS.Properties != nil &&
+			ipConfigurationsEqual(existingPLS.Properties.IPConfigurations, ipConfigurations) &&
+			subscriptionsEqual(visibilitySubscriptions(existingPLS.Properties.Visibility), s.VisibilitySubscriptions) &&
+			subscriptionsEqual(autoApprovalSubscriptions(existingPLS.Properties.AutoApproval), s.AutoApprovalSubscriptions) {
+			// private link service already exists with the desired configuration
+			return nil, nil
+		}
+	}
+
+	return armnetwork.PrivateLinkService{
+		Location:         ptr.To(s.Location),
+		ExtendedLocation: converters.ExtendedLocationToNetworkSDK(s.ExtendedLocation),
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Role:        ptr.To(infrav1.APIServerRole),
+			Additional:  s.AdditionalTags,
+		})),
+		Properties: &armnetwork.PrivateLinkServiceProperties{
+			LoadBalancerFrontendIPConfigurations: []*armnetwork.FrontendIPConfiguration{
+				{
+					ID: ptr.To(azure.FrontendIPConfigID(s.SubscriptionID, s.ResourceGroup, s.LoadBalancerName, s.FrontendIPConfigName)),
+				},
+			},
+			IPConfigurations: ipConfigurations,
+			Visibility: &armnetwork.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: azure.PtrSlice(&s.VisibilitySubscriptions),
+			},
+			AutoApproval: &armnetwork.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: azure.PtrSlice(&s.AutoApprovalSubscriptions),
+			},
+		},
+	}, nil
+}
+
+// ipConfigurations returns the NAT IP configurations of the private link service. The first one is the primary.
+func (s *PrivateLinkServiceSpec) ipConfigurations() []*armnetwork.PrivateLinkServiceIPConfiguration {
+	ipConfigurations := make([]*armnetwork.PrivateLinkServiceIPConfiguration, 0, len(s.NATIPConfigurations))
+	for i, natIPConfig := range s.NATIPConfigurations {
+		properties := &armnetwork.PrivateLinkServiceIPConfigurationProperties{
+			Primary:                   ptr.To(i == 0),
+			PrivateIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodDynamic),
+			PrivateIPAddressVersion:   ptr.To(armnetwork.IPVersionIPv4),
+			Subnet: &armnetwork.Subnet{
+				ID: ptr.To(azure.SubnetID(s.SubscriptionID, s.VNetResourceGroup, s.VNetName, natIPConfig.SubnetName)),
+			},
+		}
+		if natIPConfig.PrivateIPAddress != "" {
+			properties.PrivateIPAllocationMethod = ptr.To(armnetwork.IPAllocationMethodStatic)
+			properties.PrivateIPAddress = ptr.To(natIPConfig.PrivateIPAddress)
+			if ip := net.ParseIP(natIPConfig.PrivateIPAddress); ip != nil && ip.To4() == nil {
+				properties.PrivateIPAddressVersion = ptr.To(armnetwork.IPVersionIPv6)
+			}
+		}
+		ipConfigurations = append(ipConfigurations, &armnetwork.PrivateLinkServiceIPConfiguration{
+			Name:       ptr.To(fmt.Sprintf("%s-natipconfig-%d", s.Name, i)),
+			Properties: properties,
+		})
+	}
+	return ipConfigurations
+}
+
+// ipConfigurationsEqual returns true if the existing NAT IP configurations use the same subnets and static IP addresses
+// as the desired ones. Dynamically allocated addresses are ignored.
+func ipConfigurationsEqual(existing, desired []*armnetwork.PrivateLinkServiceIPConfiguration) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+	for i := range desired {
+		if existing[i] == nil || existing[i].Properties == nil || existing[i].Properties.Subnet == nil {
+			return false
+		}
+		if !strings.EqualFold(ptr.Deref(existing[i].Properties.Subnet.ID, ""), ptr.Deref(desired[i].Properties.Subnet.ID, "")) {
+			return false
+		}
+		if desired[i].Properties.PrivateIPAddress != nil &&
+			ptr.Deref(existing[i].Properties.PrivateIPAddress, "") != *desired[i].Properties.PrivateIPAddress {
+			return false
+		}
+		if desired[i].Properties.PrivateIPAddress == nil &&
+			ptr.Deref(existing[i].Properties.PrivateIPAllocationMethod, "") == armnetwork.IPAllocationMethodStatic {
+			return false
+		}
+	}
+	return true
+}
+
+func visibilitySubscriptions(visibility *armnetwork.PrivateLinkServicePropertiesVisibility) []string {
+	if visibility == nil {
+		return nil
+	}
+	return derefSubscriptions(visibility.Subscriptions)
+}
+
+func autoApprovalSubscriptions(autoApproval *armnetwork.PrivateLinkServicePropertiesAutoApproval) []string {
+	if autoApproval == nil {
+		return nil
+	}
+	return derefSubscriptions(autoApproval.Subscriptions)
+}
+
+func derefSubscriptions(subscriptions []*string) []string {
+	var result []string
+	for _, subscription := range subscriptions {
+		if subscription != nil {
+			result = append(result, *subscription)
+		}
+	}
+	return result
+}
+
+// subscriptionsEqual returns true if both lists contain the same subscription IDs, regardless of order and case.
+func subscriptionsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	normalize := func(subscriptions []string) []string {
+		normalized := make([]string, len(subscriptions))
+		for i, subscription := range subscriptions {
+			normalized[i] = strings.ToLower(subscription)
+		}
+		sort.Strings(normalized)
+		return normalized
+	}
+	na, nb := normalize(a), normalize(b)
+	for i := range na {
+		if na[i] != nb[i] {
+			return false
+		}
+	}
+	return true
+}
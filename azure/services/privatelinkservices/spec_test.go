@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package privatelinkservices
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func newExistingPLS() armnetwork.PrivateLinkService {
+	return armnetwork.PrivateLinkService{
+		Name: ptr.To("my-cluster-apiserver-pls"),
+		Properties: &armnetwork.PrivateLinkServiceProperties{
+			IPConfigurations: []*armnetwork.PrivateLinkServiceIPConfiguration{
+				{
+					Name: ptr.To("my-cluster-apiserver-pls-natipconfig-0"),
+					Properties: &armnetwork.PrivateLinkServiceIPConfigurationProperties{
+						Primary:                   ptr.To(true),
+						PrivateIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodDynamic),
+						PrivateIPAddress:          ptr.To("10.0.0.5"),
+						Subnet: &armnetwork.Subnet{
+							ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-cp-subnet"),
+						},
+					},
+				},
+			},
+			Visibility: &armnetwork.PrivateLinkServicePropertiesVisibility{
+				Subscriptions: []*string{ptr.To("*")},
+			},
+			AutoApproval: &armnetwork.PrivateLinkServicePropertiesAutoApproval{
+				Subscriptions: []*string{ptr.To("00000000-0000-0000-0000-000000000000")},
+			},
+		},
+	}
+}
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *PrivateLinkServiceSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "new private link service",
+			spec:     &fakePLSSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.PrivateLinkService{}))
+				pls := result.(armnetwork.PrivateLinkService)
+				g.Expect(*pls.Location).To(Equal("my-location"))
+				g.Expect(pls.Properties.LoadBalancerFrontendIPConfigurations).To(HaveLen(1))
+				g.Expect(*pls.Properties.LoadBalancerFrontendIPConfigurations[0].ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-private-lb/frontendIPConfigurations/my-private-lb-frontEnd"))
+				g.Expect(pls.Properties.IPConfigurations).To(HaveLen(1))
+				g.Expect(*pls.Properties.IPConfigurations[0].Name).To(Equal("my-cluster-apiserver-pls-natipconfig-0"))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.Primary).To(BeTrue())
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.PrivateIPAllocationMethod).To(Equal(armnetwork.IPAllocationMethodDynamic))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.Subnet.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-cp-subnet"))
+				g.Expect(pls.Properties.Visibility.Subscriptions).To(Equal([]*string{ptr.To("*")}))
+				g.Expect(pls.Properties.AutoApproval.Subscriptions).To(Equal([]*string{ptr.To("00000000-0000-0000-0000-000000000000")}))
+				g.Expect(pls.Tags).To(HaveKeyWithValue("sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster", ptr.To("owned")))
+			},
+		},
+		{
+			name: "new private link service with static NAT IP addresses",
+			spec: func() *PrivateLinkServiceSpec {
+				spec := fakePLSSpec
+				spec.NATIPConfigurations = []NATIPConfiguration{
+					{SubnetName: "my-cp-subnet", PrivateIPAddress: "10.0.0.100"},
+					{SubnetName: "my-pls-subnet"},
+				}
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.PrivateLinkService{}))
+				pls := result.(armnetwork.PrivateLinkService)
+				g.Expect(pls.Properties.IPConfigurations).To(HaveLen(2))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.PrivateIPAllocationMethod).To(Equal(armnetwork.IPAllocationMethodStatic))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.PrivateIPAddress).To(Equal("10.0.0.100"))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.PrivateIPAddressVersion).To(Equal(armnetwork.IPVersionIPv4))
+				g.Expect(*pls.Properties.IPConfigurations[1].Name).To(Equal("my-cluster-apiserver-pls-natipconfig-1"))
+				g.Expect(*pls.Properties.IPConfigurations[1].Properties.Primary).To(BeFalse())
+				g.Expect(*pls.Properties.IPConfigurations[1].Properties.Subnet.ID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-pls-subnet"))
+			},
+		},
+		{
+			name: "new private link service with an IPv6 NAT IP address",
+			spec: func() *PrivateLinkServiceSpec {
+				spec := fakePLSSpec
+				spec.NATIPConfigurations = []NATIPConfiguration{
+					{SubnetName: "my-cp-subnet"},
+					{SubnetName: "my-cp-subnet", PrivateIPAddress: "2001:1234:5678:9abd::5"},
+				}
+				return &spec
+			}(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.PrivateLinkService{}))
+				pls := result.(armnetwork.PrivateLinkService)
+				g.Expect(pls.Properties.IPConfigurations).To(HaveLen(2))
+				g.Expect(*pls.Properties.IPConfigurations[0].Properties.PrivateIPAddressVersion).To(Equal(armnetwork.IPVersionIPv4))
+				g.Expect(*pls.Properties.IPConfigurations[1].Properties.PrivateIPAddressVersion).To(Equal(armnetwork.IPVersionIPv6))
+			},
+		},
+		{
+			name:     "existing private link service is up to date",
+			spec:     &fakePLSSpec,
+			existing: newExistingPLS(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name: "existing private link service with different visibility is updated",
+			spec: func() *PrivateLinkServiceSpec {
+				spec := fakePLSSpec
+				spec.VisibilitySubscriptions = []string{"00000000-0000-0000-0000-000000000000", "11111111-1111-1111-1111-111111111111"}
+				return &spec
+			}(),
+			existing: newExistingPLS(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.PrivateLinkService{}))
+				pls := result.(armnetwork.PrivateLinkService)
+				g.Expect(pls.Properties.Visibility.Subscriptions).To(HaveLen(2))
+			},
+		},
+		{
+			name: "existing private link service with a different NAT subnet is updated",
+			spec: func() *PrivateLinkServiceSpec {
+				spec := fakePLSSpec
+				spec.NATIPConfigurations = []NATIPConfiguration{{SubnetName: "my-pls-subnet"}}
+				return &spec
+			}(),
+			existing: newExistingPLS(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.PrivateLinkService{}))
+			},
+		},
+		{
+			name:     "existing is not a private link service",
+			spec:     &fakePLSSpec,
+			existing: "wrong type",
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "string is not an armnetwork.PrivateLinkService",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+		})
+	}
+}
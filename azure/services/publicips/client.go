@@ -29,6 +29,12 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
+// client wraps go-sdk.
+type client interface {
+	List(context.Context, string) ([]armnetwork.PublicIPAddress, error)
+	UpdateTags(context.Context, string, string, map[string]*string) error
+}
+
 // AzureClient contains the Azure go-sdk Client.
 type AzureClient struct {
 	publicips      *armnetwork.PublicIPAddressesClient
@@ -61,6 +67,35 @@ func (ac *AzureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (
 	return resp.PublicIPAddress, nil
 }
 
+// List returns all public IP addresses in a resource group.
+func (ac *AzureClient) List(ctx context.Context, resourceGroupName string) ([]armnetwork.PublicIPAddress, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.AzureClient.List")
+	defer done()
+
+	var publicIPs []armnetwork.PublicIPAddress
+	pager := ac.publicips.NewListPager(resourceGroupName, nil)
+	for pager.More() {
+		nextResult, err := pager.NextPage(ctx)
+		if err != nil {
+			return publicIPs, errors.Wrap(err, "could not iterate public IPs")
+		}
+		for _, publicIP := range nextResult.Value {
+			publicIPs = append(publicIPs, *publicIP)
+		}
+	}
+
+	return publicIPs, nil
+}
+
+// UpdateTags replaces the tags of the specified public IP address.
+func (ac *AzureClient) UpdateTags(ctx context.Context, resourceGroupName, name string, tags map[string]*string) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.AzureClient.UpdateTags")
+	defer done()
+
+	_, err := ac.publicips.UpdateTags(ctx, resourceGroupName, name, armnetwork.TagsObject{Tags: tags}, nil)
+	return err
+}
+
 // CreateOrUpdateAsync creates or updates a static or dynamic public IP address.
 // It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
 // progress of the operation.
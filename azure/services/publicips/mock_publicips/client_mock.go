@@ -24,3 +24,63 @@ limitations under the License.
 
 // Package mock_publicips is a generated GoMock package.
 package mock_publicips
+
+import (
+	context "context"
+	reflect "reflect"
+
+	armnetwork "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// Mockclient is a mock of client interface.
+type Mockclient struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientMockRecorder
+}
+
+// MockclientMockRecorder is the mock recorder for Mockclient.
+type MockclientMockRecorder struct {
+	mock *Mockclient
+}
+
+// NewMockclient creates a new mock instance.
+func NewMockclient(ctrl *gomock.Controller) *Mockclient {
+	mock := &Mockclient{ctrl: ctrl}
+	mock.recorder = &MockclientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockclient) EXPECT() *MockclientMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *Mockclient) List(arg0 context.Context, arg1 string) ([]armnetwork.PublicIPAddress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]armnetwork.PublicIPAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockclientMockRecorder) List(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*Mockclient)(nil).List), arg0, arg1)
+}
+
+// UpdateTags mocks base method.
+func (m *Mockclient) UpdateTags(arg0 context.Context, arg1, arg2 string, arg3 map[string]*string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockclientMockRecorder) UpdateTags(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*Mockclient)(nil).UpdateTags), arg0, arg1, arg2, arg3)
+}
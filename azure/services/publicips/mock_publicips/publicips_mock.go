@@ -26,6 +26,7 @@ limitations under the License.
 package mock_publicips
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -454,3 +455,55 @@ func (mr *MockPublicIPScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockPublicIPScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
 }
+
+// MockDeletionGracePeriodGetter is a mock of DeletionGracePeriodGetter interface.
+type MockDeletionGracePeriodGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletionGracePeriodGetterMockRecorder
+}
+
+// MockDeletionGracePeriodGetterMockRecorder is the mock recorder for MockDeletionGracePeriodGetter.
+type MockDeletionGracePeriodGetterMockRecorder struct {
+	mock *MockDeletionGracePeriodGetter
+}
+
+// NewMockDeletionGracePeriodGetter creates a new mock instance.
+func NewMockDeletionGracePeriodGetter(ctrl *gomock.Controller) *MockDeletionGracePeriodGetter {
+	mock := &MockDeletionGracePeriodGetter{ctrl: ctrl}
+	mock.recorder = &MockDeletionGracePeriodGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletionGracePeriodGetter) EXPECT() *MockDeletionGracePeriodGetterMockRecorder {
+	return m.recorder
+}
+
+// MachinePublicIPNames mocks base method.
+func (m *MockDeletionGracePeriodGetter) MachinePublicIPNames(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MachinePublicIPNames", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MachinePublicIPNames indicates an expected call of MachinePublicIPNames.
+func (mr *MockDeletionGracePeriodGetterMockRecorder) MachinePublicIPNames(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MachinePublicIPNames", reflect.TypeOf((*MockDeletionGracePeriodGetter)(nil).MachinePublicIPNames), arg0)
+}
+
+// PublicIPDeletionGracePeriod mocks base method.
+func (m *MockDeletionGracePeriodGetter) PublicIPDeletionGracePeriod() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicIPDeletionGracePeriod")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// PublicIPDeletionGracePeriod indicates an expected call of PublicIPDeletionGracePeriod.
+func (mr *MockDeletionGracePeriodGetterMockRecorder) PublicIPDeletionGracePeriod() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIPDeletionGracePeriod", reflect.TypeOf((*MockDeletionGracePeriodGetter)(nil).PublicIPDeletionGracePeriod))
+}
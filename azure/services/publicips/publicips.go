@@ -18,19 +18,33 @@ package publicips
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/tags"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
-const serviceName = "publicips"
+const (
+	serviceName = "publicips"
+
+	// pendingDeletionTag is the tag recording when an unreferenced managed public IP was first found,
+	// in RFC 3339 format.
+	pendingDeletionTag = infrav1.NameAzureProviderPrefix + "pending-deletion"
+)
+
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupNetworking)
+}
 
 // PublicIPScope defines the scope interface for a public IP service.
 type PublicIPScope interface {
@@ -40,9 +54,18 @@ type PublicIPScope interface {
 	PublicIPSpecs() []azure.ResourceSpecGetter
 }
 
+// DeletionGracePeriodGetter may be implemented by a scope whose managed public IPs should be deleted once they
+// are no longer referenced. Unreferenced public IPs are kept for the returned grace period before being deleted.
+// Public IPs named by MachinePublicIPNames belong to existing machines and are never deleted.
+type DeletionGracePeriodGetter interface {
+	PublicIPDeletionGracePeriod() time.Duration
+	MachinePublicIPNames(ctx context.Context) ([]string, error)
+}
+
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope PublicIPScope
+	client
 	async.Reconciler
 	async.Getter
 	async.TagsGetter
@@ -60,6 +83,7 @@ func New(scope PublicIPScope) (*Service, error) {
 	}
 	return &Service{
 		Scope:      scope,
+		client:     client,
 		Getter:     client,
 		TagsGetter: tagsClient,
 		Reconciler: async.New[armnetwork.PublicIPAddressesClientCreateOrUpdateResponse, armnetwork.PublicIPAddressesClientDeleteResponse](scope, client, client),
@@ -76,12 +100,12 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
 	if len(specs) == 0 {
-		return nil
+		return s.deleteOrphanedPublicIPs(ctx, specs)
 	}
 
 	// We go through the list of PublicIPSpecs to reconcile each one, independently of the result of the previous one.
@@ -90,6 +114,11 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	var result error
 	for _, publicIPSpec := range specs {
 		if _, err := s.CreateOrUpdateResource(ctx, publicIPSpec, serviceName); err != nil {
+			// A public IP with the same name may still be deleting, e.g. when a cluster is recreated right after
+			// being deleted. Azure rejects the create with a conflict until the deletion completes, so retry later.
+			if azure.ResourceConflict(err) {
+				err = azure.WithTransientError(errors.Wrapf(err, "public IP %s conflicts with an ongoing operation", publicIPSpec.ResourceName()), s.Scope.DefaultedReconcilerRequeue())
+			}
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
@@ -97,15 +126,108 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, result)
+	if result != nil {
+		return result
+	}
+
+	return s.deleteOrphanedPublicIPs(ctx, specs)
+}
+
+// deleteOrphanedPublicIPs deletes the managed public IPs in the cluster resource group which are neither desired
+// nor attached to a resource, once they have been unreferenced for longer than the scope's grace period.
+// The time a public IP was first found unreferenced is tracked with a tag so it survives controller restarts.
+func (s *Service) deleteOrphanedPublicIPs(ctx context.Context, specs []azure.ResourceSpecGetter) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "publicips.Service.deleteOrphanedPublicIPs")
+	defer done()
+
+	graceScope, ok := s.Scope.(DeletionGracePeriodGetter)
+	if !ok || graceScope.PublicIPDeletionGracePeriod() <= 0 {
+		return nil
+	}
+	gracePeriod := graceScope.PublicIPDeletionGracePeriod()
+
+	desired := make(map[string]struct{}, len(specs))
+	for _, spec := range specs {
+		desired[spec.ResourceName()] = struct{}{}
+	}
+
+	// Public IPs of machines are not part of the cluster specs, but must be kept as long as their machine exists.
+	machinePublicIPNames, err := graceScope.MachinePublicIPNames(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the public IPs of existing machines")
+	}
+	for _, name := range machinePublicIPNames {
+		desired[name] = struct{}{}
+	}
+
+	resourceGroup := s.Scope.ResourceGroup()
+	publicIPs, err := s.client.List(ctx, resourceGroup)
+	if err != nil {
+		return errors.Wrap(err, "failed to list public IPs")
+	}
+
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
+	var result error
+	for _, publicIP := range publicIPs {
+		name := ptr.Deref(publicIP.Name, "")
+		if !converters.MapToTags(publicIP.Tags).HasOwned(s.Scope.ClusterName()) {
+			continue
+		}
+
+		pendingSince, pending := publicIP.Tags[pendingDeletionTag]
+		if _, ok := desired[name]; ok || isAttached(publicIP) {
+			if pending && !azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped cancelling the pending deletion of public IP %s/%s", resourceGroup, name)) {
+				log.V(2).Info("public IP is referenced again, cancelling its pending deletion", "public ip", name)
+				delete(publicIP.Tags, pendingDeletionTag)
+				if err := s.client.UpdateTags(ctx, resourceGroup, name, publicIP.Tags); err != nil && (result == nil || azure.IsOperationNotDoneError(result)) {
+					result = errors.Wrapf(err, "failed to cancel pending deletion of public IP %s", name)
+				}
+			}
+			continue
+		}
+
+		since, err := time.Parse(time.RFC3339, ptr.Deref(pendingSince, ""))
+		if !pending || err != nil {
+			if azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped marking public IP %s/%s for deletion", resourceGroup, name)) {
+				continue
+			}
+			log.V(2).Info("public IP is no longer referenced, marking it for deletion", "public ip", name, "grace period", gracePeriod)
+			publicIP.Tags[pendingDeletionTag] = ptr.To(time.Now().UTC().Format(time.RFC3339))
+			if err := s.client.UpdateTags(ctx, resourceGroup, name, publicIP.Tags); err != nil && (result == nil || azure.IsOperationNotDoneError(result)) {
+				result = errors.Wrapf(err, "failed to mark public IP %s for deletion", name)
+			}
+			continue
+		}
+
+		if time.Since(since) < gracePeriod {
+			log.V(4).Info("public IP deletion grace period has not elapsed yet", "public ip", name, "pending since", since)
+			continue
+		}
+
+		log.V(2).Info("deleting unreferenced public IP", "public ip", name)
+		spec := &PublicIPSpec{Name: name, ResourceGroup: resourceGroup}
+		if err := s.DeleteResource(ctx, spec, serviceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || result == nil {
+				result = err
+			}
+		}
+	}
+
 	return result
 }
 
+// isAttached returns true if the public IP is associated with a network interface, load balancer or NAT gateway.
+func isAttached(publicIP armnetwork.PublicIPAddress) bool {
+	return publicIP.Properties != nil && (publicIP.Properties.IPConfiguration != nil || publicIP.Properties.NatGateway != nil)
+}
+
 // Delete deletes the public IP with the provided scope.
 func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
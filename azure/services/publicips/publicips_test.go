@@ -22,10 +22,13 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
@@ -122,6 +125,15 @@ var (
 			StatusCode: http.StatusInternalServerError,
 		},
 	}
+
+	conflictError = &azcore.ResponseError{
+		StatusCode: http.StatusConflict,
+		RawResponse: &http.Response{
+			Body:       io.NopCloser(strings.NewReader("#: Conflict: StatusCode=409")),
+			StatusCode: http.StatusConflict,
+		},
+	}
+	transientConflictError = azure.WithTransientError(errors.Wrap(conflictError, "public IP my-publicip-2 conflicts with an ongoing operation"), reconciler.DefaultReconcilerRequeue)
 )
 
 func TestReconcilePublicIP(t *testing.T) {
@@ -164,6 +176,18 @@ func TestReconcilePublicIP(t *testing.T) {
 				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, internalError)
 			},
 		},
+		{
+			name:          "requeue when a public IP with the same name is still being deleted",
+			expectedError: transientConflictError.Error(),
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec2, serviceName).Return(nil, conflictError)
+				s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, gomockinternal.ErrStrEq(transientConflictError.Error()))
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -326,3 +350,165 @@ func TestDeletePublicIP(t *testing.T) {
 		})
 	}
 }
+
+type graceScope struct {
+	*mock_publicips.MockPublicIPScope
+	*mock_publicips.MockDeletionGracePeriodGetter
+}
+
+func orphanedPublicIP(name string, tags map[string]*string, attached bool) armnetwork.PublicIPAddress {
+	publicIP := armnetwork.PublicIPAddress{
+		Name:       ptr.To(name),
+		Tags:       tags,
+		Properties: &armnetwork.PublicIPAddressPropertiesFormat{},
+	}
+	if attached {
+		publicIP.Properties.IPConfiguration = &armnetwork.IPConfiguration{ID: ptr.To("my-ipconfig")}
+	}
+	return publicIP
+}
+
+func TestDeleteOrphanedPublicIPs(t *testing.T) {
+	ownedTags := func(extra map[string]*string) map[string]*string {
+		tags := map[string]*string{
+			"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+		}
+		for k, v := range extra {
+			tags[k] = v
+		}
+		return tags
+	}
+	longAgo := ptr.To(time.Now().Add(-2 * time.Hour).UTC().Format(time.RFC3339))
+	recently := ptr.To(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339))
+
+	testcases := []struct {
+		name                 string
+		gracePeriod          time.Duration
+		machinePublicIPNames []string
+		machinesErr          error
+		expectedError        string
+		expect               func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:        "noop if no grace period is set",
+			gracePeriod: 0,
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			},
+		},
+		{
+			name:        "marks unreferenced managed public IPs for deletion",
+			gracePeriod: time.Hour,
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return([]armnetwork.PublicIPAddress{
+					orphanedPublicIP("my-publicip", ownedTags(nil), false),
+					orphanedPublicIP("my-orphan", ownedTags(nil), false),
+					orphanedPublicIP("my-attached", ownedTags(nil), true),
+					orphanedPublicIP("my-unmanaged", map[string]*string{"foo": ptr.To("bar")}, false),
+				}, nil)
+				c.UpdateTags(gomockinternal.AContext(), "my-rg", "my-orphan", gomock.Any()).
+					Do(func(_ context.Context, _, _ string, tags map[string]*string) {
+						if _, ok := tags[pendingDeletionTag]; !ok {
+							t.Errorf("expected %s tag to be set", pendingDeletionTag)
+						}
+					}).Return(nil)
+			},
+		},
+		{
+			name:        "deletes public IPs that have been unreferenced for longer than the grace period",
+			gracePeriod: time.Hour,
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return([]armnetwork.PublicIPAddress{
+					orphanedPublicIP("my-expired", ownedTags(map[string]*string{pendingDeletionTag: longAgo}), false),
+					orphanedPublicIP("my-pending", ownedTags(map[string]*string{pendingDeletionTag: recently}), false),
+				}, nil)
+				r.DeleteResource(gomockinternal.AContext(), &PublicIPSpec{Name: "my-expired", ResourceGroup: "my-rg"}, serviceName).Return(nil)
+			},
+		},
+		{
+			name:        "cancels the pending deletion of public IPs that are referenced again",
+			gracePeriod: time.Hour,
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return([]armnetwork.PublicIPAddress{
+					orphanedPublicIP("my-publicip", ownedTags(map[string]*string{pendingDeletionTag: longAgo}), false),
+					orphanedPublicIP("my-attached", ownedTags(map[string]*string{pendingDeletionTag: longAgo}), true),
+				}, nil)
+				c.UpdateTags(gomockinternal.AContext(), "my-rg", "my-publicip", ownedTags(nil)).Return(nil)
+				c.UpdateTags(gomockinternal.AContext(), "my-rg", "my-attached", ownedTags(nil)).Return(nil)
+			},
+		},
+		{
+			name:                 "keeps the public IPs of existing machines",
+			gracePeriod:          time.Hour,
+			machinePublicIPNames: []string{"pip-my-machine", "pip-my-expired-machine"},
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return([]armnetwork.PublicIPAddress{
+					orphanedPublicIP("pip-my-machine", ownedTags(nil), false),
+					orphanedPublicIP("pip-my-expired-machine", ownedTags(map[string]*string{pendingDeletionTag: longAgo}), false),
+				}, nil)
+				c.UpdateTags(gomockinternal.AContext(), "my-rg", "pip-my-expired-machine", ownedTags(nil)).Return(nil)
+			},
+		},
+		{
+			name:          "fails to list the public IPs of existing machines",
+			gracePeriod:   time.Hour,
+			machinesErr:   internalError,
+			expectedError: "failed to list the public IPs of existing machines: " + internalError.Error(),
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			},
+		},
+		{
+			name:          "fails to list public IPs",
+			gracePeriod:   time.Hour,
+			expectedError: "failed to list public IPs: " + internalError.Error(),
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return(nil, internalError)
+			},
+		},
+		{
+			name:          "fails to delete an expired public IP",
+			gracePeriod:   time.Hour,
+			expectedError: internalError.Error(),
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, c *mock_publicips.MockclientMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				c.List(gomockinternal.AContext(), "my-rg").Return([]armnetwork.PublicIPAddress{
+					orphanedPublicIP("my-expired", ownedTags(map[string]*string{pendingDeletionTag: longAgo}), false),
+				}, nil)
+				r.DeleteResource(gomockinternal.AContext(), &PublicIPSpec{Name: "my-expired", ResourceGroup: "my-rg"}, serviceName).Return(internalError)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scopeMock := mock_publicips.NewMockPublicIPScope(mockCtrl)
+			graceMock := mock_publicips.NewMockDeletionGracePeriodGetter(mockCtrl)
+			clientMock := mock_publicips.NewMockclient(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			graceMock.EXPECT().PublicIPDeletionGracePeriod().Return(tc.gracePeriod).AnyTimes()
+			graceMock.EXPECT().MachinePublicIPNames(gomockinternal.AContext()).Return(tc.machinePublicIPNames, tc.machinesErr).AnyTimes()
+			scopeMock.EXPECT().ResourceGroup().Return("my-rg").AnyTimes()
+			scopeMock.EXPECT().ClusterName().Return("my-cluster").AnyTimes()
+			tc.expect(scopeMock.EXPECT(), clientMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      graceScope{scopeMock, graceMock},
+				client:     clientMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.deleteOrphanedPublicIPs(context.TODO(), []azure.ResourceSpecGetter{&fakePublicIPSpec1})
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
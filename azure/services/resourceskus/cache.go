@@ -165,6 +165,32 @@ func (c *Cache) Map(ctx context.Context, mapFn func(sku SKU)) error {
 	return nil
 }
 
+// GetDiskSKUs returns the names of the managed disk SKUs, e.g. Premium_ZRS, which are not restricted in the location
+// of the cache.
+func (c *Cache) GetDiskSKUs(ctx context.Context) ([]string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "resourceskus.Cache.GetDiskSKUs")
+	defer done()
+
+	var diskSKUs []string
+	mapFn := func(sku SKU) {
+		if sku.Name == nil || sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, string(Disks)) {
+			return
+		}
+		for _, restriction := range sku.Restrictions {
+			if ptr.Deref(restriction.Type, "") == armcompute.ResourceSKURestrictionsTypeLocation {
+				return
+			}
+		}
+		diskSKUs = append(diskSKUs, *sku.Name)
+	}
+
+	if err := c.Map(ctx, mapFn); err != nil {
+		return nil, err
+	}
+
+	return diskSKUs, nil
+}
+
 // GetZones looks at all virtual machine sizes and returns the unique
 // set of zones into which some machine size may deploy. It removes
 // restricted virtual machine sizes and duplicates.
@@ -391,3 +391,35 @@ func TestCacheGetZonesWithVMSize(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheGetDiskSKUs(t *testing.T) {
+	cache := &Cache{
+		data: []armcompute.ResourceSKU{
+			{
+				Name:         ptr.To("Premium_LRS"),
+				ResourceType: ptr.To(string(Disks)),
+			},
+			{
+				Name:         ptr.To("Premium_ZRS"),
+				ResourceType: ptr.To(string(Disks)),
+				Restrictions: []*armcompute.ResourceSKURestrictions{
+					{
+						Type: ptr.To(armcompute.ResourceSKURestrictionsTypeLocation),
+					},
+				},
+			},
+			{
+				Name:         ptr.To("Standard_D2s_v3"),
+				ResourceType: ptr.To(string(VirtualMachines)),
+			},
+		},
+	}
+
+	diskSKUs, err := cache.GetDiskSKUs(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+	if diff := cmp.Diff(diskSKUs, []string{"Premium_LRS"}); diff != "" {
+		t.Errorf("%s", diff)
+	}
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
 )
 
 // SKU is a thin layer over the Azure resource SKU API to better introspect capabilities.
@@ -75,6 +78,23 @@ const (
 	ConfidentialComputingType = "ConfidentialComputingType"
 	// CPUArchitectureType identifies the capability for cpu architecture.
 	CPUArchitectureType = "CpuArchitectureType"
+	// HyperVGenerations identifies the capability for the comma-separated list of supported Hyper-V generations, e.g. "V1,V2".
+	HyperVGenerations = "HyperVGenerations"
+	// CachedDiskBytes identifies the capability for the size in bytes of the cache disk.
+	CachedDiskBytes = "CachedDiskBytes"
+	// MaxResourceVolumeMB identifies the capability for the size in MB of the temp (resource) disk.
+	MaxResourceVolumeMB = "MaxResourceVolumeMB"
+	// NvmeDiskSizeInMiB identifies the capability for the size in MiB of the local NVMe disks.
+	NvmeDiskSizeInMiB = "NvmeDiskSizeInMiB"
+)
+
+const (
+	// linuxImageOSDiskSizeGB is the OS disk size of the Linux reference images, used as a hint of the ephemeral
+	// OS disk size when the OS disk size is not set.
+	linuxImageOSDiskSizeGB = 30
+	// windowsImageOSDiskSizeGB is the OS disk size of the Windows reference images, used as a hint of the ephemeral
+	// OS disk size when the OS disk size is not set.
+	windowsImageOSDiskSizeGB = 127
 )
 
 // HasCapability return true for a capability which can be either
@@ -125,6 +145,68 @@ func (s SKU) HasCapabilityWithCapacity(name string, value int64) (bool, error) {
 	return false, nil
 }
 
+// HasEphemeralOSDiskCapacity returns false when the local disk selected by the placement of the ephemeral OS disk is
+// known to be smaller than the OS disk. When the OS disk size is not set, the size of the reference image for the OS
+// type is used as a hint. Without a placement, Azure uses the cache disk and falls back to the temp disk. A local disk
+// whose size is not exposed by the SKU is assumed to be large enough.
+func (s SKU) HasEphemeralOSDiskCapacity(osDisk infrav1.OSDisk) (bool, error) {
+	sizeGB := int64(linuxImageOSDiskSizeGB)
+	if strings.EqualFold(osDisk.OSType, azure.WindowsOS) {
+		sizeGB = windowsImageOSDiskSizeGB
+	}
+	if osDisk.DiskSizeGB != nil {
+		sizeGB = int64(*osDisk.DiskSizeGB)
+	}
+
+	var capabilities []string
+	var placement infrav1.DiffDiskPlacement
+	if osDisk.DiffDiskSettings != nil {
+		placement = ptr.Deref(osDisk.DiffDiskSettings.Placement, "")
+	}
+	switch placement {
+	case infrav1.DiffDiskPlacementCacheDisk:
+		capabilities = []string{CachedDiskBytes}
+	case infrav1.DiffDiskPlacementResourceDisk:
+		capabilities = []string{MaxResourceVolumeMB}
+	case infrav1.DiffDiskPlacementNvmeDisk:
+		capabilities = []string{NvmeDiskSizeInMiB}
+	default:
+		capabilities = []string{CachedDiskBytes, MaxResourceVolumeMB}
+	}
+
+	for _, name := range capabilities {
+		value, ok := s.GetCapability(name)
+		if !ok {
+			return true, nil
+		}
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to parse string '%s' as int64", value)
+		}
+		if name == CachedDiskBytes {
+			size /= 1024 * 1024
+		}
+		if size >= sizeGB*1024 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// IsDiskSKUAvailable returns whether a managed disk storage account type, e.g. Premium_ZRS, is one of the disk SKUs
+// available in a location. Any storage account type is assumed to be available when the disk SKUs are unknown.
+func IsDiskSKUAvailable(diskSKUs []string, storageAccountType string) bool {
+	if len(diskSKUs) == 0 {
+		return true
+	}
+	for _, diskSKU := range diskSKUs {
+		if strings.EqualFold(diskSKU, storageAccountType) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetCapability gets the value assigned to the given capability.
 // Eg. MaximumPlatformFaultDomainCount -> "3" will return "3" for the capability "MaximumPlatformFaultDomainCount".
 func (s SKU) GetCapability(name string) (string, bool) {
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+func TestHasEphemeralOSDiskCapacity(t *testing.T) {
+	// 64 GiB cache disk and 32 GiB temp disk.
+	sku := SKU{
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(CachedDiskBytes),
+				Value: ptr.To("68719476736"),
+			},
+			{
+				Name:  ptr.To(MaxResourceVolumeMB),
+				Value: ptr.To("32768"),
+			},
+		},
+	}
+	testcases := []struct {
+		name        string
+		sku         SKU
+		osDisk      infrav1.OSDisk
+		expected    bool
+		expectedErr string
+	}{
+		{
+			name: "linux image fits on the cache disk",
+			sku:  sku,
+			osDisk: infrav1.OSDisk{
+				OSType:           "Linux",
+				DiffDiskSettings: &infrav1.DiffDiskSettings{Option: "Local"},
+			},
+			expected: true,
+		},
+		{
+			name: "windows image does not fit on any local disk",
+			sku:  sku,
+			osDisk: infrav1.OSDisk{
+				OSType:           "Windows",
+				DiffDiskSettings: &infrav1.DiffDiskSettings{Option: "Local"},
+			},
+			expected: false,
+		},
+		{
+			name: "os disk fits on the cache disk",
+			sku:  sku,
+			osDisk: infrav1.OSDisk{
+				OSType:     "Linux",
+				DiskSizeGB: ptr.To[int32](64),
+				DiffDiskSettings: &infrav1.DiffDiskSettings{
+					Option:    "Local",
+					Placement: ptr.To(infrav1.DiffDiskPlacementCacheDisk),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "os disk does not fit on the temp disk",
+			sku:  sku,
+			osDisk: infrav1.OSDisk{
+				OSType:     "Linux",
+				DiskSizeGB: ptr.To[int32](64),
+				DiffDiskSettings: &infrav1.DiffDiskSettings{
+					Option:    "Local",
+					Placement: ptr.To(infrav1.DiffDiskPlacementResourceDisk),
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "nvme disk size is not exposed",
+			sku:  sku,
+			osDisk: infrav1.OSDisk{
+				OSType:     "Linux",
+				DiskSizeGB: ptr.To[int32](1024),
+				DiffDiskSettings: &infrav1.DiffDiskSettings{
+					Option:    "Local",
+					Placement: ptr.To(infrav1.DiffDiskPlacementNvmeDisk),
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "invalid capability value",
+			sku: SKU{
+				Capabilities: []*armcompute.ResourceSKUCapabilities{
+					{
+						Name:  ptr.To(MaxResourceVolumeMB),
+						Value: ptr.To("a lot"),
+					},
+				},
+			},
+			osDisk: infrav1.OSDisk{
+				OSType: "Linux",
+				DiffDiskSettings: &infrav1.DiffDiskSettings{
+					Option:    "Local",
+					Placement: ptr.To(infrav1.DiffDiskPlacementResourceDisk),
+				},
+			},
+			expectedErr: "failed to parse string 'a lot' as int64",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			actual, err := tc.sku.HasEphemeralOSDiskCapacity(tc.osDisk)
+			if tc.expectedErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(actual).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestIsDiskSKUAvailable(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(IsDiskSKUAvailable(nil, "Premium_ZRS")).To(BeTrue())
+	g.Expect(IsDiskSKUAvailable([]string{"Premium_LRS", "Premium_ZRS"}, "premium_zrs")).To(BeTrue())
+	g.Expect(IsDiskSKUAvailable([]string{"Premium_LRS"}, "Premium_ZRS")).To(BeFalse())
+}
@@ -70,3 +70,13 @@ func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.Resou
 	resp, err := ac.roleassignments.Create(ctx, spec.OwnerResourceName(), spec.ResourceName(), createParams, nil)
 	return resp.RoleAssignment, nil, err
 }
+
+// DeleteAsync deletes a roleassignment.
+// Deleting a roleassignment is not a long running operation, so we don't ever return a poller.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armauthorization.RoleAssignmentsClientDeleteResponse], err error) { //nolint:revive // keeping resumeToken for readability
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "roleassignments.azureClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.roleassignments.Delete(ctx, spec.OwnerResourceName(), spec.ResourceName(), nil)
+	return nil, err
+}
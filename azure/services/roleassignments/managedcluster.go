@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/pkg/errors"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const managedClusterServiceName = "managedclusterroleassignments"
+
+func init() {
+	reconciler.RegisterServiceGroup(managedClusterServiceName, reconciler.ServiceGroupIdentity)
+}
+
+// ManagedClusterRoleAssignmentScope defines the scope interface for the role assignments required by a managed cluster.
+type ManagedClusterRoleAssignmentScope interface {
+	azure.AsyncStatusUpdater
+	azure.Authorizer
+	ManagedClusterRoleAssignmentSpecs() []azure.ResourceSpecGetter
+	ObserveManagedClusterIdentities(ctx context.Context) error
+}
+
+// ManagedClusterService provides operations on the role assignments required by the add-ons of a managed cluster.
+type ManagedClusterService struct {
+	Scope ManagedClusterRoleAssignmentScope
+	async.Reconciler
+}
+
+// NewManagedClusterService creates a new service.
+func NewManagedClusterService(scope ManagedClusterRoleAssignmentScope) (*ManagedClusterService, error) {
+	client, err := newClient(scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create roleassignments service")
+	}
+	return &ManagedClusterService{
+		Scope: scope,
+		Reconciler: async.New[armauthorization.RoleAssignmentsClientCreateResponse,
+			armauthorization.RoleAssignmentsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *ManagedClusterService) Name() string {
+	return managedClusterServiceName
+}
+
+// Reconcile idempotently creates the role assignments required by the managed cluster.
+func (s *ManagedClusterService) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.ManagedClusterService.Reconcile")
+	defer done()
+
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	specs := s.Scope.ManagedClusterRoleAssignmentSpecs()
+	if len(specs) == 0 {
+		log.V(2).Info("no role assignment spec to reconcile")
+		return nil
+	}
+
+	for _, roleAssignmentSpec := range specs {
+		log.V(2).Info("creating role assignment", "scope", roleAssignmentSpec.OwnerResourceName())
+		if _, err := s.CreateOrUpdateResource(ctx, roleAssignmentSpec, managedClusterServiceName); err != nil {
+			return errors.Wrapf(err, "failed to create role assignment on %s, check that the cluster identity is allowed to assign roles on it",
+				roleAssignmentSpec.OwnerResourceName())
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the role assignments of the managed cluster. Azure keeps the role assignments of a deleted
+// principal, so they are deleted before the managed cluster and its identities.
+func (s *ManagedClusterService) Delete(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.ManagedClusterService.Delete")
+	defer done()
+
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	if err := s.Scope.ObserveManagedClusterIdentities(ctx); err != nil {
+		return errors.Wrap(err, "failed to get the identities of the managed cluster")
+	}
+
+	specs := s.Scope.ManagedClusterRoleAssignmentSpecs()
+	if len(specs) == 0 {
+		log.V(2).Info("no role assignment spec to delete")
+		return nil
+	}
+
+	for _, roleAssignmentSpec := range specs {
+		log.V(2).Info("deleting role assignment", "scope", roleAssignmentSpec.OwnerResourceName())
+		if err := s.DeleteResource(ctx, roleAssignmentSpec, managedClusterServiceName); err != nil {
+			return errors.Wrapf(err, "failed to delete role assignment on %s", roleAssignmentSpec.OwnerResourceName())
+		}
+	}
+
+	return nil
+}
+
+// IsManaged returns always returns true as CAPZ does not support BYO role assignments.
+func (s *ManagedClusterService) IsManaged(_ context.Context) (bool, error) {
+	return true, nil
+}
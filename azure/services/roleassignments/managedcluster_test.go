@@ -0,0 +1,184 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roleassignments
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments/mock_roleassignments"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeDNSZoneRoleAssignment = RoleAssignmentSpec{
+		Name:          "00000000-0000-0000-0000-000000000001",
+		ResourceGroup: "dns-rg",
+		Scope:         "/subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com",
+		PrincipalID:   ptr.To("fake-principal-id"),
+	}
+	fakePrivateDNSZoneRoleAssignment = RoleAssignmentSpec{
+		Name:          "00000000-0000-0000-0000-000000000002",
+		ResourceGroup: "dns-rg",
+		Scope:         "/subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/privateDnsZones/example.internal",
+		PrincipalID:   ptr.To("fake-principal-id"),
+	}
+)
+
+func TestReconcileManagedClusterRoleAssignments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expect        func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expectedError string
+	}{
+		{
+			name:          "no role assignments",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, _ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ManagedClusterRoleAssignmentSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "create role assignments",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ManagedClusterRoleAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeDNSZoneRoleAssignment, &fakePrivateDNSZoneRoleAssignment})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, managedClusterServiceName).Return(&fakeDNSZoneRoleAssignment, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePrivateDNSZoneRoleAssignment, managedClusterServiceName).Return(&fakePrivateDNSZoneRoleAssignment, nil)
+			},
+		},
+		{
+			name:          "return error when creating a role assignment",
+			expectedError: "failed to create role assignment on /subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com, check that the cluster identity is allowed to assign roles on it:.*#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ManagedClusterRoleAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeDNSZoneRoleAssignment, &fakePrivateDNSZoneRoleAssignment})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, managedClusterServiceName).Return(nil, internalError())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_roleassignments.NewMockManagedClusterRoleAssignmentScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &ManagedClusterService{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(strings.ReplaceAll(err.Error(), "\n", "")).To(MatchRegexp(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteManagedClusterRoleAssignments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expect        func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expectedError string
+	}{
+		{
+			name:          "no role assignments",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, _ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ObserveManagedClusterIdentities(gomockinternal.AContext()).Return(nil)
+				s.ManagedClusterRoleAssignmentSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "delete role assignments",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ObserveManagedClusterIdentities(gomockinternal.AContext()).Return(nil)
+				s.ManagedClusterRoleAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeDNSZoneRoleAssignment, &fakePrivateDNSZoneRoleAssignment})
+				r.DeleteResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, managedClusterServiceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakePrivateDNSZoneRoleAssignment, managedClusterServiceName).Return(nil)
+			},
+		},
+		{
+			name:          "return error when getting the identities of the managed cluster",
+			expectedError: "failed to get the identities of the managed cluster: an error",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, _ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ObserveManagedClusterIdentities(gomockinternal.AContext()).Return(errors.New("an error"))
+			},
+		},
+		{
+			name:          "return error when deleting a role assignment",
+			expectedError: "failed to delete role assignment on /subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com:.*#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_roleassignments.MockManagedClusterRoleAssignmentScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ObserveManagedClusterIdentities(gomockinternal.AContext()).Return(nil)
+				s.ManagedClusterRoleAssignmentSpecs().Return([]azure.ResourceSpecGetter{&fakeDNSZoneRoleAssignment, &fakePrivateDNSZoneRoleAssignment})
+				r.DeleteResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, managedClusterServiceName).Return(internalError())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_roleassignments.NewMockManagedClusterRoleAssignmentScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &ManagedClusterService{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(strings.ReplaceAll(err.Error(), "\n", "")).To(MatchRegexp(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ limitations under the License.
 //
 //go:generate ../../../../hack/tools/bin/mockgen -destination client_mock.go -package mock_roleassignments -source ../client.go Client
 //go:generate ../../../../hack/tools/bin/mockgen -destination roleassignments_mock.go -package mock_roleassignments -source ../roleassignments.go RoleAssignmentScope
+//go:generate ../../../../hack/tools/bin/mockgen -destination managedcluster_mock.go -package mock_roleassignments -source ../managedcluster.go ManagedClusterRoleAssignmentScope
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt client_mock.go > _client_mock.go && mv _client_mock.go client_mock.go"
 //go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt roleassignments_mock.go > _roleassignments_mock.go && mv _roleassignments_mock.go roleassignments_mock.go"
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt managedcluster_mock.go > _managedcluster_mock.go && mv _managedcluster_mock.go managedcluster_mock.go"
 package mock_roleassignments
@@ -211,6 +211,34 @@ func (mr *MockRoleAssignmentScopeMockRecorder) HashKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockRoleAssignmentScope)(nil).HashKey))
 }
 
+// ImagePullIdentityID mocks base method.
+func (m *MockRoleAssignmentScope) ImagePullIdentityID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePullIdentityID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ImagePullIdentityID indicates an expected call of ImagePullIdentityID.
+func (mr *MockRoleAssignmentScopeMockRecorder) ImagePullIdentityID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePullIdentityID", reflect.TypeOf((*MockRoleAssignmentScope)(nil).ImagePullIdentityID))
+}
+
+// ImagePullRoleAssignmentSpec mocks base method.
+func (m *MockRoleAssignmentScope) ImagePullRoleAssignmentSpec(principalID *string) azure.ResourceSpecGetter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImagePullRoleAssignmentSpec", principalID)
+	ret0, _ := ret[0].(azure.ResourceSpecGetter)
+	return ret0
+}
+
+// ImagePullRoleAssignmentSpec indicates an expected call of ImagePullRoleAssignmentSpec.
+func (mr *MockRoleAssignmentScopeMockRecorder) ImagePullRoleAssignmentSpec(principalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImagePullRoleAssignmentSpec", reflect.TypeOf((*MockRoleAssignmentScope)(nil).ImagePullRoleAssignmentSpec), principalID)
+}
+
 // Name mocks base method.
 func (m *MockRoleAssignmentScope) Name() string {
 	m.ctrl.T.Helper()
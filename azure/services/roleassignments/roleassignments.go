@@ -18,6 +18,7 @@ package roleassignments
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
@@ -27,17 +28,24 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualmachines"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "roleassignments"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupIdentity)
+}
+
 // RoleAssignmentScope defines the scope interface for a role assignment service.
 type RoleAssignmentScope interface {
 	azure.AsyncStatusUpdater
 	azure.Authorizer
 	RoleAssignmentSpecs(principalID *string) []azure.ResourceSpecGetter
 	HasSystemAssignedIdentity() bool
+	ImagePullIdentityID() string
+	ImagePullRoleAssignmentSpec(principalID *string) azure.ResourceSpecGetter
 	RoleAssignmentResourceType() string
 	Name() string
 	ResourceGroup() string
@@ -70,7 +78,7 @@ func New(scope RoleAssignmentScope) (*Service, error) {
 		virtualMachinesGetter:        virtualMachinesClient,
 		virtualMachineScaleSetGetter: scaleSetsClient,
 		Reconciler: async.New[armauthorization.RoleAssignmentsClientCreateResponse,
-			armauthorization.RoleAssignmentsClientDeleteResponse](scope, client, nil),
+			armauthorization.RoleAssignmentsClientDeleteResponse](scope, client, client),
 	}, nil
 }
 
@@ -84,7 +92,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	log.V(2).Info("reconciling role assignment")
@@ -92,28 +100,17 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	// Return early if the identity is not system assigned as there will be no
 	// role assignment spec in this case.
 	if !s.Scope.HasSystemAssignedIdentity() {
+		if identityID := s.Scope.ImagePullIdentityID(); identityID != "" {
+			return s.reconcileImagePullRoleAssignment(ctx, identityID)
+		}
 		log.V(2).Info("no role assignment spec to reconcile")
 		return nil
 	}
 
-	var principalID *string
 	resourceType := s.Scope.RoleAssignmentResourceType()
-	switch resourceType {
-	case azure.VirtualMachine:
-		ID, err := s.getVMPrincipalID(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to assign role to system assigned identity")
-		}
-		principalID = ID
-	case azure.VirtualMachineScaleSet:
-		ID, err := s.getVMSSPrincipalID(ctx)
-		if err != nil {
-			return errors.Wrap(err, "failed to assign role to system assigned identity")
-		}
-		principalID = ID
-	default:
-		return errors.Errorf("unexpected resource type %q. Expected one of [%s, %s]", resourceType,
-			azure.VirtualMachine, azure.VirtualMachineScaleSet)
+	principalID, err := s.getSystemAssignedPrincipalID(ctx, resourceType)
+	if err != nil {
+		return errors.Wrap(err, "failed to assign role to system assigned identity")
 	}
 
 	for _, roleAssignmentSpec := range s.Scope.RoleAssignmentSpecs(principalID) {
@@ -130,6 +127,66 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	return nil
 }
 
+// getSystemAssignedPrincipalID returns the principal ID of the system-assigned identity of the VM or VMSS.
+func (s *Service) getSystemAssignedPrincipalID(ctx context.Context, resourceType string) (*string, error) {
+	switch resourceType {
+	case azure.VirtualMachine:
+		return s.getVMPrincipalID(ctx)
+	case azure.VirtualMachineScaleSet:
+		return s.getVMSSPrincipalID(ctx)
+	default:
+		return nil, errors.Errorf("unexpected resource type %q. Expected one of [%s, %s]", resourceType,
+			azure.VirtualMachine, azure.VirtualMachineScaleSet)
+	}
+}
+
+// reconcileImagePullRoleAssignment grants the user-assigned identity used for image pulls
+// AcrPull on the image pull registry.
+func (s *Service) reconcileImagePullRoleAssignment(ctx context.Context, identityID string) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.reconcileImagePullRoleAssignment")
+	defer done()
+
+	principalID, err := s.getVMUserAssignedPrincipalID(ctx, identityID)
+	if err != nil {
+		return errors.Wrap(err, "failed to assign role to image pull identity")
+	}
+
+	log.V(2).Info("Creating image pull role assignment")
+	if _, err := s.CreateOrUpdateResource(ctx, s.Scope.ImagePullRoleAssignmentSpec(principalID), serviceName); err != nil {
+		return errors.Wrap(err, "cannot assign role to image pull identity")
+	}
+	return nil
+}
+
+// getVMUserAssignedPrincipalID returns the principal ID of a user-assigned identity assigned to the VM.
+func (s *Service) getVMUserAssignedPrincipalID(ctx context.Context, identityID string) (*string, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.getVMUserAssignedPrincipalID")
+	defer done()
+	log.V(2).Info("fetching principal ID for VM user-assigned identity")
+	spec := &virtualmachines.VMSpec{
+		Name:          s.Scope.Name(),
+		ResourceGroup: s.Scope.ResourceGroup(),
+	}
+
+	resultVMIface, err := s.virtualMachinesGetter.Get(ctx, spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get principal ID for VM")
+	}
+	resultVM, ok := resultVMIface.(armcompute.VirtualMachine)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armcompute.VirtualMachine", resultVMIface)
+	}
+	if resultVM.Identity != nil {
+		// Azure may return the identity ID with different casing than it was assigned with.
+		for id, identity := range resultVM.Identity.UserAssignedIdentities {
+			if strings.EqualFold(id, identityID) && identity != nil && identity.PrincipalID != nil {
+				return identity.PrincipalID, nil
+			}
+		}
+	}
+	return nil, errors.Errorf("user-assigned identity %s is not assigned to VM %s", identityID, s.Scope.Name())
+}
+
 // getVMPrincipalID returns the VM principal ID.
 func (s *Service) getVMPrincipalID(ctx context.Context) (*string, error) {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.getVMPrincipalID")
@@ -173,10 +230,38 @@ func (s *Service) getVMSSPrincipalID(ctx context.Context) (*string, error) {
 	return resultVMSS.Identity.PrincipalID, nil
 }
 
-// Delete is a no-op as the role assignments get deleted as part of VM deletion.
+// Delete deletes the role assignments of the system-assigned identity. Azure does not delete them along with the
+// VM or VMSS, so they would otherwise linger on their scopes, e.g. a registry outside of the cluster resource group.
 func (s *Service) Delete(ctx context.Context) error {
-	_, _, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.Delete")
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "roleassignments.Service.Delete")
 	defer done()
+
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	if !s.Scope.HasSystemAssignedIdentity() {
+		log.V(2).Info("no role assignment spec to delete")
+		return nil
+	}
+
+	resourceType := s.Scope.RoleAssignmentResourceType()
+	principalID, err := s.getSystemAssignedPrincipalID(ctx, resourceType)
+	if azure.ResourceNotFound(err) {
+		// The role assignment names are derived from the principal ID, which is gone along with the VM or VMSS.
+		log.V(2).Info("skipping role assignment deletion as the system assigned identity no longer exists", "resourceType", resourceType)
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to delete role assignments of system assigned identity")
+	}
+
+	for _, roleAssignmentSpec := range s.Scope.RoleAssignmentSpecs(principalID) {
+		log.V(2).Info("deleting role assignment", "scope", roleAssignmentSpec.OwnerResourceName())
+		if err := s.DeleteResource(ctx, roleAssignmentSpec, serviceName); err != nil {
+			return errors.Wrapf(err, "failed to delete role assignment on %s", roleAssignmentSpec.OwnerResourceName())
+		}
+	}
+
 	return nil
 }
 
@@ -45,8 +45,9 @@ var (
 		Name:          "test-vm",
 		ResourceGroup: "my-rg",
 	}
-	fakePrincipalID     = "fake-p-id"
-	fakeRoleAssignment1 = RoleAssignmentSpec{
+	fakePrincipalID         = "fake-p-id"
+	fakeImagePullIdentityID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/pull"
+	fakeRoleAssignment1     = RoleAssignmentSpec{
 		MachineName:   "test-vm",
 		ResourceGroup: "my-rg",
 		ResourceType:  azure.VirtualMachine,
@@ -102,6 +103,55 @@ func TestReconcileRoleAssignmentsVM(t *testing.T) {
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRoleAssignment1, serviceName).Return(&fakeRoleAssignment1, nil)
 			},
 		},
+		{
+			name:          "create a role assignment for the image pull identity",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName)
+				s.HasSystemAssignedIdentity().Return(false)
+				s.ImagePullIdentityID().Return(fakeImagePullIdentityID)
+				s.ImagePullRoleAssignmentSpec(&fakePrincipalID).Return(&fakeRoleAssignment1)
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(armcompute.VirtualMachine{
+					Identity: &armcompute.VirtualMachineIdentity{
+						UserAssignedIdentities: map[string]*armcompute.UserAssignedIdentitiesValue{
+							strings.ToLower(fakeImagePullIdentityID): {PrincipalID: &fakePrincipalID},
+						},
+					},
+				}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRoleAssignment1, serviceName).Return(&fakeRoleAssignment1, nil)
+			},
+		},
+		{
+			name:          "image pull identity is not assigned to the VM",
+			expectedError: "failed to assign role to image pull identity: user-assigned identity .* is not assigned to VM test-vm",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName).Times(2)
+				s.HasSystemAssignedIdentity().Return(false)
+				s.ImagePullIdentityID().Return(fakeImagePullIdentityID)
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(armcompute.VirtualMachine{
+					Identity: &armcompute.VirtualMachineIdentity{},
+				}, nil)
+			},
+		},
+		{
+			name:          "no role assignment without a system-assigned or image pull identity",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.HasSystemAssignedIdentity().Return(false)
+				s.ImagePullIdentityID().Return("")
+			},
+		},
 		{
 			name:          "error getting VM",
 			expectedError: "failed to assign role to system assigned identity: failed to get principal ID for VM:.*#: Internal Server Error: StatusCode=500",
@@ -263,3 +313,119 @@ func TestReconcileRoleAssignmentsVMSS(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteRoleAssignments(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expect        func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expectedError string
+	}{
+		{
+			name:          "no role assignment without a system-assigned identity",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				_ *mock_async.MockGetterMockRecorder,
+				_ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.HasSystemAssignedIdentity().Return(false)
+			},
+		},
+		{
+			name:          "delete the role assignments",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName)
+				s.HasSystemAssignedIdentity().Return(true)
+				s.RoleAssignmentResourceType().Return(azure.VirtualMachine)
+				s.RoleAssignmentSpecs(&fakePrincipalID).Return([]azure.ResourceSpecGetter{&fakeRoleAssignment1, &fakeDNSZoneRoleAssignment})
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(armcompute.VirtualMachine{
+					Identity: &armcompute.VirtualMachineIdentity{
+						PrincipalID: &fakePrincipalID,
+					},
+				}, nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeRoleAssignment1, serviceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, serviceName).Return(nil)
+			},
+		},
+		{
+			name:          "skip deleting the role assignments when the VM no longer exists",
+			expectedError: "",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				_ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName)
+				s.HasSystemAssignedIdentity().Return(true)
+				s.RoleAssignmentResourceType().Return(azure.VirtualMachine)
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(nil, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+			},
+		},
+		{
+			name:          "error getting VM",
+			expectedError: "failed to delete role assignments of system assigned identity: failed to get principal ID for VM:.*#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				_ *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName)
+				s.HasSystemAssignedIdentity().Return(true)
+				s.RoleAssignmentResourceType().Return(azure.VirtualMachine)
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(armcompute.VirtualMachine{}, internalError())
+			},
+		},
+		{
+			name:          "return error when deleting a role assignment",
+			expectedError: "failed to delete role assignment on /subscriptions/12345/resourceGroups/dns-rg/providers/Microsoft.Network/dnszones/example.com:.*#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_roleassignments.MockRoleAssignmentScopeMockRecorder,
+				m *mock_async.MockGetterMockRecorder,
+				r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ResourceGroup().Return("my-rg")
+				s.Name().Return(fakeRoleAssignment1.MachineName)
+				s.HasSystemAssignedIdentity().Return(true)
+				s.RoleAssignmentResourceType().Return(azure.VirtualMachine)
+				s.RoleAssignmentSpecs(&fakePrincipalID).Return([]azure.ResourceSpecGetter{&fakeDNSZoneRoleAssignment})
+				m.Get(gomockinternal.AContext(), &fakeVMSpec).Return(armcompute.VirtualMachine{
+					Identity: &armcompute.VirtualMachineIdentity{
+						PrincipalID: &fakePrincipalID,
+					},
+				}, nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeDNSZoneRoleAssignment, serviceName).Return(internalError())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_roleassignments.NewMockRoleAssignmentScope(mockCtrl)
+			vmGetterMock := mock_async.NewMockGetter(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), vmGetterMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:                 scopeMock,
+				virtualMachinesGetter: vmGetterMock,
+				Reconciler:            asyncMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(strings.ReplaceAll(err.Error(), "\n", "")).To(MatchRegexp(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -197,6 +197,20 @@ func (mr *MockRouteTableScopeMockRecorder) HashKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockRouteTableScope)(nil).HashKey))
 }
 
+// IsOutboundDisabled mocks base method.
+func (m *MockRouteTableScope) IsOutboundDisabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOutboundDisabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsOutboundDisabled indicates an expected call of IsOutboundDisabled.
+func (mr *MockRouteTableScopeMockRecorder) IsOutboundDisabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOutboundDisabled", reflect.TypeOf((*MockRouteTableScope)(nil).IsOutboundDisabled))
+}
+
 // IsVnetManaged mocks base method.
 func (m *MockRouteTableScope) IsVnetManaged() bool {
 	m.ctrl.T.Helper()
@@ -21,14 +21,25 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
-const serviceName = "routetables"
+const (
+	serviceName = "routetables"
+
+	// defaultRouteAddressPrefix is the address prefix of a route matching all IPv4 traffic.
+	defaultRouteAddressPrefix = "0.0.0.0/0"
+)
+
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupNetworking)
+}
 
 // RouteTableScope defines the scope interface for route table service.
 type RouteTableScope interface {
@@ -36,12 +47,14 @@ type RouteTableScope interface {
 	azure.AsyncStatusUpdater
 	RouteTableSpecs() []azure.ResourceSpecGetter
 	IsVnetManaged() bool
+	IsOutboundDisabled() bool
 }
 
 // Service provides operations on azure resources.
 type Service struct {
 	Scope RouteTableScope
 	async.Reconciler
+	async.Getter
 }
 
 // New creates a new service.
@@ -51,7 +64,8 @@ func New(scope RouteTableScope) (*Service, error) {
 		return nil, err
 	}
 	return &Service{
-		Scope: scope,
+		Scope:  scope,
+		Getter: client,
 		Reconciler: async.New[armnetwork.RouteTablesClientCreateOrUpdateResponse,
 			armnetwork.RouteTablesClientDeleteResponse](scope, client, client),
 	}, nil
@@ -67,14 +81,19 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "routetables.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	var resErr error
 
 	if managed, err := s.IsManaged(ctx); err == nil && !managed {
 		log.V(4).Info("Skipping route tables reconcile in custom vnet mode")
-		return nil
+		if !s.Scope.IsOutboundDisabled() {
+			return nil
+		}
+		resErr = s.validateDefaultRoutes(ctx)
+		s.Scope.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, resErr)
+		return resErr
 	} else if err != nil {
 		return errors.Wrap(err, "failed to check if route tables are managed")
 	}
@@ -95,16 +114,53 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		}
 	}
 
+	if resErr == nil && s.Scope.IsOutboundDisabled() {
+		resErr = s.validateDefaultRoutes(ctx)
+	}
+
 	s.Scope.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, resErr)
 	return resErr
 }
 
+// validateDefaultRoutes returns an error if one of the route tables has no default route. It is used when the
+// cluster's outbound connectivity is disabled, as nodes would then have no egress at all.
+func (s *Service) validateDefaultRoutes(ctx context.Context) error {
+	for _, rtSpec := range s.Scope.RouteTableSpecs() {
+		existing, err := s.Get(ctx, rtSpec)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get route table %s", rtSpec.ResourceName())
+		}
+		routeTable, ok := existing.(armnetwork.RouteTable)
+		if !ok {
+			return errors.Errorf("%T is not an armnetwork.RouteTable", existing)
+		}
+		if !hasDefaultRoute(routeTable) {
+			return errors.Errorf("route table %s has no default route (%s) but outbound connectivity is disabled for the cluster", rtSpec.ResourceName(), defaultRouteAddressPrefix)
+		}
+	}
+
+	return nil
+}
+
+// hasDefaultRoute returns true if the route table routes all Internet-bound traffic.
+func hasDefaultRoute(routeTable armnetwork.RouteTable) bool {
+	if routeTable.Properties == nil {
+		return false
+	}
+	for _, route := range routeTable.Properties.Routes {
+		if route != nil && route.Properties != nil && ptr.Deref(route.Properties.AddressPrefix, "") == defaultRouteAddressPrefix {
+			return true
+		}
+	}
+	return false
+}
+
 // Delete deletes route tables.
 func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "routetables.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	// Only delete the route tables if their lifecycle is managed by this controller.
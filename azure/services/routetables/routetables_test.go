@@ -21,8 +21,10 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -48,6 +50,20 @@ var (
 		Location:      "fake-location",
 		ClusterName:   "test-cluster",
 	}
+	routeTableWithDefaultRoute = armnetwork.RouteTable{
+		Properties: &armnetwork.RouteTablePropertiesFormat{
+			Routes: []*armnetwork.Route{
+				{
+					Name: ptr.To("default"),
+					Properties: &armnetwork.RoutePropertiesFormat{
+						AddressPrefix:    ptr.To("0.0.0.0/0"),
+						NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+						NextHopIPAddress: ptr.To("10.0.0.4"),
+					},
+				},
+			},
+		},
+	}
 	errFake      = errors.New("this is an error")
 	notDoneError = azure.NewOperationNotDoneError(&infrav1.Future{})
 )
@@ -57,12 +73,12 @@ func TestReconcileRouteTables(t *testing.T) {
 		name          string
 		tags          infrav1.Tags
 		expectedError string
-		expect        func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expect        func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder)
 	}{
 		{
 			name:          "noop if no route table specs are found",
 			expectedError: "",
-			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{})
@@ -71,19 +87,48 @@ func TestReconcileRouteTables(t *testing.T) {
 		{
 			name:          "create multiple route tables succeeds",
 			expectedError: "",
-			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, nil)
+				s.IsOutboundDisabled().Return(false)
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "route tables with a default route succeed when outbound is disabled",
+			expectedError: "",
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, nil)
+				s.IsOutboundDisabled().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT})
+				g.Get(gomockinternal.AContext(), &fakeRT).Return(routeTableWithDefaultRoute, nil)
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "route table without a default route fails when outbound is disabled",
+			expectedError: "route table test-rt-1 has no default route (0.0.0.0/0) but outbound connectivity is disabled for the cluster",
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, nil)
+				s.IsOutboundDisabled().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT})
+				g.Get(gomockinternal.AContext(), &fakeRT).Return(armnetwork.RouteTable{Properties: &armnetwork.RouteTablePropertiesFormat{}}, nil)
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, gomockinternal.ErrStrEq("route table test-rt-1 has no default route (0.0.0.0/0) but outbound connectivity is disabled for the cluster"))
+			},
+		},
 		{
 			name:          "first route table create fails",
 			expectedError: errFake.Error(),
-			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
@@ -95,7 +140,7 @@ func TestReconcileRouteTables(t *testing.T) {
 		{
 			name:          "second route table create not done",
 			expectedError: errFake.Error(),
-			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(true)
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
@@ -107,9 +152,22 @@ func TestReconcileRouteTables(t *testing.T) {
 		{
 			name:          "noop if vnet is not managed",
 			expectedError: "",
-			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.IsVnetManaged().Return(false)
+				s.IsOutboundDisabled().Return(false)
+			},
+		},
+		{
+			name:          "route table without a default route fails when vnet is not managed and outbound is disabled",
+			expectedError: "route table test-rt-1 has no default route (0.0.0.0/0) but outbound connectivity is disabled for the cluster",
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(false)
+				s.IsOutboundDisabled().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT})
+				g.Get(gomockinternal.AContext(), &fakeRT).Return(armnetwork.RouteTable{Properties: &armnetwork.RouteTablePropertiesFormat{}}, nil)
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, gomockinternal.ErrStrEq("route table test-rt-1 has no default route (0.0.0.0/0) but outbound connectivity is disabled for the cluster"))
 			},
 		},
 	}
@@ -122,12 +180,14 @@ func TestReconcileRouteTables(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_routetables.NewMockRouteTableScope(mockCtrl)
 			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
 
-			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT(), getterMock.EXPECT())
 
 			s := &Service{
 				Scope:      scopeMock,
 				Reconciler: reconcilerMock,
+				Getter:     getterMock,
 			}
 
 			err := s.Reconcile(context.TODO())
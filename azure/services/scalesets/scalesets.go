@@ -22,6 +22,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	azprovider "sigs.k8s.io/cloud-provider-azure/pkg/provider"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -29,13 +30,19 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/slice"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "scalesets"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupCompute)
+}
+
 type (
 	// ScaleSetScope defines the scope interface for a scale sets service.
 	ScaleSetScope interface {
@@ -83,7 +90,7 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	if err := s.validateSpec(ctx); err != nil {
@@ -159,7 +166,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	scaleSetSpec := s.Scope.ScaleSetSpec(ctx)
@@ -183,7 +190,7 @@ func (s *Service) Delete(ctx context.Context) error {
 }
 
 func (s *Service) validateSpec(ctx context.Context) error {
-	ctx, _, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.validateSpec")
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "scalesets.Service.validateSpec")
 	defer done()
 
 	spec := s.Scope.ScaleSetSpec(ctx)
@@ -222,7 +229,22 @@ func (s *Service) validateSpec(ctx context.Context) error {
 		return azure.WithTerminalError(fmt.Errorf("vm size %s does not support ephemeral os. select a different vm size or disable ephemeral os", scaleSetSpec.Size))
 	}
 
-	if scaleSetSpec.SecurityProfile != nil && !sku.HasCapability(resourceskus.EncryptionAtHost) {
+	if scaleSetSpec.OSDisk.DiffDiskSettings != nil {
+		hasCapacity, err := sku.HasEphemeralOSDiskCapacity(scaleSetSpec.OSDisk)
+		if err != nil {
+			return azure.WithTerminalError(errors.Wrap(err, "failed to validate the ephemeral os disk capacity"))
+		}
+		if !hasCapacity {
+			// Without an explicit OS disk size, the capacity check relies on the size of a reference image, which
+			// may be larger than the image actually used.
+			if scaleSetSpec.OSDisk.DiskSizeGB != nil {
+				return azure.WithTerminalError(fmt.Errorf("vm size %s does not have enough cache or temp disk space for the ephemeral os disk. select a different vm size or placement, or a smaller os disk", scaleSetSpec.Size))
+			}
+			log.Info("vm size may not have enough cache or temp disk space for the ephemeral os disk", "size", scaleSetSpec.Size)
+		}
+	}
+
+	if scaleSetSpec.SecurityProfile != nil && ptr.Deref(scaleSetSpec.SecurityProfile.EncryptionAtHost, false) && !sku.HasCapability(resourceskus.EncryptionAtHost) {
 		return azure.WithTerminalError(errors.Errorf("encryption at host is not supported for VM type %s", scaleSetSpec.Size))
 	}
 
@@ -253,6 +275,27 @@ func (s *Service) validateSpec(ctx context.Context) error {
 		}
 	}
 
+	// Warn about storage account types, e.g. Premium_ZRS, which are not available for managed disks in the location.
+	// The scale set is still created, leaving the final decision to Azure.
+	diskSKUs, err := s.resourceSKUCache.GetDiskSKUs(ctx)
+	if err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "failed to get the disk SKUs for location %s", scaleSetSpec.Location))
+	}
+	storageAccountTypes := make([]string, 0, len(scaleSetSpec.DataDisks)+1)
+	if scaleSetSpec.OSDisk.ManagedDisk != nil {
+		storageAccountTypes = append(storageAccountTypes, scaleSetSpec.OSDisk.ManagedDisk.StorageAccountType)
+	}
+	for _, disk := range scaleSetSpec.DataDisks {
+		if disk.ManagedDisk != nil {
+			storageAccountTypes = append(storageAccountTypes, disk.ManagedDisk.StorageAccountType)
+		}
+	}
+	for _, storageAccountType := range storageAccountTypes {
+		if storageAccountType != "" && !resourceskus.IsDiskSKUAvailable(diskSKUs, storageAccountType) {
+			log.Info("storage account type is not available for managed disks in the location", "storageAccountType", storageAccountType, "location", scaleSetSpec.Location)
+		}
+	}
+
 	// Validate DiagnosticProfile spec
 	if scaleSetSpec.DiagnosticsProfile != nil && scaleSetSpec.DiagnosticsProfile.Boot != nil {
 		if scaleSetSpec.DiagnosticsProfile.Boot.StorageAccountType == infrav1.UserManagedDiagnosticsStorage {
@@ -275,6 +318,13 @@ func (s *Service) validateSpec(ctx context.Context) error {
 		}
 	}
 
+	// A single placement group is limited in size. This isn't a terminal error since the MachinePool can be scaled
+	// back down.
+	if ptr.Deref(scaleSetSpec.SinglePlacementGroup, false) && scaleSetSpec.Capacity > infrav1exp.MaxSinglePlacementGroupInstances {
+		return errors.Errorf("scale set %s with a single placement group cannot have more than %d instances, but %d were requested",
+			scaleSetSpec.Name, infrav1exp.MaxSinglePlacementGroupInstances, scaleSetSpec.Capacity)
+	}
+
 	// Checking if selected availability zones are available selected VM type in location
 	azsInLocation, err := s.resourceSKUCache.GetZonesWithVMSize(ctx, scaleSetSpec.Size, scaleSetSpec.Location)
 	if err != nil {
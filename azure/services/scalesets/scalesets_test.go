@@ -278,6 +278,54 @@ func TestReconcileVMSS(t *testing.T) {
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
 			},
 		},
+		{
+			name:          "validate spec failure: fail to create a vm with an ephemeral os disk larger than the temp disk",
+			expectedError: "reconcile error that cannot be recovered occurred: vm size VM_SIZE_EPH does not have enough cache or temp disk space for the ephemeral os disk. select a different vm size or placement, or a smaller os disk. Object will not be requeued",
+			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				spec := newDefaultVMSSSpec()
+				spec.Size = vmSizeEPH
+				spec.Capacity = 2
+				spec.SSHKeyData = sshKeyData
+				spec.OSDisk.DiffDiskSettings = &infrav1.DiffDiskSettings{
+					Option:    string(armcompute.DiffDiskOptionsLocal),
+					Placement: ptr.To(infrav1.DiffDiskPlacementResourceDisk),
+				}
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
+			},
+		},
+		{
+			name:          "create a vmss with encryption at host enabled, when the vm size supports it",
+			expectedError: "",
+			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				spec := newDefaultVMSSSpec()
+				spec.Size = "VM_SIZE_EAH"
+				spec.SecurityProfile = &infrav1.SecurityProfile{
+					EncryptionAtHost: ptr.To(true),
+				}
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
+				m.Get(gomockinternal.AContext(), &spec).Return(nil, notFoundError)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &spec, serviceName).Return(getResultVMSS(), nil)
+				s.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, nil)
+
+				s.ReconcileReplicas(gomockinternal.AContext(), &fetchedVMSS).Return(nil)
+				s.SetProviderID(azureutil.ProviderIDPrefix + defaultVMSSID)
+				s.SetVMSSState(&fetchedVMSS)
+			},
+		},
+		{
+			name:          "validate spec failure: fail to create a vm with encryption at host enabled, when the vm size does not support it",
+			expectedError: "reconcile error that cannot be recovered occurred: encryption at host is not supported for VM type VM_SIZE. Object will not be requeued",
+			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				spec := newDefaultVMSSSpec()
+				spec.SecurityProfile = &infrav1.SecurityProfile{
+					EncryptionAtHost: ptr.To(true),
+				}
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
+			},
+		},
 		{
 			name:          "validate spec failure: fail to create a vm with diagnostics set to User Managed but empty StorageAccountURI",
 			expectedError: "reconcile error that cannot be recovered occurred: userManaged must be specified when storageAccountType is 'UserManaged'. Object will not be requeued",
@@ -296,6 +344,17 @@ func TestReconcileVMSS(t *testing.T) {
 				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
 			},
 		},
+		{
+			name:          "validate spec failure: more instances than a single placement group allows",
+			expectedError: "scale set my-vmss with a single placement group cannot have more than 100 instances, but 101 were requested",
+			expect: func(g *WithT, s *mock_scalesets.MockScaleSetScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, m *mock_scalesets.MockClientMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				spec := newDefaultVMSSSpec()
+				spec.Capacity = 101
+				spec.SinglePlacementGroup = ptr.To(true)
+				s.ScaleSetSpec(gomockinternal.AContext()).Return(&spec).AnyTimes()
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -631,6 +690,14 @@ func getFakeSkus() []armcompute.ResourceSKU {
 					Name:  ptr.To(resourceskus.EphemeralOSDisk),
 					Value: ptr.To("True"),
 				},
+				{
+					Name:  ptr.To(resourceskus.CachedDiskBytes),
+					Value: ptr.To("137438953472"),
+				},
+				{
+					Name:  ptr.To(resourceskus.MaxResourceVolumeMB),
+					Value: ptr.To("65536"),
+				},
 			},
 		},
 	}
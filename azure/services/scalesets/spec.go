@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/generators"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
@@ -75,6 +76,14 @@ type ScaleSetSpec struct {
 	AdditionalTags               infrav1.Tags
 	PlatformFaultDomainCount     *int32
 	ZoneBalance                  *bool
+	Overprovision                *bool
+	SinglePlacementGroup         *bool
+	ScaleInPolicy                string
+	AutomaticOSUpgrade           *bool
+	RollingUpgradePolicy         *infrav1exp.AzureMachinePoolRollingUpgradePolicy
+	OSDiskDeleteOption           string
+	DataDisksDeleteOption        string
+	NICDeleteOption              string
 }
 
 // ResourceName returns the name of the Scale Set.
@@ -125,6 +134,10 @@ func (s *ScaleSetSpec) existingParameters(ctx context.Context, existing interfac
 	if s.MaxSurge > 0 && (hasModelChanges || !updated) && !s.HasReplicasExternallyManaged {
 		// surge capacity with the intention of lowering during instance reconciliation
 		surge := s.Capacity + int64(s.MaxSurge)
+		if ptr.Deref(s.SinglePlacementGroup, false) && surge > infrav1exp.MaxSinglePlacementGroupInstances {
+			// a single placement group can't hold more instances, so surge only up to its limit
+			surge = max(s.Capacity, infrav1exp.MaxSinglePlacementGroupInstances)
+		}
 		vmss.SKU.Capacity = ptr.To[int64](surge)
 	}
 
@@ -199,7 +212,7 @@ func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		Plan:  s.generateImagePlan(ctx),
 		Properties: &armcompute.VirtualMachineScaleSetProperties{
 			OrchestrationMode:    ptr.To(orchestrationMode),
-			SinglePlacementGroup: ptr.To(false),
+			SinglePlacementGroup: ptr.To(ptr.Deref(s.SinglePlacementGroup, false)),
 			VirtualMachineProfile: &armcompute.VirtualMachineScaleSetVMProfile{
 				OSProfile:          osProfile,
 				StorageProfile:     storageProfile,
@@ -238,6 +251,32 @@ func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		vmss.Properties.ZoneBalance = s.ZoneBalance
 	}
 
+	if s.Overprovision != nil && orchestrationMode == armcompute.OrchestrationModeUniform {
+		vmss.Properties.Overprovision = s.Overprovision
+		// Extra instances are deleted once the requested capacity is reached, so they should not run the bootstrap extensions.
+		vmss.Properties.DoNotRunExtensionsOnOverprovisionedVMs = s.Overprovision
+	}
+
+	if s.ScaleInPolicy != "" {
+		vmss.Properties.ScaleInPolicy = &armcompute.ScaleInPolicy{
+			Rules: []*armcompute.VirtualMachineScaleSetScaleInRules{
+				ptr.To(armcompute.VirtualMachineScaleSetScaleInRules(s.ScaleInPolicy)),
+			},
+		}
+	}
+
+	if ptr.Deref(s.AutomaticOSUpgrade, false) {
+		if vmss.Properties.UpgradePolicy == nil {
+			vmss.Properties.UpgradePolicy = &armcompute.UpgradePolicy{Mode: ptr.To(armcompute.UpgradeModeManual)}
+		}
+		// Automatic OS upgrades roll out in batches according to the rolling upgrade policy, independently of
+		// the upgrade mode, so CAPZ keeps rolling out its own model changes.
+		vmss.Properties.UpgradePolicy.AutomaticOSUpgradePolicy = &armcompute.AutomaticOSUpgradePolicy{
+			EnableAutomaticOSUpgrade: ptr.To(true),
+		}
+		vmss.Properties.UpgradePolicy.RollingUpgradePolicy = s.getRollingUpgradePolicy()
+	}
+
 	// Assign Identity to VMSS
 	if s.Identity == infrav1.VMIdentitySystemAssigned {
 		vmss.Identity = &armcompute.VirtualMachineScaleSetIdentity{
@@ -331,6 +370,9 @@ func (s *ScaleSetSpec) getVirtualMachineScaleSetNetworkConfiguration() *[]armcom
 		nicConfig.Properties = &armcompute.VirtualMachineScaleSetNetworkConfigurationProperties{}
 		nicConfig.Name = ptr.To(s.Name + "-nic-" + strconv.Itoa(i))
 		nicConfig.Properties.EnableIPForwarding = ptr.To(true)
+		if s.OrchestrationMode == infrav1.FlexibleOrchestrationMode {
+			nicConfig.Properties.DeleteOption = ptr.To(armcompute.DeleteOptions(deleteOptionOrDefault(s.NICDeleteOption)))
+		}
 		if n.AcceleratedNetworking != nil {
 			nicConfig.Properties.EnableAcceleratedNetworking = n.AcceleratedNetworking
 		} else {
@@ -423,6 +465,12 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 		storageProfile.OSDisk.Caching = ptr.To(armcompute.CachingTypes(s.OSDisk.CachingType))
 	}
 
+	// Delete options are only supported for Flexible scale sets, and ephemeral OS disks are always deleted.
+	isFlex := s.OrchestrationMode == infrav1.FlexibleOrchestrationMode
+	if isFlex && s.OSDisk.DiffDiskSettings == nil {
+		storageProfile.OSDisk.DeleteOption = ptr.To(armcompute.DiskDeleteOptionTypes(deleteOptionOrDefault(s.OSDiskDeleteOption)))
+	}
+
 	dataDisks := make([]armcompute.VirtualMachineScaleSetDataDisk, len(s.DataDisks))
 	for i, disk := range s.DataDisks {
 		dataDisks[i] = armcompute.VirtualMachineScaleSetDataDisk{
@@ -432,6 +480,10 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 			Name:         ptr.To(azure.GenerateDataDiskName(s.Name, disk.NameSuffix)),
 		}
 
+		if isFlex {
+			dataDisks[i].DeleteOption = ptr.To(armcompute.DiskDeleteOptionTypes(deleteOptionOrDefault(s.DataDisksDeleteOption)))
+		}
+
 		if disk.ManagedDisk != nil {
 			dataDisks[i].ManagedDisk = &armcompute.VirtualMachineScaleSetManagedDiskParameters{
 				StorageAccountType: ptr.To(armcompute.StorageAccountTypes(disk.ManagedDisk.StorageAccountType)),
@@ -457,6 +509,14 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 	return storageProfile, nil
 }
 
+// deleteOptionOrDefault returns the given delete option, or Delete if none is set.
+func deleteOptionOrDefault(option string) string {
+	if option == "" {
+		return string(armcompute.DiskDeleteOptionTypesDelete)
+	}
+	return option
+}
+
 func (s *ScaleSetSpec) generateOSProfile(_ context.Context) (*armcompute.VirtualMachineScaleSetOSProfile, error) {
 	sshKey, err := base64.StdEncoding.DecodeString(s.SSHKeyData)
 	if err != nil {
@@ -544,3 +604,18 @@ func (s *ScaleSetSpec) getSecurityProfile() (*armcompute.SecurityProfile, error)
 		EncryptionAtHost: ptr.To(*s.SecurityProfile.EncryptionAtHost),
 	}, nil
 }
+
+func (s *ScaleSetSpec) getRollingUpgradePolicy() *armcompute.RollingUpgradePolicy {
+	if s.RollingUpgradePolicy == nil {
+		return nil
+	}
+	policy := &armcompute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             s.RollingUpgradePolicy.MaxBatchInstancePercent,
+		MaxUnhealthyInstancePercent:         s.RollingUpgradePolicy.MaxUnhealthyInstancePercent,
+		MaxUnhealthyUpgradedInstancePercent: s.RollingUpgradePolicy.MaxUnhealthyUpgradedInstancePercent,
+	}
+	if s.RollingUpgradePolicy.PauseTimeBetweenBatches != nil {
+		policy.PauseTimeBetweenBatches = ptr.To(fmt.Sprintf("PT%dS", int64(s.RollingUpgradePolicy.PauseTimeBetweenBatches.Seconds())))
+	}
+	return policy
+}
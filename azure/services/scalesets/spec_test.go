@@ -20,16 +20,19 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 )
 
 var (
@@ -55,6 +58,11 @@ var (
 	managedDiagnosticsSpec, managedDiagnoisticsVMSS                                                                                                                                       = getManagedDiagnosticsVMSS()
 	disabledDiagnosticsSpec, disabledDiagnosticsVMSS                                                                                                                                      = getDisabledDiagnosticsVMSS()
 	nilDiagnosticsProfileSpec, nilDiagnosticsProfileVMSS                                                                                                                                  = getNilDiagnosticsProfileVMSS()
+	defaultDeleteOptionSpec, defaultDeleteOptionVMSS                                                                                                                                      = getFlexibleDeleteOptionVMSS("", armcompute.DiskDeleteOptionTypesDelete)
+	detachDeleteOptionSpec, detachDeleteOptionVMSS                                                                                                                                        = getFlexibleDeleteOptionVMSS("Detach", armcompute.DiskDeleteOptionTypesDetach)
+	overprovisionScaleInSpec, overprovisionScaleInVMSS                                                                                                                                    = getOverprovisionScaleInPolicyVMSS()
+	automaticOSUpgradeSpec, automaticOSUpgradeVMSS                                                                                                                                        = getAutomaticOSUpgradeVMSS()
+	singlePlacementGroupSpec, singlePlacementGroupVMSS                                                                                                                                    = getSinglePlacementGroupVMSS()
 )
 
 func getDefaultVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
@@ -587,6 +595,70 @@ func getNilDiagnosticsProfileVMSS() (ScaleSetSpec, armcompute.VirtualMachineScal
 	return spec, vmss
 }
 
+func getFlexibleDeleteOptionVMSS(option string, expected armcompute.DiskDeleteOptionTypes) (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.OrchestrationMode = infrav1.FlexibleOrchestrationMode
+	spec.OSDiskDeleteOption = option
+	spec.DataDisksDeleteOption = option
+	spec.NICDeleteOption = option
+
+	vmss.Properties.OrchestrationMode = ptr.To(armcompute.OrchestrationModeFlexible)
+	vmss.Properties.Overprovision = nil
+	vmss.Properties.UpgradePolicy = nil
+	vmss.Properties.VirtualMachineProfile.NetworkProfile.NetworkAPIVersion = ptr.To(armcompute.NetworkAPIVersionTwoThousandTwenty1101)
+	vmss.Properties.VirtualMachineProfile.StorageProfile.OSDisk.DeleteOption = ptr.To(expected)
+	for _, dataDisk := range vmss.Properties.VirtualMachineProfile.StorageProfile.DataDisks {
+		dataDisk.DeleteOption = ptr.To(expected)
+	}
+	for _, nicConfig := range vmss.Properties.VirtualMachineProfile.NetworkProfile.NetworkInterfaceConfigurations {
+		nicConfig.Properties.DeleteOption = ptr.To(armcompute.DeleteOptions(expected))
+	}
+
+	return spec, vmss
+}
+
+func getOverprovisionScaleInPolicyVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.Overprovision = ptr.To(true)
+	spec.ScaleInPolicy = "OldestVM"
+
+	vmss.Properties.Overprovision = ptr.To(true)
+	vmss.Properties.DoNotRunExtensionsOnOverprovisionedVMs = ptr.To(true)
+	vmss.Properties.ScaleInPolicy = &armcompute.ScaleInPolicy{
+		Rules: []*armcompute.VirtualMachineScaleSetScaleInRules{ptr.To(armcompute.VirtualMachineScaleSetScaleInRulesOldestVM)},
+	}
+
+	return spec, vmss
+}
+
+func getAutomaticOSUpgradeVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.AutomaticOSUpgrade = ptr.To(true)
+	spec.RollingUpgradePolicy = &infrav1exp.AzureMachinePoolRollingUpgradePolicy{
+		MaxBatchInstancePercent: ptr.To[int32](50),
+		PauseTimeBetweenBatches: &metav1.Duration{Duration: 90 * time.Second},
+	}
+
+	vmss.Properties.UpgradePolicy.AutomaticOSUpgradePolicy = &armcompute.AutomaticOSUpgradePolicy{
+		EnableAutomaticOSUpgrade: ptr.To(true),
+	}
+	vmss.Properties.UpgradePolicy.RollingUpgradePolicy = &armcompute.RollingUpgradePolicy{
+		MaxBatchInstancePercent: ptr.To[int32](50),
+		PauseTimeBetweenBatches: ptr.To("PT90S"),
+	}
+
+	return spec, vmss
+}
+
+func getSinglePlacementGroupVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec, vmss := getDefaultVMSS()
+	spec.SinglePlacementGroup = ptr.To(true)
+
+	vmss.Properties.SinglePlacementGroup = ptr.To(true)
+
+	return spec, vmss
+}
+
 func TestScaleSetParameters(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -749,6 +821,41 @@ func TestScaleSetParameters(t *testing.T) {
 			expected:      nilDiagnosticsProfileVMSS,
 			expectedError: "",
 		},
+		{
+			name:          "flexible vmss defaults disks and network interfaces to be deleted with the instance",
+			spec:          defaultDeleteOptionSpec,
+			existing:      nil,
+			expected:      defaultDeleteOptionVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "flexible vmss detaches disks and network interfaces from deleted instances",
+			spec:          detachDeleteOptionSpec,
+			existing:      nil,
+			expected:      detachDeleteOptionVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "uniform vmss with overprovisioning and OldestVM scale-in policy",
+			spec:          overprovisionScaleInSpec,
+			existing:      nil,
+			expected:      overprovisionScaleInVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "uniform vmss with automatic OS upgrades",
+			spec:          automaticOSUpgradeSpec,
+			existing:      nil,
+			expected:      automaticOSUpgradeVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "uniform vmss with a single placement group",
+			spec:          singlePlacementGroupSpec,
+			existing:      nil,
+			expected:      singlePlacementGroupVMSS,
+			expectedError: "",
+		},
 		{
 			name:          "update for existing vmss with only capacity change",
 			spec:          defaultExistingSpecOnlyCapacityChange,
@@ -40,8 +40,13 @@ type SubnetSpec struct {
 	IsVNetManaged     bool
 	RouteTableName    string
 	SecurityGroupName string
+	SecurityGroupID   string
 	NatGatewayName    string
 	ServiceEndpoints  infrav1.ServiceEndpoints
+
+	// DisablePrivateLinkServiceNetworkPolicies disables the network policies for private link services, which is
+	// required to allocate the NAT IP addresses of a private link service in the subnet.
+	DisablePrivateLinkServiceNetworkPolicies bool
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -90,7 +95,14 @@ func (s *SubnetSpec) Parameters(_ context.Context, existing *asonetworkv1.Virtua
 		}
 	}
 
-	if s.SecurityGroupName != "" {
+	// An unmanaged security group is referenced by its ID, which may live outside of the vnet resource group.
+	if s.SecurityGroupID != "" {
+		subnet.Spec.NetworkSecurityGroup = &asonetworkv1.NetworkSecurityGroupSpec_VirtualNetworks_Subnet_SubResourceEmbedded{
+			Reference: &genruntime.ResourceReference{
+				ARMID: s.SecurityGroupID,
+			},
+		}
+	} else if s.SecurityGroupName != "" {
 		subnet.Spec.NetworkSecurityGroup = &asonetworkv1.NetworkSecurityGroupSpec_VirtualNetworks_Subnet_SubResourceEmbedded{
 			Reference: &genruntime.ResourceReference{
 				ARMID: azure.SecurityGroupID(s.SubscriptionID, s.VNetResourceGroup, s.SecurityGroupName),
@@ -104,6 +116,10 @@ func (s *SubnetSpec) Parameters(_ context.Context, existing *asonetworkv1.Virtua
 	}
 	subnet.Spec.ServiceEndpoints = serviceEndpoints
 
+	if s.DisablePrivateLinkServiceNetworkPolicies {
+		subnet.Spec.PrivateLinkServiceNetworkPolicies = ptr.To(asonetworkv1.SubnetPropertiesFormat_PrivateLinkServiceNetworkPolicies_Disabled)
+	}
+
 	return subnet, nil
 }
 
@@ -89,6 +89,36 @@ func TestParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "unmanaged security group referenced by ID",
+			spec: &SubnetSpec{
+				IsVNetManaged:     true,
+				Name:              "subnet",
+				SubscriptionID:    "sub",
+				ResourceGroup:     "rg",
+				VNetName:          "vnet",
+				VNetResourceGroup: "vnet-rg",
+				CIDRs:             []string{"cidr"},
+				SecurityGroupName: "securitygroup",
+				SecurityGroupID:   "/subscriptions/sub/resourceGroups/nsg-rg/providers/Microsoft.Network/networkSecurityGroups/securitygroup",
+			},
+			existing: nil,
+			expected: &asonetworkv1.VirtualNetworksSubnet{
+				Spec: asonetworkv1.VirtualNetworks_Subnet_Spec{
+					AzureName: "subnet",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "vnet",
+					},
+					AddressPrefixes: []string{"cidr"},
+					AddressPrefix:   ptr.To("cidr"),
+					NetworkSecurityGroup: &asonetworkv1.NetworkSecurityGroupSpec_VirtualNetworks_Subnet_SubResourceEmbedded{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/sub/resourceGroups/nsg-rg/providers/Microsoft.Network/networkSecurityGroups/securitygroup",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "with existing subnet",
 			spec: &SubnetSpec{
@@ -18,6 +18,7 @@ package tags
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	"github.com/pkg/errors"
@@ -25,11 +26,16 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "tags"
 
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupResources)
+}
+
 // TagScope defines the scope interface for a tags service.
 type TagScope interface {
 	azure.Authorizer
@@ -67,7 +73,8 @@ func (s *Service) Name() string {
 // key for those types should be listed here so their tags are always
 // interpreted as managed.
 var alwaysManagedAnnotations = map[string]struct{}{
-	azure.ManagedClusterTagsLastAppliedAnnotation: {},
+	azure.ManagedClusterTagsLastAppliedAnnotation:    {},
+	azure.NodeResourceGroupTagsLastAppliedAnnotation: {},
 }
 
 // Reconcile ensures tags are correct.
@@ -96,6 +103,10 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		}
 		changed, createdOrUpdated, deleted, newAnnotation := TagsChanged(lastAppliedTags, tagsSpec.Tags, tags)
 		if changed {
+			if azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped update of tags at scope %s", tagsSpec.Scope)) {
+				// The annotation is left as is so the tags are still seen as changed once dry-run mode is disabled.
+				continue
+			}
 			log.V(2).Info("Updating tags")
 			if len(createdOrUpdated) > 0 {
 				createdOrUpdatedTags := make(map[string]*string)
@@ -294,6 +294,54 @@ func TestReconcileTags(t *testing.T) {
 	}
 }
 
+func TestReconcileTagsDryRun(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	scopeMock := mock_tags.NewMockTagScope(mockCtrl)
+	clientMock := mock_tags.NewMockclient(mockCtrl)
+
+	scopeMock.EXPECT().ClusterName().AnyTimes().Return("test-cluster")
+	scopeMock.EXPECT().TagsSpecs().Return([]azure.TagsSpec{
+		{
+			Scope: "/sub/123/fake/scope",
+			Tags: map[string]string{
+				"foo": "bar",
+			},
+			Annotation: "my-annotation",
+		},
+	})
+	clientMock.EXPECT().GetAtScope(gomockinternal.AContext(), "/sub/123/fake/scope").Return(armresources.TagsResource{Properties: &armresources.Tags{
+		Tags: map[string]*string{
+			"sigs.k8s.io_cluster-api-provider-azure_cluster_test-cluster": ptr.To("owned"),
+			"removed": ptr.To("value"),
+		},
+	}}, nil)
+	scopeMock.EXPECT().AnnotationJSON("my-annotation").Return(map[string]interface{}{"removed": "value"}, nil)
+
+	dryRunScope := &dryRunTagScope{MockTagScope: scopeMock}
+	s := &Service{
+		Scope:  dryRunScope,
+		client: clientMock,
+	}
+
+	g.Expect(s.Reconcile(context.TODO())).To(Succeed())
+	g.Expect(dryRunScope.skipped).To(ConsistOf("dry-run: skipped update of tags at scope /sub/123/fake/scope"))
+}
+
+// dryRunTagScope is a TagScope in dry-run mode which records the skipped mutations.
+type dryRunTagScope struct {
+	*mock_tags.MockTagScope
+	skipped []string
+}
+
+func (s *dryRunTagScope) IsDryRun() bool {
+	return true
+}
+
+func (s *dryRunTagScope) RecordDryRunSkip(message string) {
+	s.skipped = append(s.skipped, message)
+}
+
 func TestTagsChanged(t *testing.T) {
 	g := NewWithT(t)
 
@@ -18,6 +18,8 @@ package virtualmachines
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
@@ -27,6 +29,7 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -34,14 +37,24 @@ type (
 	// AzureClient contains the Azure go-sdk Client.
 	AzureClient struct {
 		virtualmachines *armcompute.VirtualMachinesClient
+		auth            azure.Authorizer
 		apiCallTimeout  time.Duration
+
+		// capacityReservations holds the capacity reservations clients by lowercase subscription ID.
+		capacityReservationsMu sync.Mutex
+		capacityReservations   map[string]*armcompute.CapacityReservationsClient
 	}
 
 	// Client provides operations on Azure virtual machine resources.
 	Client interface {
 		Get(context.Context, azure.ResourceSpecGetter) (interface{}, error)
+		GetInstanceView(ctx context.Context, spec azure.ResourceSpecGetter) (armcompute.VirtualMachineInstanceView, error)
 		CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.VirtualMachinesClientCreateOrUpdateResponse], err error)
 		DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachinesClientDeleteResponse], err error)
+		DeallocateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachinesClientDeallocateResponse], err error)
+		StartAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachinesClientStartResponse], err error)
+		UpdateOSDiskSizeAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, diskSizeGB int32) (poller *runtime.Poller[armcompute.VirtualMachinesClientUpdateResponse], err error)
+		ListCapacityReservations(ctx context.Context, capacityReservationGroupID string) ([]armcompute.CapacityReservation, error)
 	}
 )
 
@@ -57,10 +70,17 @@ func NewClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*AzureClien
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create armcompute client factory")
 	}
-	return &AzureClient{factory.NewVirtualMachinesClient(), apiCallTimeout}, nil
+	return &AzureClient{
+		virtualmachines: factory.NewVirtualMachinesClient(),
+		auth:            auth,
+		apiCallTimeout:  apiCallTimeout,
+		capacityReservations: map[string]*armcompute.CapacityReservationsClient{
+			strings.ToLower(auth.SubscriptionID()): factory.NewCapacityReservationsClient(),
+		},
+	}, nil
 }
 
-// Get retrieves information about the model view or the instance view of a virtual machine.
+// Get retrieves information about the model view of a virtual machine.
 func (ac *AzureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.Get")
 	defer done()
@@ -72,6 +92,18 @@ func (ac *AzureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (
 	return resp.VirtualMachine, nil
 }
 
+// GetInstanceView retrieves the run-time state of a virtual machine, such as its power state.
+func (ac *AzureClient) GetInstanceView(ctx context.Context, spec azure.ResourceSpecGetter) (armcompute.VirtualMachineInstanceView, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.GetInstanceView")
+	defer done()
+
+	resp, err := ac.virtualmachines.InstanceView(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return armcompute.VirtualMachineInstanceView{}, err
+	}
+	return resp.VirtualMachineInstanceView, nil
+}
+
 // CreateOrUpdateAsync creates or updates a virtual machine asynchronously.
 // It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
 // progress of the operation.
@@ -133,3 +165,151 @@ func (ac *AzureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	// if the operation completed, return a nil poller.
 	return nil, err
 }
+
+// DeallocateAsync shuts down a virtual machine and releases its compute resources asynchronously. DeallocateAsync
+// sends a POST request to Azure and if accepted without error, the func will return a Poller which can be used to
+// track the ongoing progress of the operation.
+func (ac *AzureClient) DeallocateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachinesClientDeallocateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.Deallocate")
+	defer done()
+
+	opts := &armcompute.VirtualMachinesClientBeginDeallocateOptions{ResumeToken: resumeToken}
+	poller, err = ac.virtualmachines.BeginDeallocate(ctx, spec.ResourceGroupName(), spec.ResourceName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
+
+// StartAsync starts a virtual machine asynchronously. StartAsync sends a POST request to Azure and if accepted
+// without error, the func will return a Poller which can be used to track the ongoing progress of the operation.
+func (ac *AzureClient) StartAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armcompute.VirtualMachinesClientStartResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.Start")
+	defer done()
+
+	opts := &armcompute.VirtualMachinesClientBeginStartOptions{ResumeToken: resumeToken}
+	poller, err = ac.virtualmachines.BeginStart(ctx, spec.ResourceGroupName(), spec.ResourceName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
+
+// UpdateOSDiskSizeAsync patches the size of a virtual machine's OS disk asynchronously. Azure only allows growing
+// the OS disk of a deallocated virtual machine. UpdateOSDiskSizeAsync sends a PATCH request to Azure and if accepted
+// without error, the func will return a Poller which can be used to track the ongoing progress of the operation.
+func (ac *AzureClient) UpdateOSDiskSizeAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, diskSizeGB int32) (poller *runtime.Poller[armcompute.VirtualMachinesClientUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.UpdateOSDiskSize")
+	defer done()
+
+	update := armcompute.VirtualMachineUpdate{
+		Properties: &armcompute.VirtualMachineProperties{
+			StorageProfile: &armcompute.StorageProfile{
+				OSDisk: &armcompute.OSDisk{
+					DiskSizeGB: ptr.To(diskSizeGB),
+				},
+			},
+		},
+	}
+	opts := &armcompute.VirtualMachinesClientBeginUpdateOptions{ResumeToken: resumeToken}
+	poller, err = ac.virtualmachines.BeginUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), update, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the Poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
+
+// ListCapacityReservations lists the capacity reservations in a capacity reservation group.
+func (ac *AzureClient) ListCapacityReservations(ctx context.Context, capacityReservationGroupID string) ([]armcompute.CapacityReservation, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.AzureClient.ListCapacityReservations")
+	defer done()
+
+	groupID, err := azureutil.ParseResourceID(capacityReservationGroupID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse capacity reservation group ID %s", capacityReservationGroupID)
+	}
+
+	client, err := ac.capacityReservationsClient(groupID.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reservations []armcompute.CapacityReservation
+	pager := client.NewListByCapacityReservationGroupPager(groupID.ResourceGroupName, groupID.Name, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Value {
+			if reservation != nil {
+				reservations = append(reservations, *reservation)
+			}
+		}
+	}
+	return reservations, nil
+}
+
+// capacityReservationsClient returns the capacity reservations client for a subscription. The capacity reservation
+// group may be shared from another subscription, so a client is created and kept for each subscription.
+func (ac *AzureClient) capacityReservationsClient(subscriptionID string) (*armcompute.CapacityReservationsClient, error) {
+	ac.capacityReservationsMu.Lock()
+	defer ac.capacityReservationsMu.Unlock()
+
+	key := strings.ToLower(subscriptionID)
+	if client, ok := ac.capacityReservations[key]; ok {
+		return client, nil
+	}
+	opts, err := azure.ARMClientOptions(ac.auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create capacityreservations client options")
+	}
+	client, err := armcompute.NewCapacityReservationsClient(subscriptionID, ac.auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create capacityreservations client")
+	}
+	if ac.capacityReservations == nil {
+		ac.capacityReservations = map[string]*armcompute.CapacityReservationsClient{}
+	}
+	ac.capacityReservations[key] = client
+	return client, nil
+}
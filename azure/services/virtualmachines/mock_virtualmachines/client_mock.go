@@ -74,6 +74,21 @@ func (mr *MockClientMockRecorder) CreateOrUpdateAsync(ctx, spec, resumeToken, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateAsync", reflect.TypeOf((*MockClient)(nil).CreateOrUpdateAsync), ctx, spec, resumeToken, parameters)
 }
 
+// DeallocateAsync mocks base method.
+func (m *MockClient) DeallocateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientDeallocateResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeallocateAsync", ctx, spec, resumeToken)
+	ret0, _ := ret[0].(*runtime.Poller[armcompute.VirtualMachinesClientDeallocateResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeallocateAsync indicates an expected call of DeallocateAsync.
+func (mr *MockClientMockRecorder) DeallocateAsync(ctx, spec, resumeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeallocateAsync", reflect.TypeOf((*MockClient)(nil).DeallocateAsync), ctx, spec, resumeToken)
+}
+
 // DeleteAsync mocks base method.
 func (m *MockClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientDeleteResponse], error) {
 	m.ctrl.T.Helper()
@@ -103,3 +118,63 @@ func (mr *MockClientMockRecorder) Get(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockClient)(nil).Get), arg0, arg1)
 }
+
+// ListCapacityReservations mocks base method.
+func (m *MockClient) ListCapacityReservations(ctx context.Context, capacityReservationGroupID string) ([]armcompute.CapacityReservation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapacityReservations", ctx, capacityReservationGroupID)
+	ret0, _ := ret[0].([]armcompute.CapacityReservation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityReservations indicates an expected call of ListCapacityReservations.
+func (mr *MockClientMockRecorder) ListCapacityReservations(ctx, capacityReservationGroupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityReservations", reflect.TypeOf((*MockClient)(nil).ListCapacityReservations), ctx, capacityReservationGroupID)
+}
+
+// StartAsync mocks base method.
+func (m *MockClient) StartAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientStartResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartAsync", ctx, spec, resumeToken)
+	ret0, _ := ret[0].(*runtime.Poller[armcompute.VirtualMachinesClientStartResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartAsync indicates an expected call of StartAsync.
+func (mr *MockClientMockRecorder) StartAsync(ctx, spec, resumeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartAsync", reflect.TypeOf((*MockClient)(nil).StartAsync), ctx, spec, resumeToken)
+}
+
+// GetInstanceView mocks base method.
+func (m *MockClient) GetInstanceView(ctx context.Context, spec azure.ResourceSpecGetter) (armcompute.VirtualMachineInstanceView, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstanceView", ctx, spec)
+	ret0, _ := ret[0].(armcompute.VirtualMachineInstanceView)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstanceView indicates an expected call of GetInstanceView.
+func (mr *MockClientMockRecorder) GetInstanceView(ctx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceView", reflect.TypeOf((*MockClient)(nil).GetInstanceView), ctx, spec)
+}
+
+// UpdateOSDiskSizeAsync mocks base method.
+func (m *MockClient) UpdateOSDiskSizeAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, diskSizeGB int32) (*runtime.Poller[armcompute.VirtualMachinesClientUpdateResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOSDiskSizeAsync", ctx, spec, resumeToken, diskSizeGB)
+	ret0, _ := ret[0].(*runtime.Poller[armcompute.VirtualMachinesClientUpdateResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOSDiskSizeAsync indicates an expected call of UpdateOSDiskSizeAsync.
+func (mr *MockClientMockRecorder) UpdateOSDiskSizeAsync(ctx, spec, resumeToken, diskSizeGB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOSDiskSizeAsync", reflect.TypeOf((*MockClient)(nil).UpdateOSDiskSizeAsync), ctx, spec, resumeToken, diskSizeGB)
+}
@@ -170,6 +170,20 @@ func (mr *MockVMScopeMockRecorder) DeleteLongRunningOperationState(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLongRunningOperationState", reflect.TypeOf((*MockVMScope)(nil).DeleteLongRunningOperationState), arg0, arg1, arg2)
 }
 
+// FailedStartAttempts mocks base method.
+func (m *MockVMScope) FailedStartAttempts() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailedStartAttempts")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// FailedStartAttempts indicates an expected call of FailedStartAttempts.
+func (mr *MockVMScopeMockRecorder) FailedStartAttempts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailedStartAttempts", reflect.TypeOf((*MockVMScope)(nil).FailedStartAttempts))
+}
+
 // GetLongRunningOperationState mocks base method.
 func (m *MockVMScope) GetLongRunningOperationState(arg0, arg1, arg2 string) *v1beta1.Future {
 	m.ctrl.T.Helper()
@@ -198,6 +212,34 @@ func (mr *MockVMScopeMockRecorder) HashKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockVMScope)(nil).HashKey))
 }
 
+// OSDiskResizing mocks base method.
+func (m *MockVMScope) OSDiskResizing() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OSDiskResizing")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// OSDiskResizing indicates an expected call of OSDiskResizing.
+func (mr *MockVMScopeMockRecorder) OSDiskResizing() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OSDiskResizing", reflect.TypeOf((*MockVMScope)(nil).OSDiskResizing))
+}
+
+// ProviderID mocks base method.
+func (m *MockVMScope) ProviderID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProviderID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ProviderID indicates an expected call of ProviderID.
+func (mr *MockVMScopeMockRecorder) ProviderID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProviderID", reflect.TypeOf((*MockVMScope)(nil).ProviderID))
+}
+
 // SetAddresses mocks base method.
 func (m *MockVMScope) SetAddresses(arg0 []v1.NodeAddress) {
 	m.ctrl.T.Helper()
@@ -234,6 +276,18 @@ func (mr *MockVMScopeMockRecorder) SetConditionFalse(arg0, arg1, arg2, arg3 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConditionFalse", reflect.TypeOf((*MockVMScope)(nil).SetConditionFalse), arg0, arg1, arg2, arg3)
 }
 
+// SetFailedStartAttempts mocks base method.
+func (m *MockVMScope) SetFailedStartAttempts(arg0 int32) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetFailedStartAttempts", arg0)
+}
+
+// SetFailedStartAttempts indicates an expected call of SetFailedStartAttempts.
+func (mr *MockVMScopeMockRecorder) SetFailedStartAttempts(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFailedStartAttempts", reflect.TypeOf((*MockVMScope)(nil).SetFailedStartAttempts), arg0)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockVMScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -246,6 +300,18 @@ func (mr *MockVMScopeMockRecorder) SetLongRunningOperationState(arg0 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockVMScope)(nil).SetLongRunningOperationState), arg0)
 }
 
+// SetOSDiskResizing mocks base method.
+func (m *MockVMScope) SetOSDiskResizing(arg0 bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetOSDiskResizing", arg0)
+}
+
+// SetOSDiskResizing indicates an expected call of SetOSDiskResizing.
+func (mr *MockVMScopeMockRecorder) SetOSDiskResizing(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOSDiskResizing", reflect.TypeOf((*MockVMScope)(nil).SetOSDiskResizing), arg0)
+}
+
 // SetProviderID mocks base method.
 func (m *MockVMScope) SetProviderID(arg0 string) {
 	m.ctrl.T.Helper()
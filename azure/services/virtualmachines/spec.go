@@ -20,8 +20,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 
@@ -30,6 +32,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/util/generators"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 // VMSpec defines the specification for a Virtual Machine.
@@ -56,7 +59,11 @@ type VMSpec struct {
 	DiagnosticsProfile         *infrav1.Diagnostics
 	DisableExtensionOperations bool
 	CapacityReservationGroupID string
+	GalleryApplications        []infrav1.VMGalleryApplication
+	Secrets                    []infrav1.VaultSecretGroup
+	LicenseType                string
 	SKU                        resourceskus.SKU
+	DiskSKUs                   []string
 	Image                      *infrav1.Image
 	BootstrapData              string
 	ProviderID                 string
@@ -78,7 +85,7 @@ func (s *VMSpec) OwnerResourceName() string {
 }
 
 // Parameters returns the parameters for the virtual machine.
-func (s *VMSpec) Parameters(_ context.Context, existing interface{}) (params interface{}, err error) {
+func (s *VMSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
 	if existing != nil {
 		if _, ok := existing.(armcompute.VirtualMachine); !ok {
 			return nil, errors.Errorf("%T is not an armcompute.VirtualMachine", existing)
@@ -92,7 +99,7 @@ func (s *VMSpec) Parameters(_ context.Context, existing interface{}) (params int
 		return nil, azure.VMDeletedError{ProviderID: s.ProviderID}
 	}
 
-	storageProfile, err := s.generateStorageProfile()
+	storageProfile, err := s.generateStorageProfile(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -145,14 +152,32 @@ func (s *VMSpec) Parameters(_ context.Context, existing interface{}) (params int
 			BillingProfile:      billingProfile,
 			DiagnosticsProfile:  converters.GetDiagnosticsProfile(s.DiagnosticsProfile),
 			CapacityReservation: s.getCapacityReservationProfile(),
+			ApplicationProfile:  s.getApplicationProfile(),
+			LicenseType:         s.getLicenseType(),
 		},
 		Identity: identity,
 		Zones:    s.getZones(),
 	}, nil
 }
 
+// osDiskResizeRequired returns true if the OS disk of the existing virtual machine is smaller than the size in the spec.
+// Ephemeral OS disks are never resized as they live on the VM host.
+func (s *VMSpec) osDiskResizeRequired(existing armcompute.VirtualMachine) bool {
+	if s.OSDisk.DiskSizeGB == nil || s.OSDisk.DiffDiskSettings != nil {
+		return false
+	}
+	if existing.Properties == nil || existing.Properties.StorageProfile == nil || existing.Properties.StorageProfile.OSDisk == nil {
+		return false
+	}
+	current := existing.Properties.StorageProfile.OSDisk.DiskSizeGB
+	return current != nil && *s.OSDisk.DiskSizeGB > *current
+}
+
 // generateStorageProfile generates a pointer to an armcompute.StorageProfile which can utilized for VM creation.
-func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
+func (s *VMSpec) generateStorageProfile(ctx context.Context) (*armcompute.StorageProfile, error) {
+	_, log, done := tele.StartSpanWithLogger(ctx, "virtualmachines.VMSpec.generateStorageProfile")
+	defer done()
+
 	osDisk := &armcompute.OSDisk{
 		Name:         ptr.To(azure.GenerateOSDiskName(s.Name)),
 		OSType:       ptr.To(armcompute.OperatingSystemTypes(s.OSDisk.OSType)),
@@ -190,6 +215,18 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 		if !s.SKU.HasCapability(resourceskus.EphemeralOSDisk) {
 			return nil, azure.WithTerminalError(fmt.Errorf("VM size %s does not support ephemeral os. Select a different VM size or disable ephemeral os", s.Size))
 		}
+		hasCapacity, err := s.SKU.HasEphemeralOSDiskCapacity(s.OSDisk)
+		if err != nil {
+			return nil, azure.WithTerminalError(errors.Wrap(err, "failed to validate the ephemeral os disk capacity"))
+		}
+		if !hasCapacity {
+			// Without an explicit OS disk size, the capacity check relies on the size of a reference image, which
+			// may be larger than the image actually used.
+			if s.OSDisk.DiskSizeGB != nil {
+				return nil, azure.WithTerminalError(fmt.Errorf("VM size %s does not have enough cache or temp disk space for the ephemeral os disk. Select a different VM size or placement, or a smaller os disk", s.Size))
+			}
+			log.Info("VM size may not have enough cache or temp disk space for the ephemeral os disk", "size", s.Size)
+		}
 
 		storageProfile.OSDisk.DiffDiskSettings = &armcompute.DiffDiskSettings{
 			Option: ptr.To(armcompute.DiffDiskOptions(s.OSDisk.DiffDiskSettings.Option)),
@@ -203,6 +240,7 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	if s.OSDisk.ManagedDisk != nil {
 		storageProfile.OSDisk.ManagedDisk = &armcompute.ManagedDiskParameters{}
 		if s.OSDisk.ManagedDisk.StorageAccountType != "" {
+			s.warnUnavailableDiskSKU(log, s.OSDisk.ManagedDisk.StorageAccountType)
 			storageProfile.OSDisk.ManagedDisk.StorageAccountType = ptr.To(armcompute.StorageAccountTypes(s.OSDisk.ManagedDisk.StorageAccountType))
 		}
 		if s.OSDisk.ManagedDisk.DiskEncryptionSet != nil {
@@ -226,6 +264,20 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 
 	dataDisks := make([]*armcompute.DataDisk, len(s.DataDisks))
 	for i, disk := range s.DataDisks {
+		if disk.ManagedDiskID != "" {
+			dataDisks[i] = &armcompute.DataDisk{
+				CreateOption: ptr.To(armcompute.DiskCreateOptionTypesAttach),
+				Lun:          disk.Lun,
+				ManagedDisk: &armcompute.ManagedDiskParameters{
+					ID: ptr.To(disk.ManagedDiskID),
+				},
+			}
+			if disk.CachingType != "" {
+				dataDisks[i].Caching = ptr.To(armcompute.CachingTypes(disk.CachingType))
+			}
+			continue
+		}
+
 		dataDisks[i] = &armcompute.DataDisk{
 			CreateOption: ptr.To(armcompute.DiskCreateOptionTypesEmpty),
 			DiskSizeGB:   ptr.To[int32](disk.DiskSizeGB),
@@ -237,8 +289,10 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 		}
 
 		if disk.ManagedDisk != nil {
-			dataDisks[i].ManagedDisk = &armcompute.ManagedDiskParameters{
-				StorageAccountType: ptr.To(armcompute.StorageAccountTypes(disk.ManagedDisk.StorageAccountType)),
+			dataDisks[i].ManagedDisk = &armcompute.ManagedDiskParameters{}
+			if disk.ManagedDisk.StorageAccountType != "" {
+				s.warnUnavailableDiskSKU(log, disk.ManagedDisk.StorageAccountType)
+				dataDisks[i].ManagedDisk.StorageAccountType = ptr.To(armcompute.StorageAccountTypes(disk.ManagedDisk.StorageAccountType))
 			}
 
 			if disk.ManagedDisk.DiskEncryptionSet != nil {
@@ -263,6 +317,14 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	return storageProfile, nil
 }
 
+// warnUnavailableDiskSKU logs a warning when a storage account type, e.g. Premium_ZRS, is not one of the managed disk
+// SKUs available in the location. The VM is still created, leaving the final decision to Azure.
+func (s *VMSpec) warnUnavailableDiskSKU(log logr.Logger, storageAccountType string) {
+	if !resourceskus.IsDiskSKUAvailable(s.DiskSKUs, storageAccountType) {
+		log.Info("storage account type is not available for managed disks in the location", "storageAccountType", storageAccountType, "location", s.Location)
+	}
+}
+
 func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	sshKey, err := base64.StdEncoding.DecodeString(s.SSHKeyData)
 	if err != nil {
@@ -274,6 +336,7 @@ func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 		AdminUsername:            ptr.To(azure.DefaultUserName),
 		CustomData:               ptr.To(s.BootstrapData),
 		AllowExtensionOperations: ptr.To(!s.DisableExtensionOperations),
+		Secrets:                  s.generateSecrets(),
 	}
 
 	switch s.OSDisk.OSType {
@@ -306,47 +369,82 @@ func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	return osProfile, nil
 }
 
+// generateSecrets returns the Key Vault certificates to install on the virtual machine.
+func (s *VMSpec) generateSecrets() []*armcompute.VaultSecretGroup {
+	if len(s.Secrets) == 0 {
+		return nil
+	}
+
+	secrets := make([]*armcompute.VaultSecretGroup, 0, len(s.Secrets))
+	for _, secret := range s.Secrets {
+		certificates := make([]*armcompute.VaultCertificate, 0, len(secret.VaultCertificates))
+		for _, cert := range secret.VaultCertificates {
+			certificates = append(certificates, &armcompute.VaultCertificate{
+				CertificateURL:   ptr.To(cert.CertificateURL),
+				CertificateStore: azure.AliasOrNil[string](&cert.CertificateStore),
+			})
+		}
+		secrets = append(secrets, &armcompute.VaultSecretGroup{
+			SourceVault: &armcompute.SubResource{
+				ID: ptr.To(secret.SourceVaultID),
+			},
+			VaultCertificates: certificates,
+		})
+	}
+	return secrets
+}
+
 func (s *VMSpec) generateSecurityProfile(storageProfile *armcompute.StorageProfile) (*armcompute.SecurityProfile, error) {
 	if s.SecurityProfile == nil {
 		return nil, nil
 	}
 
-	securityProfile := &armcompute.SecurityProfile{}
-
 	if storageProfile.OSDisk.ManagedDisk != nil &&
 		storageProfile.OSDisk.ManagedDisk.SecurityProfile != nil &&
 		ptr.Deref(storageProfile.OSDisk.ManagedDisk.SecurityProfile.SecurityEncryptionType, "") != "" {
-		if s.SecurityProfile.EncryptionAtHost != nil && *s.SecurityProfile.EncryptionAtHost &&
-			*storageProfile.OSDisk.ManagedDisk.SecurityProfile.SecurityEncryptionType == armcompute.SecurityEncryptionTypesDiskWithVMGuestState {
-			return nil, azure.WithTerminalError(errors.Errorf("encryption at host is not supported when securityEncryptionType is set to %s", armcompute.SecurityEncryptionTypesDiskWithVMGuestState))
-		}
+		return s.generateConfidentialVMSecurityProfile(*storageProfile.OSDisk.ManagedDisk.SecurityProfile.SecurityEncryptionType)
+	}
 
-		if s.SecurityProfile.SecurityType != infrav1.SecurityTypesConfidentialVM {
-			return nil, azure.WithTerminalError(errors.Errorf("securityType should be set to %s when securityEncryptionType is set", infrav1.SecurityTypesConfidentialVM))
-		}
+	return s.generateTrustedLaunchSecurityProfile()
+}
 
-		if s.SecurityProfile.UefiSettings == nil {
-			return nil, azure.WithTerminalError(errors.New("vTpmEnabled should be true when securityEncryptionType is set"))
-		}
+// generateConfidentialVMSecurityProfile generates the security profile of a confidential VM whose OS disk is encrypted
+// with the given security encryption type.
+func (s *VMSpec) generateConfidentialVMSecurityProfile(encryptionType armcompute.SecurityEncryptionTypes) (*armcompute.SecurityProfile, error) {
+	if ptr.Deref(s.SecurityProfile.EncryptionAtHost, false) && encryptionType == armcompute.SecurityEncryptionTypesDiskWithVMGuestState {
+		return nil, azure.WithTerminalError(errors.Errorf("encryption at host is not supported when securityEncryptionType is set to %s", armcompute.SecurityEncryptionTypesDiskWithVMGuestState))
+	}
 
-		if ptr.Deref(storageProfile.OSDisk.ManagedDisk.SecurityProfile.SecurityEncryptionType, "") == armcompute.SecurityEncryptionTypesDiskWithVMGuestState &&
-			!*s.SecurityProfile.UefiSettings.SecureBootEnabled {
-			return nil, azure.WithTerminalError(errors.Errorf("secureBootEnabled should be true when securityEncryptionType is set to %s", armcompute.SecurityEncryptionTypesDiskWithVMGuestState))
-		}
+	if err := s.validateSecurityType(infrav1.SecurityTypesConfidentialVM, "securityEncryptionType is set"); err != nil {
+		return nil, err
+	}
 
-		if s.SecurityProfile.UefiSettings.VTpmEnabled != nil && !*s.SecurityProfile.UefiSettings.VTpmEnabled {
-			return nil, azure.WithTerminalError(errors.New("vTpmEnabled should be true when securityEncryptionType is set"))
-		}
+	if s.SecurityProfile.UefiSettings == nil {
+		return nil, azure.WithTerminalError(errors.New("vTpmEnabled should be true when securityEncryptionType is set"))
+	}
 
-		securityProfile.SecurityType = ptr.To(armcompute.SecurityTypesConfidentialVM)
+	if encryptionType == armcompute.SecurityEncryptionTypesDiskWithVMGuestState &&
+		!ptr.Deref(s.SecurityProfile.UefiSettings.SecureBootEnabled, false) {
+		return nil, azure.WithTerminalError(errors.Errorf("secureBootEnabled should be true when securityEncryptionType is set to %s", armcompute.SecurityEncryptionTypesDiskWithVMGuestState))
+	}
 
-		securityProfile.UefiSettings = &armcompute.UefiSettings{
+	if s.SecurityProfile.UefiSettings.VTpmEnabled != nil && !*s.SecurityProfile.UefiSettings.VTpmEnabled {
+		return nil, azure.WithTerminalError(errors.New("vTpmEnabled should be true when securityEncryptionType is set"))
+	}
+
+	return &armcompute.SecurityProfile{
+		SecurityType: ptr.To(armcompute.SecurityTypesConfidentialVM),
+		UefiSettings: &armcompute.UefiSettings{
 			SecureBootEnabled: s.SecurityProfile.UefiSettings.SecureBootEnabled,
 			VTpmEnabled:       s.SecurityProfile.UefiSettings.VTpmEnabled,
-		}
+		},
+	}, nil
+}
 
-		return securityProfile, nil
-	}
+// generateTrustedLaunchSecurityProfile generates the security profile of a VM that is not a confidential VM. Secure boot
+// and vTPM require the VM to use trusted launch.
+func (s *VMSpec) generateTrustedLaunchSecurityProfile() (*armcompute.SecurityProfile, error) {
+	securityProfile := &armcompute.SecurityProfile{}
 
 	if s.SecurityProfile.EncryptionAtHost != nil {
 		if !s.SKU.HasCapability(resourceskus.EncryptionAtHost) && *s.SecurityProfile.EncryptionAtHost {
@@ -356,41 +454,69 @@ func (s *VMSpec) generateSecurityProfile(storageProfile *armcompute.StorageProfi
 		securityProfile.EncryptionAtHost = s.SecurityProfile.EncryptionAtHost
 	}
 
-	hasTrustedLaunchDisabled := s.SKU.HasCapability(resourceskus.TrustedLaunchDisabled)
+	if s.SecurityProfile.UefiSettings == nil {
+		return securityProfile, nil
+	}
 
-	if s.SecurityProfile.UefiSettings != nil {
-		securityProfile.UefiSettings = &armcompute.UefiSettings{}
+	hasTrustedLaunchDisabled := s.SKU.HasCapability(resourceskus.TrustedLaunchDisabled)
+	securityProfile.UefiSettings = &armcompute.UefiSettings{}
 
-		if s.SecurityProfile.UefiSettings.SecureBootEnabled != nil && *s.SecurityProfile.UefiSettings.SecureBootEnabled {
-			if hasTrustedLaunchDisabled {
-				return nil, azure.WithTerminalError(errors.Errorf("secure boot is not supported for VM type %s", s.Size))
-			}
+	if (ptr.Deref(s.SecurityProfile.UefiSettings.SecureBootEnabled, false) || ptr.Deref(s.SecurityProfile.UefiSettings.VTpmEnabled, false)) &&
+		!hasTrustedLaunchDisabled && !s.supportsHyperVGen2() {
+		return nil, azure.WithTerminalError(errors.Errorf("trusted launch requires a Gen2 VM but VM type %s does not support Hyper-V generation V2", s.Size))
+	}
 
-			if s.SecurityProfile.SecurityType != infrav1.SecurityTypesTrustedLaunch {
-				return nil, azure.WithTerminalError(errors.Errorf("securityType should be set to %s when secureBootEnabled is true", infrav1.SecurityTypesTrustedLaunch))
-			}
+	if ptr.Deref(s.SecurityProfile.UefiSettings.SecureBootEnabled, false) {
+		if hasTrustedLaunchDisabled {
+			return nil, azure.WithTerminalError(errors.Errorf("secure boot is not supported for VM type %s", s.Size))
+		}
 
-			securityProfile.SecurityType = ptr.To(armcompute.SecurityTypesTrustedLaunch)
-			securityProfile.UefiSettings.SecureBootEnabled = ptr.To(true)
+		if err := s.validateSecurityType(infrav1.SecurityTypesTrustedLaunch, "secureBootEnabled is true"); err != nil {
+			return nil, err
 		}
 
-		if s.SecurityProfile.UefiSettings.VTpmEnabled != nil && *s.SecurityProfile.UefiSettings.VTpmEnabled {
-			if hasTrustedLaunchDisabled {
-				return nil, azure.WithTerminalError(errors.Errorf("vTPM is not supported for VM type %s", s.Size))
-			}
+		securityProfile.SecurityType = ptr.To(armcompute.SecurityTypesTrustedLaunch)
+		securityProfile.UefiSettings.SecureBootEnabled = ptr.To(true)
+	}
 
-			if s.SecurityProfile.SecurityType != infrav1.SecurityTypesTrustedLaunch {
-				return nil, azure.WithTerminalError(errors.Errorf("securityType should be set to %s when vTpmEnabled is true", infrav1.SecurityTypesTrustedLaunch))
-			}
+	if ptr.Deref(s.SecurityProfile.UefiSettings.VTpmEnabled, false) {
+		if hasTrustedLaunchDisabled {
+			return nil, azure.WithTerminalError(errors.Errorf("vTPM is not supported for VM type %s", s.Size))
+		}
 
-			securityProfile.SecurityType = ptr.To(armcompute.SecurityTypesTrustedLaunch)
-			securityProfile.UefiSettings.VTpmEnabled = ptr.To(true)
+		if err := s.validateSecurityType(infrav1.SecurityTypesTrustedLaunch, "vTpmEnabled is true"); err != nil {
+			return nil, err
 		}
+
+		securityProfile.SecurityType = ptr.To(armcompute.SecurityTypesTrustedLaunch)
+		securityProfile.UefiSettings.VTpmEnabled = ptr.To(true)
 	}
 
 	return securityProfile, nil
 }
 
+// supportsHyperVGen2 returns false if the VM size lists the Hyper-V generations it supports and V2 is not one of them.
+func (s *VMSpec) supportsHyperVGen2() bool {
+	generations, ok := s.SKU.GetCapability(resourceskus.HyperVGenerations)
+	if !ok {
+		return true
+	}
+	for _, generation := range strings.Split(generations, ",") {
+		if strings.EqualFold(strings.TrimSpace(generation), "V2") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateSecurityType returns a terminal error if the security type of the VM is not the one required by the given condition.
+func (s *VMSpec) validateSecurityType(required infrav1.SecurityTypes, condition string) error {
+	if s.SecurityProfile.SecurityType != required {
+		return azure.WithTerminalError(errors.Errorf("securityType should be set to %s when %s", required, condition))
+	}
+	return nil
+}
+
 func (s *VMSpec) generateNICRefs() []*armcompute.NetworkInterfaceReference {
 	nicRefs := make([]*armcompute.NetworkInterfaceReference, len(s.NICIDs))
 	for i, id := range s.NICIDs {
@@ -449,6 +575,14 @@ func (s *VMSpec) getZones() []*string {
 	return zones
 }
 
+// getLicenseType returns the Azure Hybrid Benefit license type of the virtual machine, or nil if it is not set.
+func (s *VMSpec) getLicenseType() *string {
+	if s.LicenseType == "" {
+		return nil
+	}
+	return ptr.To(s.LicenseType)
+}
+
 func (s *VMSpec) getCapacityReservationProfile() *armcompute.CapacityReservationProfile {
 	var crf *armcompute.CapacityReservationProfile
 	if s.CapacityReservationGroupID != "" {
@@ -458,3 +592,21 @@ func (s *VMSpec) getCapacityReservationProfile() *armcompute.CapacityReservation
 	}
 	return crf
 }
+
+func (s *VMSpec) getApplicationProfile() *armcompute.ApplicationProfile {
+	if len(s.GalleryApplications) == 0 {
+		return nil
+	}
+	apps := make([]*armcompute.VMGalleryApplication, 0, len(s.GalleryApplications))
+	for _, app := range s.GalleryApplications {
+		apps = append(apps, &armcompute.VMGalleryApplication{
+			PackageReferenceID:              ptr.To(app.PackageReferenceID),
+			Order:                           app.Order,
+			ConfigurationReference:          app.ConfigurationReference,
+			TreatFailureAsDeploymentFailure: app.TreatFailureAsDeploymentFailure,
+		})
+	}
+	return &armcompute.ApplicationProfile{
+		GalleryApplications: apps,
+	}
+}
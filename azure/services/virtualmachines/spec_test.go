@@ -95,6 +95,28 @@ var (
 		},
 	}
 
+	validSKUWithGen1Only = resourceskus.SKU{
+		Name: ptr.To("Standard_D2v3"),
+		Kind: ptr.To(string(resourceskus.VirtualMachines)),
+		Locations: []*string{
+			ptr.To("test-location"),
+		},
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.VCPUs),
+				Value: ptr.To("2"),
+			},
+			{
+				Name:  ptr.To(resourceskus.MemoryGB),
+				Value: ptr.To("4"),
+			},
+			{
+				Name:  ptr.To(resourceskus.HyperVGenerations),
+				Value: ptr.To("V1"),
+			},
+		},
+	}
+
 	validSKUWithConfidentialComputingType = resourceskus.SKU{
 		Name: ptr.To("Standard_D2v3"),
 		Kind: ptr.To(string(resourceskus.VirtualMachines)),
@@ -696,6 +718,32 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "reconcile error that cannot be recovered occurred: vTPM is not supported for VM type Standard_D2v3. Object will not be requeued",
 		},
+		{
+			name: "creating a trusted launch vm on a VM type without Gen2 support fails",
+			spec: &VMSpec{
+				Name:              "my-vm",
+				Role:              infrav1.Node,
+				NICIDs:            []string{"my-nic"},
+				SSHKeyData:        "fakesshpublickey",
+				Size:              "Standard_D2v3",
+				AvailabilitySetID: "fake-availability-set-id",
+				Zone:              "",
+				Image:             &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SecurityProfile: &infrav1.SecurityProfile{
+					SecurityType: infrav1.SecurityTypesTrustedLaunch,
+					UefiSettings: &infrav1.UefiSettings{
+						SecureBootEnabled: ptr.To(true),
+						VTpmEnabled:       ptr.To(true),
+					},
+				},
+				SKU: validSKUWithGen1Only,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: trusted launch requires a Gen2 VM but VM type Standard_D2v3 does not support Hyper-V generation V2. Object will not be requeued",
+		},
 		{
 			name: "creating a confidential vm with securityTypeEncryption DiskWithVMGuestState and encryption at host enabled fails",
 			spec: &VMSpec{
@@ -768,6 +816,41 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "reconcile error that cannot be recovered occurred: secureBootEnabled should be true when securityEncryptionType is set to DiskWithVMGuestState. Object will not be requeued",
 		},
+		{
+			name: "creating a confidential vm with DiskWithVMGuestState encryption type and secure boot unset fails",
+			spec: &VMSpec{
+				Name:              "my-vm",
+				Role:              infrav1.Node,
+				NICIDs:            []string{"my-nic"},
+				SSHKeyData:        "fakesshpublickey",
+				Size:              "Standard_D2v3",
+				AvailabilitySetID: "fake-availability-set-id",
+				Zone:              "",
+				Image:             &infrav1.Image{ID: ptr.To("fake-image-id")},
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+						SecurityProfile: &infrav1.VMDiskSecurityProfile{
+							SecurityEncryptionType: infrav1.SecurityEncryptionTypeDiskWithVMGuestState,
+						},
+					},
+				},
+				SecurityProfile: &infrav1.SecurityProfile{
+					SecurityType: infrav1.SecurityTypesConfidentialVM,
+					UefiSettings: &infrav1.UefiSettings{
+						VTpmEnabled: ptr.To(true),
+					},
+				},
+				SKU: validSKUWithConfidentialComputingType,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: secureBootEnabled should be true when securityEncryptionType is set to DiskWithVMGuestState. Object will not be requeued",
+		},
 		{
 			name: "creating a confidential vm with vTPM disabled fails",
 			spec: &VMSpec{
@@ -865,6 +948,72 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "reconcile error that cannot be recovered occurred: VM size Standard_D2v3 does not support ephemeral os. Select a different VM size or disable ephemeral os. Object will not be requeued",
 		},
+		{
+			name: "cannot create vm with EphemeralOSDisk if the cache disk is too small",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+					DiffDiskSettings: &infrav1.DiffDiskSettings{
+						Option:    string(armcompute.DiffDiskOptionsLocal),
+						Placement: ptr.To(infrav1.DiffDiskPlacementCacheDisk),
+					},
+				},
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU: resourceskus.SKU{
+					Name:         validSKUWithEphemeralOS.Name,
+					Kind:         validSKUWithEphemeralOS.Kind,
+					Locations:    validSKUWithEphemeralOS.Locations,
+					Capabilities: append(validSKUWithEphemeralOS.Capabilities, &armcompute.ResourceSKUCapabilities{Name: ptr.To(resourceskus.CachedDiskBytes), Value: ptr.To("53687091200")}),
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: VM size Standard_D2v3 does not have enough cache or temp disk space for the ephemeral os disk. Select a different VM size or placement, or a smaller os disk. Object will not be requeued",
+		},
+		{
+			name: "can create vm with EphemeralOSDisk if the cache disk may be too small for an OS disk without a size",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				OSDisk: infrav1.OSDisk{
+					OSType: "Linux",
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+					DiffDiskSettings: &infrav1.DiffDiskSettings{
+						Option:    string(armcompute.DiffDiskOptionsLocal),
+						Placement: ptr.To(infrav1.DiffDiskPlacementCacheDisk),
+					},
+				},
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU: resourceskus.SKU{
+					Name:         validSKUWithEphemeralOS.Name,
+					Kind:         validSKUWithEphemeralOS.Kind,
+					Locations:    validSKUWithEphemeralOS.Locations,
+					Capabilities: append(validSKUWithEphemeralOS.Capabilities, &armcompute.ResourceSKUCapabilities{Name: ptr.To(resourceskus.CachedDiskBytes), Value: ptr.To("10737418240")}),
+				},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.StorageProfile.OSDisk.DiffDiskSettings.Placement).To(Equal(ptr.To(armcompute.DiffDiskPlacementCacheDisk)))
+			},
+			expectedError: "",
+		},
 		{
 			name: "cannot create vm if vCPU is less than 2",
 			spec: &VMSpec{
@@ -1087,6 +1236,86 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "reconcile error that cannot be recovered occurred: VM size Standard_D2v3 does not support ultra disks in location test-location. Select a different VM size or disable ultra disks. Object will not be requeued",
 		},
+		{
+			name: "can create a vm with zone-redundant disks available in the location",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "westeurope",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumZRS),
+					},
+				},
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix: "mydisk",
+						DiskSizeGB: 64,
+						Lun:        ptr.To[int32](0),
+						ManagedDisk: &infrav1.ManagedDiskParameters{
+							StorageAccountType: string(armcompute.StorageAccountTypesStandardSSDZRS),
+						},
+					},
+				},
+				SKU:      validSKU,
+				DiskSKUs: []string{"Premium_ZRS", "StandardSSD_ZRS"},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				storageProfile := result.(armcompute.VirtualMachine).Properties.StorageProfile
+				g.Expect(storageProfile.OSDisk.ManagedDisk.StorageAccountType).To(Equal(ptr.To(armcompute.StorageAccountTypesPremiumZRS)))
+				g.Expect(storageProfile.DataDisks[0].ManagedDisk.StorageAccountType).To(Equal(ptr.To(armcompute.StorageAccountTypesStandardSSDZRS)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "creates a vm with zone-redundant disks not available in the location",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "test-location",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](128),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumZRS),
+					},
+				},
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix: "mydisk",
+						DiskSizeGB: 64,
+						Lun:        ptr.To[int32](0),
+						ManagedDisk: &infrav1.ManagedDiskParameters{
+							StorageAccountType: string(armcompute.StorageAccountTypesStandardSSDZRS),
+						},
+					},
+				},
+				SKU:      validSKU,
+				DiskSKUs: []string{"Premium_LRS", "StandardSSD_LRS"},
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				storageProfile := result.(armcompute.VirtualMachine).Properties.StorageProfile
+				g.Expect(storageProfile.OSDisk.ManagedDisk.StorageAccountType).To(Equal(ptr.To(armcompute.StorageAccountTypesPremiumZRS)))
+				g.Expect(storageProfile.DataDisks[0].ManagedDisk.StorageAccountType).To(Equal(ptr.To(armcompute.StorageAccountTypesStandardSSDZRS)))
+			},
+			expectedError: "",
+		},
 		{
 			name: "creates a vm with AdditionalCapabilities.UltraSSDEnabled false, if an ultra disk is specified as data disk but AdditionalCapabilities.UltraSSDEnabled is false",
 			spec: &VMSpec{
@@ -1219,6 +1448,44 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "creates a vm which attaches an existing managed disk",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "test-location",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix:    "existingdisk",
+						ManagedDiskID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/existing-disk",
+						Lun:           ptr.To[int32](2),
+						CachingType:   string(armcompute.CachingTypesReadWrite),
+					},
+				},
+				SKU: validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				expectedDataDisks := []*armcompute.DataDisk{
+					{
+						Lun:          ptr.To[int32](2),
+						CreateOption: ptr.To(armcompute.DiskCreateOptionTypesAttach),
+						Caching:      ptr.To(armcompute.CachingTypesReadWrite),
+						ManagedDisk: &armcompute.ManagedDiskParameters{
+							ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/existing-disk"),
+						},
+					},
+				}
+				g.Expect(gomockinternal.DiffEq(expectedDataDisks).Matches(result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks)).To(BeTrue(), cmp.Diff(expectedDataDisks, result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks))
+			},
+			expectedError: "",
+		},
 		{
 			name: "creates a vm with AdditionalCapabilities.UltraSSDEnabled true, if no ultra disk is specified as data disk and AdditionalCapabilities.UltraSSDEnabled is true",
 			spec: &VMSpec{
@@ -1417,6 +1684,105 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "creates a vm with gallery applications",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "test-location",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				GalleryApplications: []infrav1.VMGalleryApplication{
+					{
+						PackageReferenceID:              "my-app-version-id",
+						Order:                           ptr.To[int32](1),
+						ConfigurationReference:          ptr.To("my-config-uri"),
+						TreatFailureAsDeploymentFailure: ptr.To(true),
+					},
+				},
+				SKU: validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.ApplicationProfile).To(Equal(&armcompute.ApplicationProfile{
+					GalleryApplications: []*armcompute.VMGalleryApplication{
+						{
+							PackageReferenceID:              ptr.To("my-app-version-id"),
+							Order:                           ptr.To[int32](1),
+							ConfigurationReference:          ptr.To("my-config-uri"),
+							TreatFailureAsDeploymentFailure: ptr.To(true),
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "creates a vm with key vault secrets",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "test-location",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				Secrets: []infrav1.VaultSecretGroup{
+					{
+						SourceVaultID: "my-vault-id",
+						VaultCertificates: []infrav1.VaultCertificate{
+							{
+								CertificateURL: "https://my-vault.vault.azure.net/secrets/my-cert/1",
+							},
+						},
+					},
+				},
+				SKU: validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.Secrets).To(Equal([]*armcompute.VaultSecretGroup{
+					{
+						SourceVault: &armcompute.SubResource{
+							ID: ptr.To("my-vault-id"),
+						},
+						VaultCertificates: []*armcompute.VaultCertificate{
+							{
+								CertificateURL: ptr.To("https://my-vault.vault.azure.net/secrets/my-cert/1"),
+							},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "creates a vm with an Azure Hybrid Benefit license type",
+			spec: &VMSpec{
+				Name:        "my-vm",
+				Role:        infrav1.Node,
+				NICIDs:      []string{"my-nic"},
+				SSHKeyData:  "fakesshpublickey",
+				Size:        "Standard_D2v3",
+				Location:    "test-location",
+				Zone:        "1",
+				Image:       &infrav1.Image{ID: ptr.To("fake-image-id")},
+				LicenseType: infrav1.LicenseTypeRHELBYOS,
+				SKU:         validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.LicenseType).To(Equal(ptr.To("RHEL_BYOS")))
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1434,3 +1800,72 @@ func TestParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestOSDiskResizeRequired(t *testing.T) {
+	existingVM := func(diskSizeGB *int32) armcompute.VirtualMachine {
+		return armcompute.VirtualMachine{
+			Properties: &armcompute.VirtualMachineProperties{
+				StorageProfile: &armcompute.StorageProfile{
+					OSDisk: &armcompute.OSDisk{
+						DiskSizeGB: diskSizeGB,
+					},
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name     string
+		osDisk   infrav1.OSDisk
+		existing armcompute.VirtualMachine
+		expected bool
+	}{
+		{
+			name:     "no resize when the spec does not set a disk size",
+			osDisk:   infrav1.OSDisk{OSType: "Linux"},
+			existing: existingVM(ptr.To[int32](30)),
+			expected: false,
+		},
+		{
+			name:     "no resize when the disk size is unchanged",
+			osDisk:   infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30)},
+			existing: existingVM(ptr.To[int32](30)),
+			expected: false,
+		},
+		{
+			name:     "resize when the disk size is increased",
+			osDisk:   infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128)},
+			existing: existingVM(ptr.To[int32](30)),
+			expected: true,
+		},
+		{
+			name:     "no resize when the existing disk is bigger",
+			osDisk:   infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](30)},
+			existing: existingVM(ptr.To[int32](128)),
+			expected: false,
+		},
+		{
+			name:     "no resize when the existing disk size is unknown",
+			osDisk:   infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128)},
+			existing: armcompute.VirtualMachine{},
+			expected: false,
+		},
+		{
+			name: "no resize for ephemeral os disks",
+			osDisk: infrav1.OSDisk{
+				OSType:           "Linux",
+				DiskSizeGB:       ptr.To[int32](128),
+				DiffDiskSettings: &infrav1.DiffDiskSettings{Option: string(armcompute.DiffDiskOptionsLocal)},
+			},
+			existing: existingVM(ptr.To[int32](30)),
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := &VMSpec{OSDisk: tc.osDisk}
+			g.Expect(spec.osDiskResizeRequired(tc.existing)).To(Equal(tc.expected))
+		})
+	}
+}
@@ -18,8 +18,10 @@ package virtualmachines
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -36,28 +38,55 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 const serviceName = "virtualmachine"
+
+func init() {
+	reconciler.RegisterServiceGroup(serviceName, reconciler.ServiceGroupCompute)
+}
+
 const vmMissingUAI = "VM is missing expected user assigned identity with client ID: "
 
+const (
+	// deallocateFutureType is the future type of a VM deallocation.
+	deallocateFutureType = "VMDeallocate"
+	// startFutureType is the future type of a VM start.
+	startFutureType = "VMStart"
+)
+
+const (
+	// defaultMaxStartAttempts is the number of times a deallocated Spot VM is started before it is replaced
+	// when the AzureMachine does not set spotVMOptions.maxStartAttempts.
+	defaultMaxStartAttempts int32 = 3
+	// powerStateDeallocated is the instance view status code of a deallocated VM.
+	powerStateDeallocated = "PowerState/deallocated"
+)
+
 // VMScope defines the scope interface for a virtual machines service.
 type VMScope interface {
 	azure.Authorizer
 	azure.AsyncStatusUpdater
 	VMSpec() azure.ResourceSpecGetter
+	ProviderID() string
 	SetAnnotation(string, string)
 	SetProviderID(string)
 	SetAddresses([]corev1.NodeAddress)
 	SetVMState(infrav1.ProvisioningState)
 	SetConditionFalse(clusterv1.ConditionType, string, clusterv1.ConditionSeverity, string)
+	FailedStartAttempts() int32
+	SetFailedStartAttempts(int32)
+	OSDiskResizing() bool
+	SetOSDiskResizing(bool)
 }
 
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope VMScope
 	async.Reconciler
+	client           Client
 	interfacesGetter async.Getter
 	publicIPsGetter  async.Getter
 	identitiesGetter identities.Client
@@ -83,6 +112,7 @@ func New(scope VMScope) (*Service, error) {
 	}
 	return &Service{
 		Scope:            scope,
+		client:           Client,
 		interfacesGetter: interfacesSvc,
 		publicIPsGetter:  publicIPsSvc,
 		identitiesGetter: identitiesSvc,
@@ -101,7 +131,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	vmSpec := s.Scope.VMSpec()
@@ -109,6 +139,15 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return nil
 	}
 
+	// Check the capacity reservation before the VM is created, as Azure only reports a generic allocation
+	// failure when the reservation group cannot serve the VM.
+	if spec, ok := vmSpec.(*VMSpec); ok && spec.CapacityReservationGroupID != "" && s.Scope.ProviderID() == "" {
+		if err := s.validateCapacityReservation(ctx, spec); err != nil {
+			s.Scope.UpdatePutStatus(infrav1.VMRunningCondition, serviceName, err)
+			return err
+		}
+	}
+
 	result, err := s.CreateOrUpdateResource(ctx, vmSpec, serviceName)
 	s.Scope.UpdatePutStatus(infrav1.VMRunningCondition, serviceName, err)
 	// Set the DiskReady condition here since the disk gets created with the VM.
@@ -144,7 +183,235 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "failed to check user assigned identities")
 		}
+
+		if s.needsInstanceView(spec, vm) {
+			instanceView, err := s.client.GetInstanceView(ctx, spec)
+			if err != nil {
+				return errors.Wrap(err, "failed to get VM instance view")
+			}
+			vm.Properties.InstanceView = &instanceView
+		}
+
+		if err := s.restartDeallocatedSpotVM(ctx, spec, vm); err != nil {
+			return err
+		}
+
+		if err := s.resizeOSDisk(ctx, spec, vm); err != nil {
+			return errors.Wrap(err, "failed to resize OS disk")
+		}
+	}
+	return err
+}
+
+// validateCapacityReservation returns a transient error if the capacity reservation group of the VM has no
+// reservation for the VM's size in the VM's zone, so that the VM is created once such a reservation is added to the
+// group. Zonal VMs can only use reservations in the same zone, and VMs without a zone can only use regional
+// reservations. Zones are logical zones mapped to physical zones per subscription, so the zones of a group shared
+// from another subscription can't be compared with the VM's zone and only whether the reservation is zonal is checked.
+func (s *Service) validateCapacityReservation(ctx context.Context, spec *VMSpec) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "virtualmachines.Service.validateCapacityReservation")
+	defer done()
+
+	groupID, err := azureutil.ParseResourceID(spec.CapacityReservationGroupID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse capacity reservation group ID %s", spec.CapacityReservationGroupID)
+	}
+	compareZones := strings.EqualFold(groupID.SubscriptionID, s.Scope.SubscriptionID())
+
+	reservations, err := s.client.ListCapacityReservations(ctx, spec.CapacityReservationGroupID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list capacity reservations")
+	}
+	for _, reservation := range reservations {
+		if reservation.SKU == nil || !strings.EqualFold(ptr.Deref(reservation.SKU.Name, ""), spec.Size) {
+			continue
+		}
+		if (len(reservation.Zones) == 0) != (spec.Zone == "") {
+			continue
+		}
+		if spec.Zone == "" {
+			return nil
+		}
+		if !compareZones {
+			log.V(4).Info("not comparing the zones of a capacity reservation group shared from another subscription", "capacityReservationGroupID", spec.CapacityReservationGroupID)
+			return nil
+		}
+		for _, zone := range reservation.Zones {
+			if ptr.Deref(zone, "") == spec.Zone {
+				return nil
+			}
+		}
+	}
+	return azure.WithTransientError(errors.Errorf("capacity reservation group %s has no reservation for VM size %s in zone %q", spec.CapacityReservationGroupID, spec.Size, spec.Zone), s.Scope.DefaultedReconcilerRequeue())
+}
+
+// restartDeallocatedSpotVM starts a Spot VM that was evicted and deallocated, as Azure does not start it again
+// once capacity is available. If the VM still can't be started after the maximum number of attempts, a terminal
+// error marks the AzureMachine as failed so that the Machine can be remediated. Only start operations which
+// Azure reports as failed count as failed attempts; a start which is still in progress is polled on the next reconcile.
+func (s *Service) restartDeallocatedSpotVM(ctx context.Context, spec *VMSpec, vm armcompute.VirtualMachine) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "virtualmachines.Service.restartDeallocatedSpotVM")
+	defer done()
+
+	if spec.SpotVMOptions == nil || ptr.Deref(spec.SpotVMOptions.EvictionPolicy, infrav1.SpotEvictionPolicyDeallocate) != infrav1.SpotEvictionPolicyDeallocate {
+		return nil
+	}
+	// The VM is deallocated on purpose while its OS disk is resized, and resizeOSDisk starts it again.
+	if s.Scope.OSDiskResizing() {
+		return nil
+	}
+
+	attempts := s.Scope.FailedStartAttempts()
+	starting := s.Scope.GetLongRunningOperationState(spec.ResourceName(), serviceName, startFutureType) != nil
+	if !starting && !isVMDeallocated(vm) {
+		if attempts != 0 {
+			s.Scope.SetFailedStartAttempts(0)
+		}
+		return nil
+	}
+
+	maxAttempts := ptr.Deref(spec.SpotVMOptions.MaxStartAttempts, defaultMaxStartAttempts)
+	if attempts >= maxAttempts {
+		err := errors.Errorf("spot VM %s is still deallocated after %d failed start attempts", spec.Name, attempts)
+		s.Scope.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityError, err.Error())
+		return azure.WithTerminalError(err)
+	}
+
+	if !starting && azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped starting deallocated spot virtual machine %s/%s", spec.ResourceGroupName(), spec.Name)) {
+		log.Info("dry-run: skipping start of deallocated spot VM", "vm", spec.Name)
+		return nil
+	}
+
+	s.Scope.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityWarning, "Spot VM was evicted and is deallocated")
+	log.Info("starting deallocated spot VM", "vm", spec.Name, "attempt", attempts+1, "maxAttempts", maxAttempts)
+	err := runOperation(ctx, s.Scope, spec, startFutureType, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientStartResponse], error) {
+		return s.client.StartAsync(ctx, spec, resumeToken)
+	})
+	if azure.IsOperationNotDoneError(err) {
+		return err
+	}
+	if err != nil {
+		s.Scope.SetFailedStartAttempts(attempts + 1)
+		return errors.Wrapf(err, "failed to start deallocated spot VM (attempt %d of %d)", attempts+1, maxAttempts)
+	}
+	s.Scope.SetFailedStartAttempts(0)
+	s.Scope.UpdatePutStatus(infrav1.VMRunningCondition, serviceName, nil)
+	return nil
+}
+
+// needsInstanceView returns true if the power state of the VM is needed to restart an evicted spot VM or to resize
+// its OS disk. Get does not return the instance view of the VM, so it is only fetched in those cases.
+func (s *Service) needsInstanceView(spec *VMSpec, vm armcompute.VirtualMachine) bool {
+	if vm.Properties == nil {
+		return false
+	}
+	if spec.osDiskResizeRequired(vm) {
+		return true
+	}
+	return spec.SpotVMOptions != nil &&
+		ptr.Deref(spec.SpotVMOptions.EvictionPolicy, infrav1.SpotEvictionPolicyDeallocate) == infrav1.SpotEvictionPolicyDeallocate &&
+		!s.Scope.OSDiskResizing()
+}
+
+// isVMDeallocated returns true if the instance view of the VM reports that it is deallocated.
+func isVMDeallocated(vm armcompute.VirtualMachine) bool {
+	if vm.Properties == nil || vm.Properties.InstanceView == nil {
+		return false
+	}
+	return isDeallocated(*vm.Properties.InstanceView)
+}
+
+// isDeallocated returns true if the instance view reports that the VM is deallocated.
+func isDeallocated(instanceView armcompute.VirtualMachineInstanceView) bool {
+	for _, status := range instanceView.Statuses {
+		if status != nil && strings.EqualFold(ptr.Deref(status.Code, ""), powerStateDeallocated) {
+			return true
+		}
+	}
+	return false
+}
+
+// resizeOSDisk grows the OS disk of an existing virtual machine when the spec requests a bigger disk.
+// Azure only allows resizing the OS disk of a deallocated virtual machine, so the VM is deallocated,
+// its OS disk is updated, and it is started again. Each step is a long-running operation which may span
+// several reconciles, and the resize is recorded in the AzureMachine status until the VM is started again
+// so that a failed start is retried.
+func (s *Service) resizeOSDisk(ctx context.Context, spec *VMSpec, vm armcompute.VirtualMachine) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "virtualmachines.Service.resizeOSDisk")
+	defer done()
+
+	resizeRequired := spec.osDiskResizeRequired(vm)
+	if !s.Scope.OSDiskResizing() {
+		if !resizeRequired {
+			return nil
+		}
+		desired := *spec.OSDisk.DiskSizeGB
+		if azure.SkipInDryRun(s.Scope, fmt.Sprintf("dry-run: skipped resizing the OS disk of virtual machine %s/%s to %dGB", spec.ResourceGroupName(), spec.Name, desired)) {
+			log.Info("dry-run: skipping OS disk resize", "vm", spec.Name, "diskSizeGB", desired)
+			return nil
+		}
+		log.Info("resizing OS disk", "vm", spec.Name, "diskSizeGB", desired)
+		s.Scope.SetOSDiskResizing(true)
+	}
+
+	if resizeRequired {
+		desired := *spec.OSDisk.DiskSizeGB
+		if !isVMDeallocated(vm) {
+			err := runOperation(ctx, s.Scope, spec, deallocateFutureType, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientDeallocateResponse], error) {
+				return s.client.DeallocateAsync(ctx, spec, resumeToken)
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to deallocate VM")
+			}
+		}
+		err := runOperation(ctx, s.Scope, spec, infrav1.PatchFuture, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientUpdateResponse], error) {
+			return s.client.UpdateOSDiskSizeAsync(ctx, spec, resumeToken, desired)
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to update OS disk size to %dGB", desired)
+		}
 	}
+
+	err := runOperation(ctx, s.Scope, spec, startFutureType, func(ctx context.Context, resumeToken string) (*runtime.Poller[armcompute.VirtualMachinesClientStartResponse], error) {
+		return s.client.StartAsync(ctx, spec, resumeToken)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to start VM")
+	}
+	s.Scope.SetOSDiskResizing(false)
+	return nil
+}
+
+// runOperation runs a long-running operation other than a PUT or a DELETE on an existing virtual machine.
+// Like the async reconciler, it resumes the operation from the future stored in the scope, and stores a new future
+// if the operation does not complete before the context is done so that it is polled again on the next reconcile.
+func runOperation[T any](ctx context.Context, scope VMScope, spec azure.ResourceSpecGetter, futureType string, begin func(context.Context, string) (*runtime.Poller[T], error)) error {
+	resourceName := spec.ResourceName()
+	rgName := spec.ResourceGroupName()
+
+	resumeToken := ""
+	if future := scope.GetLongRunningOperationState(resourceName, serviceName, futureType); future != nil {
+		t, err := converters.FutureToResumeToken(*future)
+		if err != nil {
+			scope.DeleteLongRunningOperationState(resourceName, serviceName, futureType)
+			return errors.Wrap(err, "could not decode future data, resetting long-running operation state")
+		}
+		resumeToken = t
+	}
+
+	poller, err := begin(ctx, resumeToken)
+	if poller != nil && azure.IsContextDeadlineExceededOrCanceledError(err) {
+		future, err := converters.PollerToFuture(poller, futureType, serviceName, resourceName, rgName)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert poller to future")
+		}
+		scope.SetLongRunningOperationState(future)
+		return azure.WithTransientError(azure.NewOperationNotDoneError(future), scope.DefaultedReconcilerRequeue())
+	}
+
+	// Once the operation is done, delete the long-running operation state. Even if the operation ended with
+	// an error, clear out any lingering state to try the operation again.
+	scope.DeleteLongRunningOperationState(resourceName, serviceName, futureType)
 	return err
 }
 
@@ -153,7 +420,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualmachines.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	vmSpec := s.Scope.VMSpec()
@@ -312,7 +579,7 @@ func getResourceNameByID(resourceID string) string {
 	return resourceName
 }
 
-// IsManaged returns always returns true as CAPZ does not support BYO VM.
+// IsManaged always returns true as CAPZ does not support BYO VM.
 func (s *Service) IsManaged(_ context.Context) (bool, error) {
 	return true, nil
 }
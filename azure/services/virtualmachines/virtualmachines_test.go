@@ -20,10 +20,12 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	. "github.com/onsi/gomega"
@@ -34,6 +36,8 @@ import (
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/identities/mock_identities"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
@@ -160,6 +164,7 @@ func TestReconcileVM(t *testing.T) {
 				mpip.Get(gomockinternal.AContext(), &fakePublicIPSpec).Return(fakePublicIPs, nil)
 				s.SetAddresses(fakeNodeAddresses)
 				s.SetVMState(infrav1.Succeeded)
+				s.OSDiskResizing().Return(false)
 			},
 		},
 		{
@@ -420,3 +425,587 @@ func TestCheckUserAssignedIdentities(t *testing.T) {
 		})
 	}
 }
+
+func TestResizeOSDisk(t *testing.T) {
+	resizedVMSpec := fakeVMSpec
+	resizedVMSpec.OSDisk = infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128)}
+	existingVM := armcompute.VirtualMachine{
+		Properties: &armcompute.VirtualMachineProperties{
+			StorageProfile: &armcompute.StorageProfile{
+				OSDisk: &armcompute.OSDisk{
+					DiskSizeGB: ptr.To[int32](30),
+				},
+			},
+		},
+	}
+	resizedVM := armcompute.VirtualMachine{
+		Properties: &armcompute.VirtualMachineProperties{
+			StorageProfile: &armcompute.StorageProfile{
+				OSDisk: &armcompute.OSDisk{
+					DiskSizeGB: ptr.To[int32](128),
+				},
+			},
+			InstanceView: &armcompute.VirtualMachineInstanceView{
+				Statuses: []*armcompute.InstanceViewStatus{
+					{Code: ptr.To("PowerState/deallocated")},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		spec          *VMSpec
+		vm            armcompute.VirtualMachine
+		dryRun        bool
+		expect        func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder)
+		expectedError string
+	}{
+		{
+			name: "noop if the os disk size is unchanged",
+			spec: &fakeVMSpec,
+			vm:   existingVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+			},
+		},
+		{
+			name:   "os disk is not resized in dry-run mode",
+			spec:   &resizedVMSpec,
+			vm:     existingVM,
+			dryRun: true,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+			},
+		},
+		{
+			name: "os disk is resized while the vm is deallocated",
+			spec: &resizedVMSpec,
+			vm:   existingVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				gomock.InOrder(
+					s.OSDiskResizing().Return(false),
+					s.SetOSDiskResizing(true),
+					s.GetLongRunningOperationState("test-vm", serviceName, deallocateFutureType).Return(nil),
+					c.DeallocateAsync(gomockinternal.AContext(), &resizedVMSpec, "").Return(nil, nil),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, deallocateFutureType),
+					s.GetLongRunningOperationState("test-vm", serviceName, infrav1.PatchFuture).Return(nil),
+					c.UpdateOSDiskSizeAsync(gomockinternal.AContext(), &resizedVMSpec, "", int32(128)).Return(nil, nil),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, infrav1.PatchFuture),
+					s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil),
+					c.StartAsync(gomockinternal.AContext(), &resizedVMSpec, "").Return(nil, nil),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType),
+					s.SetOSDiskResizing(false),
+				)
+			},
+		},
+		{
+			name: "deallocation which does not complete in time is stored as a future",
+			spec: &resizedVMSpec,
+			vm:   existingVM,
+			expect: func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				gomock.InOrder(
+					s.OSDiskResizing().Return(false),
+					s.SetOSDiskResizing(true),
+					s.GetLongRunningOperationState("test-vm", serviceName, deallocateFutureType).Return(nil),
+					c.DeallocateAsync(gomockinternal.AContext(), &resizedVMSpec, "").Return(fakePoller[armcompute.VirtualMachinesClientDeallocateResponse](g), context.DeadlineExceeded),
+					s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{})),
+					s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue),
+				)
+			},
+			expectedError: "operation type VMDeallocate on Azure resource test-group/test-vm is not done",
+		},
+		{
+			name: "vm is not started if the os disk update fails",
+			spec: &resizedVMSpec,
+			vm:   existingVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				gomock.InOrder(
+					s.OSDiskResizing().Return(true),
+					s.GetLongRunningOperationState("test-vm", serviceName, deallocateFutureType).Return(nil),
+					c.DeallocateAsync(gomockinternal.AContext(), &resizedVMSpec, "").Return(nil, nil),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, deallocateFutureType),
+					s.GetLongRunningOperationState("test-vm", serviceName, infrav1.PatchFuture).Return(nil),
+					c.UpdateOSDiskSizeAsync(gomockinternal.AContext(), &resizedVMSpec, "", int32(128)).Return(nil, internalError()),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, infrav1.PatchFuture),
+				)
+			},
+			expectedError: "failed to update OS disk size to 128GB",
+		},
+		{
+			name: "vm is started again after the os disk of the deallocated vm was resized",
+			spec: &resizedVMSpec,
+			vm:   resizedVM,
+			expect: func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				future, err := converters.PollerToFuture(fakePoller[armcompute.VirtualMachinesClientStartResponse](g), startFutureType, serviceName, "test-vm", "test-group")
+				g.Expect(err).NotTo(HaveOccurred())
+				resumeToken, err := converters.FutureToResumeToken(*future)
+				g.Expect(err).NotTo(HaveOccurred())
+				gomock.InOrder(
+					s.OSDiskResizing().Return(true),
+					s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(future),
+					c.StartAsync(gomockinternal.AContext(), &resizedVMSpec, resumeToken).Return(nil, nil),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType),
+					s.SetOSDiskResizing(false),
+				)
+			},
+		},
+		{
+			name: "resize stays in progress if the vm fails to start",
+			spec: &resizedVMSpec,
+			vm:   resizedVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				gomock.InOrder(
+					s.OSDiskResizing().Return(true),
+					s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil),
+					c.StartAsync(gomockinternal.AContext(), &resizedVMSpec, "").Return(nil, internalError()),
+					s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType),
+				)
+			},
+			expectedError: "failed to start VM",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_virtualmachines.NewMockVMScope(mockCtrl)
+			clientMock := mock_virtualmachines.NewMockClient(mockCtrl)
+
+			tc.expect(g, scopeMock.EXPECT(), clientMock.EXPECT())
+			s := &Service{
+				Scope:  scopeMock,
+				client: clientMock,
+			}
+			dryRunScope := &dryRunVMScope{MockVMScope: scopeMock}
+			if tc.dryRun {
+				s.Scope = dryRunScope
+			}
+
+			err := s.resizeOSDisk(context.TODO(), tc.spec, tc.vm)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tc.dryRun {
+				g.Expect(dryRunScope.skipped).To(HaveLen(1))
+			}
+		})
+	}
+}
+
+// dryRunVMScope is a VMScope in dry-run mode which records the skipped mutations.
+type dryRunVMScope struct {
+	*mock_virtualmachines.MockVMScope
+	skipped []string
+}
+
+func (s *dryRunVMScope) IsDryRun() bool {
+	return true
+}
+
+func (s *dryRunVMScope) RecordDryRunSkip(message string) {
+	s.skipped = append(s.skipped, message)
+}
+
+func fakePoller[T any](g *WithT) *runtime.Poller[T] {
+	response := &http.Response{
+		Body: io.NopCloser(strings.NewReader("")),
+		Request: &http.Request{
+			Method: http.MethodPut,
+			URL:    &url.URL{Path: "/"},
+		},
+		StatusCode: http.StatusAccepted,
+	}
+	pipeline := runtime.NewPipeline("testmodule", "v0.1.0", runtime.PipelineOptions{}, nil)
+	poller, err := runtime.NewPoller[T](response, pipeline, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	return poller
+}
+
+func TestNeedsInstanceView(t *testing.T) {
+	resizedVMSpec := fakeVMSpec
+	resizedVMSpec.OSDisk = infrav1.OSDisk{OSType: "Linux", DiskSizeGB: ptr.To[int32](128)}
+	spotVMSpec := fakeVMSpec
+	spotVMSpec.SpotVMOptions = &infrav1.SpotVMOptions{}
+	deleteSpotVMSpec := fakeVMSpec
+	deleteSpotVMSpec.SpotVMOptions = &infrav1.SpotVMOptions{EvictionPolicy: ptr.To(infrav1.SpotEvictionPolicyDelete)}
+	existingVM := armcompute.VirtualMachine{
+		Properties: &armcompute.VirtualMachineProperties{
+			StorageProfile: &armcompute.StorageProfile{
+				OSDisk: &armcompute.OSDisk{
+					DiskSizeGB: ptr.To[int32](30),
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		spec     *VMSpec
+		expect   func(s *mock_virtualmachines.MockVMScopeMockRecorder)
+		expected bool
+	}{
+		{
+			name:     "regular VM",
+			spec:     &fakeVMSpec,
+			expect:   func(_ *mock_virtualmachines.MockVMScopeMockRecorder) {},
+			expected: false,
+		},
+		{
+			name:     "VM whose OS disk must be resized",
+			spec:     &resizedVMSpec,
+			expect:   func(_ *mock_virtualmachines.MockVMScopeMockRecorder) {},
+			expected: true,
+		},
+		{
+			name:     "spot VM with the Delete eviction policy",
+			spec:     &deleteSpotVMSpec,
+			expect:   func(_ *mock_virtualmachines.MockVMScopeMockRecorder) {},
+			expected: false,
+		},
+		{
+			name: "spot VM with the Deallocate eviction policy",
+			spec: &spotVMSpec,
+			expect: func(s *mock_virtualmachines.MockVMScopeMockRecorder) {
+				s.OSDiskResizing().Return(false)
+			},
+			expected: true,
+		},
+		{
+			name: "spot VM which is deallocated to resize its OS disk",
+			spec: &spotVMSpec,
+			expect: func(s *mock_virtualmachines.MockVMScopeMockRecorder) {
+				s.OSDiskResizing().Return(true)
+			},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_virtualmachines.NewMockVMScope(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT())
+			s := &Service{
+				Scope: scopeMock,
+			}
+
+			g.Expect(s.needsInstanceView(tc.spec, existingVM)).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestRestartDeallocatedSpotVM(t *testing.T) {
+	spotVMSpec := fakeVMSpec
+	spotVMSpec.SpotVMOptions = &infrav1.SpotVMOptions{
+		EvictionPolicy:   ptr.To(infrav1.SpotEvictionPolicyDeallocate),
+		MaxStartAttempts: ptr.To[int32](2),
+	}
+	deleteSpotVMSpec := fakeVMSpec
+	deleteSpotVMSpec.SpotVMOptions = &infrav1.SpotVMOptions{EvictionPolicy: ptr.To(infrav1.SpotEvictionPolicyDelete)}
+	runningVM := armcompute.VirtualMachine{
+		Properties: &armcompute.VirtualMachineProperties{
+			InstanceView: &armcompute.VirtualMachineInstanceView{
+				Statuses: []*armcompute.InstanceViewStatus{
+					{Code: ptr.To("ProvisioningState/succeeded")},
+					{Code: ptr.To("PowerState/running")},
+				},
+			},
+		},
+	}
+	deallocatedVM := armcompute.VirtualMachine{
+		Properties: &armcompute.VirtualMachineProperties{
+			InstanceView: &armcompute.VirtualMachineInstanceView{
+				Statuses: []*armcompute.InstanceViewStatus{
+					{Code: ptr.To("ProvisioningState/succeeded")},
+					{Code: ptr.To("PowerState/deallocated")},
+				},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		spec          *VMSpec
+		vm            armcompute.VirtualMachine
+		dryRun        bool
+		expect        func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder)
+		expectedError string
+		terminal      bool
+	}{
+		{
+			name: "noop for a regular VM",
+			spec: &fakeVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, _ *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+			},
+		},
+		{
+			name: "noop for a spot VM with the Delete eviction policy",
+			spec: &deleteSpotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, _ *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+			},
+		},
+		{
+			name: "noop for a spot VM which is deallocated to resize its OS disk",
+			spec: &spotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(true)
+			},
+		},
+		{
+			name: "noop for a running spot VM",
+			spec: &spotVMSpec,
+			vm:   runningVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(0))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil)
+			},
+		},
+		{
+			name: "failed start attempts are reset once the spot VM is running",
+			spec: &spotVMSpec,
+			vm:   runningVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(1))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil)
+				s.SetFailedStartAttempts(int32(0))
+			},
+		},
+		{
+			name:   "deallocated spot VM is not started in dry-run mode",
+			spec:   &spotVMSpec,
+			vm:     deallocatedVM,
+			dryRun: true,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(0))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil)
+			},
+		},
+		{
+			name: "deallocated spot VM is started",
+			spec: &spotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(1))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil).Times(2)
+				s.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityWarning, gomock.Any())
+				c.StartAsync(gomockinternal.AContext(), &spotVMSpec, "").Return(nil, nil)
+				s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType)
+				s.SetFailedStartAttempts(int32(0))
+				s.UpdatePutStatus(infrav1.VMRunningCondition, serviceName, nil)
+			},
+		},
+		{
+			name: "start which does not complete in time is not a failed attempt",
+			spec: &spotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(1))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil).Times(2)
+				s.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityWarning, gomock.Any())
+				c.StartAsync(gomockinternal.AContext(), &spotVMSpec, "").Return(fakePoller[armcompute.VirtualMachinesClientStartResponse](g), context.DeadlineExceeded)
+				s.SetLongRunningOperationState(gomock.AssignableToTypeOf(&infrav1.Future{}))
+				s.DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue)
+			},
+			expectedError: "operation type VMStart on Azure resource test-group/test-vm is not done",
+		},
+		{
+			name: "start in progress is resumed while the spot VM is starting",
+			spec: &spotVMSpec,
+			vm:   runningVM,
+			expect: func(g *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				future, err := converters.PollerToFuture(fakePoller[armcompute.VirtualMachinesClientStartResponse](g), startFutureType, serviceName, "test-vm", "test-group")
+				g.Expect(err).NotTo(HaveOccurred())
+				resumeToken, err := converters.FutureToResumeToken(*future)
+				g.Expect(err).NotTo(HaveOccurred())
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(0))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(future).Times(2)
+				s.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityWarning, gomock.Any())
+				c.StartAsync(gomockinternal.AContext(), &spotVMSpec, resumeToken).Return(nil, nil)
+				s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType)
+				s.SetFailedStartAttempts(int32(0))
+				s.UpdatePutStatus(infrav1.VMRunningCondition, serviceName, nil)
+			},
+		},
+		{
+			name: "failed start attempt is recorded",
+			spec: &spotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, c *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(0))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil).Times(2)
+				s.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityWarning, gomock.Any())
+				c.StartAsync(gomockinternal.AContext(), &spotVMSpec, "").Return(nil, internalError())
+				s.DeleteLongRunningOperationState("test-vm", serviceName, startFutureType)
+				s.SetFailedStartAttempts(int32(1))
+			},
+			expectedError: "failed to start deallocated spot VM (attempt 1 of 2)",
+		},
+		{
+			name: "terminal error once the maximum number of start attempts is reached",
+			spec: &spotVMSpec,
+			vm:   deallocatedVM,
+			expect: func(_ *WithT, s *mock_virtualmachines.MockVMScopeMockRecorder, _ *mock_virtualmachines.MockClientMockRecorder) {
+				s.OSDiskResizing().Return(false)
+				s.FailedStartAttempts().Return(int32(2))
+				s.GetLongRunningOperationState("test-vm", serviceName, startFutureType).Return(nil)
+				s.SetConditionFalse(infrav1.VMRunningCondition, infrav1.VMDeallocatedReason, clusterv1.ConditionSeverityError, gomock.Any())
+			},
+			expectedError: "is still deallocated after 2 failed start attempts",
+			terminal:      true,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_virtualmachines.NewMockVMScope(mockCtrl)
+			clientMock := mock_virtualmachines.NewMockClient(mockCtrl)
+
+			tc.expect(g, scopeMock.EXPECT(), clientMock.EXPECT())
+			s := &Service{
+				Scope:  scopeMock,
+				client: clientMock,
+			}
+			dryRunScope := &dryRunVMScope{MockVMScope: scopeMock}
+			if tc.dryRun {
+				s.Scope = dryRunScope
+			}
+
+			err := s.restartDeallocatedSpotVM(context.TODO(), tc.spec, tc.vm)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				var reconcileErr azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileErr) && reconcileErr.IsTerminal()).To(Equal(tc.terminal))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			if tc.dryRun {
+				g.Expect(dryRunScope.skipped).To(HaveLen(1))
+			}
+		})
+	}
+}
+
+func TestValidateCapacityReservation(t *testing.T) {
+	groupID := "/subscriptions/123/resourceGroups/test-group/providers/Microsoft.Compute/capacityReservationGroups/test-crg"
+	regionalSpec := fakeVMSpec
+	regionalSpec.CapacityReservationGroupID = groupID
+	zonalSpec := regionalSpec
+	zonalSpec.Zone = "2"
+	sharedZonalSpec := zonalSpec
+	sharedZonalSpec.CapacityReservationGroupID = "/subscriptions/456/resourceGroups/test-group/providers/Microsoft.Compute/capacityReservationGroups/test-crg"
+
+	testcases := []struct {
+		name          string
+		spec          *VMSpec
+		reservations  []armcompute.CapacityReservation
+		expectedError string
+	}{
+		{
+			name: "regional reservation for the VM size",
+			spec: &regionalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("standard_fake_size")}},
+			},
+		},
+		{
+			name: "zonal reservation for the VM size and zone",
+			spec: &zonalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}, Zones: []*string{ptr.To("1")}},
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}, Zones: []*string{ptr.To("2")}},
+			},
+		},
+		{
+			name: "no reservation for the VM size",
+			spec: &regionalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Other_Size")}},
+			},
+			expectedError: "has no reservation for VM size Standard_Fake_Size",
+		},
+		{
+			name: "zonal reservation in another zone",
+			spec: &zonalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}, Zones: []*string{ptr.To("1")}},
+			},
+			expectedError: "has no reservation for VM size Standard_Fake_Size in zone \"2\"",
+		},
+		{
+			name: "regional reservation for a zonal VM",
+			spec: &zonalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}},
+			},
+			expectedError: "has no reservation for VM size Standard_Fake_Size in zone \"2\"",
+		},
+		{
+			name: "zonal reservation in another logical zone of a group shared from another subscription",
+			spec: &sharedZonalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}, Zones: []*string{ptr.To("1")}},
+			},
+		},
+		{
+			name: "regional reservation for a zonal VM in a group shared from another subscription",
+			spec: &sharedZonalSpec,
+			reservations: []armcompute.CapacityReservation{
+				{SKU: &armcompute.SKU{Name: ptr.To("Standard_Fake_Size")}},
+			},
+			expectedError: "has no reservation for VM size Standard_Fake_Size in zone \"2\"",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			clientMock := mock_virtualmachines.NewMockClient(mockCtrl)
+			scopeMock := mock_virtualmachines.NewMockVMScope(mockCtrl)
+
+			scopeMock.EXPECT().SubscriptionID().Return("123").AnyTimes()
+			scopeMock.EXPECT().DefaultedReconcilerRequeue().Return(reconciler.DefaultReconcilerRequeue).AnyTimes()
+			clientMock.EXPECT().ListCapacityReservations(gomockinternal.AContext(), tc.spec.CapacityReservationGroupID).Return(tc.reservations, nil)
+			s := &Service{
+				Scope:  scopeMock,
+				client: clientMock,
+			}
+
+			err := s.validateCapacityReservation(context.TODO(), tc.spec)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				// A reservation may be added to the group later, so the VM creation is retried.
+				var reconcileErr azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileErr)).To(BeTrue())
+				g.Expect(reconcileErr.IsTransient()).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -34,6 +34,7 @@ type VNetSpec struct {
 	ResourceGroup    string
 	Name             string
 	CIDRs            []string
+	DNSServers       []string
 	Location         string
 	ExtendedLocation *infrav1.ExtendedLocationSpec
 	ClusterName      string
@@ -75,6 +76,13 @@ func (s *VNetSpec) Parameters(_ context.Context, existing *asonetworkv1.VirtualN
 	vnet.Spec.AddressSpace = &asonetworkv1.AddressSpace{
 		AddressPrefixes: s.CIDRs,
 	}
+	// Leaving DhcpOptions unset makes the virtual network use the Azure-provided DNS.
+	vnet.Spec.DhcpOptions = nil
+	if len(s.DNSServers) > 0 {
+		vnet.Spec.DhcpOptions = &asonetworkv1.DhcpOptions{
+			DnsServers: s.DNSServers,
+		}
+	}
 
 	return vnet, nil
 }
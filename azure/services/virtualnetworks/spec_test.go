@@ -73,6 +73,75 @@ func TestParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "new vnet with DNS servers",
+			spec: VNetSpec{
+				ResourceGroup:  "rg",
+				Name:           "name",
+				CIDRs:          []string{"cidr"},
+				DNSServers:     []string{"10.0.0.4", "10.0.0.5"},
+				Location:       "location",
+				ClusterName:    "cluster",
+				AdditionalTags: map[string]string{"my": "tag"},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"my": "tag",
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": "owned",
+						"sigs.k8s.io_cluster-api-provider-azure_role":            "common",
+						"Name": "name",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4", "10.0.0.5"},
+					},
+				},
+			},
+		},
+		{
+			name: "from existing vnet with DNS servers removed",
+			spec: VNetSpec{
+				ResourceGroup:  "rg",
+				Name:           "name",
+				CIDRs:          []string{"cidr"},
+				Location:       "location",
+				ClusterName:    "cluster",
+				AdditionalTags: map[string]string{"my": "tag"},
+			},
+			existing: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4"},
+					},
+				},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+				},
+			},
+		},
 		{
 			name: "from existing vnet",
 			spec: VNetSpec{
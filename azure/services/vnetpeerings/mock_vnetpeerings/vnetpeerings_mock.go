@@ -197,6 +197,18 @@ func (mr *MockVnetPeeringScopeMockRecorder) HashKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockVnetPeeringScope)(nil).HashKey))
 }
 
+// MarkVnetPeeringFailed mocks base method.
+func (m *MockVnetPeeringScope) MarkVnetPeeringFailed(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "MarkVnetPeeringFailed", arg0, arg1)
+}
+
+// MarkVnetPeeringFailed indicates an expected call of MarkVnetPeeringFailed.
+func (mr *MockVnetPeeringScopeMockRecorder) MarkVnetPeeringFailed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVnetPeeringFailed", reflect.TypeOf((*MockVnetPeeringScope)(nil).MarkVnetPeeringFailed), arg0, arg1)
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockVnetPeeringScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -18,23 +18,30 @@ package vnetpeerings
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
 // ServiceName is the name of this service.
 const ServiceName = "vnetpeerings"
 
+func init() {
+	reconciler.RegisterServiceGroup(ServiceName, reconciler.ServiceGroupNetworking)
+}
+
 // VnetPeeringScope defines the scope interface for a subnet service.
 type VnetPeeringScope interface {
 	azure.Authorizer
 	azure.AsyncStatusUpdater
 	VnetPeeringSpecs() []azure.ResourceSpecGetter
+	MarkVnetPeeringFailed(reason, message string)
 }
 
 // Service provides operations on Azure resources.
@@ -66,7 +73,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "vnetpeerings.Service.Reconcile")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.VnetPeeringSpecs()
@@ -78,24 +85,49 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
+	var failedSpec azure.ResourceSpecGetter
 	for _, peeringSpec := range specs {
 		if _, err := s.CreateOrUpdateResource(ctx, peeringSpec, ServiceName); err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
+				failedSpec = peeringSpec
 			}
 		}
 	}
 
+	// Errors that need action on one of the peered virtual networks are reported with a specific reason.
+	if peeringSpec, ok := failedSpec.(*VnetPeeringSpec); ok {
+		if reason := failureReason(result); reason != "" {
+			s.Scope.MarkVnetPeeringFailed(reason, fmt.Sprintf("%s failed to create peering %s from virtual network %s/%s to virtual network %s/%s in subscription %s. err: %s",
+				ServiceName, peeringSpec.PeeringName, peeringSpec.SourceResourceGroup, peeringSpec.SourceVnetName,
+				peeringSpec.RemoteResourceGroup, peeringSpec.RemoteVnetName, peeringSpec.SubscriptionID, result.Error()))
+			return result
+		}
+	}
+
 	s.Scope.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, result)
 	return result
 }
 
+// failureReason returns the condition reason of a peering error that needs action on one of the peered
+// virtual networks, or an empty string for any other error.
+func failureReason(err error) string {
+	switch {
+	case azure.ResourceForbidden(err):
+		return infrav1.VnetPeeringForbiddenReason
+	case azure.ResourceNotFound(err):
+		return infrav1.RemoteVnetNotFoundReason
+	default:
+		return ""
+	}
+}
+
 // Delete deletes the peering with the provided name.
 func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "vnetpeerings.Service.Delete")
 	defer done()
 
-	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	ctx, cancel := reconciler.WithServiceReconcileTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
 	specs := s.Scope.VnetPeeringSpecs()
@@ -116,6 +116,26 @@ func internalError() *azcore.ResponseError {
 	}
 }
 
+func forbiddenError() *azcore.ResponseError {
+	return &azcore.ResponseError{
+		RawResponse: &http.Response{
+			Body:       io.NopCloser(strings.NewReader("#: Forbidden: StatusCode=403")),
+			StatusCode: http.StatusForbidden,
+		},
+		StatusCode: http.StatusForbidden,
+	}
+}
+
+func notFoundError() *azcore.ResponseError {
+	return &azcore.ResponseError{
+		RawResponse: &http.Response{
+			Body:       io.NopCloser(strings.NewReader("#: Not Found: StatusCode=404")),
+			StatusCode: http.StatusNotFound,
+		},
+		StatusCode: http.StatusNotFound,
+	}
+}
+
 func TestReconcileVnetPeerings(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -193,6 +213,32 @@ func TestReconcileVnetPeerings(t *testing.T) {
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, internalError())
 			},
 		},
+		{
+			name:          "permission error in creating peering on remote vnet",
+			expectedError: "#: Forbidden: StatusCode=403",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(nil, forbiddenError())
+				p.MarkVnetPeeringFailed(infrav1.VnetPeeringForbiddenReason, gomock.Cond(func(message string) bool {
+					return strings.Contains(message, "from virtual network group2/vnet2 to virtual network group1/vnet1 in subscription sub1")
+				}))
+			},
+		},
+		{
+			name:          "remote vnet not found in creating peering",
+			expectedError: "#: Not Found: StatusCode=404",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(nil, notFoundError())
+				p.MarkVnetPeeringFailed(infrav1.RemoteVnetNotFoundReason, gomock.Cond(func(message string) bool {
+					return strings.Contains(message, "from virtual network group2/vnet2 to virtual network group1/vnet1 in subscription sub1")
+				}))
+			},
+		},
 		{
 			name:          "not done error in creating is ignored",
 			expectedError: "#: Internal Server Error: StatusCode=500",
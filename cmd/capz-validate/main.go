@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// capz-validate runs the checks of the CAPZ webhooks against the AzureCluster, AzureClusterTemplate,
+// AzureMachine and AzureMachineTemplate objects of a manifest, without a management cluster.
+//
+//	capz-validate validate -f cluster.yaml [--old previous.yaml]
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"sigs.k8s.io/cluster-api-provider-azure/pkg/validation"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "validate" {
+		fmt.Fprintln(os.Stderr, "usage: capz-validate validate -f <file> [--old <file>]")
+		os.Exit(2)
+	}
+
+	fs := pflag.NewFlagSet("validate", pflag.ExitOnError)
+	filename := fs.StringP("filename", "f", "", "Manifest to validate, or - to read from stdin.")
+	oldFilename := fs.String("old", "", "Manifest with the objects as currently applied. When set, objects found in both manifests are validated as updates.")
+	_ = fs.Parse(os.Args[2:])
+
+	if *filename == "" {
+		fmt.Fprintln(os.Stderr, "--filename is required")
+		os.Exit(2)
+	}
+
+	failed, err := run(context.Background(), os.Stdout, *filename, *oldFilename)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// run validates the objects of filename and prints the result for each of them. It returns true if any object
+// is invalid.
+func run(ctx context.Context, out io.Writer, filename, oldFilename string) (bool, error) {
+	objs, err := decodeFile(filename)
+	if err != nil {
+		return false, err
+	}
+	oldObjs := map[string]client.Object{}
+	if oldFilename != "" {
+		olds, err := decodeFile(oldFilename)
+		if err != nil {
+			return false, err
+		}
+		for _, old := range olds {
+			oldObjs[objectKey(old)] = old
+		}
+	}
+
+	failed := false
+	for _, obj := range objs {
+		warnings, err := validation.Validate(ctx, obj, oldObjs[objectKey(obj)])
+		for _, warning := range warnings {
+			fmt.Fprintf(out, "%s: warning: %s\n", objectKey(obj), warning)
+		}
+		if err != nil {
+			failed = true
+			fmt.Fprintf(out, "%s: invalid: %v\n", objectKey(obj), err)
+			continue
+		}
+		fmt.Fprintf(out, "%s: valid\n", objectKey(obj))
+	}
+	return failed, nil
+}
+
+func decodeFile(filename string) ([]client.Object, error) {
+	r := os.Stdin
+	if filename != "-" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open %s", filename)
+		}
+		defer f.Close()
+		r = f
+	}
+	objs, err := validation.Decode(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %s", filename)
+	}
+	return objs, nil
+}
+
+// objectKey identifies an object by kind, namespace and name.
+func objectKey(obj client.Object) string {
+	kind := obj.GetObjectKind().GroupVersionKind().Kind
+	if obj.GetNamespace() == "" {
+		return fmt.Sprintf("%s/%s", kind, obj.GetName())
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, obj.GetNamespace(), obj.GetName())
+}
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	infrav1alpha "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -49,7 +50,7 @@ func (r *AgentPoolAdoptReconciler) SetupWithManager(_ context.Context, mgr ctrl.
 		For(&asocontainerservicev1.ManagedClustersAgentPool{}).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(ev event.UpdateEvent) bool {
-				return ev.ObjectOld.GetAnnotations()[adoptAnnotation] != ev.ObjectNew.GetAnnotations()[adoptAnnotation]
+				return infrav1.IsAdoptRequested(ev.ObjectOld.GetAnnotations()) != infrav1.IsAdoptRequested(ev.ObjectNew.GetAnnotations())
 			},
 			DeleteFunc: func(_ event.DeleteEvent) bool { return false },
 		}).
@@ -79,7 +80,7 @@ func (r *AgentPoolAdoptReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if agentPool.GetAnnotations()[adoptAnnotation] != adoptAnnotationValue {
+	if !infrav1.IsAdoptRequested(agentPool.GetAnnotations()) {
 		return ctrl.Result{}, nil
 	}
 
@@ -19,6 +19,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -51,6 +52,8 @@ type AzureClusterReconciler struct {
 	Timeouts                  reconciler.Timeouts
 	WatchFilterValue          string
 	CredentialCache           azure.CredentialCache
+	PublicIPDeletionGrace     time.Duration
+	VerboseEvents             bool
 	createAzureClusterService azureClusterServiceCreator
 }
 
@@ -81,7 +84,7 @@ func (acr *AzureClusterReconciler) SetupWithManager(ctx context.Context, mgr ctr
 
 	var r reconcile.Reconciler = acr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(acr, options.Cache, log)
+		r = coalescing.NewReconciler(acr, options.Cache, acr.Client, &infrav1.AzureCluster{}, log)
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -116,6 +119,7 @@ func (acr *AzureClusterReconciler) SetupWithManager(ctx context.Context, mgr ctr
 func (acr *AzureClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx, cancel := context.WithTimeout(ctx, acr.Timeouts.DefaultedLoopTimeout())
 	defer cancel()
+	ctx = reconciler.WithServiceGroupTimeouts(ctx, acr.Timeouts.ServiceGroups)
 
 	ctx, log, done := tele.StartSpanWithLogger(
 		ctx,
@@ -152,18 +156,26 @@ func (acr *AzureClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	log = log.WithValues("cluster", cluster.Name)
 
 	// Create the scope.
-	clusterScope, err := scope.NewClusterScope(ctx, scope.ClusterScopeParams{
+	clusterScopeParams := scope.ClusterScopeParams{
 		Client:          acr.Client,
 		Cluster:         cluster,
 		AzureCluster:    azureCluster,
 		Timeouts:        acr.Timeouts,
 		CredentialCache: acr.CredentialCache,
-	})
+
+		PublicIPDeletionGrace: acr.PublicIPDeletionGrace,
+	}
+	if acr.VerboseEvents {
+		clusterScopeParams.ResourceEventRecorder = acr.Recorder
+	}
+	clusterScope, err := scope.NewClusterScope(ctx, clusterScopeParams)
 	if err != nil {
 		err = errors.Wrap(err, "failed to create scope")
 		acr.Recorder.Eventf(azureCluster, corev1.EventTypeWarning, "CreateClusterScopeFailed", err.Error())
 		return reconcile.Result{}, err
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
+	log = tele.LoggerFrom(ctx)
 
 	// Always close the scope when exiting this function so we can persist any AzureMachine changes.
 	defer func() {
@@ -219,6 +231,7 @@ func (acr *AzureClusterReconciler) reconcileNormal(ctx context.Context, clusterS
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create a new AzureClusterReconciler")
 	}
+	acs.recorder = acr.Recorder
 
 	if err := acs.Reconcile(ctx); err != nil {
 		// Handle terminal & transient errors
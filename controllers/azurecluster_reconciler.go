@@ -18,10 +18,16 @@ package controllers
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
@@ -30,6 +36,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatedns"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privatelinkservices"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/routetables"
@@ -50,6 +57,11 @@ type azureClusterService struct {
 	Reconcile func(context.Context) error
 	Pause     func(context.Context) error
 	Delete    func(context.Context) error
+	// recorder, when set, is used to emit an event with the duration of each service reconciled while the
+	// AzureCluster is being provisioned.
+	recorder record.EventRecorder
+	// ownedResourceIDs, when set, lists the resources owned by the cluster to record them in the AzureCluster status.
+	ownedResourceIDs func(context.Context) ([]string, error)
 }
 
 // newAzureClusterService populates all the services based on input scope.
@@ -82,6 +94,10 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 	if err != nil {
 		return nil, err
 	}
+	privateLinkServicesSvc, err := privatelinkservices.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	acs := &azureClusterService{
 		scope: scope,
 		services: []azure.ServiceReconciler{
@@ -94,11 +110,13 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 			subnets.New(scope),
 			vnetPeeringsSvc,
 			loadbalancersSvc,
+			privateLinkServicesSvc,
 			privateDNSSvc,
 			privateendpoints.New(scope),
 			bastionhosts.New(scope),
 		},
-		skuCache: skuCache,
+		skuCache:         skuCache,
+		ownedResourceIDs: scope.OwnedResourceIDs,
 	}
 	acs.Reconcile = acs.reconcile
 	acs.Pause = acs.pause
@@ -122,14 +140,62 @@ func (s *azureClusterService) reconcile(ctx context.Context) error {
 	}
 
 	for _, service := range s.services {
-		if err := service.Reconcile(ctx); err != nil {
+		start := time.Now()
+		if err := ReconcileService(ctx, service); err != nil {
+			if service.Name() == vnetpeerings.ServiceName {
+				s.recordVnetPeeringFailed()
+			}
 			return errors.Wrapf(err, "failed to reconcile AzureCluster service %s", service.Name())
 		}
+		s.recordServiceReconciled(service, time.Since(start))
 	}
 
+	s.updateManagedResources(ctx)
+
 	return nil
 }
 
+// updateManagedResources records the resources owned by the cluster in the AzureCluster status.
+// The inventory is informational, so failing to list the resources does not fail the reconcile.
+func (s *azureClusterService) updateManagedResources(ctx context.Context) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.azureClusterService.updateManagedResources")
+	defer done()
+
+	if s.ownedResourceIDs == nil {
+		return
+	}
+	ids, err := s.ownedResourceIDs(ctx)
+	if err != nil {
+		log.Error(err, "failed to list resources owned by the cluster")
+		return
+	}
+	s.scope.SetManagedResources(ids)
+}
+
+// recordServiceReconciled emits an event with the time it took to reconcile a service.
+// Events are only emitted until the AzureCluster is ready to avoid an event for every service on every resync.
+func (s *azureClusterService) recordServiceReconciled(service azure.ServiceReconciler, elapsed time.Duration) {
+	if s.recorder == nil || s.scope.AzureCluster.Status.Ready {
+		return
+	}
+	s.recorder.Eventf(s.scope.AzureCluster, corev1.EventTypeNormal, "ServiceReconciled", "Reconciled %s in %s", service.Name(), elapsed.Round(time.Millisecond))
+}
+
+// recordVnetPeeringFailed emits a warning event when the virtual network peerings failed for a reason that needs
+// action on one of the peered virtual networks, e.g. missing permissions on a virtual network in another subscription.
+func (s *azureClusterService) recordVnetPeeringFailed() {
+	if s.recorder == nil {
+		return
+	}
+	condition := conditions.Get(s.scope.AzureCluster, infrav1.VnetPeeringReadyCondition)
+	if condition == nil || condition.Status != corev1.ConditionFalse {
+		return
+	}
+	if condition.Reason == infrav1.VnetPeeringForbiddenReason || condition.Reason == infrav1.RemoteVnetNotFoundReason {
+		s.recorder.Event(s.scope.AzureCluster, corev1.EventTypeWarning, condition.Reason, condition.Message)
+	}
+}
+
 // Pause pauses all components making up the cluster.
 func (s *azureClusterService) pause(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "controllers.azureClusterService.Pause")
@@ -160,7 +226,7 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrap(err, "failed to get vnet peerings service")
 		}
-		if err := vnetPeeringsSvc.Delete(ctx); err != nil {
+		if err := DeleteService(ctx, vnetPeeringsSvc); err != nil {
 			return errors.Wrap(err, "failed to delete peerings")
 		}
 
@@ -170,14 +236,16 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 		}
 
 		// Delete the entire resource group directly.
-		if err := groupSvc.Delete(ctx); err != nil {
+		if err := DeleteService(ctx, groupSvc); err != nil {
 			return errors.Wrap(err, "failed to delete resource group")
 		}
 	} else {
 		// If the resource group is not managed we need to delete resources inside the group one by one.
-		// services are deleted in reverse order from the order in which they are reconciled.
+		// services are deleted in reverse order from the order in which they are reconciled, and a service that
+		// is still deleting stops the loop, so e.g. subnets are gone before their security groups, route tables
+		// and vnet are deleted.
 		for i := len(s.services) - 1; i >= 0; i-- {
-			if err := s.services[i].Delete(ctx); err != nil {
+			if err := DeleteService(ctx, s.services[i]); err != nil {
 				return errors.Wrapf(err, "failed to delete AzureCluster service %s", s.services[i].Name())
 			}
 		}
@@ -187,6 +255,8 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 }
 
 // setFailureDomainsForLocation sets the AzureCluster Status failure domains based on which Azure Availability Zones are available in the cluster location.
+// When the AzureCluster spec restricts its failure domains, the listed ones must all be available zones in the location and only those are set.
+// Zones set on the cluster's public IPs must also be available zones in the location.
 // Note that this is not done in a webhook as it requires API calls to fetch the availability zones.
 func (s *azureClusterService) setFailureDomainsForLocation(ctx context.Context) error {
 	if s.scope.ExtendedLocation() != nil {
@@ -198,6 +268,20 @@ func (s *azureClusterService) setFailureDomainsForLocation(ctx context.Context)
 		return errors.Wrapf(err, "failed to get zones for location %s", s.scope.Location())
 	}
 
+	for id := range s.scope.AzureCluster.Spec.FailureDomains {
+		if s.scope.AzureCluster.Spec.RestrictFailureDomains && !slices.Contains(zones, id) {
+			return errors.Errorf("failure domain %q is not an availability zone in location %s (available zones: %v)", id, s.scope.Location(), zones)
+		}
+	}
+
+	for name, ipZones := range s.scope.PublicIPZones() {
+		for _, zone := range ipZones {
+			if !slices.Contains(zones, zone) {
+				return errors.Errorf("zone %q of public IP %s is not an availability zone in location %s (available zones: %v)", zone, name, s.scope.Location(), zones)
+			}
+		}
+	}
+
 	for _, zone := range zones {
 		s.scope.SetFailureDomain(zone, clusterv1.FailureDomainSpec{
 			ControlPlane: true,
@@ -29,8 +29,10 @@ import (
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -81,6 +83,10 @@ func TestAzureClusterServiceReconcile(t *testing.T) {
 			svcThreeMock := mock_azure.NewMockServiceReconciler(mockCtrl)
 
 			tc.expect(svcOneMock.EXPECT(), svcTwoMock.EXPECT(), svcThreeMock.EXPECT())
+			// Services are named when applying their group timeout.
+			svcOneMock.EXPECT().Name().Return("one").AnyTimes()
+			svcTwoMock.EXPECT().Name().Return("two").AnyTimes()
+			svcThreeMock.EXPECT().Name().Return("three").AnyTimes()
 
 			s := &azureClusterService{
 				scope: &scope.ClusterScope{
@@ -106,6 +112,300 @@ func TestAzureClusterServiceReconcile(t *testing.T) {
 	}
 }
 
+func TestAzureClusterServiceReconcileEvents(t *testing.T) {
+	cases := map[string]struct {
+		ready          bool
+		expectedEvents []string
+	}{
+		"an event is emitted for each service while provisioning": {
+			ready: false,
+			expectedEvents: []string{
+				"Normal ServiceReconciled Reconciled one in ",
+				"Normal ServiceReconciled Reconciled two in ",
+			},
+		},
+		"no events are emitted once the cluster is ready": {
+			ready: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			svcOneMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+			svcTwoMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+
+			svcOneMock.EXPECT().Reconcile(gomockinternal.AContext()).Return(nil)
+			svcTwoMock.EXPECT().Reconcile(gomockinternal.AContext()).Return(nil)
+			svcOneMock.EXPECT().Name().Return("one").AnyTimes()
+			svcTwoMock.EXPECT().Name().Return("two").AnyTimes()
+
+			recorder := record.NewFakeRecorder(10)
+			s := &azureClusterService{
+				scope: &scope.ClusterScope{
+					Cluster: &clusterv1.Cluster{},
+					AzureCluster: &infrav1.AzureCluster{
+						Status: infrav1.AzureClusterStatus{
+							Ready: tc.ready,
+						},
+					},
+				},
+				services: []azure.ServiceReconciler{
+					svcOneMock,
+					svcTwoMock,
+				},
+				skuCache: resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, ""),
+				recorder: recorder,
+			}
+
+			g.Expect(s.reconcile(context.TODO())).To(Succeed())
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(HaveLen(len(tc.expectedEvents)))
+			for i, event := range events {
+				g.Expect(event).To(HavePrefix(tc.expectedEvents[i]))
+			}
+		})
+	}
+}
+
+func TestAzureClusterServiceReconcileVnetPeeringFailedEvent(t *testing.T) {
+	cases := map[string]struct {
+		reason         string
+		expectedEvents []string
+	}{
+		"an event is emitted when the remote vnet cannot be accessed": {
+			reason:         infrav1.VnetPeeringForbiddenReason,
+			expectedEvents: []string{"Warning VnetPeeringForbidden vnetpeerings failed to create peering"},
+		},
+		"an event is emitted when the remote vnet is not found": {
+			reason:         infrav1.RemoteVnetNotFoundReason,
+			expectedEvents: []string{"Warning RemoteVnetNotFound vnetpeerings failed to create peering"},
+		},
+		"no event is emitted for other failures": {
+			reason: infrav1.FailedReason,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			svcMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+
+			clusterScope := &scope.ClusterScope{
+				Cluster:      &clusterv1.Cluster{},
+				AzureCluster: &infrav1.AzureCluster{},
+			}
+			svcMock.EXPECT().Reconcile(gomockinternal.AContext()).DoAndReturn(func(context.Context) error {
+				conditions.MarkFalse(clusterScope.AzureCluster, infrav1.VnetPeeringReadyCondition, tc.reason, clusterv1.ConditionSeverityError,
+					"vnetpeerings failed to create peering vnet2-to-vnet1")
+				return errors.New("peering failed")
+			})
+			svcMock.EXPECT().Name().Return(vnetpeerings.ServiceName).AnyTimes()
+
+			recorder := record.NewFakeRecorder(10)
+			s := &azureClusterService{
+				scope:    clusterScope,
+				services: []azure.ServiceReconciler{svcMock},
+				skuCache: resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, ""),
+				recorder: recorder,
+			}
+
+			g.Expect(s.reconcile(context.TODO())).To(MatchError(ContainSubstring("peering failed")))
+			close(recorder.Events)
+			var events []string
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+			g.Expect(events).To(HaveLen(len(tc.expectedEvents)))
+			for i, event := range events {
+				g.Expect(event).To(HavePrefix(tc.expectedEvents[i]))
+			}
+		})
+	}
+}
+
+func TestAzureClusterServiceUpdateManagedResources(t *testing.T) {
+	cases := map[string]struct {
+		ownedResourceIDs func(context.Context) ([]string, error)
+		expected         *infrav1.ManagedResources
+	}{
+		"owned resources are recorded in the status": {
+			ownedResourceIDs: func(context.Context) ([]string, error) {
+				return []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet"}, nil
+			},
+			expected: &infrav1.ManagedResources{
+				Count:       1,
+				ResourceIDs: []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/vnet"},
+			},
+		},
+		"status is left unchanged when listing fails": {
+			ownedResourceIDs: func(context.Context) ([]string, error) {
+				return nil, errors.New("some error happened")
+			},
+		},
+		"status is left unchanged when listing is disabled": {},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			s := &azureClusterService{
+				scope: &scope.ClusterScope{
+					Cluster:      &clusterv1.Cluster{},
+					AzureCluster: &infrav1.AzureCluster{},
+				},
+				services:         []azure.ServiceReconciler{},
+				skuCache:         resourceskus.NewStaticCache([]armcompute.ResourceSKU{}, ""),
+				ownedResourceIDs: tc.ownedResourceIDs,
+			}
+
+			g.Expect(s.reconcile(context.TODO())).To(Succeed())
+			g.Expect(s.scope.AzureCluster.Status.ManagedResources).To(Equal(tc.expected))
+		})
+	}
+}
+
+func TestAzureClusterServiceSetFailureDomainsForLocation(t *testing.T) {
+	skus := []armcompute.ResourceSKU{
+		{
+			Name:         ptr.To("Standard_D2s_v3"),
+			ResourceType: ptr.To(string(resourceskus.VirtualMachines)),
+			LocationInfo: []*armcompute.ResourceSKULocationInfo{
+				{
+					Location: ptr.To("eastus"),
+					Zones:    []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")},
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		specifiedFDs  clusterv1.FailureDomains
+		restrict      bool
+		apiServerLB   *infrav1.LoadBalancerSpec
+		expectedFDs   clusterv1.FailureDomains
+		expectedError string
+	}{
+		"all zones are discovered when no failure domains are specified": {
+			expectedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"3": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		"all zones are discovered when the specified failure domains are not restricted": {
+			specifiedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"4": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+			expectedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"3": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		"only specified zones are set": {
+			specifiedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+			restrict: true,
+			expectedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		"specified zone not available in location": {
+			specifiedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"4": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+			restrict:      true,
+			expectedError: `failure domain "4" is not an availability zone in location eastus (available zones: [1 2 3])`,
+		},
+		"public IP zone available in location": {
+			apiServerLB: &infrav1.LoadBalancerSpec{
+				FrontendIPs: []infrav1.FrontendIP{
+					{
+						Name:     "my-frontend",
+						PublicIP: &infrav1.PublicIPSpec{Name: "my-publicip", Zones: []string{"2"}},
+					},
+				},
+			},
+			expectedFDs: clusterv1.FailureDomains{
+				"1": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"2": clusterv1.FailureDomainSpec{ControlPlane: true},
+				"3": clusterv1.FailureDomainSpec{ControlPlane: true},
+			},
+		},
+		"public IP zone not available in location": {
+			apiServerLB: &infrav1.LoadBalancerSpec{
+				FrontendIPs: []infrav1.FrontendIP{
+					{
+						Name:     "my-frontend",
+						PublicIP: &infrav1.PublicIPSpec{Name: "my-publicip", Zones: []string{"1", "4"}},
+					},
+				},
+			},
+			expectedError: `zone "4" of public IP my-publicip is not an availability zone in location eastus (available zones: [1 2 3])`,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			s := &azureClusterService{
+				scope: &scope.ClusterScope{
+					Cluster: &clusterv1.Cluster{},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location:               "eastus",
+								FailureDomains:         tc.specifiedFDs,
+								RestrictFailureDomains: tc.restrict,
+							},
+							NetworkSpec: infrav1.NetworkSpec{
+								APIServerLB: tc.apiServerLB,
+							},
+						},
+						Status: infrav1.AzureClusterStatus{
+							// A zone discovered by a previous reconcile must be dropped if no longer specified.
+							FailureDomains: clusterv1.FailureDomains{
+								"3": clusterv1.FailureDomainSpec{ControlPlane: true},
+							},
+						},
+					},
+				},
+				skuCache: resourceskus.NewStaticCache(skus, "eastus"),
+			}
+
+			err := s.setFailureDomainsForLocation(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(s.scope.AzureCluster.Status.FailureDomains).To(Equal(tc.expectedFDs))
+		})
+	}
+}
+
 func TestAzureClusterServicePause(t *testing.T) {
 	type pausingServiceReconciler struct {
 		*mock_azure.MockServiceReconciler
@@ -340,6 +640,12 @@ func TestAzureClusterServiceDelete(t *testing.T) {
 			svcThreeMock := mock_azure.NewMockServiceReconciler(mockCtrl)
 
 			tc.expect(groupsMock.EXPECT(), vnetpeeringsMock.EXPECT(), svcOneMock.EXPECT(), svcTwoMock.EXPECT(), svcThreeMock.EXPECT())
+			// Services are named when applying their group timeout.
+			groupsMock.EXPECT().Name().Return(groups.ServiceName).AnyTimes()
+			vnetpeeringsMock.EXPECT().Name().Return(vnetpeerings.ServiceName).AnyTimes()
+			svcOneMock.EXPECT().Name().Return("one").AnyTimes()
+			svcTwoMock.EXPECT().Name().Return("two").AnyTimes()
+			svcThreeMock.EXPECT().Name().Return("three").AnyTimes()
 			c := tc.clientBuilder(g)
 
 			s := &azureClusterService{
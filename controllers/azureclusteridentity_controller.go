@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// AzureClusterIdentityReconciler reconciles the Ready condition of AzureClusterIdentity objects, which reports
+// whether the secret referenced by the identity can be used to authenticate. It also removes the request rate
+// limits of identities which are deleted or no longer set one.
+type AzureClusterIdentityReconciler struct {
+	client.Client
+	Timeouts         reconciler.Timeouts
+	WatchFilterValue string
+}
+
+// SetupWithManager initializes this controller with a manager.
+func (r *AzureClusterIdentityReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	_, log, done := tele.StartSpanWithLogger(ctx,
+		"controllers.AzureClusterIdentityReconciler.SetupWithManager",
+		tele.KVP("controller", "AzureClusterIdentity"),
+	)
+	defer done()
+
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1.AzureClusterIdentity{}, builder.WithPredicates(predicates.ResourceHasFilterLabel(log, r.WatchFilterValue))).
+		// Add a watch on the secrets referenced by AzureClusterIdentities, so that a secret created or fixed after its
+		// identity is observed. Only the metadata of secrets is watched to avoid caching the content of every secret
+		// in the cluster, and secrets are not expected to have the watch filter label.
+		WatchesMetadata(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.secretToAzureClusterIdentities),
+		).
+		Complete(r)
+}
+
+// secretToAzureClusterIdentities maps a secret to the AzureClusterIdentities referencing it.
+func (r *AzureClusterIdentityReconciler) secretToAzureClusterIdentities(ctx context.Context, o client.Object) []reconcile.Request {
+	identities := &infrav1.AzureClusterIdentityList{}
+	if err := r.List(ctx, identities); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range identities.Items {
+		ref := identities.Items[i].Spec.ClientSecret
+		if ref.Name == o.GetName() && ref.Namespace == o.GetNamespace() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&identities.Items[i])})
+		}
+	}
+	return requests
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azureclusteridentities;azureclusteridentities/status,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile validates the secret referenced by an AzureClusterIdentity and reports the result in its Ready condition.
+func (r *AzureClusterIdentityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	ctx, cancel := context.WithTimeout(ctx, r.Timeouts.DefaultedLoopTimeout())
+	defer cancel()
+
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.AzureClusterIdentityReconciler.Reconcile",
+		tele.KVP("namespace", req.Namespace),
+		tele.KVP("name", req.Name),
+		tele.KVP("kind", "AzureClusterIdentity"),
+	)
+	defer done()
+
+	identity := &infrav1.AzureClusterIdentity{}
+	if err := r.Get(ctx, req.NamespacedName, identity); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("object was not found")
+			// The request rate limits of a deleted identity no longer apply to the subscriptions it was used with.
+			azure.RemoveRateLimitOwner(req.NamespacedName.String())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if identity.Spec.RateLimit == nil {
+		azure.RemoveRateLimitOwner(req.NamespacedName.String())
+	}
+
+	patchHelper, err := patch.NewHelper(identity, r.Client)
+	if err != nil {
+		return reconcile.Result{}, errors.Wrap(err, "failed to init patch helper")
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, identity, patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{clusterv1.ReadyCondition}}); err != nil && reterr == nil {
+			reterr = err
+		}
+	}()
+
+	if !usesClientSecret(identity) {
+		conditions.MarkTrue(identity, clusterv1.ReadyCondition)
+		return reconcile.Result{}, nil
+	}
+
+	ref := identity.Spec.ClientSecret
+	if ref.Name == "" || ref.Namespace == "" {
+		conditions.MarkFalse(identity, clusterv1.ReadyCondition, infrav1.ClientSecretReferenceInvalidReason, clusterv1.ConditionSeverityError,
+			"identity of type %s requires the name and namespace of a clientSecret", identity.Spec.Type)
+		return reconcile.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			conditions.MarkFalse(identity, clusterv1.ReadyCondition, infrav1.ClientSecretNotFoundReason, clusterv1.ConditionSeverityError,
+				"secret %s/%s not found", ref.Namespace, ref.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, errors.Wrapf(err, "failed to get secret %s/%s", ref.Namespace, ref.Name)
+	}
+
+	value := secret.Data[scope.AzureSecretKey]
+	if len(value) == 0 {
+		conditions.MarkFalse(identity, clusterv1.ReadyCondition, infrav1.ClientSecretKeyMissingReason, clusterv1.ConditionSeverityError,
+			"secret %s/%s has no %s key", ref.Namespace, ref.Name, scope.AzureSecretKey)
+		return reconcile.Result{}, nil
+	}
+
+	if identity.Spec.Type == infrav1.ServicePrincipalCertificate {
+		if _, _, err := azidentity.ParseCertificates(value, nil); err != nil {
+			conditions.MarkFalse(identity, clusterv1.ReadyCondition, infrav1.ClientCertificateInvalidReason, clusterv1.ConditionSeverityError,
+				"failed to parse the certificate in the %s key of secret %s/%s: %v", scope.AzureSecretKey, ref.Namespace, ref.Name, err)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	conditions.MarkTrue(identity, clusterv1.ReadyCondition)
+	return reconcile.Result{}, nil
+}
+
+// usesClientSecret returns true if the identity authenticates with the secret referenced by its clientSecret.
+func usesClientSecret(identity *infrav1.AzureClusterIdentity) bool {
+	switch identity.Spec.Type {
+	case infrav1.ServicePrincipal, infrav1.ManualServicePrincipal:
+		return true
+	case infrav1.ServicePrincipalCertificate:
+		return identity.Spec.CertPath == ""
+	default:
+		return false
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+func TestAzureClusterIdentityReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	identity := func(identityType infrav1.IdentityType, secretName string) *infrav1.AzureClusterIdentity {
+		return &infrav1.AzureClusterIdentity{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-identity", Namespace: "default"},
+			Spec: infrav1.AzureClusterIdentitySpec{
+				Type:         identityType,
+				ClientID:     "fooClient",
+				TenantID:     "fooTenant",
+				ClientSecret: corev1.SecretReference{Name: secretName, Namespace: "default"},
+			},
+		}
+	}
+	secret := func(data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "default"},
+			Data:       data,
+		}
+	}
+
+	cases := map[string]struct {
+		objects        []client.Object
+		expectedReady  bool
+		expectedReason string
+	}{
+		"identity without a secret is ready": {
+			objects:       []client.Object{identity(infrav1.WorkloadIdentity, "")},
+			expectedReady: true,
+		},
+		"service principal without a secret reference": {
+			objects:        []client.Object{identity(infrav1.ServicePrincipal, "")},
+			expectedReason: infrav1.ClientSecretReferenceInvalidReason,
+		},
+		"service principal with a missing secret": {
+			objects:        []client.Object{identity(infrav1.ServicePrincipal, "my-secret")},
+			expectedReason: infrav1.ClientSecretNotFoundReason,
+		},
+		"service principal with a secret without the clientSecret key": {
+			objects: []client.Object{
+				identity(infrav1.ServicePrincipal, "my-secret"),
+				secret(map[string][]byte{"password": []byte("fooSecret")}),
+			},
+			expectedReason: infrav1.ClientSecretKeyMissingReason,
+		},
+		"service principal with a valid secret": {
+			objects: []client.Object{
+				identity(infrav1.ServicePrincipal, "my-secret"),
+				secret(map[string][]byte{"clientSecret": []byte("fooSecret")}),
+			},
+			expectedReady: true,
+		},
+		"service principal certificate with an invalid certificate": {
+			objects: []client.Object{
+				identity(infrav1.ServicePrincipalCertificate, "my-secret"),
+				secret(map[string][]byte{"clientSecret": []byte("not a certificate")}),
+			},
+			expectedReason: infrav1.ClientCertificateInvalidReason,
+		},
+		"service principal certificate with a valid certificate": {
+			objects: []client.Object{
+				identity(infrav1.ServicePrincipalCertificate, "my-secret"),
+				secret(map[string][]byte{"clientSecret": generateClientCertificate(t)}),
+			},
+			expectedReady: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&infrav1.AzureClusterIdentity{}).
+				Build()
+			r := &AzureClusterIdentityReconciler{Client: c}
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Namespace: "default", Name: "my-identity"}})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			actual := &infrav1.AzureClusterIdentity{}
+			g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "my-identity"}, actual)).To(Succeed())
+			g.Expect(conditions.IsTrue(actual, clusterv1.ReadyCondition)).To(Equal(tc.expectedReady))
+			if tc.expectedReason != "" {
+				g.Expect(conditions.GetReason(actual, clusterv1.ReadyCondition)).To(Equal(tc.expectedReason))
+			}
+		})
+	}
+}
+
+func TestSecretToAzureClusterIdentities(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = infrav1.AddToScheme(scheme)
+
+	referencing := &infrav1.AzureClusterIdentity{
+		ObjectMeta: metav1.ObjectMeta{Name: "referencing", Namespace: "default"},
+		Spec:       infrav1.AzureClusterIdentitySpec{ClientSecret: corev1.SecretReference{Name: "my-secret", Namespace: "secrets"}},
+	}
+	other := &infrav1.AzureClusterIdentity{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+		Spec:       infrav1.AzureClusterIdentitySpec{ClientSecret: corev1.SecretReference{Name: "my-secret", Namespace: "default"}},
+	}
+	r := &AzureClusterIdentityReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(referencing, other).Build()}
+
+	requests := r.secretToAzureClusterIdentities(context.Background(), &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: "my-secret", Namespace: "secrets"},
+	})
+	g.Expect(requests).To(ConsistOf(ctrl.Request{NamespacedName: client.ObjectKeyFromObject(referencing)}))
+}
+
+// generateClientCertificate returns a self-signed certificate and its private key, PEM encoded.
+func generateClientCertificate(t *testing.T) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})...,
+	)
+}
@@ -200,6 +200,8 @@ func (r *AzureJSONMachineReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create scope")
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
+	log = tele.LoggerFrom(ctx)
 
 	apiVersion, kind := infrav1.GroupVersion.WithKind("AzureMachine").ToAPIVersionAndKind()
 	owner := metav1.OwnerReference{
@@ -218,9 +220,10 @@ func (r *AzureJSONMachineReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to create identities client")
 		}
-		parsed, err := azureutil.ParseResourceID(azureMachine.Spec.UserAssignedIdentities[0].ProviderID)
+		providerID := azure.ResolveUserAssignedIdentityID(azureMachine.Spec.UserAssignedIdentities[0].ProviderID, clusterScope.SubscriptionID(), clusterScope.ResourceGroup())
+		parsed, err := azureutil.ParseResourceID(providerID)
 		if err != nil {
-			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", azureMachine.Spec.UserAssignedIdentities[0].ProviderID)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", providerID)
 		}
 		if parsed.SubscriptionID != clusterScope.SubscriptionID() {
 			identitiesClient, err = identities.NewClientBySub(clusterScope, parsed.SubscriptionID)
@@ -229,7 +232,7 @@ func (r *AzureJSONMachineReconciler) Reconcile(ctx context.Context, req ctrl.Req
 			}
 		}
 		userAssignedIdentityIfExists, err = identitiesClient.GetClientID(
-			ctx, azureMachine.Spec.UserAssignedIdentities[0].ProviderID)
+			ctx, providerID)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to get user-assigned identity ClientID")
 		}
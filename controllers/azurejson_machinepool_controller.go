@@ -38,6 +38,7 @@ import (
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/identities"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
@@ -141,6 +142,8 @@ func (r *AzureJSONMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl
 	if err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "failed to create cluster scope for cluster %s/%s", cluster.Namespace, cluster.Name)
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
+	log = tele.LoggerFrom(ctx)
 
 	// Construct secret for this machine
 	userAssignedIdentityIfExists := ""
@@ -150,9 +153,10 @@ func (r *AzureJSONMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to create identities client")
 		}
-		parsed, err := azureutil.ParseResourceID(azureMachinePool.Spec.UserAssignedIdentities[0].ProviderID)
+		providerID := azure.ResolveUserAssignedIdentityID(azureMachinePool.Spec.UserAssignedIdentities[0].ProviderID, clusterScope.SubscriptionID(), clusterScope.ResourceGroup())
+		parsed, err := azureutil.ParseResourceID(providerID)
 		if err != nil {
-			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", azureMachinePool.Spec.UserAssignedIdentities[0].ProviderID)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", providerID)
 		}
 		if parsed.SubscriptionID != clusterScope.SubscriptionID() {
 			identitiesClient, err = identities.NewClientBySub(clusterScope, parsed.SubscriptionID)
@@ -161,7 +165,7 @@ func (r *AzureJSONMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl
 			}
 		}
 		userAssignedIdentityIfExists, err = identitiesClient.GetClientID(
-			ctx, azureMachinePool.Spec.UserAssignedIdentities[0].ProviderID)
+			ctx, providerID)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to get user-assigned identity ClientID")
 		}
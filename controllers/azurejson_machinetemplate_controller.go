@@ -160,6 +160,8 @@ func (r *AzureJSONTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create scope")
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
+	log = tele.LoggerFrom(ctx)
 
 	apiVersion, kind := infrav1.GroupVersion.WithKind("AzureMachineTemplate").ToAPIVersionAndKind()
 	owner := metav1.OwnerReference{
@@ -178,9 +180,10 @@ func (r *AzureJSONTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to create identities client")
 		}
-		parsed, err := azureutil.ParseResourceID(azureMachineTemplate.Spec.Template.Spec.UserAssignedIdentities[0].ProviderID)
+		providerID := azure.ResolveUserAssignedIdentityID(azureMachineTemplate.Spec.Template.Spec.UserAssignedIdentities[0].ProviderID, clusterScope.SubscriptionID(), clusterScope.ResourceGroup())
+		parsed, err := azureutil.ParseResourceID(providerID)
 		if err != nil {
-			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", azureMachineTemplate.Spec.Template.Spec.UserAssignedIdentities[0].ProviderID)
+			return reconcile.Result{}, errors.Wrapf(err, "failed to parse ProviderID %s", providerID)
 		}
 		if parsed.SubscriptionID != clusterScope.SubscriptionID() {
 			identitiesClient, err = identities.NewClientBySub(clusterScope, parsed.SubscriptionID)
@@ -189,7 +192,7 @@ func (r *AzureJSONTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Re
 			}
 		}
 		userAssignedIdentityIfExists, err = identitiesClient.GetClientID(
-			ctx, azureMachineTemplate.Spec.Template.Spec.UserAssignedIdentities[0].ProviderID)
+			ctx, providerID)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to get user-assigned identity ClientID")
 		}
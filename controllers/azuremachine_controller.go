@@ -52,6 +52,7 @@ type AzureMachineReconciler struct {
 	Timeouts                  reconciler.Timeouts
 	WatchFilterValue          string
 	CredentialCache           azure.CredentialCache
+	VerboseEvents             bool
 	createAzureMachineService azureMachineServiceCreator
 }
 
@@ -82,7 +83,7 @@ func (amr *AzureMachineReconciler) SetupWithManager(ctx context.Context, mgr ctr
 
 	var r reconcile.Reconciler = amr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(amr, options.Cache, log)
+		r = coalescing.NewReconciler(amr, options.Cache, amr.Client, &infrav1.AzureMachine{}, log)
 	}
 
 	// create mapper to transform incoming AzureClusters into AzureMachine requests
@@ -132,6 +133,7 @@ func (amr *AzureMachineReconciler) SetupWithManager(ctx context.Context, mgr ctr
 func (amr *AzureMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx, cancel := context.WithTimeout(ctx, amr.Timeouts.DefaultedLoopTimeout())
 	defer cancel()
+	ctx = reconciler.WithServiceGroupTimeouts(ctx, amr.Timeouts.ServiceGroups)
 
 	ctx, log, done := tele.StartSpanWithLogger(
 		ctx,
@@ -199,14 +201,20 @@ func (amr *AzureMachineReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		amr.Recorder.Eventf(azureCluster, corev1.EventTypeWarning, "Error creating the cluster scope", err.Error())
 		return reconcile.Result{}, err
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
+	log = tele.LoggerFrom(ctx)
 
 	// Create the machine scope
-	machineScope, err := scope.NewMachineScope(scope.MachineScopeParams{
+	machineScopeParams := scope.MachineScopeParams{
 		Client:       amr.Client,
 		Machine:      machine,
 		AzureMachine: azureMachine,
 		ClusterScope: clusterScope,
-	})
+	}
+	if amr.VerboseEvents {
+		machineScopeParams.ResourceEventRecorder = amr.Recorder
+	}
+	machineScope, err := scope.NewMachineScope(machineScopeParams)
 	if err != nil {
 		amr.Recorder.Eventf(azureMachine, corev1.EventTypeWarning, "Error creating the machine scope", err.Error())
 		return reconcile.Result{}, errors.Wrap(err, "failed to create scope")
@@ -285,6 +293,11 @@ func (amr *AzureMachineReconciler) reconcileNormal(ctx context.Context, machineS
 		return reconcile.Result{}, errors.Wrap(err, "failed to init machine scope cache")
 	}
 
+	if err := machineScope.ValidateNodeOutboundBackendPool(); err != nil {
+		amr.Recorder.Event(machineScope.AzureMachine, corev1.EventTypeWarning, "NodeOutboundBackendPoolNotFound", err.Error())
+		return reconcile.Result{}, err
+	}
+
 	// Mark the AzureMachine as failed if the identities are not ready.
 	cond := conditions.Get(machineScope.AzureMachine, infrav1.VMIdentitiesReadyCondition)
 	if cond != nil && cond.Status == corev1.ConditionFalse && cond.Reason == infrav1.UserAssignedIdentityMissingReason {
@@ -370,14 +383,23 @@ func (amr *AzureMachineReconciler) reconcileDelete(ctx context.Context, machineS
 		return reconcile.Result{}, err
 	}
 
-	if ShouldDeleteIndividualResources(ctx, clusterScope) {
-		log.Info("Deleting AzureMachine")
+	deleteIndividualResources := ShouldDeleteIndividualResources(ctx, clusterScope)
+	// Additional role assignments may be scoped outside of the resource group, so they outlive its deletion.
+	if deleteIndividualResources || len(machineScope.AzureMachine.Spec.AdditionalRoleAssignments) > 0 {
 		ams, err := amr.createAzureMachineService(machineScope)
 		if err != nil {
 			return reconcile.Result{}, errors.Wrap(err, "failed to create azure machine service")
 		}
 
-		if err := ams.Delete(ctx); err != nil {
+		deleteMachine := ams.Delete
+		if deleteIndividualResources {
+			log.Info("Deleting AzureMachine")
+		} else {
+			log.Info("Skipping AzureMachine Deletion; will delete whole resource group. Deleting its additional role assignments.")
+			deleteMachine = ams.DeleteRoleAssignments
+		}
+
+		if err := deleteMachine(ctx); err != nil {
 			// Handle transient errors
 			var reconcileError azure.ReconcileError
 			if errors.As(err, &reconcileError) {
@@ -176,6 +176,10 @@ func (f fakeSKUCacher) Get(context.Context, string, resourceskus.ResourceType) (
 	return resourceskus.SKU{}, errors.New("not implemented")
 }
 
+func (f fakeSKUCacher) GetDiskSKUs(context.Context) ([]string, error) {
+	return nil, nil
+}
+
 func TestAzureMachineReconcileNormal(t *testing.T) {
 	cases := map[string]TestMachineReconcileInput{
 		"should reconcile normally": {
@@ -528,6 +532,9 @@ func getDefaultAzureMachineService(machineScope *scope.MachineScope, cache *reso
 		Delete: func(context.Context) error {
 			return nil
 		},
+		DeleteRoleAssignments: func(context.Context) error {
+			return nil
+		},
 	}
 }
 
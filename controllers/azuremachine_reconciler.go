@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/tags"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualmachines"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vmextensions"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -46,6 +47,8 @@ type azureMachineService struct {
 	Reconcile func(context.Context) error
 	Pause     func(context.Context) error
 	Delete    func(context.Context) error
+	// DeleteRoleAssignments deletes only the role assignments of the machine.
+	DeleteRoleAssignments func(context.Context) error
 }
 
 // newAzureMachineService populates all the services based on input scope.
@@ -108,6 +111,7 @@ func newAzureMachineService(machineScope *scope.MachineScope) (*azureMachineServ
 	ams.Reconcile = ams.reconcile
 	ams.Pause = ams.pause
 	ams.Delete = ams.delete
+	ams.DeleteRoleAssignments = ams.deleteRoleAssignments
 
 	return ams, nil
 }
@@ -125,7 +129,7 @@ func (s *azureMachineService) reconcile(ctx context.Context) error {
 	}
 
 	for _, service := range s.services {
-		if err := service.Reconcile(ctx); err != nil {
+		if err := ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureMachine service %s", service.Name())
 		}
 	}
@@ -158,10 +162,28 @@ func (s *azureMachineService) delete(ctx context.Context) error {
 
 	// Delete services in reverse order of creation.
 	for i := len(s.services) - 1; i >= 0; i-- {
-		if err := s.services[i].Delete(ctx); err != nil {
+		if err := DeleteService(ctx, s.services[i]); err != nil {
 			return errors.Wrapf(err, "failed to delete AzureMachine service %s", s.services[i].Name())
 		}
 	}
 
 	return nil
 }
+
+// deleteRoleAssignments deletes the role assignments of the machine. Unlike the other resources of the machine,
+// they may be scoped outside of the resource group and so are not deleted along with it.
+func (s *azureMachineService) deleteRoleAssignments(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "controllers.azureMachineService.deleteRoleAssignments")
+	defer done()
+
+	for _, service := range s.services {
+		if group, _ := reconciler.ServiceGroupOf(service.Name()); group != reconciler.ServiceGroupIdentity {
+			continue
+		}
+		if err := DeleteService(ctx, service); err != nil {
+			return errors.Wrapf(err, "failed to delete AzureMachine service %s", service.Name())
+		}
+	}
+
+	return nil
+}
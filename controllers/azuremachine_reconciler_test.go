@@ -24,6 +24,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -32,8 +33,40 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
+func Test_newAzureMachineService(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	clusterMock := mock_azure.NewMockClusterScoper(mockCtrl)
+	clusterMock.EXPECT().SubscriptionID().AnyTimes()
+	clusterMock.EXPECT().BaseURI().AnyTimes()
+	clusterMock.EXPECT().CloudEnvironment().AnyTimes()
+	clusterMock.EXPECT().Token().AnyTimes()
+	clusterMock.EXPECT().Location().Return("test-location").AnyTimes()
+	clusterMock.EXPECT().HashKey().Return("fakeCluster").AnyTimes()
+	clusterMock.EXPECT().DefaultedAzureCallTimeout().AnyTimes()
+
+	subject, err := newAzureMachineService(&scope.MachineScope{
+		ClusterScoper: clusterMock,
+		Machine:       &clusterv1.Machine{},
+		AzureMachine: &infrav1.AzureMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "machineName",
+			},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(subject.services).NotTo(BeEmpty())
+	for _, service := range subject.services {
+		_, ok := reconciler.ServiceGroupOf(service.Name())
+		g.Expect(ok).To(BeTrue(), "service %s is not in a service group", service.Name())
+	}
+}
+
 func TestAzureMachineServiceReconcile(t *testing.T) {
 	cases := map[string]struct {
 		expectedError string
@@ -71,6 +104,10 @@ func TestAzureMachineServiceReconcile(t *testing.T) {
 			svcThreeMock := mock_azure.NewMockServiceReconciler(mockCtrl)
 
 			tc.expect(svcOneMock.EXPECT(), svcTwoMock.EXPECT(), svcThreeMock.EXPECT())
+			// Services are named when applying their group timeout.
+			svcOneMock.EXPECT().Name().Return("one").AnyTimes()
+			svcTwoMock.EXPECT().Name().Return("two").AnyTimes()
+			svcThreeMock.EXPECT().Name().Return("three").AnyTimes()
 
 			s := &azureMachineService{
 				scope: &scope.MachineScope{
@@ -210,6 +247,10 @@ func TestAzureMachineServiceDelete(t *testing.T) {
 			svcThreeMock := mock_azure.NewMockServiceReconciler(mockCtrl)
 
 			tc.expect(svcOneMock.EXPECT(), svcTwoMock.EXPECT(), svcThreeMock.EXPECT())
+			// Services are named when applying their group timeout.
+			svcOneMock.EXPECT().Name().Return("one").AnyTimes()
+			svcTwoMock.EXPECT().Name().Return("two").AnyTimes()
+			svcThreeMock.EXPECT().Name().Return("three").AnyTimes()
 
 			s := &azureMachineService{
 				scope: &scope.MachineScope{
@@ -238,3 +279,25 @@ func TestAzureMachineServiceDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureMachineServiceDeleteRoleAssignments(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	vmMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+	roleAssignmentsMock := mock_azure.NewMockServiceReconciler(mockCtrl)
+
+	// Only the services of the identity group are deleted.
+	vmMock.EXPECT().Name().Return("virtualmachine").AnyTimes()
+	roleAssignmentsMock.EXPECT().Name().Return("roleassignments").AnyTimes()
+	roleAssignmentsMock.EXPECT().Delete(gomockinternal.AContext()).Return(nil)
+
+	s := &azureMachineService{
+		services: []azure.ServiceReconciler{
+			vmMock,
+			roleAssignmentsMock,
+		},
+	}
+
+	g.Expect(s.deleteRoleAssignments(context.TODO())).To(Succeed())
+}
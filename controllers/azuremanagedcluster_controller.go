@@ -58,7 +58,7 @@ func (amcr *AzureManagedClusterReconciler) SetupWithManager(ctx context.Context,
 
 	var r reconcile.Reconciler = amcr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(amcr, options.Cache, log)
+		r = coalescing.NewReconciler(amcr, options.Cache, amcr.Client, &infrav1.AzureManagedCluster{}, log)
 	}
 
 	azManagedCluster := &infrav1.AzureManagedCluster{}
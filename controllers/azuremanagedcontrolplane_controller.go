@@ -66,7 +66,7 @@ func (amcpr *AzureManagedControlPlaneReconciler) SetupWithManager(ctx context.Co
 	amcpr.getNewAzureManagedControlPlaneReconciler = newAzureManagedControlPlaneReconciler
 	var r reconcile.Reconciler = amcpr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(amcpr, options.Cache, log)
+		r = coalescing.NewReconciler(amcpr, options.Cache, amcpr.Client, &infrav1.AzureManagedControlPlane{}, log)
 	}
 
 	azManagedControlPlane := &infrav1.AzureManagedControlPlane{}
@@ -123,6 +123,7 @@ func (amcpr *AzureManagedControlPlaneReconciler) SetupWithManager(ctx context.Co
 func (amcpr *AzureManagedControlPlaneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
 	ctx, cancel := context.WithTimeout(ctx, amcpr.Timeouts.DefaultedLoopTimeout())
 	defer cancel()
+	ctx = reconciler.WithServiceGroupTimeouts(ctx, amcpr.Timeouts.ServiceGroups)
 
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.AzureManagedControlPlaneReconciler.Reconcile",
 		tele.KVP("namespace", req.Namespace),
@@ -190,6 +191,8 @@ func (amcpr *AzureManagedControlPlaneReconciler) Reconcile(ctx context.Context,
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create scope")
 	}
+	ctx = scope.WithLogValues(ctx, mcpScope)
+	log = tele.LoggerFrom(ctx)
 
 	// Always patch when exiting so we can persist changes to finalizers and status
 	defer func() {
@@ -304,6 +304,7 @@ func TestAzureManagedControlPlaneReconcileNormal(t *testing.T) {
 	amcpr.getNewAzureManagedControlPlaneReconciler = func(scope *scope.ManagedControlPlaneScope) (*azureManagedControlPlaneService, error) {
 		ctrlr := gomock.NewController(t)
 		svcr := mock_azure.NewMockServiceReconciler(ctrlr)
+		svcr.EXPECT().Name().Return("one").AnyTimes()
 		svcr.EXPECT().Reconcile(gomock.Any()).Return(nil)
 
 		return &azureManagedControlPlaneService{
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/tools/clientcmd"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -29,13 +30,17 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aksextensions"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/fleetsmembers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/managedclusters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/privateendpoints"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourcehealth"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/tags"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
@@ -49,33 +54,64 @@ type azureManagedControlPlaneService struct {
 
 // newAzureManagedControlPlaneReconciler populates all the services based on input scope.
 func newAzureManagedControlPlaneReconciler(scope *scope.ManagedControlPlaneScope) (*azureManagedControlPlaneService, error) {
+	roleAssignmentsSvc, err := roleassignments.NewManagedClusterService(scope)
+	if err != nil {
+		return nil, err
+	}
 	resourceHealthSvc, err := resourcehealth.New(scope)
 	if err != nil {
 		return nil, err
 	}
+	tagsSvc, err := tags.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	managedClustersSvc, err := managedclusters.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	services := []azure.ServiceReconciler{
+		groups.New(scope),
+		virtualnetworks.New(scope),
+		subnets.New(scope),
+		managedClustersSvc,
+	}
+	if scope.ControlPlane.Spec.SystemNodePool != nil {
+		services = append(services, &systemNodePoolService{Service: agentpools.New(scope.SystemNodePoolScope())})
+	}
+	services = append(services,
+		tagsSvc,
+		roleAssignmentsSvc,
+		privateendpoints.New(scope),
+		fleetsmembers.New(scope),
+		aksextensions.New(scope),
+		resourceHealthSvc,
+	)
 	return &azureManagedControlPlaneService{
 		kubeclient: scope.Client,
 		scope:      scope,
-		services: []azure.ServiceReconciler{
-			groups.New(scope),
-			virtualnetworks.New(scope),
-			subnets.New(scope),
-			managedclusters.New(scope),
-			privateendpoints.New(scope),
-			fleetsmembers.New(scope),
-			aksextensions.New(scope),
-			resourceHealthSvc,
-		},
+		services:   services,
 	}, nil
 }
 
+// systemNodePoolService reconciles the system node pool declared on the AzureManagedControlPlane like any
+// other agent pool.
+type systemNodePoolService struct {
+	*aso.Service[genruntime.MetaObject, agentpools.AgentPoolScope]
+}
+
+// Delete is a no-op as AKS deletes the system node pool together with the managed cluster.
+func (s *systemNodePoolService) Delete(context.Context) error {
+	return nil
+}
+
 // Reconcile reconciles all the services in a predetermined order.
 func (r *azureManagedControlPlaneService) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "controllers.azureManagedControlPlaneService.Reconcile")
 	defer done()
 
 	for _, service := range r.services {
-		if err := service.Reconcile(ctx); err != nil {
+		if err := ReconcileService(ctx, service); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureManagedControlPlane service %s", service.Name())
 		}
 	}
@@ -112,7 +148,7 @@ func (r *azureManagedControlPlaneService) Delete(ctx context.Context) error {
 
 	// Delete services in reverse order of creation.
 	for i := len(r.services) - 1; i >= 0; i-- {
-		if err := r.services[i].Delete(ctx); err != nil {
+		if err := DeleteService(ctx, r.services[i]); err != nil {
 			return errors.Wrapf(err, "failed to delete AzureManagedControlPlane service %s", r.services[i].Name())
 		}
 	}
@@ -154,6 +190,11 @@ func (r *azureManagedControlPlaneService) reconcileKubeconfig(ctx context.Contex
 		}
 	}
 
+	// The admin kubeconfig is not retrieved while the cluster is stopped.
+	if len(kubeConfigs[0]) == 0 {
+		return nil
+	}
+
 	// store cluster-info for the cluster with the admin kubeconfig.
 	kubeconfigFile, err := clientcmd.Load(kubeConfigs[0])
 	if err != nil {
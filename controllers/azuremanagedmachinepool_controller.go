@@ -82,7 +82,7 @@ func (ammpr *AzureManagedMachinePoolReconciler) SetupWithManager(ctx context.Con
 
 	var r reconcile.Reconciler = ammpr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(ammpr, options.Cache, log)
+		r = coalescing.NewReconciler(ammpr, options.Cache, ammpr.Client, &infrav1.AzureManagedMachinePool{}, log)
 	}
 
 	azManagedMachinePool := &infrav1.AzureManagedMachinePool{}
@@ -203,6 +203,8 @@ func (ammpr *AzureManagedMachinePoolReconciler) Reconcile(ctx context.Context, r
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed to create ManagedControlPlane scope")
 	}
+	ctx = scope.WithLogValues(ctx, managedControlPlaneScope)
+	log = tele.LoggerFrom(ctx)
 
 	// Create the scope.
 	mcpScope, err := scope.NewManagedMachinePoolScope(ctx, scope.ManagedMachinePoolScopeParams{
@@ -1152,3 +1152,17 @@ func RemoveBlockMoveAnnotation(obj metav1.Object) {
 	delete(azClusterAnnotations, clusterctlv1.BlockMoveAnnotation)
 	obj.SetAnnotations(azClusterAnnotations)
 }
+
+// ReconcileService reconciles an Azure service, applying the timeout of the service's group when one is configured.
+func ReconcileService(ctx context.Context, service azure.ServiceReconciler) error {
+	ctx, cancel := reconciler.WithServiceGroupTimeout(ctx, service.Name())
+	defer cancel()
+	return service.Reconcile(ctx)
+}
+
+// DeleteService deletes an Azure service, applying the timeout of the service's group when one is configured.
+func DeleteService(ctx context.Context, service azure.ServiceReconciler) error {
+	ctx, cancel := reconciler.WithServiceGroupTimeout(ctx, service.Name())
+	defer cancel()
+	return service.Delete(ctx)
+}
@@ -33,14 +33,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	infrav1alpha "sigs.k8s.io/cluster-api-provider-azure/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
-const (
-	adoptAnnotation      = "sigs.k8s.io/cluster-api-provider-azure-adopt"
-	adoptAnnotationValue = "true"
-)
-
 // ManagedClusterAdoptReconciler adopts ASO ManagedCluster resources into a CAPI Cluster.
 type ManagedClusterAdoptReconciler struct {
 	client.Client
@@ -53,7 +49,7 @@ func (r *ManagedClusterAdoptReconciler) SetupWithManager(_ context.Context, mgr
 		For(&asocontainerservicev1.ManagedCluster{}).
 		WithEventFilter(predicate.Funcs{
 			UpdateFunc: func(ev event.UpdateEvent) bool {
-				return ev.ObjectOld.GetAnnotations()[adoptAnnotation] != ev.ObjectNew.GetAnnotations()[adoptAnnotation]
+				return infrav1.IsAdoptRequested(ev.ObjectOld.GetAnnotations()) != infrav1.IsAdoptRequested(ev.ObjectNew.GetAnnotations())
 			},
 			DeleteFunc: func(_ event.DeleteEvent) bool { return false },
 		}).
@@ -83,7 +79,7 @@ func (r *ManagedClusterAdoptReconciler) Reconcile(ctx context.Context, req ctrl.
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if managedCluster.GetAnnotations()[adoptAnnotation] != adoptAnnotationValue {
+	if !infrav1.IsAdoptRequested(managedCluster.GetAnnotations()) {
 		return ctrl.Result{}, nil
 	}
 
@@ -40,6 +40,23 @@ const (
 	NewestDeletePolicyType AzureMachinePoolDeletePolicyType = "Newest"
 	// RandomDeletePolicyType will delete machines in random order.
 	RandomDeletePolicyType AzureMachinePoolDeletePolicyType = "Random"
+
+	// DeleteDeleteOptionType deletes the resource when the instance it is attached to is deleted.
+	DeleteDeleteOptionType AzureMachinePoolDeleteOptionType = "Delete"
+	// DetachDeleteOptionType detaches the resource and keeps it when the instance it is attached to is deleted.
+	DetachDeleteOptionType AzureMachinePoolDeleteOptionType = "Detach"
+
+	// DefaultScaleInPolicyType balances instances across zones and fault domains first, then removes the instances
+	// with the highest instance IDs.
+	DefaultScaleInPolicyType AzureMachinePoolScaleInPolicyType = "Default"
+	// OldestVMScaleInPolicyType removes the oldest instances first.
+	OldestVMScaleInPolicyType AzureMachinePoolScaleInPolicyType = "OldestVM"
+	// NewestVMScaleInPolicyType removes the newest instances first.
+	NewestVMScaleInPolicyType AzureMachinePoolScaleInPolicyType = "NewestVM"
+
+	// MaxSinglePlacementGroupInstances is the maximum number of instances of a Virtual Machine Scale Set that is
+	// limited to a single placement group.
+	MaxSinglePlacementGroupInstances = 100
 )
 
 type (
@@ -104,6 +121,32 @@ type (
 		// The primary interface will be the first networkInterface specified (index 0) in the list.
 		// +optional
 		NetworkInterfaces []infrav1.NetworkInterface `json:"networkInterfaces,omitempty"`
+
+		// NodeOutboundBackendPool is the name of an outbound backend pool of the cluster's node outbound load balancer
+		// that the instances join instead of its default backend pool, to egress through the frontend IPs of the pool.
+		// +optional
+		NodeOutboundBackendPool string `json:"nodeOutboundBackendPool,omitempty"`
+
+		// OSDiskDeleteOption specifies whether the OS disk of an instance is deleted or detached when the instance is
+		// deleted. Detach requires the Flexible orchestration mode and is not supported for ephemeral OS disks.
+		// Defaults to Delete.
+		// +kubebuilder:validation:Enum=Delete;Detach
+		// +optional
+		OSDiskDeleteOption AzureMachinePoolDeleteOptionType `json:"osDiskDeleteOption,omitempty"`
+
+		// DataDisksDeleteOption specifies whether the data disks of an instance are deleted or detached when the
+		// instance is deleted. Detach requires the Flexible orchestration mode.
+		// Defaults to Delete.
+		// +kubebuilder:validation:Enum=Delete;Detach
+		// +optional
+		DataDisksDeleteOption AzureMachinePoolDeleteOptionType `json:"dataDisksDeleteOption,omitempty"`
+
+		// NetworkInterfacesDeleteOption specifies whether the network interfaces of an instance are deleted or
+		// detached when the instance is deleted. Detach requires the Flexible orchestration mode.
+		// Defaults to Delete.
+		// +kubebuilder:validation:Enum=Delete;Detach
+		// +optional
+		NetworkInterfacesDeleteOption AzureMachinePoolDeleteOptionType `json:"networkInterfacesDeleteOption,omitempty"`
 	}
 
 	// AzureMachinePoolSpec defines the desired state of AzureMachinePool.
@@ -170,6 +213,35 @@ type (
 		// ZoneBalane dictates whether to force strictly even Virtual Machine distribution cross x-zones in case there is zone outage.
 		// +optional
 		ZoneBalance *bool `json:"zoneBalance,omitempty"`
+
+		// Overprovision specifies whether the Virtual Machine Scale Set should create more instances than requested
+		// and delete the extra ones once the requested number of instances have been provisioned.
+		// Only supported with Uniform orchestration mode. Defaults to false.
+		// +optional
+		Overprovision *bool `json:"overprovision,omitempty"`
+
+		// SinglePlacementGroup specifies whether the Virtual Machine Scale Set is limited to a single placement group,
+		// which caps it at 100 instances. It can't be true with Flexible orchestration mode, and can only be changed
+		// from true to false. Defaults to false.
+		// +optional
+		SinglePlacementGroup *bool `json:"singlePlacementGroup,omitempty"`
+
+		// ScaleInPolicy specifies the order in which the Virtual Machine Scale Set removes instances when it scales in.
+		// Valid values are "Default", "OldestVM" and "NewestVM".
+		// +kubebuilder:validation:Enum=Default;OldestVM;NewestVM
+		// +optional
+		ScaleInPolicy AzureMachinePoolScaleInPolicyType `json:"scaleInPolicy,omitempty"`
+
+		// AutomaticOSUpgrade specifies whether the Virtual Machine Scale Set should automatically upgrade its instances
+		// when a new version of the OS image is published. Requires a Marketplace or Compute Gallery image whose version
+		// is "latest" and an application health extension.
+		// +optional
+		AutomaticOSUpgrade *bool `json:"automaticOSUpgrade,omitempty"`
+
+		// RollingUpgradePolicy configures the batches in which the Virtual Machine Scale Set upgrades its instances when
+		// AutomaticOSUpgrade is enabled.
+		// +optional
+		RollingUpgradePolicy *AzureMachinePoolRollingUpgradePolicy `json:"rollingUpgradePolicy,omitempty"`
 	}
 
 	// AzureMachinePoolDeploymentStrategyType is the type of deployment strategy employed to rollout a new version of
@@ -191,10 +263,47 @@ type (
 		RollingUpdate *MachineRollingUpdateDeployment `json:"rollingUpdate,omitempty"`
 	}
 
+	// AzureMachinePoolRollingUpgradePolicy describes how a Virtual Machine Scale Set upgrades its instances in batches.
+	AzureMachinePoolRollingUpgradePolicy struct {
+		// MaxBatchInstancePercent is the maximum percent of instances upgraded simultaneously in one batch.
+		// Defaults to 20.
+		// +kubebuilder:validation:Minimum=5
+		// +kubebuilder:validation:Maximum=100
+		// +optional
+		MaxBatchInstancePercent *int32 `json:"maxBatchInstancePercent,omitempty"`
+
+		// MaxUnhealthyInstancePercent is the maximum percent of instances which can be unhealthy, either as a result
+		// of being upgraded or of any other cause, before the upgrade is aborted. Defaults to 20.
+		// +kubebuilder:validation:Minimum=5
+		// +kubebuilder:validation:Maximum=100
+		// +optional
+		MaxUnhealthyInstancePercent *int32 `json:"maxUnhealthyInstancePercent,omitempty"`
+
+		// MaxUnhealthyUpgradedInstancePercent is the maximum percent of upgraded instances which can be unhealthy
+		// before the upgrade is aborted. Defaults to 20.
+		// +kubebuilder:validation:Minimum=0
+		// +kubebuilder:validation:Maximum=100
+		// +optional
+		MaxUnhealthyUpgradedInstancePercent *int32 `json:"maxUnhealthyUpgradedInstancePercent,omitempty"`
+
+		// PauseTimeBetweenBatches is the time to wait after upgrading a batch of instances before upgrading the next one.
+		// Defaults to 0.
+		// +optional
+		PauseTimeBetweenBatches *metav1.Duration `json:"pauseTimeBetweenBatches,omitempty"`
+	}
+
 	// AzureMachinePoolDeletePolicyType is the type of DeletePolicy employed to select machines to be deleted during an
 	// upgrade.
 	AzureMachinePoolDeletePolicyType string
 
+	// AzureMachinePoolDeleteOptionType specifies what happens to a disk or network interface of an instance when the
+	// instance is deleted.
+	AzureMachinePoolDeleteOptionType string
+
+	// AzureMachinePoolScaleInPolicyType is the rule used by a Virtual Machine Scale Set to select instances to remove
+	// when it scales in.
+	AzureMachinePoolScaleInPolicyType string
+
 	// MachineRollingUpdateDeployment is used to control the desired behavior of rolling update.
 	MachineRollingUpdateDeployment struct {
 		// The maximum number of machines that can be unavailable during the update.
@@ -253,6 +362,11 @@ type (
 		// +optional
 		Instances []*AzureMachinePoolInstanceStatus `json:"instances,omitempty"`
 
+		// ProtectedInstances lists the provider IDs of the VMSS instances annotated with
+		// infrastructure.cluster.x-k8s.io/protect, which are excluded from scale down.
+		// +optional
+		ProtectedInstances []string `json:"protectedInstances,omitempty"`
+
 		// Image is the current image used in the AzureMachinePool. When the spec image is nil, this image is populated
 		// with the details of the defaulted Azure Marketplace "capi" offer.
 		// +optional
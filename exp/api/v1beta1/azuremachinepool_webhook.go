@@ -29,6 +29,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	capifeature "sigs.k8s.io/cluster-api/feature"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +40,17 @@ import (
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
+const (
+	// latestImageVersion is the image version which always resolves to the most recently published version.
+	latestImageVersion = "latest"
+
+	// applicationHealthExtensionPublisher is the publisher of the application health VM extensions, which report the
+	// health of an instance to the scale set during automatic OS image upgrades.
+	applicationHealthExtensionPublisher   = "Microsoft.ManagedServices"
+	applicationHealthLinuxExtensionName   = "ApplicationHealthLinux"
+	applicationHealthWindowsExtensionName = "ApplicationHealthWindows"
+)
+
 // SetupAzureMachinePoolWebhookWithManager sets up and registers the webhook with the manager.
 func SetupAzureMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
 	ampw := &azureMachinePoolWebhook{Client: mgr.GetClient()}
@@ -112,6 +124,13 @@ func (amp *AzureMachinePool) Validate(old runtime.Object, client client.Client)
 		amp.ValidateSystemAssignedIdentityRole,
 		amp.ValidateNetwork,
 		amp.ValidateOSDisk,
+		amp.ValidateEncryptionAtHost,
+		amp.ValidateDataDisks,
+		amp.ValidateSpotVMOptions,
+		amp.ValidateDeleteOptions,
+		amp.ValidateScaleSetPolicies,
+		amp.ValidateAutomaticOSUpgrade,
+		amp.ValidateSinglePlacementGroup(old, client),
 	}
 
 	var errs []error
@@ -140,6 +159,136 @@ func (amp *AzureMachinePool) ValidateOSDisk() error {
 	return nil
 }
 
+// ValidateEncryptionAtHost validates that encryption at host is supported by the VM size of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateEncryptionAtHost() error {
+	if errs := infrav1.ValidateEncryptionAtHost(amp.Spec.Template.VMSize, amp.Spec.Template.SecurityProfile, field.NewPath("template", "securityProfile", "encryptionAtHost")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	return nil
+}
+
+// ValidateDataDisks validates the data disks of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateDataDisks() error {
+	var allErrs field.ErrorList
+	for i, disk := range amp.Spec.Template.DataDisks {
+		// An existing disk can only be attached to a single VM.
+		if disk.ManagedDiskID != "" {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("template", "dataDisks").Index(i).Child("managedDiskID"),
+				"attaching an existing managed disk is not supported for AzureMachinePools"))
+		}
+	}
+	return allErrs.ToAggregate()
+}
+
+// ValidateSpotVMOptions validates the Spot VM options of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateSpotVMOptions() error {
+	// Deallocated Spot VMSS instances are not started again by CAPZ, so the maximum number of start attempts
+	// only applies to AzureMachines.
+	if amp.Spec.Template.SpotVMOptions != nil && amp.Spec.Template.SpotVMOptions.MaxStartAttempts != nil {
+		return field.Forbidden(field.NewPath("spec", "template", "spotVMOptions", "maxStartAttempts"),
+			"maxStartAttempts is not supported for AzureMachinePools")
+	}
+	return nil
+}
+
+// ValidateDeleteOptions validates the delete options of the disks and network interfaces of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateDeleteOptions() error {
+	var allErrs field.ErrorList
+	template := amp.Spec.Template
+	isFlex := amp.Spec.OrchestrationMode == infrav1.FlexibleOrchestrationMode
+	deleteOptions := []struct {
+		name   string
+		option AzureMachinePoolDeleteOptionType
+	}{
+		{name: "osDiskDeleteOption", option: template.OSDiskDeleteOption},
+		{name: "dataDisksDeleteOption", option: template.DataDisksDeleteOption},
+		{name: "networkInterfacesDeleteOption", option: template.NetworkInterfacesDeleteOption},
+	}
+	for _, deleteOption := range deleteOptions {
+		if deleteOption.option == DetachDeleteOptionType && !isFlex {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("template", deleteOption.name),
+				fmt.Sprintf("%s can only be used with %s orchestration mode", DetachDeleteOptionType, infrav1.FlexibleOrchestrationMode)))
+		}
+	}
+	if template.OSDiskDeleteOption == DetachDeleteOptionType && template.OSDisk.DiffDiskSettings != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("template", "osDiskDeleteOption"),
+			"ephemeral OS disks cannot be detached"))
+	}
+
+	if len(allErrs) > 0 {
+		return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateScaleSetPolicies validates the overprovisioning and scale-in policy of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateScaleSetPolicies() error {
+	var allErrs field.ErrorList
+
+	if ptr.Deref(amp.Spec.Overprovision, false) && amp.Spec.OrchestrationMode == infrav1.FlexibleOrchestrationMode {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("overprovision"),
+			fmt.Sprintf("overprovisioning is not supported with %s orchestration mode", infrav1.FlexibleOrchestrationMode)))
+	}
+
+	switch amp.Spec.ScaleInPolicy {
+	case "", DefaultScaleInPolicyType, OldestVMScaleInPolicyType, NewestVMScaleInPolicyType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("scaleInPolicy"), amp.Spec.ScaleInPolicy,
+			[]string{string(DefaultScaleInPolicyType), string(OldestVMScaleInPolicyType), string(NewestVMScaleInPolicyType)}))
+	}
+
+	if len(allErrs) > 0 {
+		return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateAutomaticOSUpgrade validates that automatic OS image upgrades are only enabled for an AzureMachinePool
+// whose instances run the latest version of a Marketplace or Compute Gallery image and report their health.
+func (amp *AzureMachinePool) ValidateAutomaticOSUpgrade() error {
+	var allErrs field.ErrorList
+
+	if !ptr.Deref(amp.Spec.AutomaticOSUpgrade, false) {
+		if amp.Spec.RollingUpgradePolicy != nil {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("rollingUpgradePolicy"),
+				"can only be set when automaticOSUpgrade is enabled"))
+		}
+		return allErrs.ToAggregate()
+	}
+
+	fldPath := field.NewPath("automaticOSUpgrade")
+	image := amp.Spec.Template.Image
+	switch {
+	case image == nil:
+		allErrs = append(allErrs, field.Required(field.NewPath("template", "image"),
+			"must be set when automaticOSUpgrade is enabled"))
+	case image.Marketplace != nil && image.Marketplace.Version == latestImageVersion,
+		image.ComputeGallery != nil && image.ComputeGallery.Version == latestImageVersion,
+		image.SharedGallery != nil && image.SharedGallery.Version == latestImageVersion:
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("requires a Marketplace or Compute Gallery image with version %q", latestImageVersion)))
+	}
+
+	hasHealthExtension := false
+	for _, extension := range amp.Spec.Template.VMExtensions {
+		if extension.Publisher == applicationHealthExtensionPublisher &&
+			(extension.Name == applicationHealthLinuxExtensionName || extension.Name == applicationHealthWindowsExtensionName) {
+			hasHealthExtension = true
+			break
+		}
+	}
+	if !hasHealthExtension {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("requires the %s or %s VM extension from publisher %s",
+				applicationHealthLinuxExtensionName, applicationHealthWindowsExtensionName, applicationHealthExtensionPublisher)))
+	}
+
+	return allErrs.ToAggregate()
+}
+
 // ValidateImage of an AzureMachinePool.
 func (amp *AzureMachinePool) ValidateImage() error {
 	if amp.Spec.Template.Image != nil {
@@ -188,6 +337,12 @@ func (amp *AzureMachinePool) ValidateUserAssignedIdentity() error {
 		return kerrors.NewAggregate(errs.ToAggregate().Errors())
 	}
 
+	for i, identity := range amp.Spec.UserAssignedIdentities {
+		if identity.Purpose != "" {
+			return field.Forbidden(fldPath.Index(i).Child("purpose"), "purpose is only supported on AzureMachines")
+		}
+	}
+
 	return nil
 }
 
@@ -303,6 +458,48 @@ func (amp *AzureMachinePool) ValidateDiagnostics() error {
 	return nil
 }
 
+// ValidateSinglePlacementGroup validates that an AzureMachinePool limited to a single placement group uses Uniform
+// orchestration mode and doesn't exceed the instance limit of a single placement group. Azure only allows changing
+// singlePlacementGroup from true to false on an existing scale set.
+func (amp *AzureMachinePool) ValidateSinglePlacementGroup(old runtime.Object, c client.Client) func() error {
+	return func() error {
+		var allErrs field.ErrorList
+		fldPath := field.NewPath("singlePlacementGroup")
+
+		if old != nil {
+			oldMachinePool, ok := old.(*AzureMachinePool)
+			if !ok {
+				return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+					"AzureMachinePool", reflect.TypeOf(old))
+			}
+			if !ptr.Deref(oldMachinePool.Spec.SinglePlacementGroup, false) && ptr.Deref(amp.Spec.SinglePlacementGroup, false) {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "singlePlacementGroup cannot be changed from false to true"))
+			}
+		}
+
+		if ptr.Deref(amp.Spec.SinglePlacementGroup, false) {
+			if amp.Spec.OrchestrationMode == infrav1.FlexibleOrchestrationMode {
+				allErrs = append(allErrs, field.Forbidden(fldPath,
+					fmt.Sprintf("a single placement group is not supported with %s orchestration mode", infrav1.FlexibleOrchestrationMode)))
+			}
+			// The parent MachinePool may not exist yet when the AzureMachinePool is created. The scalesets service
+			// checks the number of instances again before scaling the scale set.
+			if c != nil {
+				if parent, err := azureutil.FindParentMachinePool(amp.Name, c); err == nil && ptr.Deref(parent.Spec.Replicas, 0) > MaxSinglePlacementGroupInstances {
+					allErrs = append(allErrs, field.Forbidden(fldPath,
+						fmt.Sprintf("a single placement group is limited to %d instances, but the MachinePool has %d replicas", MaxSinglePlacementGroupInstances, *parent.Spec.Replicas)))
+				}
+			}
+		}
+
+		if len(allErrs) > 0 {
+			return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+		}
+
+		return nil
+	}
+}
+
 // ValidateOrchestrationMode validates requirements for the VMSS orchestration mode.
 func (amp *AzureMachinePool) ValidateOrchestrationMode(c client.Client) func() error {
 	return func() error {
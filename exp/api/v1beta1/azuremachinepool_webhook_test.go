@@ -53,6 +53,7 @@ var (
 type mockClient struct {
 	client.Client
 	Version     string
+	Replicas    *int32
 	ReturnError bool
 }
 
@@ -67,6 +68,7 @@ func (m mockClient) List(ctx context.Context, list client.ObjectList, opts ...cl
 	}
 	mp := &expv1.MachinePool{}
 	mp.Spec.Template.Spec.Version = &m.Version
+	mp.Spec.Replicas = m.Replicas
 	list.(*expv1.MachinePoolList).Items = []expv1.MachinePool{*mp}
 
 	return nil
@@ -77,6 +79,7 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 		name          string
 		amp           *AzureMachinePool
 		version       string
+		replicas      *int32
 		ownerNotFound bool
 		wantErr       bool
 	}{
@@ -125,6 +128,26 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			amp:     createMachinePoolWithSSHPublicKey("invalid ssh key"),
 			wantErr: true,
 		},
+		{
+			name: "azuremachinepool with encryption at host on a supported VM size",
+			amp: func() *AzureMachinePool {
+				amp := getKnownValidAzureMachinePool()
+				amp.Spec.Template.VMSize = "Standard_D2s_v3"
+				amp.Spec.Template.SecurityProfile = &infrav1.SecurityProfile{EncryptionAtHost: ptr.To(true)}
+				return amp
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with encryption at host on an unsupported VM size",
+			amp: func() *AzureMachinePool {
+				amp := getKnownValidAzureMachinePool()
+				amp.Spec.Template.VMSize = "Standard_A2"
+				amp.Spec.Template.SecurityProfile = &infrav1.SecurityProfile{EncryptionAtHost: ptr.To(true)}
+				return amp
+			}(),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachinepool with wrong terminate notification",
 			amp:     createMachinePoolWithSharedImage("SUB123", "RG123", "NAME123", "GALLERY1", "1.0.0", ptr.To(35)),
@@ -148,6 +171,17 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			}),
 			wantErr: false,
 		},
+		{
+			name: "azuremachinepool with an image pull user assigned identity",
+			amp: func() *AzureMachinePool {
+				amp := createMachinePoolWithUserAssignedIdentity([]string{
+					"azure:///subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-resource-group/providers/Microsoft.ManagedIdentity/userAssignedIdentities/pull",
+				})
+				amp.Spec.UserAssignedIdentities[len(amp.Spec.UserAssignedIdentities)-1].Purpose = infrav1.UserAssignedIdentityPurposeImagePull
+				return amp
+			}(),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachinepool with user assigned identity, but without any provider ids",
 			amp:     createMachinePoolWithUserAssignedIdentity([]string{}),
@@ -249,10 +283,128 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			}),
 			wantErr: true,
 		},
+		{
+			name: "azuremachinepool with spot VM max start attempts",
+			amp: func() *AzureMachinePool {
+				amp := getKnownValidAzureMachinePool()
+				amp.Spec.Template.SpotVMOptions = &infrav1.SpotVMOptions{
+					EvictionPolicy:   ptr.To(infrav1.SpotEvictionPolicyDeallocate),
+					MaxStartAttempts: ptr.To[int32](5),
+				}
+				return amp
+			}(),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with Delete delete options and Uniform orchestration mode",
+			amp:     createMachinePoolWithDeleteOptions(armcompute.OrchestrationModeUniform, DeleteDeleteOptionType, nil),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with Detach delete options and Uniform orchestration mode",
+			amp:     createMachinePoolWithDeleteOptions(armcompute.OrchestrationModeUniform, DetachDeleteOptionType, nil),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with Detach delete options and Flexible orchestration mode",
+			amp:     createMachinePoolWithDeleteOptions(armcompute.OrchestrationModeFlexible, DetachDeleteOptionType, nil),
+			version: "v1.26.0",
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with Detach delete options and an ephemeral OS disk",
+			amp: createMachinePoolWithDeleteOptions(armcompute.OrchestrationModeFlexible, DetachDeleteOptionType, &infrav1.DiffDiskSettings{
+				Option: string(armcompute.DiffDiskOptionsLocal),
+			}),
+			version: "v1.26.0",
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with overprovisioning and Uniform orchestration mode",
+			amp:     createMachinePoolWithScaleSetPolicies(armcompute.OrchestrationModeUniform, ptr.To(true), OldestVMScaleInPolicyType),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with overprovisioning and Flexible orchestration mode",
+			amp:     createMachinePoolWithScaleSetPolicies(armcompute.OrchestrationModeFlexible, ptr.To(true), ""),
+			version: "v1.26.0",
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with NewestVM scale-in policy and Flexible orchestration mode",
+			amp:     createMachinePoolWithScaleSetPolicies(armcompute.OrchestrationModeFlexible, nil, NewestVMScaleInPolicyType),
+			version: "v1.26.0",
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with invalid scale-in policy",
+			amp:     createMachinePoolWithScaleSetPolicies(armcompute.OrchestrationModeUniform, nil, "Random"),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with automatic OS upgrade, latest marketplace image and health extension",
+			amp:     createMachinePoolWithAutomaticOSUpgrade(marketplaceImage("latest"), applicationHealthExtension(), nil),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with automatic OS upgrade and rolling upgrade policy",
+			amp: createMachinePoolWithAutomaticOSUpgrade(marketplaceImage("latest"), applicationHealthExtension(), &AzureMachinePoolRollingUpgradePolicy{
+				MaxBatchInstancePercent: ptr.To[int32](50),
+			}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with automatic OS upgrade and a specific image version",
+			amp:     createMachinePoolWithAutomaticOSUpgrade(marketplaceImage("1.0.0"), applicationHealthExtension(), nil),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with automatic OS upgrade and no image",
+			amp:     createMachinePoolWithAutomaticOSUpgrade(nil, applicationHealthExtension(), nil),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with automatic OS upgrade and no health extension",
+			amp:     createMachinePoolWithAutomaticOSUpgrade(marketplaceImage("latest"), nil, nil),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with rolling upgrade policy and no automatic OS upgrade",
+			amp: func() *AzureMachinePool {
+				amp := createMachinePoolWithAutomaticOSUpgrade(marketplaceImage("latest"), applicationHealthExtension(), &AzureMachinePoolRollingUpgradePolicy{})
+				amp.Spec.AutomaticOSUpgrade = nil
+				return amp
+			}(),
+			wantErr: true,
+		},
+		{
+			name:     "azuremachinepool with a single placement group",
+			amp:      createMachinePoolWithSinglePlacementGroup(ptr.To(true), ""),
+			replicas: ptr.To[int32](100),
+			wantErr:  false,
+		},
+		{
+			name:     "azuremachinepool with a single placement group and more than 100 replicas",
+			amp:      createMachinePoolWithSinglePlacementGroup(ptr.To(true), ""),
+			replicas: ptr.To[int32](101),
+			wantErr:  true,
+		},
+		{
+			name:     "azuremachinepool without a single placement group and more than 100 replicas",
+			amp:      createMachinePoolWithSinglePlacementGroup(ptr.To(false), ""),
+			replicas: ptr.To[int32](500),
+			wantErr:  false,
+		},
+		{
+			name:    "azuremachinepool with a single placement group and Flexible orchestration mode",
+			amp:     createMachinePoolWithSinglePlacementGroup(ptr.To(true), infrav1.FlexibleOrchestrationMode),
+			version: "v1.27.0",
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
-		client := mockClient{Version: tc.version, ReturnError: tc.ownerNotFound}
+		client := mockClient{Version: tc.version, Replicas: tc.replicas, ReturnError: tc.ownerNotFound}
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
 			ampw := &azureMachinePoolWebhook{
@@ -383,6 +535,18 @@ func TestAzureMachinePool_ValidateUpdate(t *testing.T) {
 			amp:     createMachinePoolWithNetworkConfig("subnet", []infrav1.NetworkInterface{{SubnetName: "testSubnet2"}}),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachinepool singlePlacementGroup changed from true to false",
+			oldAMP:  createMachinePoolWithSinglePlacementGroup(ptr.To(true), ""),
+			amp:     createMachinePoolWithSinglePlacementGroup(ptr.To(false), ""),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool singlePlacementGroup changed from false to true",
+			oldAMP:  createMachinePoolWithSinglePlacementGroup(nil, ""),
+			amp:     createMachinePoolWithSinglePlacementGroup(ptr.To(true), ""),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachinepool with valid network interface config",
 			oldAMP:  createMachinePoolWithNetworkConfig("subnet", []infrav1.NetworkInterface{}),
@@ -671,6 +835,21 @@ func createMachinePoolWithStrategy(strategy AzureMachinePoolDeploymentStrategy)
 	}
 }
 
+func createMachinePoolWithSinglePlacementGroup(singlePlacementGroup *bool, mode infrav1.OrchestrationModeType) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			SinglePlacementGroup: singlePlacementGroup,
+			OrchestrationMode:    mode,
+			Template: AzureMachinePoolMachineTemplate{
+				OSDisk: infrav1.OSDisk{
+					CachingType: "None",
+					OSType:      "Linux",
+				},
+			},
+		},
+	}
+}
+
 func createMachinePoolWithOrchestrationMode(mode armcompute.OrchestrationMode) *AzureMachinePool {
 	return &AzureMachinePool{
 		Spec: AzureMachinePoolSpec{
@@ -685,6 +864,81 @@ func createMachinePoolWithOrchestrationMode(mode armcompute.OrchestrationMode) *
 	}
 }
 
+func createMachinePoolWithDeleteOptions(mode armcompute.OrchestrationMode, option AzureMachinePoolDeleteOptionType, diffDiskSettings *infrav1.DiffDiskSettings) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			OrchestrationMode: infrav1.OrchestrationModeType(mode),
+			Template: AzureMachinePoolMachineTemplate{
+				OSDisk: infrav1.OSDisk{
+					CachingType:      "None",
+					OSType:           "Linux",
+					DiffDiskSettings: diffDiskSettings,
+				},
+				OSDiskDeleteOption:            option,
+				DataDisksDeleteOption:         option,
+				NetworkInterfacesDeleteOption: option,
+			},
+		},
+	}
+}
+
+func createMachinePoolWithScaleSetPolicies(mode armcompute.OrchestrationMode, overprovision *bool, scaleInPolicy AzureMachinePoolScaleInPolicyType) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			OrchestrationMode: infrav1.OrchestrationModeType(mode),
+			Overprovision:     overprovision,
+			ScaleInPolicy:     scaleInPolicy,
+			Template: AzureMachinePoolMachineTemplate{
+				OSDisk: infrav1.OSDisk{
+					CachingType: "None",
+					OSType:      "Linux",
+				},
+			},
+		},
+	}
+}
+
+func createMachinePoolWithAutomaticOSUpgrade(image *infrav1.Image, extension *infrav1.VMExtension, policy *AzureMachinePoolRollingUpgradePolicy) *AzureMachinePool {
+	amp := &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			AutomaticOSUpgrade:   ptr.To(true),
+			RollingUpgradePolicy: policy,
+			Template: AzureMachinePoolMachineTemplate{
+				Image: image,
+				OSDisk: infrav1.OSDisk{
+					CachingType: "None",
+					OSType:      "Linux",
+				},
+			},
+		},
+	}
+	if extension != nil {
+		amp.Spec.Template.VMExtensions = []infrav1.VMExtension{*extension}
+	}
+	return amp
+}
+
+func marketplaceImage(version string) *infrav1.Image {
+	return &infrav1.Image{
+		Marketplace: &infrav1.AzureMarketplaceImage{
+			ImagePlan: infrav1.ImagePlan{
+				Publisher: "PUB",
+				Offer:     "OFFER",
+				SKU:       "SKU",
+			},
+			Version: version,
+		},
+	}
+}
+
+func applicationHealthExtension() *infrav1.VMExtension {
+	return &infrav1.VMExtension{
+		Name:      "ApplicationHealthLinux",
+		Publisher: "Microsoft.ManagedServices",
+		Version:   "1.0",
+	}
+}
+
 func createMachinePoolWithDiffDiskSettings(settings infrav1.DiffDiskSettings) *AzureMachinePool {
 	return &AzureMachinePool{
 		Spec: AzureMachinePoolSpec{
@@ -31,6 +31,10 @@ const (
 
 	// AzureMachinePoolMachineKind indicates the kind of an AzureMachinePoolMachine.
 	AzureMachinePoolMachineKind = "AzureMachinePoolMachine"
+
+	// ProtectMachineAnnotation is the annotation that, when present on an AzureMachinePoolMachine or its owner Machine,
+	// excludes the VMSS instance from being selected for deletion when the AzureMachinePool scales down.
+	ProtectMachineAnnotation = "infrastructure.cluster.x-k8s.io/protect"
 )
 
 type (
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	apiv1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
@@ -316,6 +317,41 @@ func (in *AzureMachinePoolMachineTemplate) DeepCopy() *AzureMachinePoolMachineTe
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureMachinePoolRollingUpgradePolicy) DeepCopyInto(out *AzureMachinePoolRollingUpgradePolicy) {
+	*out = *in
+	if in.MaxBatchInstancePercent != nil {
+		in, out := &in.MaxBatchInstancePercent, &out.MaxBatchInstancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnhealthyInstancePercent != nil {
+		in, out := &in.MaxUnhealthyInstancePercent, &out.MaxUnhealthyInstancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxUnhealthyUpgradedInstancePercent != nil {
+		in, out := &in.MaxUnhealthyUpgradedInstancePercent, &out.MaxUnhealthyUpgradedInstancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PauseTimeBetweenBatches != nil {
+		in, out := &in.PauseTimeBetweenBatches, &out.PauseTimeBetweenBatches
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolRollingUpgradePolicy.
+func (in *AzureMachinePoolRollingUpgradePolicy) DeepCopy() *AzureMachinePoolRollingUpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureMachinePoolRollingUpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureMachinePoolSpec) DeepCopyInto(out *AzureMachinePoolSpec) {
 	*out = *in
@@ -353,6 +389,26 @@ func (in *AzureMachinePoolSpec) DeepCopyInto(out *AzureMachinePoolSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Overprovision != nil {
+		in, out := &in.Overprovision, &out.Overprovision
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SinglePlacementGroup != nil {
+		in, out := &in.SinglePlacementGroup, &out.SinglePlacementGroup
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AutomaticOSUpgrade != nil {
+		in, out := &in.AutomaticOSUpgrade, &out.AutomaticOSUpgrade
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RollingUpgradePolicy != nil {
+		in, out := &in.RollingUpgradePolicy, &out.RollingUpgradePolicy
+		*out = new(AzureMachinePoolRollingUpgradePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolSpec.
@@ -379,6 +435,11 @@ func (in *AzureMachinePoolStatus) DeepCopyInto(out *AzureMachinePoolStatus) {
 			}
 		}
 	}
+	if in.ProtectedInstances != nil {
+		in, out := &in.ProtectedInstances, &out.ProtectedInstances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(apiv1beta1.Image)
@@ -110,7 +110,7 @@ func (ampr *AzureMachinePoolReconciler) SetupWithManager(ctx context.Context, mg
 
 	var r reconcile.Reconciler = ampr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(ampr, options.Cache, log)
+		r = coalescing.NewReconciler(ampr, options.Cache, ampr.Client, &infrav1exp.AzureMachinePool{}, log)
 	}
 
 	// create mappers to transform incoming AzureClusters and AzureManagedClusters into AzureMachinePool requests
@@ -197,6 +197,7 @@ func (ampr *AzureMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.
 	defer done()
 	ctx, cancel := context.WithTimeout(ctx, ampr.Timeouts.DefaultedLoopTimeout())
 	defer cancel()
+	ctx = reconciler.WithServiceGroupTimeouts(ctx, ampr.Timeouts.ServiceGroups)
 
 	logger = logger.WithValues("namespace", req.Namespace, "azureMachinePool", req.Name)
 
@@ -234,6 +235,7 @@ func (ampr *AzureMachinePoolReconciler) Reconcile(ctx context.Context, req ctrl.
 	if err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "failed to create cluster scope for cluster %s/%s", cluster.Namespace, cluster.Name)
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
 
 	// Create the machine pool scope
 	machinePoolScope, err := scope.NewMachinePoolScope(scope.MachinePoolScopeParams{
@@ -318,6 +320,11 @@ func (ampr *AzureMachinePoolReconciler) reconcileNormal(ctx context.Context, mac
 		return reconcile.Result{}, errors.Wrap(err, "failed to init machinepool scope cache")
 	}
 
+	if err := machinePoolScope.ValidateNodeOutboundBackendPool(); err != nil {
+		ampr.Recorder.Event(machinePoolScope.AzureMachinePool, corev1.EventTypeWarning, "NodeOutboundBackendPoolNotFound", err.Error())
+		return reconcile.Result{}, err
+	}
+
 	ams, err := ampr.createAzureMachinePoolService(machinePoolScope)
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed creating a newAzureMachinePoolService")
@@ -338,7 +345,7 @@ func (ampr *AzureMachinePoolReconciler) reconcileNormal(ctx context.Context, mac
 				} else {
 					log.V(2).Info(fmt.Sprintf("transient failure to reconcile AzureMachinePool, retrying: %s", reconcileError.Error()))
 				}
-				return reconcile.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+				return reconcile.Result{RequeueAfter: ampr.Timeouts.Jittered(reconcileError.RequeueAfter())}, nil
 			}
 
 			return reconcile.Result{}, errors.Wrap(err, "failed to reconcile AzureMachinePool")
@@ -361,11 +368,11 @@ func (ampr *AzureMachinePoolReconciler) reconcileNormal(ctx context.Context, mac
 
 	if machinePoolScope.NeedsRequeue() {
 		return reconcile.Result{
-			RequeueAfter: 30 * time.Second,
+			RequeueAfter: ampr.Timeouts.Jittered(30 * time.Second),
 		}, nil
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: ampr.Timeouts.SteadyStateRequeue()}, nil
 }
 
 func (ampr *AzureMachinePoolReconciler) reconcilePause(ctx context.Context, machinePoolScope *scope.MachinePoolScope) (reconcile.Result, error) {
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
 )
 
 func Test_newAzureMachinePoolService(t *testing.T) {
@@ -74,6 +75,10 @@ func Test_newAzureMachinePoolService(t *testing.T) {
 	g.Expect(subject).NotTo(BeNil())
 	g.Expect(subject.services).NotTo(BeEmpty())
 	g.Expect(subject.skuCache).NotTo(BeNil())
+	for _, service := range subject.services {
+		_, ok := reconciler.ServiceGroupOf(service.Name())
+		g.Expect(ok).To(BeTrue(), "service %s is not in a service group", service.Name())
+	}
 }
 
 func newScheme(g *GomegaWithT) *runtime.Scheme {
@@ -92,7 +92,7 @@ func (ampmr *AzureMachinePoolMachineController) SetupWithManager(ctx context.Con
 
 	var r reconcile.Reconciler = ampmr
 	if options.Cache != nil {
-		r = coalescing.NewReconciler(ampmr, options.Cache, log)
+		r = coalescing.NewReconciler(ampmr, options.Cache, ampmr.Client, &infrav1exp.AzureMachinePoolMachine{}, log)
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -174,6 +174,7 @@ func (ampmr *AzureMachinePoolMachineController) Reconcile(ctx context.Context, r
 	if err != nil {
 		return reconcile.Result{}, errors.Wrapf(err, "failed to create cluster scope for cluster %s/%s", cluster.Namespace, cluster.Name)
 	}
+	ctx = scope.WithLogValues(ctx, clusterScope)
 
 	logger.V(2).Info("Fetching AzureMachinePool with object meta", "meta", azureMachine.ObjectMeta)
 	// Fetch the owning AzureMachinePool (VMSS)
@@ -282,7 +283,7 @@ func (ampmr *AzureMachinePoolMachineController) reconcileNormal(ctx context.Cont
 
 			if reconcileError.IsTransient() {
 				log.V(4).Info("failed to reconcile AzureMachinePoolMachine", "name", machineScope.Name(), "transient_error", err)
-				return reconcile.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+				return reconcile.Result{RequeueAfter: ampmr.Timeouts.Jittered(reconcileError.RequeueAfter())}, nil
 			}
 
 			return reconcile.Result{}, errors.Wrap(err, "failed to reconcile AzureMachinePool")
@@ -315,11 +316,11 @@ func (ampmr *AzureMachinePoolMachineController) reconcileNormal(ctx context.Cont
 		log.V(2).Info("Requeuing", "state", state, "ready", machineScope.IsReady())
 		// we are in a non-terminal state, retry in a bit
 		return reconcile.Result{
-			RequeueAfter: 30 * time.Second,
+			RequeueAfter: ampmr.Timeouts.Jittered(30 * time.Second),
 		}, nil
 	}
 
-	return reconcile.Result{}, nil
+	return reconcile.Result{RequeueAfter: ampmr.Timeouts.SteadyStateRequeue()}, nil
 }
 
 func (ampmr *AzureMachinePoolMachineController) reconcileDelete(ctx context.Context, machineScope *scope.MachinePoolMachineScope, clusterScope infracontroller.ClusterScoper) (_ reconcile.Result, reterr error) {
@@ -361,7 +362,7 @@ func (ampmr *AzureMachinePoolMachineController) reconcileDelete(ctx context.Cont
 
 			if reconcileError.IsTransient() {
 				log.V(4).Info("failed to delete AzureMachinePoolMachine", "name", machineScope.Name(), "transient_error", err)
-				return reconcile.Result{RequeueAfter: reconcileError.RequeueAfter()}, nil
+				return reconcile.Result{RequeueAfter: ampmr.Timeouts.Jittered(reconcileError.RequeueAfter())}, nil
 			}
 
 			return reconcile.Result{}, errors.Wrapf(err, "failed to reconcile AzureMachinePool")
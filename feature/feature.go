@@ -58,6 +58,28 @@ const (
 	// owner: @nawazkh
 	// alpha: v1.18
 	APIServerILB featuregate.Feature = "APIServerILB"
+
+	// SharedAPIServerOutboundIP is the feature gate for using the public IP of the API server load balancer for
+	// node outbound traffic instead of a separate node outbound load balancer.
+	// alpha: v1.19
+	SharedAPIServerOutboundIP featuregate.Feature = "SharedAPIServerOutboundIP"
+
+	// PublicIPNameSuffix is the feature gate for appending a short random suffix to the names of the public IPs
+	// generated for an AzureCluster, so a cluster recreated with the same name does not collide with public IPs
+	// of the previous cluster that are still being deleted.
+	// alpha: v1.19
+	PublicIPNameSuffix featuregate.Feature = "PublicIPNameSuffix"
+
+	// APIServerLBTypeMigration is the feature gate for switching the type of the API server load balancer of an
+	// existing AzureCluster between Public and Internal.
+	// alpha: v1.19
+	APIServerLBTypeMigration featuregate.Feature = "APIServerLBTypeMigration"
+
+	// ASOFastRequeue is the feature gate for checking more often whether the ASO resources managed by CAPZ are ready
+	// or deleted, to provision and delete clusters faster. It increases the load on the management cluster and is
+	// meant for test environments only.
+	// alpha: v1.19
+	ASOFastRequeue featuregate.Feature = "ASOFastRequeue"
 )
 
 func init() {
@@ -68,9 +90,13 @@ func init() {
 // To add a new feature, define a key for it above and add it here.
 var defaultCAPZFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	// Every feature should be initiated here:
-	AKS:               {Default: true, PreRelease: featuregate.GA, LockToDefault: true}, // Remove in 1.12
-	AKSResourceHealth: {Default: false, PreRelease: featuregate.Alpha},
-	EdgeZone:          {Default: false, PreRelease: featuregate.Alpha},
-	ASOAPI:            {Default: true, PreRelease: featuregate.Alpha},
-	APIServerILB:      {Default: false, PreRelease: featuregate.Alpha},
+	AKS:                       {Default: true, PreRelease: featuregate.GA, LockToDefault: true}, // Remove in 1.12
+	AKSResourceHealth:         {Default: false, PreRelease: featuregate.Alpha},
+	EdgeZone:                  {Default: false, PreRelease: featuregate.Alpha},
+	ASOAPI:                    {Default: true, PreRelease: featuregate.Alpha},
+	APIServerILB:              {Default: false, PreRelease: featuregate.Alpha},
+	SharedAPIServerOutboundIP: {Default: false, PreRelease: featuregate.Alpha},
+	PublicIPNameSuffix:        {Default: false, PreRelease: featuregate.Alpha},
+	APIServerLBTypeMigration:  {Default: false, PreRelease: featuregate.Alpha},
+	ASOFastRequeue:            {Default: false, PreRelease: featuregate.Alpha},
 }
@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	// +kubebuilder:scaffold:imports
@@ -115,6 +116,14 @@ var (
 	webhookCertDir                     string
 	managerOptions                     = flags.ManagerOptions{}
 	timeouts                           reconciler.Timeouts
+	serviceGroupTimeouts               map[string]string
+	publicIPDeletionGrace              time.Duration
+	verboseEvents                      bool
+	azureInitialGetConcurrency         int
+	azureInitialGetWindow              time.Duration
+	azureCredentialCheckInterval       time.Duration
+	azureEnvironmentFile               string
+	resourceNameTemplatesFile          string
 	enableTracing                      bool
 )
 
@@ -237,6 +246,12 @@ func InitFlags(fs *pflag.FlagSet) {
 		"The maximum duration each Azure service reconcile can run (e.g. 90m)",
 	)
 
+	fs.StringToStringVar(&serviceGroupTimeouts,
+		"service-group-reconcile-timeouts",
+		nil,
+		fmt.Sprintf("Comma-separated service group timeouts replacing --service-reconcile-timeout for the Azure services of each group (e.g. bastion=2m,dns=1m). Service groups are: %s", strings.Join(reconciler.ServiceGroups(), ", ")),
+	)
+
 	fs.DurationVar(&timeouts.AzureCall,
 		"api-call-timeout",
 		reconciler.DefaultAzureCallTimeout,
@@ -249,6 +264,54 @@ func InitFlags(fs *pflag.FlagSet) {
 		"The duration to wait before retrying after a transient reconcile error occurs (e.g. 15s)",
 	)
 
+	fs.Float64Var(&timeouts.RequeueJitter,
+		"machinepool-requeue-jitter",
+		0,
+		"The maximum factor by which the AzureMachinePool and AzureMachinePoolMachine requeue intervals, including the steady-state requeue after --sync-period, are randomly extended to stagger reconciles across machine pools (e.g. 0.2). Requeue intervals are not jittered when zero",
+	)
+
+	fs.DurationVar(&publicIPDeletionGrace,
+		"public-ip-deletion-grace",
+		0,
+		"The duration an unreferenced CAPZ-managed public IP is kept before it is deleted, allowing DNS caches to expire (e.g. 1h). Unreferenced public IPs are not deleted when zero",
+	)
+
+	fs.BoolVar(&verboseEvents,
+		"verbose-events",
+		false,
+		"Record a Kubernetes Event on the AzureCluster or AzureMachine for each Azure resource created, updated, or deleted by CAPZ, naming the service and the resource",
+	)
+
+	fs.IntVar(&azureInitialGetConcurrency,
+		"azure-initial-get-concurrency",
+		0,
+		"The maximum number of concurrent Azure GET requests sent by CAPZ, not by Azure Service Operator, while the controller warms up after starting, to avoid throttling when all clusters reconcile at once. GET requests are not limited when zero",
+	)
+
+	fs.DurationVar(&azureInitialGetWindow,
+		"azure-initial-get-window",
+		azure.DefaultInitialGetWindow,
+		"The duration, starting with the first Azure GET request, during which --azure-initial-get-concurrency applies (e.g. 5m)",
+	)
+
+	fs.DurationVar(&azureCredentialCheckInterval,
+		"azure-credential-check-interval",
+		0,
+		"The interval at which the default Azure credential of the controller is checked by fetching a token, failing the readiness probe while it cannot (e.g. 5m). The credential is not checked when zero",
+	)
+
+	fs.StringVar(&azureEnvironmentFile,
+		"azure-environment-file",
+		"",
+		"Path to a JSON file with the endpoints of a custom Azure cloud, e.g. Azure Stack Hub or an air-gapped cloud, used by clusters and identities with the AzureStackCloud environment",
+	)
+
+	fs.StringVar(&resourceNameTemplatesFile,
+		"resource-name-templates-file",
+		"",
+		"Path to a YAML or JSON file mapping kinds of Azure resources to Go templates generating their names, e.g. to follow corporate naming conventions. Only set it before creating clusters, as changing the names orphans existing resources",
+	)
+
 	fs.BoolVar(
 		&enableTracing,
 		"enable-tracing",
@@ -292,6 +355,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	timeouts.SyncPeriod = syncPeriod
+	timeouts.ServiceGroups, err = reconciler.ParseServiceGroupTimeouts(serviceGroupTimeouts)
+	if err != nil {
+		setupLog.Error(err, "Unable to start manager: invalid flags")
+		os.Exit(1)
+	}
+
 	var watchNamespaces map[string]cache.Config
 	if watchNamespace != "" {
 		setupLog.Info("Watching cluster-api objects only in namespace for reconciliation", "namespace", watchNamespace)
@@ -357,6 +427,26 @@ func main() {
 		os.Exit(1)
 	}
 
+	azure.SetInitialGetConcurrency(azureInitialGetConcurrency, azureInitialGetWindow)
+
+	if azureEnvironmentFile != "" {
+		if err := azure.LoadStackCloudEnvironment(azureEnvironmentFile); err != nil {
+			setupLog.Error(err, "unable to load the Azure environment file")
+			os.Exit(1)
+		}
+	}
+
+	if resourceNameTemplatesFile != "" {
+		if err := azure.LoadResourceNameTemplates(resourceNameTemplatesFile); err != nil {
+			setupLog.Error(err, "unable to load the resource name templates file")
+			os.Exit(1)
+		}
+	}
+
+	if azureCredentialCheckInterval > 0 {
+		registerCredentialCheck(mgr)
+	}
+
 	registerControllers(ctx, mgr)
 
 	registerWebhooks(mgr)
@@ -376,12 +466,14 @@ func registerControllers(ctx context.Context, mgr manager.Manager) {
 	if err != nil {
 		setupLog.Error(err, "failed to build machineCache ReconcileCache")
 	}
-	if err := controllers.NewAzureMachineReconciler(mgr.GetClient(),
+	azureMachineReconciler := controllers.NewAzureMachineReconciler(mgr.GetClient(),
 		mgr.GetEventRecorderFor("azuremachine-reconciler"),
 		timeouts,
 		watchFilterValue,
 		credCache,
-	).SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureMachineConcurrency}, Cache: machineCache}); err != nil {
+	)
+	azureMachineReconciler.VerboseEvents = verboseEvents
+	if err := azureMachineReconciler.SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureMachineConcurrency}, Cache: machineCache}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureMachine")
 		os.Exit(1)
 	}
@@ -390,13 +482,16 @@ func registerControllers(ctx context.Context, mgr manager.Manager) {
 	if err != nil {
 		setupLog.Error(err, "failed to build clusterCache ReconcileCache")
 	}
-	if err := controllers.NewAzureClusterReconciler(
+	azureClusterReconciler := controllers.NewAzureClusterReconciler(
 		mgr.GetClient(),
 		mgr.GetEventRecorderFor("azurecluster-reconciler"),
 		timeouts,
 		watchFilterValue,
 		credCache,
-	).SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureClusterConcurrency}, Cache: clusterCache}); err != nil {
+	)
+	azureClusterReconciler.PublicIPDeletionGrace = publicIPDeletionGrace
+	azureClusterReconciler.VerboseEvents = verboseEvents
+	if err := azureClusterReconciler.SetupWithManager(ctx, mgr, controllers.Options{Options: controller.Options{MaxConcurrentReconciles: azureClusterConcurrency}, Cache: clusterCache}); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AzureCluster")
 		os.Exit(1)
 	}
@@ -434,6 +529,15 @@ func registerControllers(ctx context.Context, mgr manager.Manager) {
 		os.Exit(1)
 	}
 
+	if err := (&controllers.AzureClusterIdentityReconciler{
+		Client:           mgr.GetClient(),
+		Timeouts:         timeouts,
+		WatchFilterValue: watchFilterValue,
+	}).SetupWithManager(ctx, mgr, controller.Options{MaxConcurrentReconciles: azureClusterConcurrency}); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AzureClusterIdentity")
+		os.Exit(1)
+	}
+
 	// just use CAPI MachinePool feature flag rather than create a new one
 	setupLog.V(1).Info(fmt.Sprintf("%+v\n", feature.Gates))
 	if feature.Gates.Enabled(capifeature.MachinePool) {
@@ -596,6 +700,24 @@ func registerControllers(ctx context.Context, mgr manager.Manager) {
 	}
 }
 
+// registerCredentialCheck periodically checks the default Azure credential of the controller and fails the
+// readiness probe while it cannot fetch a token.
+func registerCredentialCheck(mgr manager.Manager) {
+	checker, err := azure.NewDefaultCredentialChecker(os.Getenv("AZURE_ENVIRONMENT"), azureCredentialCheckInterval)
+	if err != nil {
+		setupLog.Error(err, "unable to create Azure credential checker")
+		os.Exit(1)
+	}
+	if err := mgr.Add(checker); err != nil {
+		setupLog.Error(err, "unable to add Azure credential checker to the manager")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("azure-credential", checker.Check); err != nil {
+		setupLog.Error(err, "unable to create ready check")
+		os.Exit(1)
+	}
+}
+
 func registerWebhooks(mgr manager.Manager) {
 	if err := (&infrav1.AzureCluster{}).SetupWebhookWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create webhook", "webhook", "AzureCluster")
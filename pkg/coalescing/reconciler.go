@@ -22,8 +22,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/cache/ttllru"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
@@ -46,9 +49,11 @@ type (
 
 	// reconciler is the caching reconciler middleware that uses the cache.
 	reconciler struct {
-		upstream reconcile.Reconciler
-		cache    ReconcileCacher
-		log      logr.Logger
+		upstream   reconcile.Reconciler
+		cache      ReconcileCacher
+		kubeClient client.Client
+		object     client.Object
+		log        logr.Logger
 	}
 )
 
@@ -79,11 +84,16 @@ func (cache *ReconcileCache) Reconciled(key string) {
 // NewReconciler returns a reconcile wrapper that will delay new reconcile.Requests
 // after the cache expiry of the request string key.
 // A successful reconciliation is defined as one where no error is returned.
-func NewReconciler(upstream reconcile.Reconciler, cache ReconcileCacher, log logr.Logger) reconcile.Reconciler {
+// object is the type of the reconciled resources. Requests for resources with the force-reconcile annotation are
+// not delayed, and the annotation is removed with kubeClient after a successful reconciliation. The annotation is
+// ignored if kubeClient is nil.
+func NewReconciler(upstream reconcile.Reconciler, cache ReconcileCacher, kubeClient client.Client, object client.Object, log logr.Logger) reconcile.Reconciler {
 	return &reconciler{
-		upstream: upstream,
-		cache:    cache,
-		log:      log.WithName("CoalescingReconciler"),
+		upstream:   upstream,
+		cache:      cache,
+		kubeClient: kubeClient,
+		object:     object,
+		log:        log.WithName("CoalescingReconciler"),
 	}
 }
 
@@ -97,13 +107,21 @@ func (rc *reconciler) Reconcile(ctx context.Context, r reconcile.Request) (recon
 
 	log = log.WithValues("request", r.String())
 
+	forceReconcile, err := rc.forceReconcileValue(ctx, r)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if expiration, ok := rc.cache.ShouldProcess(r.String()); !ok {
-		log.V(4).Info("not processing", "expiration", expiration, "timeUntil", time.Until(expiration))
-		var requeueAfter = time.Until(expiration)
-		if requeueAfter < 1*time.Second {
-			requeueAfter = 1 * time.Second
+		if forceReconcile == "" {
+			log.V(4).Info("not processing", "expiration", expiration, "timeUntil", time.Until(expiration))
+			var requeueAfter = time.Until(expiration)
+			if requeueAfter < 1*time.Second {
+				requeueAfter = 1 * time.Second
+			}
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
 		}
-		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		log.Info("processing before the cache expiry because of the force-reconcile annotation", "forceReconcile", forceReconcile)
 	}
 
 	log.V(4).Info("processing")
@@ -115,5 +133,54 @@ func (rc *reconciler) Reconcile(ctx context.Context, r reconcile.Request) (recon
 
 	log.V(4).Info("successful")
 	rc.cache.Reconciled(r.String())
+	if forceReconcile != "" {
+		rc.clearForceReconcile(ctx, r, forceReconcile)
+	}
 	return result, nil
 }
+
+// forceReconcileValue returns the value of the force-reconcile annotation of the requested resource, or an empty
+// string if it doesn't have one.
+func (rc *reconciler) forceReconcileValue(ctx context.Context, r reconcile.Request) (string, error) {
+	if rc.kubeClient == nil {
+		return "", nil
+	}
+	obj, ok := rc.object.DeepCopyObject().(client.Object)
+	if !ok {
+		return "", errors.Errorf("%T is not a client.Object", rc.object)
+	}
+	if err := rc.kubeClient.Get(ctx, r.NamespacedName, obj); err != nil {
+		// Let the upstream reconciler handle resources that are gone.
+		return "", client.IgnoreNotFound(err)
+	}
+	return obj.GetAnnotations()[infrav1.ForceReconcileAnnotation], nil
+}
+
+// clearForceReconcile removes the force-reconcile annotation from the requested resource, unless its value was
+// changed since the reconcile started, so that a new request to force a reconcile is not lost. Failures are only
+// logged since the next reconcile tries again.
+func (rc *reconciler) clearForceReconcile(ctx context.Context, r reconcile.Request, value string) {
+	log := rc.log.WithValues("request", r.String())
+
+	obj, ok := rc.object.DeepCopyObject().(client.Object)
+	if !ok {
+		return
+	}
+	if err := rc.kubeClient.Get(ctx, r.NamespacedName, obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "failed to get resource to remove the force-reconcile annotation")
+		}
+		return
+	}
+	if obj.GetAnnotations()[infrav1.ForceReconcileAnnotation] != value {
+		return
+	}
+
+	patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+	annotations := obj.GetAnnotations()
+	delete(annotations, infrav1.ForceReconcileAnnotation)
+	obj.SetAnnotations(annotations)
+	if err := rc.kubeClient.Patch(ctx, obj, patch); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		log.Error(err, "failed to remove the force-reconcile annotation")
+	}
+}
@@ -26,10 +26,14 @@ import (
 	gtypes "github.com/onsi/gomega/types"
 	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	mock_coalescing "sigs.k8s.io/cluster-api-provider-azure/pkg/coalescing/mocks"
 )
 
@@ -58,7 +62,7 @@ func TestCoalescingReconciler_Reconcile(t *testing.T) {
 				cacherMock.EXPECT().ShouldProcess(defaultRequestKey).Return(time.Now(), true)
 				cacherMock.EXPECT().Reconciled(defaultRequestKey)
 				mockReconciler.EXPECT().Reconcile(gomock.Any(), defaultRequest)
-				return NewReconciler(mockReconciler, cacherMock, logr.New(log.NullLogSink{}))
+				return NewReconciler(mockReconciler, cacherMock, nil, nil, logr.New(log.NullLogSink{}))
 			},
 			Request:   defaultRequest,
 			MatchThis: Equal(0 * time.Second),
@@ -67,7 +71,7 @@ func TestCoalescingReconciler_Reconcile(t *testing.T) {
 			Name: "should not call upstream reconciler if key does exists in cache and is not expired",
 			Reconciler: func(g *WithT, cacherMock *mock_coalescing.MockReconcileCacher, mockReconciler *mock_coalescing.MockReconciler) reconcile.Reconciler {
 				cacherMock.EXPECT().ShouldProcess(defaultRequestKey).Return(time.Now().Add(30*time.Second), false)
-				return NewReconciler(mockReconciler, cacherMock, logr.New(log.NullLogSink{}))
+				return NewReconciler(mockReconciler, cacherMock, nil, nil, logr.New(log.NullLogSink{}))
 			},
 			Request:   defaultRequest,
 			MatchThis: And(BeNumerically("<=", 30*time.Second), BeNumerically(">", 29*time.Second)),
@@ -77,7 +81,7 @@ func TestCoalescingReconciler_Reconcile(t *testing.T) {
 			Reconciler: func(g *WithT, cacherMock *mock_coalescing.MockReconcileCacher, mockReconciler *mock_coalescing.MockReconciler) reconcile.Reconciler {
 				cacherMock.EXPECT().ShouldProcess(defaultRequestKey).Return(time.Now(), true)
 				mockReconciler.EXPECT().Reconcile(gomock.Any(), defaultRequest).Return(reconcile.Result{}, errors.New("boom"))
-				return NewReconciler(mockReconciler, cacherMock, logr.New(log.NullLogSink{}))
+				return NewReconciler(mockReconciler, cacherMock, nil, nil, logr.New(log.NullLogSink{}))
 			},
 			Request:   defaultRequest,
 			MatchThis: Equal(0 * time.Second),
@@ -103,3 +107,65 @@ func TestCoalescingReconciler_Reconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestCoalescingReconciler_ForceReconcile(t *testing.T) {
+	request := reconcile.Request{
+		NamespacedName: types.NamespacedName{
+			Name:      "aName",
+			Namespace: "aNamespace",
+		},
+	}
+
+	cases := []struct {
+		Name                string
+		Annotations         map[string]string
+		ExpectUpstream      bool
+		ExpectedAnnotations map[string]string
+	}{
+		{
+			Name:                "should not call upstream reconciler within the cache window without the annotation",
+			Annotations:         map[string]string{"other": "value"},
+			ExpectUpstream:      false,
+			ExpectedAnnotations: map[string]string{"other": "value"},
+		},
+		{
+			Name:                "should call upstream reconciler within the cache window and remove the annotation",
+			Annotations:         map[string]string{infrav1.ForceReconcileAnnotation: "2024-01-01T00:00:00Z", "other": "value"},
+			ExpectUpstream:      true,
+			ExpectedAnnotations: map[string]string{"other": "value"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			obj := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        request.Name,
+					Namespace:   request.Namespace,
+					Annotations: c.Annotations,
+				},
+			}
+			kubeClient := fake.NewClientBuilder().WithObjects(obj).Build()
+
+			cacherMock := mock_coalescing.NewMockReconcileCacher(mockCtrl)
+			reconcilerMock := mock_coalescing.NewMockReconciler(mockCtrl)
+			cacherMock.EXPECT().ShouldProcess("aNamespace/aName").Return(time.Now().Add(30*time.Second), false)
+			if c.ExpectUpstream {
+				reconcilerMock.EXPECT().Reconcile(gomock.Any(), request)
+				cacherMock.EXPECT().Reconciled("aNamespace/aName")
+			}
+
+			subject := NewReconciler(reconcilerMock, cacherMock, kubeClient, &corev1.ConfigMap{}, logr.New(log.NullLogSink{}))
+			_, err := subject.Reconcile(context.Background(), request)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			updated := &corev1.ConfigMap{}
+			g.Expect(kubeClient.Get(context.Background(), request.NamespacedName, updated)).To(Succeed())
+			g.Expect(updated.GetAnnotations()).To(Equal(c.ExpectedAnnotations))
+		})
+	}
+}
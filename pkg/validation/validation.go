@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validation runs the checks of the CAPZ webhooks without a management cluster, so that specs can be
+// validated before they are applied, for example in CI.
+package validation
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = infrav1.AddToScheme(scheme)
+}
+
+// Decode reads the objects of a multi-document YAML or JSON stream. Documents of kinds that can't be validated
+// offline, like Cluster API or bootstrap resources, are skipped.
+func Decode(r io.Reader) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+
+	var objs []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read document")
+		}
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if runtime.IsNotRegisteredError(err) || runtime.IsMissingKind(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode document")
+		}
+		if o, ok := obj.(client.Object); ok && IsSupported(o) {
+			objs = append(objs, o)
+		}
+	}
+	return objs, nil
+}
+
+// IsSupported returns true if the object is of a kind that can be validated offline.
+func IsSupported(obj client.Object) bool {
+	switch obj.(type) {
+	case *infrav1.AzureCluster, *infrav1.AzureClusterTemplate, *infrav1.AzureMachine, *infrav1.AzureMachineTemplate:
+		return true
+	default:
+		return false
+	}
+}
+
+// Validate defaults obj the way the mutating webhooks do and runs the create validation of the validating
+// webhooks. If old is not nil, the update validation from old to obj runs instead. old is expected to be the
+// object as stored in the API server, so it is not defaulted.
+//
+// AzureMachine defaults that need the owner AzureCluster, like the scope of a system-assigned identity role,
+// are not applied.
+func Validate(ctx context.Context, obj, old client.Object) (admission.Warnings, error) {
+	if old != nil && reflect.TypeOf(old) != reflect.TypeOf(obj) {
+		return nil, errors.Errorf("cannot validate an update from %T to %T", old, obj)
+	}
+
+	switch o := obj.(type) {
+	case *infrav1.AzureCluster:
+		o.Default()
+		if old == nil {
+			return o.ValidateCreate()
+		}
+		return o.ValidateUpdate(old)
+	case *infrav1.AzureClusterTemplate:
+		o.Default()
+		if old == nil {
+			return o.ValidateCreate()
+		}
+		return o.ValidateUpdate(old)
+	case *infrav1.AzureMachine:
+		if err := o.Spec.SetDefaultsWithSubscriptionID(""); err != nil {
+			return nil, err
+		}
+		validator := infrav1.NewAzureMachineValidator()
+		if old == nil {
+			return validator.ValidateCreate(ctx, o)
+		}
+		// Stored AzureMachines always have the defaults of the CRD schema.
+		oldMachine := old.(*infrav1.AzureMachine).DeepCopy()
+		oldMachine.Spec.SetOSDiskDefaults()
+		return validator.ValidateUpdate(ctx, oldMachine, o)
+	case *infrav1.AzureMachineTemplate:
+		if err := o.Default(ctx, o); err != nil {
+			return nil, err
+		}
+		if old == nil {
+			return o.ValidateCreate(ctx, o)
+		}
+		// The update validation reads the admission request to skip immutability checks for dry runs.
+		return o.ValidateUpdate(admission.NewContextWithRequest(ctx, admission.Request{}), old, o)
+	default:
+		return nil, errors.Errorf("validation of %T is not supported", obj)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+const manifest = `apiVersion: cluster.x-k8s.io/v1beta1
+kind: Cluster
+metadata:
+  name: my-cluster
+  namespace: default
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+kind: AzureMachine
+metadata:
+  name: valid
+  namespace: default
+spec:
+  vmSize: Standard_D2s_v3
+  osDisk:
+    osType: Linux
+---
+apiVersion: infrastructure.cluster.x-k8s.io/v1beta1
+kind: AzureMachine
+metadata:
+  name: invalid
+  namespace: default
+spec:
+  vmSize: Standard_D2s_v3
+  osDisk:
+    osType: Linux
+  spotVMOptions:
+    evictionPolicy: Delete
+    maxStartAttempts: 3
+`
+
+func TestDecode(t *testing.T) {
+	g := NewWithT(t)
+
+	objs, err := Decode(strings.NewReader(manifest))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objs).To(HaveLen(2))
+	g.Expect(objs[0]).To(BeAssignableToTypeOf(&infrav1.AzureMachine{}))
+	g.Expect(objs[0].GetName()).To(Equal("valid"))
+	g.Expect(objs[1].GetName()).To(Equal("invalid"))
+}
+
+func TestValidate(t *testing.T) {
+	sshPublicKey := generateSSHPublicKey()
+	azureMachine := func(imageID string) *infrav1.AzureMachine {
+		return &infrav1.AzureMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+			Spec: infrav1.AzureMachineSpec{
+				VMSize:       "Standard_D2s_v3",
+				Image:        &infrav1.Image{ID: ptr.To(imageID)},
+				OSDisk:       infrav1.OSDisk{OSType: "Linux"},
+				SSHPublicKey: sshPublicKey,
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		obj     client.Object
+		old     client.Object
+		wantErr string
+	}{
+		{
+			name: "valid AzureMachine",
+			obj:  azureMachine("image-a"),
+		},
+		{
+			name: "AzureMachine is defaulted before it is validated",
+			obj: &infrav1.AzureMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+				Spec: infrav1.AzureMachineSpec{
+					VMSize: "Standard_D2s_v3",
+					OSDisk: infrav1.OSDisk{OSType: "Linux"},
+				},
+			},
+		},
+		{
+			name:    "invalid AzureMachine",
+			obj:     &infrav1.AzureMachine{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}},
+			wantErr: "the OS type cannot be empty",
+		},
+		{
+			name: "valid AzureMachine update",
+			obj:  azureMachine("image-a"),
+			old:  azureMachine("image-a"),
+		},
+		{
+			name:    "invalid AzureMachine update",
+			obj:     azureMachine("image-b"),
+			old:     azureMachine("image-a"),
+			wantErr: "spec.image",
+		},
+		{
+			name:    "update between different kinds",
+			obj:     azureMachine("image-a"),
+			old:     &infrav1.AzureCluster{},
+			wantErr: "cannot validate an update",
+		},
+		{
+			name:    "unsupported kind",
+			obj:     &infrav1.AzureClusterIdentity{},
+			wantErr: "is not supported",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := Validate(context.Background(), tc.obj, tc.old)
+			if tc.wantErr != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func generateSSHPublicKey() string {
+	privateKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	publicRsaKey, _ := ssh.NewPublicKey(&privateKey.PublicKey)
+	return base64.StdEncoding.EncodeToString(ssh.MarshalAuthorizedKey(publicRsaKey))
+}
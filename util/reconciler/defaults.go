@@ -18,6 +18,8 @@ package reconciler
 
 import (
 	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
 const (
@@ -41,10 +43,17 @@ type Timeouts struct {
 	Loop time.Duration
 	// AzureServiceReconcile is the timeout for an Azure service reconcile.
 	AzureServiceReconcile time.Duration
+	// ServiceGroups are the timeouts replacing AzureServiceReconcile for the services of the given groups.
+	ServiceGroups map[ServiceGroup]time.Duration
 	// AzureCall is the timeout for an Azure request after which an Azure operation is considered long-running.
 	AzureCall time.Duration
 	// Requeue is the value for the reconcile retry.
 	Requeue time.Duration
+	// RequeueJitter is the maximum factor by which the requeue intervals of the machine pool controllers are randomly
+	// extended, e.g. 0.1 extends them by up to 10%. Requeue intervals are not jittered when it is zero.
+	RequeueJitter float64
+	// SyncPeriod is the interval at which watched resources are reconciled in steady state.
+	SyncPeriod time.Duration
 }
 
 // DefaultedAzureCallTimeout will default the timeout if it is zero-valued.
@@ -82,3 +91,24 @@ func (t Timeouts) DefaultedLoopTimeout() time.Duration {
 
 	return t.Loop
 }
+
+// Jittered returns the duration randomly extended by up to RequeueJitter times the duration,
+// which spreads the requeues of objects that would otherwise be reconciled in lockstep.
+func (t Timeouts) Jittered(d time.Duration) time.Duration {
+	if t.RequeueJitter <= 0 {
+		return d
+	}
+
+	return wait.Jitter(d, t.RequeueJitter)
+}
+
+// SteadyStateRequeue returns how long to wait before reconciling an object in steady state again. It is the jittered
+// sync period when RequeueJitter is set, so objects don't all reconcile in lockstep after the sync period. It is zero,
+// leaving steady-state reconciles to the sync period, when RequeueJitter or SyncPeriod is not set.
+func (t Timeouts) SteadyStateRequeue() time.Duration {
+	if t.RequeueJitter <= 0 || t.SyncPeriod <= 0 {
+		return 0
+	}
+
+	return t.Jittered(t.SyncPeriod)
+}
@@ -164,3 +164,94 @@ func TestDefaultedAzureServiceReconcileTimeout(t *testing.T) {
 		})
 	}
 }
+
+func TestJittered(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Jitter float64
+		Min    time.Duration
+		Max    time.Duration
+	}{
+		{
+			Name:   "WithZeroValueDisabled",
+			Jitter: 0,
+			Min:    30 * time.Second,
+			Max:    30 * time.Second,
+		},
+		{
+			Name:   "WithNegativeValueDisabled",
+			Jitter: -1,
+			Min:    30 * time.Second,
+			Max:    30 * time.Second,
+		},
+		{
+			Name:   "WithRealValue",
+			Jitter: 0.5,
+			Min:    30 * time.Second,
+			Max:    45 * time.Second,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			timeouts := reconciler.Timeouts{
+				RequeueJitter: c.Jitter,
+			}
+			for range 100 {
+				jittered := timeouts.Jittered(30 * time.Second)
+				g.Expect(jittered).To(gomega.BeNumerically(">=", c.Min))
+				g.Expect(jittered).To(gomega.BeNumerically("<=", c.Max))
+			}
+		})
+	}
+}
+
+func TestSteadyStateRequeue(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Jitter     float64
+		SyncPeriod time.Duration
+		Min        time.Duration
+		Max        time.Duration
+	}{
+		{
+			Name:       "WithZeroJitterDisabled",
+			Jitter:     0,
+			SyncPeriod: 10 * time.Minute,
+			Min:        0,
+			Max:        0,
+		},
+		{
+			Name:       "WithZeroSyncPeriodDisabled",
+			Jitter:     0.5,
+			SyncPeriod: 0,
+			Min:        0,
+			Max:        0,
+		},
+		{
+			Name:       "WithRealValues",
+			Jitter:     0.5,
+			SyncPeriod: 10 * time.Minute,
+			Min:        10 * time.Minute,
+			Max:        15 * time.Minute,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			timeouts := reconciler.Timeouts{
+				RequeueJitter: c.Jitter,
+				SyncPeriod:    c.SyncPeriod,
+			}
+			for range 100 {
+				requeue := timeouts.SteadyStateRequeue()
+				g.Expect(requeue).To(gomega.BeNumerically(">=", c.Min))
+				g.Expect(requeue).To(gomega.BeNumerically("<=", c.Max))
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ServiceGroup is a group of Azure services that share a reconcile timeout.
+type ServiceGroup string
+
+const (
+	// ServiceGroupNetworking groups the services reconciling virtual networks, subnets and the resources attached to them.
+	ServiceGroupNetworking ServiceGroup = "networking"
+	// ServiceGroupDNS groups the services reconciling private DNS zones.
+	ServiceGroupDNS ServiceGroup = "dns"
+	// ServiceGroupBastion groups the services reconciling bastion hosts.
+	ServiceGroupBastion ServiceGroup = "bastion"
+	// ServiceGroupCompute groups the services reconciling virtual machines, scale sets and their disks and extensions.
+	ServiceGroupCompute ServiceGroup = "compute"
+	// ServiceGroupIdentity groups the services reconciling role assignments.
+	ServiceGroupIdentity ServiceGroup = "identity"
+	// ServiceGroupManagedCluster groups the services reconciling AKS managed clusters, their agent pools, extensions and fleet memberships.
+	ServiceGroupManagedCluster ServiceGroup = "managedcluster"
+	// ServiceGroupResources groups the services reconciling resource groups, tags and resource health.
+	ServiceGroupResources ServiceGroup = "resources"
+)
+
+// allServiceGroups lists every service group.
+var allServiceGroups = []ServiceGroup{
+	ServiceGroupNetworking,
+	ServiceGroupDNS,
+	ServiceGroupBastion,
+	ServiceGroupCompute,
+	ServiceGroupIdentity,
+	ServiceGroupManagedCluster,
+	ServiceGroupResources,
+}
+
+var (
+	serviceGroupsMu sync.RWMutex
+	// serviceGroups maps the names of the Azure services to their group.
+	serviceGroups = map[string]ServiceGroup{}
+)
+
+// RegisterServiceGroup registers the group of the Azure service with the given name.
+// Each Azure service registers its group when its package is initialized.
+func RegisterServiceGroup(serviceName string, group ServiceGroup) {
+	serviceGroupsMu.Lock()
+	defer serviceGroupsMu.Unlock()
+	serviceGroups[serviceName] = group
+}
+
+// ServiceGroupOf returns the group of the Azure service with the given name and whether the service registered one.
+func ServiceGroupOf(serviceName string) (ServiceGroup, bool) {
+	serviceGroupsMu.RLock()
+	defer serviceGroupsMu.RUnlock()
+	group, ok := serviceGroups[serviceName]
+	return group, ok
+}
+
+// ServiceGroups returns the names of all service groups, sorted.
+func ServiceGroups() []string {
+	groups := make([]string, 0, len(allServiceGroups))
+	for _, group := range allServiceGroups {
+		groups = append(groups, string(group))
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// ParseServiceGroupTimeouts parses service group timeouts given as group names mapped to durations, e.g. "bastion" to "5m".
+func ParseServiceGroupTimeouts(timeouts map[string]string) (map[ServiceGroup]time.Duration, error) {
+	if len(timeouts) == 0 {
+		return nil, nil
+	}
+	known := ServiceGroups()
+	parsed := make(map[ServiceGroup]time.Duration, len(timeouts))
+	for group, value := range timeouts {
+		i := sort.SearchStrings(known, group)
+		if i == len(known) || known[i] != group {
+			return nil, errors.Errorf("unknown service group %q, expected one of [%s]", group, strings.Join(known, ", "))
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid timeout for service group %q", group)
+		}
+		if d <= 0 {
+			return nil, errors.Errorf("timeout for service group %q must be positive", group)
+		}
+		parsed[ServiceGroup(group)] = d
+	}
+	return parsed, nil
+}
+
+type serviceGroupTimeoutsKey struct{}
+
+type serviceReconcileTimeoutKey struct{}
+
+// WithServiceGroupTimeouts returns a copy of ctx carrying the service group timeouts, which are applied to
+// the services reconciled with it by WithServiceGroupTimeout.
+func WithServiceGroupTimeouts(ctx context.Context, timeouts map[ServiceGroup]time.Duration) context.Context {
+	if len(timeouts) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, serviceGroupTimeoutsKey{}, timeouts)
+}
+
+// WithServiceGroupTimeout returns a copy of ctx to reconcile the Azure service with the given name.
+// When ctx carries a timeout for the group of the service, the copy is canceled once it elapses and
+// the timeout replaces the Azure service reconcile timeout of the service.
+func WithServiceGroupTimeout(ctx context.Context, serviceName string) (context.Context, context.CancelFunc) {
+	timeouts, _ := ctx.Value(serviceGroupTimeoutsKey{}).(map[ServiceGroup]time.Duration)
+	group, ok := ServiceGroupOf(serviceName)
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	timeout, ok := timeouts[group]
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(context.WithValue(ctx, serviceReconcileTimeoutKey{}, timeout), timeout)
+}
+
+// WithServiceReconcileTimeout returns a copy of ctx that is canceled once the Azure service reconcile timeout elapses.
+// The timeout of the service group set by WithServiceGroupTimeout takes precedence over the given timeout.
+func WithServiceReconcileTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if groupTimeout, ok := ctx.Value(serviceReconcileTimeoutKey{}).(time.Duration); ok {
+		timeout = groupTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+func TestParseServiceGroupTimeouts(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Subject     map[string]string
+		Expected    map[reconciler.ServiceGroup]time.Duration
+		ExpectedErr string
+	}{
+		{
+			Name:     "WithNoTimeouts",
+			Subject:  nil,
+			Expected: nil,
+		},
+		{
+			Name:    "WithValidTimeouts",
+			Subject: map[string]string{"bastion": "2m", "dns": "30s"},
+			Expected: map[reconciler.ServiceGroup]time.Duration{
+				reconciler.ServiceGroupBastion: 2 * time.Minute,
+				reconciler.ServiceGroupDNS:     30 * time.Second,
+			},
+		},
+		{
+			Name:        "WithUnknownGroup",
+			Subject:     map[string]string{"storage": "2m"},
+			ExpectedErr: `unknown service group "storage", expected one of [bastion, compute, dns, identity, managedcluster, networking, resources]`,
+		},
+		{
+			Name:        "WithInvalidDuration",
+			Subject:     map[string]string{"compute": "soon"},
+			ExpectedErr: `invalid timeout for service group "compute"`,
+		},
+		{
+			Name:        "WithNegativeDuration",
+			Subject:     map[string]string{"compute": "-1m"},
+			ExpectedErr: `timeout for service group "compute" must be positive`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			t.Parallel()
+			g := gomega.NewWithT(t)
+			actual, err := reconciler.ParseServiceGroupTimeouts(c.Subject)
+			if c.ExpectedErr != "" {
+				g.Expect(err).To(gomega.MatchError(gomega.ContainSubstring(c.ExpectedErr)))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(actual).To(gomega.Equal(c.Expected))
+		})
+	}
+}
+
+func TestWithServiceGroupTimeout(t *testing.T) {
+	g := gomega.NewWithT(t)
+	reconciler.RegisterServiceGroup("test-bastionhosts", reconciler.ServiceGroupBastion)
+	reconciler.RegisterServiceGroup("test-subnets", reconciler.ServiceGroupNetworking)
+	ctx := reconciler.WithServiceGroupTimeouts(context.Background(), map[reconciler.ServiceGroup]time.Duration{
+		reconciler.ServiceGroupBastion: time.Hour,
+	})
+
+	// The group timeout replaces the service reconcile timeout of the services in the group.
+	bastionCtx, cancel := reconciler.WithServiceGroupTimeout(ctx, "test-bastionhosts")
+	defer cancel()
+	serviceCtx, cancel := reconciler.WithServiceReconcileTimeout(bastionCtx, time.Second)
+	defer cancel()
+	deadline, ok := serviceCtx.Deadline()
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(time.Until(deadline)).To(gomega.BeNumerically(">", time.Minute))
+
+	// Services of other groups keep the service reconcile timeout.
+	subnetsCtx, cancel := reconciler.WithServiceGroupTimeout(ctx, "test-subnets")
+	defer cancel()
+	_, ok = subnetsCtx.Deadline()
+	g.Expect(ok).To(gomega.BeFalse())
+	serviceCtx, cancel = reconciler.WithServiceReconcileTimeout(subnetsCtx, time.Second)
+	defer cancel()
+	deadline, ok = serviceCtx.Deadline()
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(time.Until(deadline)).To(gomega.BeNumerically("<=", time.Second))
+}
+
+func TestServiceGroupOf(t *testing.T) {
+	g := gomega.NewWithT(t)
+	reconciler.RegisterServiceGroup("test-tags", reconciler.ServiceGroupResources)
+
+	group, ok := reconciler.ServiceGroupOf("test-tags")
+	g.Expect(ok).To(gomega.BeTrue())
+	g.Expect(group).To(gomega.Equal(reconciler.ServiceGroupResources))
+
+	_, ok = reconciler.ServiceGroupOf("test-unregistered")
+	g.Expect(ok).To(gomega.BeFalse())
+}
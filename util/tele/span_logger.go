@@ -175,8 +175,32 @@ func StartSpanWithLogger(
 	}
 
 	lggr := log.FromContext(ctx, kvs...).WithName(spanName)
-	return ctx, NewCompositeLogger([]logr.LogSink{
+	return ctx, spanLogger(ctx, lggr, span), endFn
+}
+
+// LoggerFrom returns a logger that composes the logger from the given
+// ctx and a logger that logs to the span of ctx, like the loggers
+// returned by StartSpanWithLogger. Use it to pick up the values added
+// by WithLogValues after the span was started:
+//
+//	ctx = WithLogValues(ctx, "cluster", clusterName)
+//	lggr = LoggerFrom(ctx)
+func LoggerFrom(ctx context.Context) logr.Logger {
+	return spanLogger(ctx, log.FromContext(ctx), trace.SpanFromContext(ctx))
+}
+
+func spanLogger(ctx context.Context, lggr logr.Logger, span trace.Span) logr.Logger {
+	return NewCompositeLogger([]logr.LogSink{
 		corrIDLogger(ctx, lggr).GetSink(),
 		NewSpanLogSink(span),
-	}), endFn
+	})
+}
+
+// WithLogValues returns a copy of ctx whose logger has the given
+// key-value pairs added. The loggers returned by StartSpanWithLogger
+// for the returned context and its descendants include them:
+//
+//	ctx = WithLogValues(ctx, "cluster", clusterName)
+func WithLogValues(ctx context.Context, keysAndValues ...interface{}) context.Context {
+	return log.IntoContext(ctx, log.FromContext(ctx).WithValues(keysAndValues...))
 }
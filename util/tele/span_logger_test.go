@@ -17,10 +17,13 @@ limitations under the License.
 package tele
 
 import (
+	"context"
 	"testing"
 
 	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
 	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 func TestSpanLogSinkWithValues(t *testing.T) {
@@ -46,3 +49,39 @@ func TestSpanLogSinkWithValues(t *testing.T) {
 	g.Expect(log1.(*spanLogSink).vals).To(HaveExactElements("k0", "v0", "k1", "v1"))
 	g.Expect(log2.(*spanLogSink).vals).To(HaveExactElements("k0", "v0", "k2", "v2"))
 }
+
+func TestWithLogValues(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var lines []string
+	ctx := log.IntoContext(context.Background(), funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{}))
+
+	ctx = WithLogValues(ctx, "subscriptionID", "123", "cluster", "my-cluster")
+	_, lggr, done := StartSpanWithLogger(ctx, "test")
+	defer done()
+	lggr.Info("reconciling")
+
+	g.Expect(lines).To(HaveLen(1))
+	g.Expect(lines[0]).To(ContainSubstring(`"subscriptionID"="123"`))
+	g.Expect(lines[0]).To(ContainSubstring(`"cluster"="my-cluster"`))
+}
+
+func TestLoggerFrom(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var lines []string
+	ctx := log.IntoContext(context.Background(), funcr.New(func(_, args string) {
+		lines = append(lines, args)
+	}, funcr.Options{}))
+
+	ctx, _, done := StartSpanWithLogger(ctx, "test")
+	defer done()
+	ctx = WithLogValues(ctx, "subscriptionID", "123")
+	LoggerFrom(ctx).Info("reconciling")
+
+	g.Expect(lines).To(HaveLen(1))
+	g.Expect(lines[0]).To(ContainSubstring(`"subscriptionID"="123"`))
+	g.Expect(lines[0]).To(ContainSubstring(string(CorrIDKeyVal)))
+}